@@ -0,0 +1,15 @@
+-- 既存のDBに、slugとslugの変更履歴を追加する。
+-- 既存のレコードには仮のslugを設定する。仮のslugは、レコードを更新するとNameから生成したslugに置き換わり、古いURLは転送される。
+ALTER TABLE programming_langs ADD COLUMN slug VARCHAR(64) DEFAULT NULL AFTER feature;
+UPDATE programming_langs SET slug = CONCAT('lang-', id) WHERE slug IS NULL;
+ALTER TABLE programming_langs MODIFY slug VARCHAR(64) NOT NULL;
+ALTER TABLE programming_langs ADD UNIQUE KEY uq_programming_langs_slug (slug);
+
+CREATE TABLE programming_lang_slug_histories (
+  slug VARCHAR(64) NOT NULL,
+  programming_lang_id bigint(20) unsigned NOT NULL,
+  created_at datetime DEFAULT NULL,
+  PRIMARY KEY (slug),
+  KEY idx_programming_lang_slug_histories_lang (programming_lang_id),
+  CONSTRAINT fk_programming_lang_slug_histories_lang FOREIGN KEY (programming_lang_id) REFERENCES programming_langs (id) ON DELETE CASCADE
+) DEFAULT CHARACTER SET utf8mb4;
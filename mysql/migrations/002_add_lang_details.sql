@@ -0,0 +1,13 @@
+-- 既存のDBに、ProgrammingLangの詳細な属性を追加する。
+-- 既存のレコードの詳細な属性は未設定(空文字、0、NULL)となり、APIでは省略される。
+-- designers、paradigms、extensionsは、文字列の配列をJSONとして保存する。
+ALTER TABLE programming_langs
+  ADD COLUMN first_appeared SMALLINT unsigned NOT NULL DEFAULT 0 AFTER slug,
+  ADD COLUMN designers TEXT AFTER first_appeared,
+  ADD COLUMN type_checking VARCHAR(16) NOT NULL DEFAULT '' AFTER designers,
+  ADD COLUMN type_strength VARCHAR(16) NOT NULL DEFAULT '' AFTER type_checking,
+  ADD COLUMN paradigms TEXT AFTER type_strength,
+  ADD COLUMN license VARCHAR(64) NOT NULL DEFAULT '' AFTER paradigms,
+  ADD COLUMN website VARCHAR(255) NOT NULL DEFAULT '' AFTER license,
+  ADD COLUMN extensions TEXT AFTER website,
+  ADD COLUMN stable_version VARCHAR(32) NOT NULL DEFAULT '' AFTER extensions;
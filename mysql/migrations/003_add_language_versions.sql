@@ -0,0 +1,15 @@
+-- 既存のDBに、ProgrammingLangのリリースされたバージョンを追加する。
+CREATE TABLE language_versions (
+  id bigint(20) unsigned NOT NULL AUTO_INCREMENT,
+  programming_lang_id bigint(20) unsigned NOT NULL,
+  version VARCHAR(64) NOT NULL,
+  release_date date NOT NULL,
+  eol_date date DEFAULT NULL,
+  lts tinyint(1) NOT NULL DEFAULT 0,
+  changelog_url VARCHAR(255) NOT NULL DEFAULT '',
+  created_at datetime DEFAULT NULL,
+  updated_at datetime DEFAULT NULL,
+  PRIMARY KEY (id),
+  UNIQUE KEY uq_language_versions_lang_version (programming_lang_id, version),
+  CONSTRAINT fk_language_versions_lang FOREIGN KEY (programming_lang_id) REFERENCES programming_langs (id) ON DELETE CASCADE
+) DEFAULT CHARACTER SET utf8mb4;
@@ -0,0 +1,18 @@
+-- 既存のDBに、ProgrammingLangを分類するタグを追加する。
+CREATE TABLE tags (
+  id bigint(20) unsigned NOT NULL AUTO_INCREMENT,
+  name VARCHAR(32) NOT NULL,
+  created_at datetime DEFAULT NULL,
+  updated_at datetime DEFAULT NULL,
+  PRIMARY KEY (id),
+  UNIQUE KEY uq_tags_name (name)
+) DEFAULT CHARACTER SET utf8mb4;
+
+CREATE TABLE programming_lang_tags (
+  programming_lang_id bigint(20) unsigned NOT NULL,
+  tag_id bigint(20) unsigned NOT NULL,
+  PRIMARY KEY (programming_lang_id, tag_id),
+  KEY idx_programming_lang_tags_tag (tag_id),
+  CONSTRAINT fk_programming_lang_tags_lang FOREIGN KEY (programming_lang_id) REFERENCES programming_langs (id) ON DELETE CASCADE,
+  CONSTRAINT fk_programming_lang_tags_tag FOREIGN KEY (tag_id) REFERENCES tags (id) ON DELETE CASCADE
+) DEFAULT CHARACTER SET utf8mb4;
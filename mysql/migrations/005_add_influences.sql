@@ -0,0 +1,10 @@
+-- 既存のDBに、ProgrammingLang同士の影響関係を追加する。
+CREATE TABLE programming_lang_influences (
+  programming_lang_id bigint(20) unsigned NOT NULL,
+  influenced_by_id bigint(20) unsigned NOT NULL,
+  created_at datetime DEFAULT NULL,
+  PRIMARY KEY (programming_lang_id, influenced_by_id),
+  KEY idx_programming_lang_influences_influenced_by (influenced_by_id),
+  CONSTRAINT fk_programming_lang_influences_lang FOREIGN KEY (programming_lang_id) REFERENCES programming_langs (id) ON DELETE CASCADE,
+  CONSTRAINT fk_programming_lang_influences_influenced_by FOREIGN KEY (influenced_by_id) REFERENCES programming_langs (id) ON DELETE CASCADE
+) DEFAULT CHARACTER SET utf8mb4;
@@ -0,0 +1,5 @@
+-- 既存のDBに、言語の推定に使用するファイル名とインタプリタの名前を追加する。
+-- 文字列の配列をJSONとして保存し、既存のレコードはNULL(未設定)となる。
+ALTER TABLE programming_langs
+  ADD COLUMN filenames TEXT AFTER extensions,
+  ADD COLUMN interpreters TEXT AFTER filenames;
@@ -0,0 +1,5 @@
+-- 既存のDBに、GitHub Linguistから取り込む別名と色を追加する。
+-- 別名は文字列の配列をJSONとして保存し、既存のレコードはNULL(未設定)となる。
+ALTER TABLE programming_langs
+  ADD COLUMN aliases TEXT AFTER interpreters,
+  ADD COLUMN color VARCHAR(7) NOT NULL DEFAULT '' AFTER aliases;
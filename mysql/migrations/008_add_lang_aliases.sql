@@ -0,0 +1,19 @@
+-- 既存のDBに、NameとAliasesを大文字と小文字の違いによらずに照合するためのキーを追加する。
+-- キーはアプリケーションでNFKCの正規化とケースフォールディングを行って保存するため、照合順序はバイナリとする。
+ALTER TABLE programming_langs
+  ADD COLUMN name_key VARCHAR(128) CHARACTER SET utf8mb4 COLLATE utf8mb4_bin NOT NULL DEFAULT '' AFTER name,
+  ADD KEY idx_programming_langs_name_key (name_key);
+
+-- LOWERは英字のみを畳み込むため、英字以外を含むNameのキーは、012の適用時にserver migrateが正しいキーに埋め直す。
+UPDATE programming_langs SET name_key=LOWER(TRIM(name));
+
+-- aliasesのカラムの値は、012の適用時にserver migrateがこのテーブルに移す。
+CREATE TABLE programming_lang_aliases (
+  alias_key VARCHAR(128) CHARACTER SET utf8mb4 COLLATE utf8mb4_bin NOT NULL,
+  programming_lang_id bigint(20) unsigned NOT NULL,
+  alias VARCHAR(32) NOT NULL,
+  created_at datetime DEFAULT NULL,
+  PRIMARY KEY (alias_key),
+  KEY idx_programming_lang_aliases_lang (programming_lang_id),
+  CONSTRAINT fk_programming_lang_aliases_lang FOREIGN KEY (programming_lang_id) REFERENCES programming_langs (id) ON DELETE CASCADE
+) DEFAULT CHARACTER SET utf8mb4;
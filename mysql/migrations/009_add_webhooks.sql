@@ -0,0 +1,43 @@
+-- 既存のDBに、ProgrammingLangの変更を通知するWebhookと、その通知と送信の記録を追加する。
+-- Webhookを削除した場合は、通知と送信の記録も外部キーにより削除する。
+CREATE TABLE webhooks (
+  id bigint(20) unsigned NOT NULL AUTO_INCREMENT,
+  url VARCHAR(255) NOT NULL,
+  secret VARCHAR(128) NOT NULL,
+  event_types TEXT DEFAULT NULL,
+  disabled tinyint(1) NOT NULL DEFAULT 0,
+  created_at datetime DEFAULT NULL,
+  updated_at datetime DEFAULT NULL,
+  PRIMARY KEY (id)
+) DEFAULT CHARACTER SET utf8mb4;
+
+CREATE TABLE webhook_deliveries (
+  id bigint(20) unsigned NOT NULL AUTO_INCREMENT,
+  webhook_id bigint(20) unsigned NOT NULL,
+  event_type VARCHAR(16) NOT NULL,
+  payload MEDIUMTEXT NOT NULL,
+  status VARCHAR(16) NOT NULL,
+  attempts int(11) NOT NULL DEFAULT 0,
+  next_attempt_at datetime NOT NULL,
+  last_status_code int(11) NOT NULL DEFAULT 0,
+  last_error VARCHAR(255) NOT NULL DEFAULT '',
+  created_at datetime DEFAULT NULL,
+  updated_at datetime DEFAULT NULL,
+  PRIMARY KEY (id),
+  KEY idx_webhook_deliveries_webhook (webhook_id, status),
+  KEY idx_webhook_deliveries_due (status, next_attempt_at),
+  CONSTRAINT fk_webhook_deliveries_webhook FOREIGN KEY (webhook_id) REFERENCES webhooks (id) ON DELETE CASCADE
+) DEFAULT CHARACTER SET utf8mb4;
+
+CREATE TABLE webhook_delivery_attempts (
+  id bigint(20) unsigned NOT NULL AUTO_INCREMENT,
+  delivery_id bigint(20) unsigned NOT NULL,
+  attempt int(11) NOT NULL,
+  status_code int(11) NOT NULL DEFAULT 0,
+  error VARCHAR(255) NOT NULL DEFAULT '',
+  duration_ms int(11) NOT NULL DEFAULT 0,
+  attempted_at datetime NOT NULL,
+  PRIMARY KEY (id),
+  KEY idx_webhook_delivery_attempts_delivery (delivery_id),
+  CONSTRAINT fk_webhook_delivery_attempts_delivery FOREIGN KEY (delivery_id) REFERENCES webhook_deliveries (id) ON DELETE CASCADE
+) DEFAULT CHARACTER SET utf8mb4;
@@ -0,0 +1,14 @@
+-- 既存のDBに、ProgrammingLangの変更と同じトランザクションで領域イベントを記録するOutboxを追加する。
+-- 配信を終えたイベントは、Relayが削除する。
+CREATE TABLE outbox_events (
+  id bigint(20) unsigned NOT NULL AUTO_INCREMENT,
+  event_name VARCHAR(32) NOT NULL,
+  aggregate_id bigint(20) unsigned NOT NULL,
+  payload MEDIUMTEXT NOT NULL,
+  attempts int(11) NOT NULL DEFAULT 0,
+  next_attempt_at datetime NOT NULL,
+  last_error VARCHAR(255) NOT NULL DEFAULT '',
+  created_at datetime DEFAULT NULL,
+  PRIMARY KEY (id),
+  KEY idx_outbox_events_due (next_attempt_at)
+) DEFAULT CHARACTER SET utf8mb4;
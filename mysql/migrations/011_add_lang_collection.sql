@@ -0,0 +1,7 @@
+-- 既存のDBに、ProgrammingLangを最後に削除した日時を記録するテーブルを追加する。
+-- 削除したレコードのupdated_atは残らないため、一覧の最終更新日時はMAX(updated_at)とこの日時の新しい方とする。
+CREATE TABLE programming_lang_collection (
+  id tinyint(3) unsigned NOT NULL,
+  last_deleted_at datetime NOT NULL,
+  PRIMARY KEY (id)
+) DEFAULT CHARACTER SET utf8mb4;
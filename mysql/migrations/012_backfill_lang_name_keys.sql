@@ -0,0 +1,4 @@
+-- 既存のDBで、NameとAliasesの照合用のキーを、アプリケーションと同じNFKCの正規化とケースフォールディングで埋め直す。
+-- SQLでは同じ正規化を行えないため、このファイルには文がなく、server migrateがこのファイルを適用した後にGoで行う。
+-- 全てのProgrammingLangのname_keyを計算し直し、aliasesのカラムの別名をprogramming_lang_aliasesに移す。
+-- 同じキーの別名を複数のProgrammingLangが持つ場合は失敗するため、どちらかの別名をPUTで消してから再実行する。
@@ -0,0 +1,5 @@
+-- 既存のDBで、大文字と小文字の違いによらずに同じNameのProgrammingLangを保存できないようにする。
+-- 012でname_keyを埋め直した後に適用する。同じキーのNameが複数ある場合は失敗するため、どちらかのNameをPUTで変えてから再実行する。
+ALTER TABLE programming_langs
+  DROP KEY idx_programming_langs_name_key,
+  ADD UNIQUE KEY uq_programming_langs_name_key (name_key);
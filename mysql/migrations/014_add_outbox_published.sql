@@ -0,0 +1,5 @@
+-- 既存のDBで、領域イベントの配信に成功した配信先をOutboxに記録し、失敗した配信先にだけ配信し直せるようにする。
+-- 同じProgrammingLangの領域イベントを記録した順に取得するため、aggregate_idのインデックスも追加する。
+ALTER TABLE outbox_events
+  ADD COLUMN published TEXT AFTER payload,
+  ADD KEY idx_outbox_events_aggregate (aggregate_id, id);
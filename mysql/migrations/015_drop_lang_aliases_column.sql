@@ -0,0 +1,12 @@
+-- 既存のDBで、別名をprogramming_lang_aliasesだけに保存し、programming_langsのaliasesのカラムを削除する。
+-- 012でaliasesのカラムの別名を移した後に適用する。別名を保存した順に返せるよう、カラムの中での位置をpositionに移す。
+ALTER TABLE programming_lang_aliases
+  ADD COLUMN position SMALLINT unsigned NOT NULL DEFAULT 0 AFTER alias;
+
+-- JSON_SEARCHはLIKEのパターンで比較するため、別名の%と_と\をエスケープする。
+UPDATE programming_lang_aliases a
+  JOIN programming_langs l ON l.id = a.programming_lang_id
+  SET a.position = CAST(SUBSTRING_INDEX(SUBSTRING_INDEX(JSON_UNQUOTE(JSON_SEARCH(l.aliases, 'one', REPLACE(REPLACE(REPLACE(a.alias, '\\', '\\\\'), '%', '\\%'), '_', '\\_'))), '[', -1), ']', 1) AS UNSIGNED)
+  WHERE l.aliases IS NOT NULL AND l.aliases != '' AND JSON_SEARCH(l.aliases, 'one', REPLACE(REPLACE(REPLACE(a.alias, '\\', '\\\\'), '%', '\\%'), '_', '\\_')) IS NOT NULL;
+
+ALTER TABLE programming_langs DROP COLUMN aliases;
@@ -1,12 +1,194 @@
 CREATE TABLE programming_langs (
   id bigint(20) unsigned NOT NULL AUTO_INCREMENT,
   name VARCHAR(20) NOT NULL,
+  name_key VARCHAR(128) NOT NULL DEFAULT '',
   feature TEXT,
+  slug VARCHAR(64) NOT NULL,
+  first_appeared SMALLINT unsigned NOT NULL DEFAULT 0,
+  designers TEXT,
+  type_checking VARCHAR(16) NOT NULL DEFAULT '',
+  type_strength VARCHAR(16) NOT NULL DEFAULT '',
+  paradigms TEXT,
+  license VARCHAR(64) NOT NULL DEFAULT '',
+  website VARCHAR(255) NOT NULL DEFAULT '',
+  extensions TEXT,
+  filenames TEXT,
+  interpreters TEXT,
+  color VARCHAR(7) NOT NULL DEFAULT '',
+  stable_version VARCHAR(32) NOT NULL DEFAULT '',
+  created_at datetime DEFAULT NULL,
+  updated_at datetime DEFAULT NULL,
+  PRIMARY KEY (id),
+  UNIQUE KEY uq_programming_langs_slug (slug),
+  UNIQUE KEY uq_programming_langs_name_key (name_key)
+);
+
+CREATE TABLE programming_lang_slug_histories (
+  slug VARCHAR(64) NOT NULL,
+  programming_lang_id bigint(20) unsigned NOT NULL,
+  created_at datetime DEFAULT NULL,
+  PRIMARY KEY (slug),
+  KEY idx_programming_lang_slug_histories_lang (programming_lang_id),
+  CONSTRAINT fk_programming_lang_slug_histories_lang FOREIGN KEY (programming_lang_id) REFERENCES programming_langs (id) ON DELETE CASCADE
+);
+
+CREATE TABLE language_versions (
+  id bigint(20) unsigned NOT NULL AUTO_INCREMENT,
+  programming_lang_id bigint(20) unsigned NOT NULL,
+  version VARCHAR(64) NOT NULL,
+  release_date date NOT NULL,
+  eol_date date DEFAULT NULL,
+  lts tinyint(1) NOT NULL DEFAULT 0,
+  changelog_url VARCHAR(255) NOT NULL DEFAULT '',
+  created_at datetime DEFAULT NULL,
+  updated_at datetime DEFAULT NULL,
+  PRIMARY KEY (id),
+  UNIQUE KEY uq_language_versions_lang_version (programming_lang_id, version),
+  CONSTRAINT fk_language_versions_lang FOREIGN KEY (programming_lang_id) REFERENCES programming_langs (id) ON DELETE CASCADE
+);
+
+CREATE TABLE tags (
+  id bigint(20) unsigned NOT NULL AUTO_INCREMENT,
+  name VARCHAR(32) NOT NULL,
+  created_at datetime DEFAULT NULL,
+  updated_at datetime DEFAULT NULL,
+  PRIMARY KEY (id),
+  UNIQUE KEY uq_tags_name (name)
+);
+
+CREATE TABLE programming_lang_tags (
+  programming_lang_id bigint(20) unsigned NOT NULL,
+  tag_id bigint(20) unsigned NOT NULL,
+  PRIMARY KEY (programming_lang_id, tag_id),
+  KEY idx_programming_lang_tags_tag (tag_id),
+  CONSTRAINT fk_programming_lang_tags_lang FOREIGN KEY (programming_lang_id) REFERENCES programming_langs (id) ON DELETE CASCADE,
+  CONSTRAINT fk_programming_lang_tags_tag FOREIGN KEY (tag_id) REFERENCES tags (id) ON DELETE CASCADE
+);
+
+CREATE TABLE programming_lang_influences (
+  programming_lang_id bigint(20) unsigned NOT NULL,
+  influenced_by_id bigint(20) unsigned NOT NULL,
+  created_at datetime DEFAULT NULL,
+  PRIMARY KEY (programming_lang_id, influenced_by_id),
+  KEY idx_programming_lang_influences_influenced_by (influenced_by_id),
+  CONSTRAINT fk_programming_lang_influences_lang FOREIGN KEY (programming_lang_id) REFERENCES programming_langs (id) ON DELETE CASCADE,
+  CONSTRAINT fk_programming_lang_influences_influenced_by FOREIGN KEY (influenced_by_id) REFERENCES programming_langs (id) ON DELETE CASCADE
+);
+
+CREATE TABLE programming_lang_aliases (
+  alias_key VARCHAR(128) NOT NULL,
+  programming_lang_id bigint(20) unsigned NOT NULL,
+  alias VARCHAR(32) NOT NULL,
+  position SMALLINT unsigned NOT NULL DEFAULT 0,
+  created_at datetime DEFAULT NULL,
+  PRIMARY KEY (alias_key),
+  KEY idx_programming_lang_aliases_lang (programming_lang_id),
+  CONSTRAINT fk_programming_lang_aliases_lang FOREIGN KEY (programming_lang_id) REFERENCES programming_langs (id) ON DELETE CASCADE
+);
+
+CREATE TABLE webhooks (
+  id bigint(20) unsigned NOT NULL AUTO_INCREMENT,
+  url VARCHAR(255) NOT NULL,
+  secret VARCHAR(128) NOT NULL,
+  event_types TEXT DEFAULT NULL,
+  disabled tinyint(1) NOT NULL DEFAULT 0,
   created_at datetime DEFAULT NULL,
   updated_at datetime DEFAULT NULL,
   PRIMARY KEY (id)
 );
 
+CREATE TABLE webhook_deliveries (
+  id bigint(20) unsigned NOT NULL AUTO_INCREMENT,
+  webhook_id bigint(20) unsigned NOT NULL,
+  event_type VARCHAR(16) NOT NULL,
+  payload MEDIUMTEXT NOT NULL,
+  status VARCHAR(16) NOT NULL,
+  attempts int(11) NOT NULL DEFAULT 0,
+  next_attempt_at datetime NOT NULL,
+  last_status_code int(11) NOT NULL DEFAULT 0,
+  last_error VARCHAR(255) NOT NULL DEFAULT '',
+  created_at datetime DEFAULT NULL,
+  updated_at datetime DEFAULT NULL,
+  PRIMARY KEY (id),
+  KEY idx_webhook_deliveries_webhook (webhook_id, status),
+  KEY idx_webhook_deliveries_due (status, next_attempt_at),
+  CONSTRAINT fk_webhook_deliveries_webhook FOREIGN KEY (webhook_id) REFERENCES webhooks (id) ON DELETE CASCADE
+);
+
+CREATE TABLE webhook_delivery_attempts (
+  id bigint(20) unsigned NOT NULL AUTO_INCREMENT,
+  delivery_id bigint(20) unsigned NOT NULL,
+  attempt int(11) NOT NULL,
+  status_code int(11) NOT NULL DEFAULT 0,
+  error VARCHAR(255) NOT NULL DEFAULT '',
+  duration_ms int(11) NOT NULL DEFAULT 0,
+  attempted_at datetime NOT NULL,
+  PRIMARY KEY (id),
+  KEY idx_webhook_delivery_attempts_delivery (delivery_id),
+  CONSTRAINT fk_webhook_delivery_attempts_delivery FOREIGN KEY (delivery_id) REFERENCES webhook_deliveries (id) ON DELETE CASCADE
+);
+
+CREATE TABLE outbox_events (
+  id bigint(20) unsigned NOT NULL AUTO_INCREMENT,
+  event_name VARCHAR(32) NOT NULL,
+  aggregate_id bigint(20) unsigned NOT NULL,
+  payload MEDIUMTEXT NOT NULL,
+  published TEXT,
+  attempts int(11) NOT NULL DEFAULT 0,
+  next_attempt_at datetime NOT NULL,
+  last_error VARCHAR(255) NOT NULL DEFAULT '',
+  created_at datetime DEFAULT NULL,
+  PRIMARY KEY (id),
+  KEY idx_outbox_events_due (next_attempt_at),
+  KEY idx_outbox_events_aggregate (aggregate_id, id)
+);
+
+CREATE TABLE programming_lang_collection (
+  id tinyint(3) unsigned NOT NULL,
+  last_deleted_at datetime NOT NULL,
+  PRIMARY KEY (id)
+);
+
 ALTER DATABASE sample CHARACTER SET utf8mb4;
 ALTER TABLE programming_langs CONVERT TO CHARACTER SET utf8mb4;
+ALTER TABLE programming_lang_slug_histories CONVERT TO CHARACTER SET utf8mb4;
+ALTER TABLE language_versions CONVERT TO CHARACTER SET utf8mb4;
+ALTER TABLE tags CONVERT TO CHARACTER SET utf8mb4;
+ALTER TABLE programming_lang_tags CONVERT TO CHARACTER SET utf8mb4;
+ALTER TABLE programming_lang_influences CONVERT TO CHARACTER SET utf8mb4;
+ALTER TABLE programming_lang_aliases CONVERT TO CHARACTER SET utf8mb4;
+ALTER TABLE webhooks CONVERT TO CHARACTER SET utf8mb4;
+ALTER TABLE webhook_deliveries CONVERT TO CHARACTER SET utf8mb4;
+ALTER TABLE webhook_delivery_attempts CONVERT TO CHARACTER SET utf8mb4;
+ALTER TABLE outbox_events CONVERT TO CHARACTER SET utf8mb4;
+ALTER TABLE programming_lang_collection CONVERT TO CHARACTER SET utf8mb4;
+
+-- 照合用のキーはアプリケーションで正規化済みのため、DBの照合順序で異なるキーが同一視されないようにする。
+ALTER TABLE programming_langs MODIFY name_key VARCHAR(128) CHARACTER SET utf8mb4 COLLATE utf8mb4_bin NOT NULL DEFAULT '';
+ALTER TABLE programming_lang_aliases MODIFY alias_key VARCHAR(128) CHARACTER SET utf8mb4 COLLATE utf8mb4_bin NOT NULL;
+
+-- 上記のテーブルは、mysql/migrationsのマイグレーションをすべて適用した状態のため、適用済みとして記録する。
+-- 以降のマイグレーションは、server migrateで適用する。
+CREATE TABLE schema_migrations (
+  version bigint(20) unsigned NOT NULL,
+  name VARCHAR(128) NOT NULL,
+  applied_at datetime NOT NULL,
+  PRIMARY KEY (version)
+) DEFAULT CHARACTER SET utf8mb4;
 
+INSERT INTO schema_migrations (version, name, applied_at) VALUES
+  (1, 'add_slug', NOW()),
+  (2, 'add_lang_details', NOW()),
+  (3, 'add_language_versions', NOW()),
+  (4, 'add_tags', NOW()),
+  (5, 'add_influences', NOW()),
+  (6, 'add_lang_detection', NOW()),
+  (7, 'add_lang_aliases_and_color', NOW()),
+  (8, 'add_lang_aliases', NOW()),
+  (9, 'add_webhooks', NOW()),
+  (10, 'add_outbox_events', NOW()),
+  (11, 'add_lang_collection', NOW()),
+  (12, 'backfill_lang_name_keys', NOW()),
+  (13, 'add_unique_lang_name_key', NOW()),
+  (14, 'add_outbox_published', NOW()),
+  (15, 'drop_lang_aliases_column', NOW());
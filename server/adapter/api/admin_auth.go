@@ -0,0 +1,35 @@
+package api
+
+import (
+	"crypto/subtle"
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+)
+
+// AdminAuth は、管理用のAPIへのリクエストをAPIキーで認証するミドルウェア。
+type AdminAuth struct {
+	Key string
+}
+
+// NewAdminAuth は、AdminAuthを生成し、返す。keyが空の場合は、管理用のAPIを無効にする。
+func NewAdminAuth(key string) *AdminAuth {
+	return &AdminAuth{
+		Key: key,
+	}
+}
+
+// Handle は、X-API-Keyヘッダーの値が管理用のAPIキーと一致するリクエストのみを許可する。
+func (a *AdminAuth) Handle(c *gin.Context) {
+	if a.Key == "" {
+		c.AbortWithStatusJSON(http.StatusForbidden, AdminDisabledErr)
+		return
+	}
+
+	if subtle.ConstantTimeCompare([]byte(c.GetHeader(APIKeyHeader)), []byte(a.Key)) != 1 {
+		c.AbortWithStatusJSON(http.StatusUnauthorized, UnauthorizedErr)
+		return
+	}
+
+	c.Next()
+}
@@ -1,7 +1,10 @@
 package api
 
 import (
+	"encoding/base64"
 	"strconv"
+	"strings"
+	"time"
 
 	"github.com/SekiguchiKai/clean-architecture-with-go/server/domain/model"
 	"github.com/gin-gonic/gin"
@@ -21,6 +24,19 @@ func getID(c *gin.Context) (int, error) {
 	return id, nil
 }
 
+// getSubID は、URLからサブリソースのIDの値を取得する。
+func getSubID(c *gin.Context) (int, error) {
+	id, err := strconv.Atoi(c.Param(SubID))
+	if err != nil {
+		return -1, &model.InvalidParameterError{
+			Parameter: SubID,
+			Message:   IDShouldBeIntErr,
+		}
+	}
+
+	return id, nil
+}
+
 // getLimit は、Query StringからLimitの値を取得する。
 func getLimit(c *gin.Context) (int, error) {
 	var err error
@@ -40,6 +56,89 @@ func getLimit(c *gin.Context) (int, error) {
 	return limit, nil
 }
 
+// getIntQuery は、Query Stringから整数の値を取得する。
+func getIntQuery(c *gin.Context, parameter string) (int, error) {
+	v, err := strconv.Atoi(c.Query(parameter))
+	if err != nil {
+		return -1, &model.InvalidParameterError{
+			Parameter: parameter,
+			Message:   ShouldBeIntErr,
+		}
+	}
+
+	return v, nil
+}
+
+// getDepth は、Query Stringから影響関係をたどる距離を取得する。指定されていない場合は、DefaultInfluenceDepthを返す。
+func getDepth(c *gin.Context) (int, error) {
+	if c.Query(Depth) == "" {
+		return DefaultInfluenceDepth, nil
+	}
+
+	depth, err := getIntQuery(c, Depth)
+	if err != nil {
+		return -1, err
+	}
+
+	if depth < 1 || MaxInfluenceDepth < depth {
+		return -1, &model.InvalidParameterError{
+			Parameter: Depth,
+			Message:   DepthErr,
+		}
+	}
+
+	return depth, nil
+}
+
+// getDate は、YYYY-MM-DDの形式の日付をUTCのtime.Timeに変換する。
+func getDate(s, parameter string) (time.Time, error) {
+	date, err := time.Parse(DateLayout, s)
+	if err != nil {
+		return time.Time{}, &model.InvalidParameterError{
+			Parameter: parameter,
+			Message:   DateShouldBeDateErr,
+		}
+	}
+	return date, nil
+}
+
+// getTagMatch は、Query StringからTagMatchの値を取得する。指定されていない場合は、allを返す。
+func getTagMatch(c *gin.Context) (model.TagMatch, error) {
+	switch m := model.TagMatch(c.Query(TagMatch)); m {
+	case "", model.TagMatchAll:
+		return model.TagMatchAll, nil
+	case model.TagMatchAny:
+		return m, nil
+	default:
+		return "", &model.InvalidParameterError{
+			Parameter: TagMatch,
+			Message:   TagMatchErr,
+		}
+	}
+}
+
+// getCursor は、Query Stringのカーソルから、一覧を続きから取得するためのNameを取り出す。指定されていない場合は、空文字を返す。
+func getCursor(c *gin.Context) (string, error) {
+	cursor := c.Query(Cursor)
+	if cursor == "" {
+		return "", nil
+	}
+
+	b, err := base64.URLEncoding.DecodeString(cursor)
+	if err != nil || !strings.HasPrefix(string(b), CursorPrefix) {
+		return "", &model.InvalidParameterError{
+			Parameter: Cursor,
+			Message:   InvalidCursorErr,
+		}
+	}
+	return strings.TrimPrefix(string(b), CursorPrefix), nil
+}
+
+// encodeCursor は、一覧の最後のNameからカーソルを生成し、返す。
+func encodeCursor(name string) string {
+	return base64.URLEncoding.EncodeToString([]byte(CursorPrefix + name))
+}
+
 // ManageLimit は、Limitを制御する。
 func ManageLimit(targetLimit, maxLimit, minLimit, defaultLimit int) int {
 	if  maxLimit < targetLimit ||  targetLimit < minLimit {
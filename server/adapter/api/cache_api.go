@@ -0,0 +1,55 @@
+package api
+
+import (
+	"net/http"
+
+	"github.com/SekiguchiKai/clean-architecture-with-go/server/domain/model"
+	"github.com/gin-gonic/gin"
+)
+
+// CacheStats は、キャッシュの統計情報のレスポンス。
+type CacheStats struct {
+	Hits      uint64 `json:"hits"`
+	Misses    uint64 `json:"misses"`
+	Shared    uint64 `json:"shared"`
+	Evictions uint64 `json:"evictions"`
+	Size      int    `json:"size"`
+}
+
+// NewCacheStats は、キャッシュの統計情報からレスポンスを生成する。
+func NewCacheStats(stats model.CacheStats) CacheStats {
+	return CacheStats{
+		Hits:      stats.Hits,
+		Misses:    stats.Misses,
+		Shared:    stats.Shared,
+		Evictions: stats.Evictions,
+		Size:      stats.Size,
+	}
+}
+
+// CacheStatsProvider は、キャッシュの統計情報を提供する。
+type CacheStatsProvider interface {
+	Stats() model.CacheStats
+}
+
+// CacheAPI は、キャッシュの統計情報を返す管理用のAPI。
+type CacheAPI struct {
+	Provider CacheStatsProvider
+}
+
+// NewCacheAPI は、CacheAPIを生成し、返す。
+func NewCacheAPI(provider CacheStatsProvider) *CacheAPI {
+	return &CacheAPI{
+		Provider: provider,
+	}
+}
+
+// InitAPI は、APIを初期設定する。adminには、管理用のAPIの認証を設定したグループを渡す。
+func (api *CacheAPI) InitAPI(admin *gin.RouterGroup) {
+	admin.GET(CacheStatsPath, api.Stats)
+}
+
+// Stats は、キャッシュの統計情報を返す。
+func (api *CacheAPI) Stats(c *gin.Context) {
+	c.JSON(http.StatusOK, NewCacheStats(api.Provider.Stats()))
+}
@@ -0,0 +1,49 @@
+package api_test
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/SekiguchiKai/clean-architecture-with-go/server/adapter/api"
+	"github.com/SekiguchiKai/clean-architecture-with-go/server/domain/model"
+	"github.com/gin-gonic/gin"
+)
+
+// stubCacheStatsProvider は、固定の統計情報を返すCacheStatsProvider。
+type stubCacheStatsProvider struct {
+	stats model.CacheStats
+}
+
+// Stats は、固定の統計情報を返す。
+func (p *stubCacheStatsProvider) Stats() model.CacheStats {
+	return p.stats
+}
+
+func TestCacheAPI_Stats(t *testing.T) {
+	want := api.CacheStats{Hits: 10, Misses: 3, Shared: 1, Evictions: 2, Size: 5}
+	stats := model.CacheStats{Hits: 10, Misses: 3, Shared: 1, Evictions: 2, Size: 5}
+
+	r := gin.New()
+	api.NewCacheAPI(&stubCacheStatsProvider{stats: stats}).InitAPI(r.Group(api.V1Path + api.AdminAPIPath))
+
+	rec := httptest.NewRecorder()
+	req, err := http.NewRequest(api.Get, api.V1Path+api.AdminAPIPath+api.CacheStatsPath, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	r.ServeHTTP(rec, req)
+
+	if rec.Code != http.StatusOK {
+		t.Errorf("Status Code = %v, want %v", rec.Code, http.StatusOK)
+	}
+
+	var got api.CacheStats
+	if err := json.Unmarshal(rec.Body.Bytes(), &got); err != nil {
+		t.Fatal(err)
+	}
+	if got != want {
+		t.Errorf("body = %+v, want %+v", got, want)
+	}
+}
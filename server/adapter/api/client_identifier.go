@@ -0,0 +1,140 @@
+package api
+
+import (
+	"crypto/sha256"
+	"crypto/subtle"
+	"encoding/hex"
+	"net"
+	"net/http"
+	"strings"
+
+	"github.com/pkg/errors"
+)
+
+// clientKeyFingerprintBytes は、APIキーの代わりにキーとして使用するハッシュのバイト数。
+const clientKeyFingerprintBytes = 8
+
+// forwardedForHeader は、プロキシが送信元のIPアドレスを追加するヘッダー。
+const forwardedForHeader = "X-Forwarded-For"
+
+// ClientIdentifier は、リクエストの送信元を識別する。
+// 登録済みのAPIキーのみを信用し、X-Forwarded-Forは信頼するプロキシから届いたリクエストの場合のみ参照する。
+type ClientIdentifier struct {
+	APIKeys        []string
+	TrustedProxies []*net.IPNet
+}
+
+// NewClientIdentifier は、ClientIdentifierを生成し、返す。空のAPIキーは無視する。
+func NewClientIdentifier(apiKeys []string, trustedProxies []*net.IPNet) *ClientIdentifier {
+	keys := make([]string, 0, len(apiKeys))
+	for _, k := range apiKeys {
+		if k != "" {
+			keys = append(keys, k)
+		}
+	}
+	return &ClientIdentifier{
+		APIKeys:        keys,
+		TrustedProxies: trustedProxies,
+	}
+}
+
+// Identify は、登録済みのAPIキー、もしくはクライアントのIPアドレスから、送信元を識別するキーを返す。
+// 登録されていないAPIキーは、指定されていないものとして扱う。
+func (ci *ClientIdentifier) Identify(r *http.Request) string {
+	if key, ok := ci.Authenticated(r); ok {
+		return key
+	}
+	return "ip:" + ci.ClientIP(r)
+}
+
+// Authenticated は、X-API-Keyヘッダーが登録済みのAPIキーと一致する場合に、そのキーを識別するキーを返す。
+// APIキーそのものは保持しないよう、ハッシュの先頭を使用する。
+func (ci *ClientIdentifier) Authenticated(r *http.Request) (string, bool) {
+	apiKey := r.Header.Get(APIKeyHeader)
+	if apiKey == "" {
+		return "", false
+	}
+
+	for _, k := range ci.APIKeys {
+		if subtle.ConstantTimeCompare([]byte(apiKey), []byte(k)) == 1 {
+			sum := sha256.Sum256([]byte(k))
+			return "key:" + hex.EncodeToString(sum[:clientKeyFingerprintBytes]), true
+		}
+	}
+	return "", false
+}
+
+// ClientIP は、クライアントのIPアドレスを返す。
+// 接続元が信頼するプロキシの場合は、X-Forwarded-Forを右から辿り、信頼するプロキシではない最初のIPアドレスを返す。
+func (ci *ClientIdentifier) ClientIP(r *http.Request) string {
+	remote := remoteIP(r.RemoteAddr)
+	if !ci.trusted(remote) {
+		return remote
+	}
+
+	hops := strings.Split(strings.Join(r.Header[forwardedForHeader], ","), ",")
+	for i := len(hops) - 1; i >= 0; i-- {
+		hop := strings.TrimSpace(hops[i])
+		if net.ParseIP(hop) == nil {
+			break
+		}
+		if !ci.trusted(hop) {
+			return hop
+		}
+		remote = hop
+	}
+	return remote
+}
+
+// trusted は、ipが信頼するプロキシのIPアドレスかどうかを確認する。
+func (ci *ClientIdentifier) trusted(ip string) bool {
+	parsed := net.ParseIP(ip)
+	if parsed == nil {
+		return false
+	}
+	for _, n := range ci.TrustedProxies {
+		if n.Contains(parsed) {
+			return true
+		}
+	}
+	return false
+}
+
+// remoteIP は、RemoteAddrからポートを取り除いたIPアドレスを返す。
+func remoteIP(addr string) string {
+	if host, _, err := net.SplitHostPort(addr); err == nil {
+		return host
+	}
+	return addr
+}
+
+// ParseTrustedProxies は、カンマ区切りのIPアドレスもしくはCIDRを読み込む。
+func ParseTrustedProxies(s string) ([]*net.IPNet, error) {
+	var nets []*net.IPNet
+	for _, v := range strings.Split(s, ",") {
+		v = strings.TrimSpace(v)
+		if v == "" {
+			continue
+		}
+
+		if !strings.Contains(v, "/") {
+			ip := net.ParseIP(v)
+			if ip == nil {
+				return nil, errors.Errorf("invalid IP address: %s", v)
+			}
+			bits := 8 * net.IPv6len
+			if ip.To4() != nil {
+				ip, bits = ip.To4(), 8*net.IPv4len
+			}
+			nets = append(nets, &net.IPNet{IP: ip, Mask: net.CIDRMask(bits, bits)})
+			continue
+		}
+
+		_, n, err := net.ParseCIDR(v)
+		if err != nil {
+			return nil, errors.Errorf("invalid CIDR: %s", v)
+		}
+		nets = append(nets, n)
+	}
+	return nets, nil
+}
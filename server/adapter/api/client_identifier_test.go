@@ -0,0 +1,147 @@
+package api_test
+
+import (
+	"net/http"
+	"testing"
+
+	"github.com/SekiguchiKai/clean-architecture-with-go/server/adapter/api"
+)
+
+func TestClientIdentifier_ClientIP(t *testing.T) {
+	proxies, err := api.ParseTrustedProxies("10.0.0.0/8, 192.0.2.10")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	tests := []struct {
+		name         string
+		remoteAddr   string
+		forwardedFor []string
+		want         string
+	}{
+		{
+			name:       "X-Forwarded-Forが指定されていない場合、接続元のIPアドレスを返すこと",
+			remoteAddr: "198.51.100.7:1234",
+			want:       "198.51.100.7",
+		},
+		{
+			name:         "接続元が信頼するプロキシではない場合、X-Forwarded-Forを無視すること",
+			remoteAddr:   "198.51.100.7:1234",
+			forwardedFor: []string{"203.0.113.9"},
+			want:         "198.51.100.7",
+		},
+		{
+			name:         "接続元が信頼するプロキシの場合、X-Forwarded-Forの信頼するプロキシではない最後のアドレスを返すこと",
+			remoteAddr:   "192.0.2.10:1234",
+			forwardedFor: []string{"203.0.113.9, 198.51.100.7", "10.1.2.3"},
+			want:         "198.51.100.7",
+		},
+		{
+			name:         "X-Forwarded-Forに不正な値が含まれる場合、その手前までで判断すること",
+			remoteAddr:   "10.0.0.1:1234",
+			forwardedFor: []string{"203.0.113.9, unknown, 10.0.0.2"},
+			want:         "10.0.0.2",
+		},
+		{
+			name:         "X-Forwarded-Forがすべて信頼するプロキシの場合、最初のアドレスを返すこと",
+			remoteAddr:   "10.0.0.1:1234",
+			forwardedFor: []string{"10.0.0.3, 10.0.0.2"},
+			want:         "10.0.0.3",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ci := api.NewClientIdentifier(nil, proxies)
+			r := &http.Request{RemoteAddr: tt.remoteAddr, Header: http.Header{}}
+			for _, v := range tt.forwardedFor {
+				r.Header.Add("X-Forwarded-For", v)
+			}
+
+			if got := ci.ClientIP(r); got != tt.want {
+				t.Errorf("ClientIdentifier.ClientIP() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestClientIdentifier_Authenticated(t *testing.T) {
+	tests := []struct {
+		name   string
+		apiKey string
+		want   string
+		wantOK bool
+	}{
+		{
+			name:   "登録済みのAPIキーの場合、キーのハッシュを返すこと",
+			apiKey: "secret",
+			want:   "key:2bb80d537b1da3e3",
+			wantOK: true,
+		},
+		{
+			name:   "登録されていないAPIキーの場合、識別しないこと",
+			apiKey: "unknown",
+		},
+		{
+			name: "APIキーが指定されていない場合、識別しないこと",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ci := api.NewClientIdentifier([]string{"", "secret"}, nil)
+			r := &http.Request{Header: http.Header{}}
+			if tt.apiKey != "" {
+				r.Header.Set(api.APIKeyHeader, tt.apiKey)
+			}
+
+			got, ok := ci.Authenticated(r)
+			if got != tt.want || ok != tt.wantOK {
+				t.Errorf("ClientIdentifier.Authenticated() = %v, %v, want %v, %v", got, ok, tt.want, tt.wantOK)
+			}
+		})
+	}
+}
+
+func TestParseTrustedProxies(t *testing.T) {
+	tests := []struct {
+		name    string
+		s       string
+		want    []string
+		wantErr bool
+	}{
+		{
+			name: "空の場合、プロキシを返さないこと",
+			s:    "",
+		},
+		{
+			name: "IPアドレスとCIDRが指定された場合、範囲を返すこと",
+			s:    "192.0.2.10, 10.0.0.0/8,::1",
+			want: []string{"192.0.2.10/32", "10.0.0.0/8", "::1/128"},
+		},
+		{
+			name:    "IPアドレスが不正な場合、エラーを返すこと",
+			s:       "proxy.example.com",
+			wantErr: true,
+		},
+		{
+			name:    "CIDRが不正な場合、エラーを返すこと",
+			s:       "10.0.0.0/33",
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := api.ParseTrustedProxies(tt.s)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("ParseTrustedProxies() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if len(got) != len(tt.want) {
+				t.Fatalf("ParseTrustedProxies() = %v, want %v", got, tt.want)
+			}
+			for i, n := range got {
+				if n.String() != tt.want[i] {
+					t.Errorf("ParseTrustedProxies()[%d] = %v, want %v", i, n, tt.want[i])
+				}
+			}
+		})
+	}
+}
@@ -0,0 +1,101 @@
+package api
+
+import (
+	"crypto/sha1"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"strings"
+	"time"
+
+	"github.com/gin-gonic/gin"
+)
+
+// weakETag は、値をJSONにした結果から弱いETagを生成し、返す。
+func weakETag(v interface{}) (string, error) {
+	b, err := json.Marshal(v)
+	if err != nil {
+		return "", err
+	}
+	return fmt.Sprintf("W/\"%x\"", sha1.Sum(b)), nil
+}
+
+// collectionETag は、一覧の最終更新日時と件数の上限から弱いETagを生成し、返す。
+// 最終更新日時は削除を含むため、一覧を取得せずにETagを決定でき、変更がない場合は一覧を読み込まずに304を返せる。
+func collectionETag(lastModified time.Time, limit int) (string, error) {
+	return weakETag(struct {
+		LastModified time.Time `json:"lastModified"`
+		Limit        int       `json:"limit"`
+	}{
+		LastModified: lastModified.UTC(),
+		Limit:        limit,
+	})
+}
+
+// setCacheHeaders は、Cache-Control、ETag、Last-Modifiedのヘッダーを設定する。
+func setCacheHeaders(c *gin.Context, etag string, lastModified time.Time) {
+	c.Header(CacheControlHeader, CacheControl)
+	if etag != "" {
+		c.Header(ETagHeader, etag)
+	}
+	if !lastModified.IsZero() {
+		c.Header(LastModifiedHeader, lastModified.UTC().Format(http.TimeFormat))
+	}
+}
+
+// hasIfNoneMatch は、リクエストにIf-None-Matchが含まれるかどうかを確認する。
+func hasIfNoneMatch(c *gin.Context) bool {
+	return c.GetHeader(IfNoneMatchHeader) != ""
+}
+
+// ifModifiedSince は、リクエストのIf-Modified-Sinceを返す。存在しないもしくは不正な場合は、falseを返す。
+func ifModifiedSince(c *gin.Context) (time.Time, bool) {
+	ims := c.GetHeader(IfModifiedSinceHeader)
+	if ims == "" {
+		return time.Time{}, false
+	}
+
+	t, err := http.ParseTime(ims)
+	if err != nil {
+		return time.Time{}, false
+	}
+	return t, true
+}
+
+// notModified は、リクエストの条件に対してリソースが変更されていないかどうかを確認する。
+// If-None-Matchが存在する場合は、If-Modified-Sinceを無視する。
+func notModified(c *gin.Context, etag string, lastModified time.Time) bool {
+	if hasIfNoneMatch(c) {
+		return matchETag(c.GetHeader(IfNoneMatchHeader), etag)
+	}
+
+	ims, ok := ifModifiedSince(c)
+	if !ok || lastModified.IsZero() {
+		return false
+	}
+	return !lastModified.Truncate(time.Second).After(ims)
+}
+
+// matchETag は、If-None-Matchの値がETagに弱い比較で一致するかどうかを確認する。
+func matchETag(ifNoneMatch, etag string) bool {
+	if etag == "" {
+		return false
+	}
+	if strings.TrimSpace(ifNoneMatch) == "*" {
+		return true
+	}
+
+	target := strings.TrimPrefix(etag, "W/")
+	for _, v := range strings.Split(ifNoneMatch, ",") {
+		if strings.TrimPrefix(strings.TrimSpace(v), "W/") == target {
+			return true
+		}
+	}
+	return false
+}
+
+// respondNotModified は、キャッシュのヘッダーを設定し、304を返す。
+func respondNotModified(c *gin.Context, etag string, lastModified time.Time) {
+	setCacheHeaders(c, etag, lastModified)
+	c.Status(http.StatusNotModified)
+}
@@ -0,0 +1,257 @@
+package api_test
+
+import (
+	"context"
+	"crypto/sha1"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/SekiguchiKai/clean-architecture-with-go/server/adapter/api"
+	"github.com/SekiguchiKai/clean-architecture-with-go/server/domain/model"
+	"github.com/SekiguchiKai/clean-architecture-with-go/server/usecase/mock"
+	"github.com/SekiguchiKai/clean-architecture-with-go/server/usecase/output"
+	"github.com/gin-gonic/gin"
+	"github.com/golang/mock/gomock"
+)
+
+// testWeakETag は、テスト用に弱いETagを生成し、返す。
+func testWeakETag(t *testing.T, v interface{}) string {
+	b, err := json.Marshal(v)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return fmt.Sprintf("W/\"%x\"", sha1.Sum(b))
+}
+
+// testCollectionETag は、テスト用に一覧の弱いETagを生成し、返す。
+func testCollectionETag(t *testing.T, lastModified time.Time, limit int) string {
+	return testWeakETag(t, struct {
+		LastModified time.Time `json:"lastModified"`
+		Limit        int       `json:"limit"`
+	}{
+		LastModified: lastModified.UTC(),
+		Limit:        limit,
+	})
+}
+
+func TestProgrammingLangAPI_List_Conditional(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	u := mock_input.NewMockProgrammingLangInputPort(ctrl)
+
+	langAPI := &api.ProgrammingLangAPI{
+		UseCase: u,
+	}
+
+	langSlice := model.CreateProgrammingLangs(20)
+	lastModified := langSlice[19].UpdatedAt
+	deletedAt := lastModified.Add(time.Hour)
+	etag := testCollectionETag(t, lastModified, api.DefaultLimit)
+	deletedETag := testCollectionETag(t, deletedAt, api.DefaultLimit)
+
+	type want struct {
+		code         int
+		etag         string
+		lastModified string
+	}
+
+	tests := []struct {
+		name               string
+		headers            map[string]string
+		collectionModified time.Time
+		want               want
+	}{
+		{
+			name:               "条件付きのヘッダーがない場合、ステータスコード200とキャッシュのヘッダーを返すこと",
+			headers:            map[string]string{},
+			collectionModified: lastModified,
+			want: want{
+				code:         http.StatusOK,
+				etag:         etag,
+				lastModified: lastModified.Format(http.TimeFormat),
+			},
+		},
+		{
+			name:               "If-Modified-Sinceが最終更新日時以降の場合、ETagとLast-Modifiedとともにステータスコード304を返すこと",
+			headers:            map[string]string{api.IfModifiedSinceHeader: lastModified.Format(http.TimeFormat)},
+			collectionModified: lastModified,
+			want: want{
+				code:         http.StatusNotModified,
+				etag:         etag,
+				lastModified: lastModified.Format(http.TimeFormat),
+			},
+		},
+		{
+			name:               "If-Modified-Sinceが最終更新日時より前の場合、ステータスコード200を返すこと",
+			headers:            map[string]string{api.IfModifiedSinceHeader: lastModified.Add(-time.Hour).Format(http.TimeFormat)},
+			collectionModified: lastModified,
+			want: want{
+				code:         http.StatusOK,
+				etag:         etag,
+				lastModified: lastModified.Format(http.TimeFormat),
+			},
+		},
+		{
+			name:               "If-Modified-Since以降に削除された場合、削除した日時とともにステータスコード200を返すこと",
+			headers:            map[string]string{api.IfModifiedSinceHeader: lastModified.Format(http.TimeFormat)},
+			collectionModified: deletedAt,
+			want: want{
+				code:         http.StatusOK,
+				etag:         deletedETag,
+				lastModified: deletedAt.Format(http.TimeFormat),
+			},
+		},
+		{
+			name:               "If-None-MatchがETagと一致する場合、ステータスコード304を返すこと",
+			headers:            map[string]string{api.IfNoneMatchHeader: etag},
+			collectionModified: lastModified,
+			want: want{
+				code:         http.StatusNotModified,
+				etag:         etag,
+				lastModified: lastModified.Format(http.TimeFormat),
+			},
+		},
+		{
+			name:               "If-None-Matchが削除前のETagの場合、ステータスコード200を返すこと",
+			headers:            map[string]string{api.IfNoneMatchHeader: etag},
+			collectionModified: deletedAt,
+			want: want{
+				code:         http.StatusOK,
+				etag:         deletedETag,
+				lastModified: deletedAt.Format(http.TimeFormat),
+			},
+		},
+		{
+			name: "If-None-MatchがETagと一致しない場合、If-Modified-Sinceを無視してステータスコード200を返すこと",
+			headers: map[string]string{
+				api.IfNoneMatchHeader:     `W/"other"`,
+				api.IfModifiedSinceHeader: lastModified.Format(http.TimeFormat),
+			},
+			collectionModified: lastModified,
+			want: want{
+				code:         http.StatusOK,
+				etag:         etag,
+				lastModified: lastModified.Format(http.TimeFormat),
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			r := gin.New()
+			r.GET(api.ProgrammingLangAPIPath, langAPI.List)
+
+			// 変更がない場合は、一覧を読み込まずに304を返す。
+			u.EXPECT().LastModified(context.Background()).Return(tt.collectionModified, nil)
+			if tt.want.code == http.StatusOK {
+				u.EXPECT().List(context.Background(), api.DefaultLimit).Return(langSlice, nil)
+			}
+
+			req, err := http.NewRequest(api.Get, api.ProgrammingLangAPIPath, nil)
+			if err != nil {
+				t.Fatal(err)
+			}
+			for k, v := range tt.headers {
+				req.Header.Set(k, v)
+			}
+
+			rec := httptest.NewRecorder()
+			r.ServeHTTP(rec, req)
+
+			if rec.Code != tt.want.code {
+				t.Errorf("Status Code = %v, want %v", rec.Code, tt.want.code)
+			}
+			if got := rec.Header().Get(api.ETagHeader); got != tt.want.etag {
+				t.Errorf("%s = %v, want %v", api.ETagHeader, got, tt.want.etag)
+			}
+			if got := rec.Header().Get(api.LastModifiedHeader); got != tt.want.lastModified {
+				t.Errorf("%s = %v, want %v", api.LastModifiedHeader, got, tt.want.lastModified)
+			}
+			if got := rec.Header().Get(api.CacheControlHeader); got != api.CacheControl {
+				t.Errorf("%s = %v, want %v", api.CacheControlHeader, got, api.CacheControl)
+			}
+			if tt.want.code == http.StatusNotModified && rec.Body.Len() != 0 {
+				t.Errorf("Response Body = %v, want empty", rec.Body.String())
+			}
+		})
+	}
+}
+
+func TestProgrammingLangAPI_Get_Conditional(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	u := mock_input.NewMockProgrammingLangInputPort(ctrl)
+
+	langAPI := &api.ProgrammingLangAPI{
+		UseCase: u,
+	}
+
+	lang := model.CreateProgrammingLangs(1)[0]
+	etag := testWeakETag(t, output.NewProgrammingLangOutput(lang))
+
+	tests := []struct {
+		name     string
+		headers  map[string]string
+		wantCode int
+	}{
+		{
+			name:     "条件付きのヘッダーがない場合、ステータスコード200を返すこと",
+			headers:  map[string]string{},
+			wantCode: http.StatusOK,
+		},
+		{
+			name:     "If-None-MatchがETagと一致する場合、ステータスコード304を返すこと",
+			headers:  map[string]string{api.IfNoneMatchHeader: fmt.Sprintf(`"a", %s`, etag)},
+			wantCode: http.StatusNotModified,
+		},
+		{
+			name:     "If-None-Matchが*の場合、ステータスコード304を返すこと",
+			headers:  map[string]string{api.IfNoneMatchHeader: "*"},
+			wantCode: http.StatusNotModified,
+		},
+		{
+			name:     "If-Modified-SinceがUpdatedAt以降の場合、ステータスコード304を返すこと",
+			headers:  map[string]string{api.IfModifiedSinceHeader: lang.UpdatedAt.Format(http.TimeFormat)},
+			wantCode: http.StatusNotModified,
+		},
+		{
+			name:     "If-Modified-SinceがUpdatedAtより前の場合、ステータスコード200を返すこと",
+			headers:  map[string]string{api.IfModifiedSinceHeader: lang.UpdatedAt.Add(-time.Second).Format(http.TimeFormat)},
+			wantCode: http.StatusOK,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			r := gin.New()
+			r.GET(fmt.Sprintf("%s/:%s", api.ProgrammingLangAPIPath, api.ID), langAPI.Get)
+			u.EXPECT().Get(context.Background(), lang.ID).Return(lang, nil)
+
+			url := fmt.Sprintf("%s/%d", api.ProgrammingLangAPIPath, lang.ID)
+			req, err := http.NewRequest(api.Get, url, nil)
+			if err != nil {
+				t.Fatal(err)
+			}
+			for k, v := range tt.headers {
+				req.Header.Set(k, v)
+			}
+
+			rec := httptest.NewRecorder()
+			r.ServeHTTP(rec, req)
+
+			if rec.Code != tt.wantCode {
+				t.Errorf("Status Code = %v, want %v", rec.Code, tt.wantCode)
+			}
+			if got := rec.Header().Get(api.ETagHeader); got != etag {
+				t.Errorf("%s = %v, want %v", api.ETagHeader, got, etag)
+			}
+			if got := rec.Header().Get(api.LastModifiedHeader); got != lang.UpdatedAt.Format(http.TimeFormat) {
+				t.Errorf("%s = %v, want %v", api.LastModifiedHeader, got, lang.UpdatedAt.Format(http.TimeFormat))
+			}
+		})
+	}
+}
@@ -1,13 +1,56 @@
 package api
 
+import "time"
+
 // パスの定義。
 const (
 	ProgrammingLangAPIPath = "/langs"
+	LangSlugAPIPath        = "/lang-slugs"
+	LangSuggestAPIPath     = "/langs-suggest"
+	LangEventsAPIPath      = "/langs-events"
+	VersionsPath           = "versions"
+	LatestPath             = "latest"
+	TagAPIPath             = "/tags"
+	TagsPath               = "tags"
+	MergePath              = "merge"
+	InfluenceAPIPath       = "/influences"
+	InfluencesPath         = "influences"
+	AncestorsPath          = "ancestors"
+	DescendantsPath        = "descendants"
+	PathPath               = "path"
+	DetectAPIPath          = "/detect"
+	AdminAPIPath           = "/admin"
+	LinguistImportPath     = "/import/linguist"
+	SearchAPIPath          = "/search"
+	SearchReindexPath      = "/search/reindex"
+	CacheStatsPath         = "/cache/stats"
+	WebhookAPIPath         = "/webhooks"
+	DeliveriesPath         = "deliveries"
+	RedeliverPath          = "redeliver"
+	DeadLettersPath        = "dead-letters"
+	V1Path                 = "/v1"
+	V2Path                 = "/v2"
+	OpenAPIPath            = "/openapi.json"
+	DocsPath               = "/docs"
+	VersionPath            = "/version"
 )
 
 // クエリストリングの属性。
 const (
-	Limit = "limit"
+	Limit       = "limit"
+	Name        = "name"
+	SupportedOn = "supportedOn"
+	Tag         = "tag"
+	TagMatch    = "tagMatch"
+	Depth       = "depth"
+	From        = "from"
+	To          = "to"
+	Format      = "format"
+	Prefix      = "prefix"
+	Query       = "q"
+	Status      = "status"
+	LastEventID = "lastEventId"
+	Cursor      = "cursor"
 )
 
 // Limitの定義。
@@ -17,9 +60,42 @@ const (
 	DefaultLimit = 20
 )
 
+// 候補の件数の定義。
+const (
+	MaxSuggestLimit     = 10
+	MinSuggestLimit     = 1
+	DefaultSuggestLimit = 5
+)
+
+// 全文検索の件数の定義。
+const (
+	MaxSearchLimit     = 50
+	MinSearchLimit     = 1
+	DefaultSearchLimit = 10
+)
+
+// 影響関係をたどる距離の定義。
+const (
+	MaxInfluenceDepth     = 10
+	DefaultInfluenceDepth = 3
+)
+
+// 影響関係の出力形式。
+const (
+	FormatJSON = "json"
+	FormatDOT  = "dot"
+)
+
 // パラメータの属性
 const (
-	ID = "id"
+	ID    = "id"
+	Slug  = "slug"
+	SubID = "subID"
+)
+
+// 日付の形式。
+const (
+	DateLayout = "2006-01-02"
 )
 
 // HTTPのメソッド。
@@ -29,3 +105,81 @@ const (
 	Put    = "PUT"
 	Delete = "DELETE"
 )
+
+// 取り込むデータのサイズの上限。
+const (
+	MaxImportBodySize = 10 << 20
+)
+
+// Content-Typeの値。
+const (
+	DOTContentType         = "text/vnd.graphviz; charset=utf-8"
+	EventStreamContentType = "text/event-stream"
+	ProblemContentType     = "application/problem+json"
+	JSONContentType        = "application/json"
+	HTMLContentType        = "text/html; charset=utf-8"
+)
+
+// HTTPのヘッダー。
+const (
+	APIKeyHeader             = "X-API-Key"
+	RateLimitLimitHeader     = "RateLimit-Limit"
+	RateLimitRemainingHeader = "RateLimit-Remaining"
+	RateLimitResetHeader     = "RateLimit-Reset"
+	RetryAfterHeader         = "Retry-After"
+	CacheControlHeader       = "Cache-Control"
+	ETagHeader               = "ETag"
+	LastModifiedHeader       = "Last-Modified"
+	IfNoneMatchHeader        = "If-None-Match"
+	IfModifiedSinceHeader    = "If-Modified-Since"
+	LastEventIDHeader        = "Last-Event-ID"
+	IdempotencyKeyHeader     = "Idempotency-Key"
+	IdempotentReplayedHeader = "Idempotent-Replayed"
+	DeprecationHeader        = "Deprecation"
+	SunsetHeader             = "Sunset"
+	LinkHeader               = "Link"
+	LocationHeader           = "Location"
+)
+
+// Rate Limitのルートの区分。
+const (
+	RateLimitRead  = "read"
+	RateLimitWrite = "write"
+)
+
+// Cache-Controlの値。
+const (
+	CacheControl = "public, max-age=60, must-revalidate"
+)
+
+// Server-Sent Eventsの設定。
+const (
+	DefaultEventHeartbeat = 15 * time.Second
+	EventRetry            = 3 * time.Second
+	ResetEvent            = "reset"
+)
+
+// Idempotency-Keyの設定。
+const (
+	MaxIdempotencyKeyLength = 255
+	DefaultIdempotencyTTL   = 24 * time.Hour
+)
+
+// /v2のエラーの形式(RFC 7807)のtype。クライアントは、エラー文ではなくtypeでエラーの種類を判別する。
+// 公開後は値を変えない。
+const (
+	ProblemTypeBlank                    = "about:blank"
+	ProblemTypeRequired                 = "urn:problem-type:required"
+	ProblemTypeInvalidProperty          = "urn:problem-type:invalid-property"
+	ProblemTypeInvalidParameter         = "urn:problem-type:invalid-parameter"
+	ProblemTypeNotFound                 = "urn:problem-type:not-found"
+	ProblemTypeAlreadyExists            = "urn:problem-type:already-exists"
+	ProblemTypeTooManyRequests          = "urn:problem-type:too-many-requests"
+	ProblemTypeIdempotencyKeyReused     = "urn:problem-type:idempotency-key-reused"
+	ProblemTypeIdempotencyKeyInProgress = "urn:problem-type:idempotency-key-in-progress"
+)
+
+// /v2のカーソルの設定。
+const (
+	CursorPrefix = "name:"
+)
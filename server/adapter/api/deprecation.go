@@ -0,0 +1,47 @@
+package api
+
+import (
+	"fmt"
+	"net/http"
+	"strings"
+	"time"
+
+	"github.com/gin-gonic/gin"
+)
+
+// Deprecation は、非推奨にしたパスのレスポンスに、Deprecation、Sunset、Linkのヘッダーを付与するミドルウェア。
+// Sunsetを過ぎた後もリクエストは拒否せず、移行先をLinkで案内し続ける。
+type Deprecation struct {
+	// DeprecatedAt は、非推奨にした日時。
+	DeprecatedAt time.Time
+	// Sunset は、提供を終了する予定の日時。
+	Sunset time.Time
+	// Path は、非推奨にしたパス。このパスと、その下のパスのみにヘッダーを付与する。
+	Path string
+	// Successor は、移行先のURL。
+	Successor string
+}
+
+// NewDeprecation は、Deprecationを生成し、返す。
+func NewDeprecation(deprecatedAt, sunset time.Time, path, successor string) *Deprecation {
+	return &Deprecation{
+		DeprecatedAt: deprecatedAt,
+		Sunset:       sunset,
+		Path:         path,
+		Successor:    successor,
+	}
+}
+
+// Handle は、非推奨にしたパスのレスポンスに、非推奨であることを示すヘッダーを付与する。
+// エラーや他のミドルウェアが中断したレスポンスにも付与するため、処理の前に設定する。
+func (d *Deprecation) Handle(c *gin.Context) {
+	if p := c.Request.URL.Path; p != d.Path && !strings.HasPrefix(p, d.Path+"/") {
+		c.Next()
+		return
+	}
+
+	c.Header(DeprecationHeader, fmt.Sprintf("@%d", d.DeprecatedAt.Unix()))
+	c.Header(SunsetHeader, d.Sunset.UTC().Format(http.TimeFormat))
+	c.Header(LinkHeader, fmt.Sprintf("<%s>; rel=\"successor-version\"", d.Successor))
+	c.Next()
+}
@@ -0,0 +1,83 @@
+package api_test
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/SekiguchiKai/clean-architecture-with-go/server/adapter/api"
+	"github.com/gin-gonic/gin"
+)
+
+func TestDeprecation_Handle(t *testing.T) {
+	deprecatedAt := time.Date(2026, time.October, 1, 0, 0, 0, 0, time.UTC)
+	sunset := time.Date(2027, time.October, 1, 0, 0, 0, 0, time.UTC)
+
+	tests := []struct {
+		name       string
+		path       string
+		handler    gin.HandlerFunc
+		code       int
+		deprecated bool
+	}{
+		{
+			name:       "成功したレスポンスに、非推奨であることを示すヘッダーを付与すること",
+			path:       "/v1/langs",
+			handler:    func(c *gin.Context) { c.JSON(http.StatusOK, nil) },
+			code:       http.StatusOK,
+			deprecated: true,
+		},
+		{
+			name:       "中断したレスポンスにも、非推奨であることを示すヘッダーを付与すること",
+			path:       "/v1/langs/1",
+			handler:    func(c *gin.Context) { c.AbortWithStatusJSON(http.StatusTooManyRequests, api.TooManyRequestsErr) },
+			code:       http.StatusTooManyRequests,
+			deprecated: true,
+		},
+		{
+			name:    "非推奨にしていないパスの場合、ヘッダーを付与しないこと",
+			path:    "/v1/tags",
+			handler: func(c *gin.Context) { c.JSON(http.StatusOK, nil) },
+			code:    http.StatusOK,
+		},
+		{
+			name:    "非推奨にしたパスで始まる別のパスの場合、ヘッダーを付与しないこと",
+			path:    "/v1/langs-suggest",
+			handler: func(c *gin.Context) { c.JSON(http.StatusOK, nil) },
+			code:    http.StatusOK,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			r := gin.New()
+			r.Use(api.NewDeprecation(deprecatedAt, sunset, "/v1/langs", "/v2/langs").Handle)
+			r.GET(tt.path, tt.handler)
+
+			rec := httptest.NewRecorder()
+			req, err := http.NewRequest(api.Get, tt.path, nil)
+			if err != nil {
+				t.Fatal(err)
+			}
+			r.ServeHTTP(rec, req)
+
+			if rec.Code != tt.code {
+				t.Errorf("Status Code = %v, want %v", rec.Code, tt.code)
+			}
+
+			want := map[string]string{
+				api.DeprecationHeader: "@1790812800",
+				api.SunsetHeader:      "Fri, 01 Oct 2027 00:00:00 GMT",
+				api.LinkHeader:        `</v2/langs>; rel="successor-version"`,
+			}
+			for k, v := range want {
+				if !tt.deprecated {
+					v = ""
+				}
+				if got := rec.Header().Get(k); got != v {
+					t.Errorf("%s = %v, want %v", k, got, v)
+				}
+			}
+		})
+	}
+}
@@ -0,0 +1,51 @@
+package api
+
+import (
+	"net/http"
+
+	"github.com/SekiguchiKai/clean-architecture-with-go/server/usecase/input"
+	"github.com/SekiguchiKai/clean-architecture-with-go/server/usecase/output"
+	"github.com/gin-gonic/gin"
+)
+
+// detectParam は、言語の推定のリクエストボディ。contentは省略できる。
+type detectParam struct {
+	Filename string `json:"filename"`
+	Content  string `json:"content"`
+}
+
+// DetectionAPI は、言語の推定のAPI。
+type DetectionAPI struct {
+	UseCase input.DetectionInputPort
+}
+
+// NewDetectionAPI は、DetectionAPIを生成し、返す。
+func NewDetectionAPI(useCase input.DetectionInputPort) *DetectionAPI {
+	return &DetectionAPI{
+		UseCase: useCase,
+	}
+}
+
+// InitAPI は、APIを初期設定する。
+func (api *DetectionAPI) InitAPI(g *gin.RouterGroup) {
+	g.POST(DetectAPIPath, api.Detect)
+}
+
+// Detect は、ファイル名と内容から推定した言語の候補を、スコアの高い順に返す。
+func (api *DetectionAPI) Detect(c *gin.Context) {
+	var params *detectParam
+	if err := c.BindJSON(&params); err != nil {
+		c.JSON(http.StatusBadRequest, err.Error())
+		return
+	}
+
+	ctx := c.Request.Context()
+	candidates, err := api.UseCase.Detect(ctx, params.Filename, params.Content)
+	if err != nil {
+		he := handleError(err)
+		c.JSON(he.code, he.message)
+		return
+	}
+
+	c.JSON(http.StatusOK, output.NewDetectionCandidateOutputs(candidates))
+}
@@ -0,0 +1,79 @@
+package api_test
+
+import (
+	"bytes"
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/SekiguchiKai/clean-architecture-with-go/server/adapter/api"
+	"github.com/SekiguchiKai/clean-architecture-with-go/server/domain/model"
+	"github.com/SekiguchiKai/clean-architecture-with-go/server/usecase/mock"
+	"github.com/gin-gonic/gin"
+	"github.com/golang/mock/gomock"
+)
+
+func TestDetectionAPI_Detect(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	u := mock_input.NewMockDetectionInputPort(ctrl)
+
+	candidates := []*model.DetectionCandidate{
+		{
+			Lang:    &model.ProgrammingLang{ID: 1, Name: "Python"},
+			Score:   80,
+			Reasons: []model.DetectionReason{model.DetectionReasonShebang},
+		},
+	}
+
+	tests := []struct {
+		name     string
+		body     string
+		mock     func(ctx context.Context)
+		wantCode int
+	}{
+		{
+			name: "ファイル名と内容を指定した場合、推定した候補を返すこと",
+			body: `{"filename":"manage","content":"#!/usr/bin/env python3\n"}`,
+			mock: func(ctx context.Context) {
+				u.EXPECT().Detect(ctx, "manage", "#!/usr/bin/env python3\n").Return(candidates, nil)
+			},
+			wantCode: http.StatusOK,
+		},
+		{
+			name: "ファイル名も内容も指定されていない場合、ステータスコード400を返すこと",
+			body: `{}`,
+			mock: func(ctx context.Context) {
+				u.EXPECT().Detect(ctx, "", "").Return(nil, &model.RequiredError{Property: model.PropertyFilename})
+			},
+			wantCode: http.StatusBadRequest,
+		},
+		{
+			name:     "リクエストボディがJSONでない場合、ステータスコード400を返すこと",
+			body:     "main.go",
+			mock:     func(ctx context.Context) {},
+			wantCode: http.StatusBadRequest,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			r := gin.New()
+			api.NewDetectionAPI(u).InitAPI(&r.RouterGroup)
+
+			tt.mock(context.Background())
+
+			rec := httptest.NewRecorder()
+			req, err := http.NewRequest(api.Post, api.DetectAPIPath, bytes.NewBufferString(tt.body))
+			if err != nil {
+				t.Fatal(err)
+			}
+			r.ServeHTTP(rec, req)
+
+			if rec.Code != tt.wantCode {
+				t.Errorf("Status Code = %v, want %v", rec.Code, tt.wantCode)
+			}
+		})
+	}
+}
@@ -0,0 +1,129 @@
+package api
+
+import (
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+)
+
+// DocsAPI は、OpenAPIの文書と、それを表示するページを返すAPI。
+type DocsAPI struct{}
+
+// NewDocsAPI は、DocsAPIを生成し、返す。
+func NewDocsAPI() *DocsAPI {
+	return &DocsAPI{}
+}
+
+// InitAPI は、APIを初期設定する。
+func (api *DocsAPI) InitAPI(g *gin.RouterGroup) {
+	g.GET(OpenAPIPath, api.OpenAPI)
+	g.GET(DocsPath, api.Docs)
+}
+
+// OpenAPI は、OpenAPI 3の文書を返す。
+func (api *DocsAPI) OpenAPI(c *gin.Context) {
+	c.Data(http.StatusOK, JSONContentType, OpenAPIDocument())
+}
+
+// Docs は、/openapi.jsonを読み込んでルートの一覧を表示するページを返す。外部のスクリプトは使用しない。
+func (api *DocsAPI) Docs(c *gin.Context) {
+	c.Data(http.StatusOK, HTMLContentType, []byte(docsPage))
+}
+
+// docsPage は、/docsで返すページ。
+const docsPage = `<!DOCTYPE html>
+<html lang="en">
+<head>
+<meta charset="utf-8">
+<title>Programming Languages API</title>
+<style>
+body { font-family: sans-serif; margin: 2em auto; max-width: 960px; color: #222; }
+h2 { border-bottom: 1px solid #ddd; padding-bottom: .2em; }
+.op { margin: 1em 0; padding: .5em 1em; border-left: 4px solid #888; background: #f8f8f8; }
+.method { display: inline-block; min-width: 4.5em; font-weight: bold; text-transform: uppercase; }
+.get { border-color: #2b7bb9; } .post { border-color: #2f9e44; } .put { border-color: #e67700; } .delete { border-color: #c92a2a; }
+table { border-collapse: collapse; margin: .5em 0; }
+td, th { border: 1px solid #ddd; padding: .2em .6em; text-align: left; }
+code { background: #eee; padding: 0 .2em; }
+pre { background: #eee; padding: .5em; overflow: auto; }
+</style>
+</head>
+<body>
+<h1 id="title">Programming Languages API</h1>
+<p id="description"></p>
+<p><a href="/openapi.json">openapi.json</a></p>
+<div id="paths"></div>
+<h2>Schemas</h2>
+<div id="schemas"></div>
+<script>
+function text(tag, content, className) {
+  var e = document.createElement(tag);
+  e.textContent = content;
+  if (className) e.className = className;
+  return e;
+}
+function refName(s) {
+  return s && s.$ref ? s.$ref.split("/").pop() : null;
+}
+function schemaLabel(s) {
+  if (!s) return "";
+  if (refName(s)) return refName(s);
+  if (s.type === "array") return schemaLabel(s.items) + "[]";
+  return s.type + (s.enum ? " (" + s.enum.join(", ") + ")" : "");
+}
+function resolve(doc, p) {
+  var name = refName(p);
+  return name ? doc.components.parameters[name] || doc.components.responses[name] : p;
+}
+fetch("/openapi.json").then(function (r) { return r.json(); }).then(function (doc) {
+  document.getElementById("title").textContent = doc.info.title + " " + doc.info.version;
+  document.getElementById("description").textContent = doc.info.description || "";
+  var paths = document.getElementById("paths");
+  Object.keys(doc.paths).forEach(function (path) {
+    Object.keys(doc.paths[path]).forEach(function (method) {
+      var op = doc.paths[path][method];
+      var div = document.createElement("div");
+      div.className = "op " + method;
+      var h = document.createElement("h3");
+      h.appendChild(text("span", method, "method"));
+      h.appendChild(text("code", path));
+      div.appendChild(h);
+      div.appendChild(text("p", (op.summary || "") + (op.description ? ". " + op.description : "")));
+      if (op.parameters && op.parameters.length) {
+        var t = document.createElement("table");
+        var head = document.createElement("tr");
+        ["name", "in", "type", "required"].forEach(function (c) { head.appendChild(text("th", c)); });
+        t.appendChild(head);
+        op.parameters.forEach(function (p) {
+          p = resolve(doc, p);
+          var tr = document.createElement("tr");
+          [p.name, p.in, schemaLabel(p.schema), p.required ? "yes" : ""].forEach(function (c) { tr.appendChild(text("td", c)); });
+          t.appendChild(tr);
+        });
+        div.appendChild(t);
+      }
+      if (op.requestBody) {
+        var body = op.requestBody.content["application/json"];
+        div.appendChild(text("p", "Body: " + schemaLabel(body && body.schema)));
+      }
+      var rs = document.createElement("ul");
+      Object.keys(op.responses).forEach(function (code) {
+        var r = resolve(doc, op.responses[code]);
+        var types = Object.keys(r.content || {});
+        var schema = types.length ? schemaLabel(r.content[types[0]].schema) + " (" + types[0] + ")" : "";
+        rs.appendChild(text("li", code + ": " + r.description + " " + schema));
+      });
+      div.appendChild(rs);
+      paths.appendChild(div);
+    });
+  });
+  var schemas = document.getElementById("schemas");
+  Object.keys(doc.components.schemas).forEach(function (name) {
+    schemas.appendChild(text("h3", name));
+    schemas.appendChild(text("pre", JSON.stringify(doc.components.schemas[name], null, 2)));
+  });
+});
+</script>
+</body>
+</html>
+`
@@ -9,9 +9,27 @@ import (
 
 // エラーの定数。
 const (
-	OtherErr            = "some error has occurred"
-	IDShouldBeIntErr    = "ID Should be int"
-	LimitShouldBeIntErr = "Limit Should be int"
+	OtherErr                    = "some error has occurred"
+	IDShouldBeIntErr            = "ID Should be int"
+	LimitShouldBeIntErr         = "Limit Should be int"
+	TooManyRequestsErr          = "too many requests"
+	DateShouldBeDateErr         = "Date should be YYYY-MM-DD"
+	TagMatchErr                 = "tagMatch should be all or any"
+	ShouldBeIntErr              = "Parameter should be int"
+	DepthErr                    = "depth should be 1 to 10"
+	FormatErr                   = "format should be json or dot"
+	UnauthorizedErr             = "valid X-API-Key is required"
+	AdminDisabledErr            = "admin API is disabled"
+	IdempotencyKeyLengthErr     = "Idempotency-Key should be 255 characters or less"
+	IdempotencyKeyReusedErr     = "Idempotency-Key was already used with a different request"
+	IdempotencyKeyInProgressErr = "a request with the same Idempotency-Key is in progress"
+	InvalidCursorErr            = "cursor is invalid"
+	InvalidJSONErr              = "should be JSON"
+	ShouldBeTypeErr             = "should be %s"
+	ShouldBeOneOfErr            = "should be one of %s"
+	MinimumErr                  = "should be %d or more"
+	MaximumErr                  = "should be %d or less"
+	MinLengthErr                = "should be at least %d characters"
 )
 
 // handledError はハンドリング後のエラー。
@@ -0,0 +1,193 @@
+package api
+
+import (
+	"bytes"
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"os"
+	"time"
+
+	"github.com/gin-gonic/gin"
+)
+
+// IdempotencyRecord は、Idempotency-Keyごとに記録したリクエストとレスポンスを表す。
+// Completedがfalseの場合は、最初のリクエストを処理中であることを表す。
+type IdempotencyRecord struct {
+	Fingerprint string
+	Completed   bool
+	Status      int
+	ContentType string
+	Body        []byte
+	ExpiresAt   time.Time
+}
+
+// IdempotencyStore は、Idempotency-Keyごとの記録を保持するStore。
+type IdempotencyStore interface {
+	// Reserve は、keyの記録がない場合は処理中として記録し、nilを返す。記録がある場合は、その記録を返す。
+	Reserve(ctx context.Context, key, fingerprint string, ttl time.Duration, now time.Time) (*IdempotencyRecord, error)
+	// Complete は、keyの記録にレスポンスを保存する。
+	Complete(ctx context.Context, key string, record *IdempotencyRecord) error
+	// Release は、keyの記録を削除し、同じkeyで処理し直せるようにする。
+	Release(ctx context.Context, key string) error
+}
+
+// Idempotency は、Idempotency-Keyを指定したPOSTのリクエストを、TTLの間に1回だけ処理するミドルウェア。
+// 同じkeyで同じリクエストを再送した場合は、保存したレスポンスを返す。
+// keyは、登録済みのAPIキーで認証したリクエストの場合はAPIキーごとに、それ以外の場合はkeyのみで区別する。
+type Idempotency struct {
+	Store   IdempotencyStore
+	Clients *ClientIdentifier
+	TTL     time.Duration
+	Now     func() time.Time
+}
+
+// NewIdempotency は、Idempotencyを生成し、返す。
+func NewIdempotency(store IdempotencyStore, clients *ClientIdentifier, ttl time.Duration) *Idempotency {
+	return &Idempotency{
+		Store:   store,
+		Clients: clients,
+		TTL:     ttl,
+		Now:     time.Now,
+	}
+}
+
+// Handle は、Idempotency-Keyを指定したPOSTのリクエストを1回だけ処理する。
+// 異なるリクエストでkeyを使い回した場合は422を、最初のリクエストを処理中の場合は409を返す。
+// 5xxのレスポンスやpanicした場合は記録を削除し、同じkeyで処理し直せるようにする。
+func (i *Idempotency) Handle(c *gin.Context) {
+	idempotencyKey := c.GetHeader(IdempotencyKeyHeader)
+	if c.Request.Method != Post || idempotencyKey == "" {
+		c.Next()
+		return
+	}
+
+	if len(idempotencyKey) > MaxIdempotencyKeyLength {
+		abortWithError(c, &Problem{
+			Type:      ProblemTypeInvalidParameter,
+			Status:    http.StatusBadRequest,
+			Detail:    IdempotencyKeyLengthErr,
+			Parameter: IdempotencyKeyHeader,
+			Reason:    IdempotencyKeyLengthErr,
+		})
+		return
+	}
+
+	body, err := ioutil.ReadAll(c.Request.Body)
+	if err != nil {
+		abortWithError(c, &Problem{
+			Type:     ProblemTypeInvalidProperty,
+			Status:   http.StatusBadRequest,
+			Detail:   err.Error(),
+			Property: bodyProperty,
+			Reason:   err.Error(),
+		})
+		return
+	}
+	c.Request.Body = ioutil.NopCloser(bytes.NewReader(body))
+
+	ctx := c.Request.Context()
+	key := i.scope(c.Request) + "|" + c.Request.URL.Path + "|" + idempotencyKey
+	fingerprint := requestFingerprint(c.Request.Method, c.Request.URL.Path, body)
+
+	record, err := i.Store.Reserve(ctx, key, fingerprint, i.TTL, i.Now())
+	if err != nil {
+		abortWithError(c, newProblem(err))
+		return
+	}
+
+	if record != nil {
+		switch {
+		case record.Fingerprint != fingerprint:
+			abortWithError(c, &Problem{Type: ProblemTypeIdempotencyKeyReused, Status: http.StatusUnprocessableEntity, Detail: IdempotencyKeyReusedErr})
+		case !record.Completed:
+			abortWithError(c, &Problem{Type: ProblemTypeIdempotencyKeyInProgress, Status: http.StatusConflict, Detail: IdempotencyKeyInProgressErr})
+		default:
+			c.Header(IdempotentReplayedHeader, "true")
+			c.Data(record.Status, record.ContentType, record.Body)
+			c.Abort()
+		}
+		return
+	}
+
+	completed := false
+	defer func() {
+		if completed {
+			return
+		}
+		if err := i.Store.Release(ctx, key); err != nil {
+			fmt.Fprintln(os.Stderr, err.Error())
+		}
+	}()
+
+	w := &recordingWriter{ResponseWriter: c.Writer}
+	c.Writer = w
+	c.Next()
+
+	if w.Status() >= http.StatusInternalServerError {
+		return
+	}
+
+	record = &IdempotencyRecord{
+		Fingerprint: fingerprint,
+		Completed:   true,
+		Status:      w.Status(),
+		ContentType: w.Header().Get("Content-Type"),
+		Body:        w.body.Bytes(),
+		ExpiresAt:   i.Now().Add(i.TTL),
+	}
+	if err := i.Store.Complete(ctx, key, record); err != nil {
+		fmt.Fprintln(os.Stderr, err.Error())
+		return
+	}
+	completed = true
+}
+
+// scope は、keyを区別する範囲として、登録済みのAPIキーを識別するキーを返す。認証していない場合は、空文字を返す。
+func (i *Idempotency) scope(r *http.Request) string {
+	if i.Clients == nil {
+		return ""
+	}
+	key, _ := i.Clients.Authenticated(r)
+	return key
+}
+
+// requestFingerprint は、リクエストのメソッド、パス、ボディから、同じリクエストかどうかを判別する値を生成する。
+// JSONのボディは、空白やキーの順序の違いで別のリクエストとみなさないように正規化する。
+func requestFingerprint(method, path string, body []byte) string {
+	var v interface{}
+	d := json.NewDecoder(bytes.NewReader(body))
+	d.UseNumber()
+	if err := d.Decode(&v); err == nil && !d.More() {
+		if normalized, err := json.Marshal(v); err == nil {
+			body = normalized
+		}
+	}
+
+	h := sha256.New()
+	h.Write([]byte(method + " " + path + "\n"))
+	h.Write(body)
+	return hex.EncodeToString(h.Sum(nil))
+}
+
+// recordingWriter は、書き込んだレスポンスのボディを記録するResponseWriter。
+type recordingWriter struct {
+	gin.ResponseWriter
+	body bytes.Buffer
+}
+
+// Write は、ボディを記録して書き込む。
+func (w *recordingWriter) Write(b []byte) (int, error) {
+	w.body.Write(b)
+	return w.ResponseWriter.Write(b)
+}
+
+// WriteString は、ボディを記録して書き込む。
+func (w *recordingWriter) WriteString(s string) (int, error) {
+	w.body.WriteString(s)
+	return w.ResponseWriter.WriteString(s)
+}
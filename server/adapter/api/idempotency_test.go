@@ -0,0 +1,217 @@
+package api_test
+
+import (
+	"context"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/SekiguchiKai/clean-architecture-with-go/server/adapter/api"
+	"github.com/gin-gonic/gin"
+)
+
+// stubIdempotencyStore は、テスト用のIdempotencyStore。期限は考慮しない。
+type stubIdempotencyStore struct {
+	records map[string]*api.IdempotencyRecord
+}
+
+// Reserve は、keyの記録がない場合は処理中として記録する。
+func (s *stubIdempotencyStore) Reserve(ctx context.Context, key, fingerprint string, ttl time.Duration, now time.Time) (*api.IdempotencyRecord, error) {
+	if r, ok := s.records[key]; ok {
+		return r, nil
+	}
+	s.records[key] = &api.IdempotencyRecord{Fingerprint: fingerprint}
+	return nil, nil
+}
+
+// Complete は、keyの記録を置き換える。
+func (s *stubIdempotencyStore) Complete(ctx context.Context, key string, record *api.IdempotencyRecord) error {
+	s.records[key] = record
+	return nil
+}
+
+// Release は、keyの記録を削除する。
+func (s *stubIdempotencyStore) Release(ctx context.Context, key string) error {
+	delete(s.records, key)
+	return nil
+}
+
+func TestIdempotency_Handle(t *testing.T) {
+	type request struct {
+		key    string
+		apiKey string
+		body   string
+	}
+
+	tests := []struct {
+		name         string
+		path         string
+		requests     []request
+		failFirst    bool
+		panicFirst   bool
+		inProgress   bool
+		wantCode     int
+		wantCalls    int
+		wantReplayed bool
+		// wantProblemType は、application/problem+jsonで返す場合のtype。
+		wantProblemType string
+	}{
+		{
+			name:         "同じkeyで同じリクエストを再送した場合、処理せずに保存したレスポンスを返すこと",
+			requests:     []request{{key: "k", body: `{"name":"Go"}`}, {key: "k", body: `{"name":"Go"}`}},
+			wantCode:     http.StatusOK,
+			wantCalls:    1,
+			wantReplayed: true,
+		},
+		{
+			name:         "空白やキーの順序だけが異なるJSONの場合、同じリクエストとみなすこと",
+			requests:     []request{{key: "k", body: `{"name":"Go","feature":"f"}`}, {key: "k", body: `{ "feature": "f", "name": "Go" }`}},
+			wantCode:     http.StatusOK,
+			wantCalls:    1,
+			wantReplayed: true,
+		},
+		{
+			name:      "同じkeyで異なるリクエストを送信した場合、ステータスコード422を返すこと",
+			requests:  []request{{key: "k", body: `{"name":"Go"}`}, {key: "k", body: `{"name":"Golang"}`}},
+			wantCode:  http.StatusUnprocessableEntity,
+			wantCalls: 1,
+		},
+		{
+			name:            "/v2で同じkeyで異なるリクエストを送信した場合、ステータスコード422をapplication/problem+jsonで返すこと",
+			path:            api.V2Path + api.ProgrammingLangAPIPath,
+			requests:        []request{{key: "k", body: `{"name":"Go"}`}, {key: "k", body: `{"name":"Golang"}`}},
+			wantCode:        http.StatusUnprocessableEntity,
+			wantCalls:       1,
+			wantProblemType: api.ProblemTypeIdempotencyKeyReused,
+		},
+		{
+			name:      "最初のリクエストが5xxの場合、同じkeyで処理し直すこと",
+			requests:  []request{{key: "k", body: `{"name":"Go"}`}, {key: "k", body: `{"name":"Go"}`}},
+			failFirst: true,
+			wantCode:  http.StatusOK,
+			wantCalls: 2,
+		},
+		{
+			name:       "最初のリクエストの処理中にpanicした場合、同じkeyで処理し直すこと",
+			requests:   []request{{key: "k", body: `{"name":"Go"}`}, {key: "k", body: `{"name":"Go"}`}},
+			panicFirst: true,
+			wantCode:   http.StatusOK,
+			wantCalls:  2,
+		},
+		{
+			name:      "異なるAPIキーで同じkeyを送信した場合、別のリクエストとして処理すること",
+			requests:  []request{{key: "k", apiKey: "client-a", body: `{"name":"Go"}`}, {key: "k", apiKey: "client-b", body: `{"name":"Go"}`}},
+			wantCode:  http.StatusOK,
+			wantCalls: 2,
+		},
+		{
+			name:         "登録されていないAPIキーの場合、認証していないリクエストと同じくkeyのみで区別すること",
+			requests:     []request{{key: "k", apiKey: "unknown", body: `{"name":"Go"}`}, {key: "k", body: `{"name":"Go"}`}},
+			wantCode:     http.StatusOK,
+			wantCalls:    1,
+			wantReplayed: true,
+		},
+		{
+			name:       "最初のリクエストを処理中の場合、ステータスコード409を返すこと",
+			requests:   []request{{key: "k", body: `{"name":"Go"}`}},
+			inProgress: true,
+			wantCode:   http.StatusConflict,
+			wantCalls:  1,
+		},
+		{
+			name:      "keyを指定しない場合、毎回処理すること",
+			requests:  []request{{body: `{"name":"Go"}`}, {body: `{"name":"Go"}`}},
+			wantCode:  http.StatusOK,
+			wantCalls: 2,
+		},
+		{
+			name:      "keyが長すぎる場合、ステータスコード400を返すこと",
+			requests:  []request{{key: strings.Repeat("k", api.MaxIdempotencyKeyLength+1), body: `{}`}},
+			wantCode:  http.StatusBadRequest,
+			wantCalls: 0,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			store := &stubIdempotencyStore{records: make(map[string]*api.IdempotencyRecord)}
+			path := tt.path
+			if path == "" {
+				path = api.ProgrammingLangAPIPath
+			}
+
+			newRequest := func(req request) *http.Request {
+				httpReq, err := http.NewRequest(api.Post, path, strings.NewReader(req.body))
+				if err != nil {
+					t.Fatal(err)
+				}
+				if req.key != "" {
+					httpReq.Header.Set(api.IdempotencyKeyHeader, req.key)
+				}
+				if req.apiKey != "" {
+					httpReq.Header.Set(api.APIKeyHeader, req.apiKey)
+				}
+				return httpReq
+			}
+
+			calls := 0
+			var rec *httptest.ResponseRecorder
+			r := gin.New()
+			clients := api.NewClientIdentifier([]string{"client-a", "client-b"}, nil)
+			r.Use(gin.RecoveryWithWriter(ioutil.Discard), api.NewIdempotency(store, clients, api.DefaultIdempotencyTTL).Handle)
+			r.POST(path, func(c *gin.Context) {
+				calls++
+				// 最初のリクエストを処理している間に、同じリクエストを再送する
+				if tt.inProgress && calls == 1 {
+					rec = httptest.NewRecorder()
+					r.ServeHTTP(rec, newRequest(tt.requests[0]))
+				}
+				if tt.panicFirst && calls == 1 {
+					panic("unexpected")
+				}
+				if tt.failFirst && calls == 1 {
+					c.JSON(http.StatusInternalServerError, api.OtherErr)
+					return
+				}
+				c.JSON(http.StatusOK, gin.H{"id": calls})
+			})
+
+			var first string
+			for i, req := range tt.requests {
+				last := httptest.NewRecorder()
+				r.ServeHTTP(last, newRequest(req))
+				if i == 0 {
+					first = last.Body.String()
+				}
+				if !tt.inProgress {
+					rec = last
+				}
+			}
+
+			if rec.Code != tt.wantCode {
+				t.Errorf("Status Code = %v, want %v", rec.Code, tt.wantCode)
+			}
+			if calls != tt.wantCalls {
+				t.Errorf("handler calls = %v, want %v", calls, tt.wantCalls)
+			}
+			if replayed := rec.Header().Get(api.IdempotentReplayedHeader) == "true"; replayed != tt.wantReplayed {
+				t.Errorf("%s = %v, want %v", api.IdempotentReplayedHeader, replayed, tt.wantReplayed)
+			}
+			if tt.wantProblemType != "" {
+				testProblem(t, rec, tt.wantCode, tt.wantProblemType)
+			} else if got := rec.Header().Get("Content-Type"); got == api.ProblemContentType {
+				t.Errorf("Content-Type = %v, want JSON", got)
+			}
+			if tt.wantReplayed && rec.Body.String() != first {
+				t.Errorf("body = %v, want %v", rec.Body.String(), first)
+			}
+			if tt.wantReplayed {
+				if got := rec.Header().Get("Content-Type"); !strings.HasPrefix(got, "application/json") {
+					t.Errorf("Content-Type = %v, want application/json", got)
+				}
+			}
+		})
+	}
+}
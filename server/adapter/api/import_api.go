@@ -0,0 +1,55 @@
+package api
+
+import (
+	"bytes"
+	"io/ioutil"
+	"net/http"
+
+	"github.com/SekiguchiKai/clean-architecture-with-go/server/adapter/linguist"
+	"github.com/SekiguchiKai/clean-architecture-with-go/server/usecase/input"
+	"github.com/SekiguchiKai/clean-architecture-with-go/server/usecase/output"
+	"github.com/gin-gonic/gin"
+)
+
+// ImportAPI は、ProgrammingLangの取り込みのAPI。
+type ImportAPI struct {
+	UseCase input.ImportInputPort
+}
+
+// NewImportAPI は、ImportAPIを生成し、返す。
+func NewImportAPI(useCase input.ImportInputPort) *ImportAPI {
+	return &ImportAPI{
+		UseCase: useCase,
+	}
+}
+
+// InitAPI は、APIを初期設定する。gには、管理用のAPIの認証を設定したグループを渡す。
+func (api *ImportAPI) InitAPI(g *gin.RouterGroup) {
+	g.POST(LinguistImportPath, api.ImportLinguist)
+}
+
+// ImportLinguist は、リクエストボディのGitHub Linguistのlanguages.ymlを取り込み、生成、更新、スキップした件数を返す。
+func (api *ImportAPI) ImportLinguist(c *gin.Context) {
+	body, err := ioutil.ReadAll(http.MaxBytesReader(c.Writer, c.Request.Body, MaxImportBodySize))
+	if err != nil {
+		c.JSON(http.StatusBadRequest, err.Error())
+		return
+	}
+
+	imports, err := linguist.Parse(bytes.NewReader(body))
+	if err != nil {
+		he := handleError(err)
+		c.JSON(he.code, he.message)
+		return
+	}
+
+	ctx := c.Request.Context()
+	report, err := api.UseCase.Import(ctx, imports)
+	if err != nil {
+		he := handleError(err)
+		c.JSON(he.code, he.message)
+		return
+	}
+
+	c.JSON(http.StatusOK, output.NewImportReportOutput(report))
+}
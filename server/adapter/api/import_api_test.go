@@ -0,0 +1,91 @@
+package api_test
+
+import (
+	"bytes"
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/SekiguchiKai/clean-architecture-with-go/server/adapter/api"
+	"github.com/SekiguchiKai/clean-architecture-with-go/server/domain/model"
+	"github.com/SekiguchiKai/clean-architecture-with-go/server/usecase/mock"
+	"github.com/gin-gonic/gin"
+	"github.com/golang/mock/gomock"
+)
+
+func TestImportAPI_ImportLinguist(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	u := mock_input.NewMockImportInputPort(ctrl)
+
+	const adminKey = "secret"
+	path := api.AdminAPIPath + api.LinguistImportPath
+
+	tests := []struct {
+		name     string
+		key      string
+		apiKey   string
+		body     string
+		mock     func(ctx context.Context)
+		wantCode int
+	}{
+		{
+			name:   "languages.ymlの形式の場合、取り込んだ件数を返すこと",
+			key:    adminKey,
+			apiKey: adminKey,
+			body:   "Go:\n  type: programming\n  extensions:\n  - \".go\"\n",
+			mock: func(ctx context.Context) {
+				imports := []*model.LangImport{
+					{Lang: &model.ProgrammingLang{Name: "Go", Extensions: []string{".go"}}, Tags: []string{"programming"}},
+				}
+				u.EXPECT().Import(ctx, imports).Return(&model.ImportReport{Created: 1}, nil)
+			},
+			wantCode: http.StatusOK,
+		},
+		{
+			name:     "YAMLとして不正な場合、ステータスコード400を返すこと",
+			key:      adminKey,
+			apiKey:   adminKey,
+			body:     "Go: [",
+			mock:     func(ctx context.Context) {},
+			wantCode: http.StatusBadRequest,
+		},
+		{
+			name:     "APIキーが一致しない場合、ステータスコード401を返すこと",
+			key:      adminKey,
+			apiKey:   "wrong",
+			body:     "Go:\n",
+			mock:     func(ctx context.Context) {},
+			wantCode: http.StatusUnauthorized,
+		},
+		{
+			name:     "管理用のAPIキーが設定されていない場合、ステータスコード403を返すこと",
+			apiKey:   "",
+			body:     "Go:\n",
+			mock:     func(ctx context.Context) {},
+			wantCode: http.StatusForbidden,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			r := gin.New()
+			api.NewImportAPI(u).InitAPI(r.Group(api.AdminAPIPath, api.NewAdminAuth(tt.key).Handle))
+
+			tt.mock(context.Background())
+
+			rec := httptest.NewRecorder()
+			req, err := http.NewRequest(api.Post, path, bytes.NewBufferString(tt.body))
+			if err != nil {
+				t.Fatal(err)
+			}
+			req.Header.Set(api.APIKeyHeader, tt.apiKey)
+			r.ServeHTTP(rec, req)
+
+			if rec.Code != tt.wantCode {
+				t.Errorf("Status Code = %v, want %v", rec.Code, tt.wantCode)
+			}
+		})
+	}
+}
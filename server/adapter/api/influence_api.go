@@ -0,0 +1,206 @@
+package api
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+
+	"github.com/SekiguchiKai/clean-architecture-with-go/server/domain/model"
+	"github.com/SekiguchiKai/clean-architecture-with-go/server/usecase/input"
+	"github.com/SekiguchiKai/clean-architecture-with-go/server/usecase/output"
+	"github.com/gin-gonic/gin"
+)
+
+// addInfluenceParam は、影響関係の追加のリクエストボディ。
+type addInfluenceParam struct {
+	InfluencedByID int `json:"influencedById"`
+}
+
+// InfluenceAPI は、InfluenceのAPI。
+type InfluenceAPI struct {
+	UseCase input.InfluenceInputPort
+}
+
+// NewInfluenceAPI は、InfluenceAPIを生成し、返す。
+func NewInfluenceAPI(useCase input.InfluenceInputPort) *InfluenceAPI {
+	return &InfluenceAPI{
+		UseCase: useCase,
+	}
+}
+
+// InitAPI は、APIを初期設定する。
+func (api *InfluenceAPI) InitAPI(g *gin.RouterGroup) {
+	g.GET(InfluenceAPIPath, api.Graph)
+	g.GET(fmt.Sprintf("%s/%s", InfluenceAPIPath, PathPath), api.ShortestPath)
+
+	influencesPath := fmt.Sprintf("%s/:%s/%s", ProgrammingLangAPIPath, ID, InfluencesPath)
+	g.GET(influencesPath, api.ListInfluencers)
+	g.GET(fmt.Sprintf("%s/:%s/%s", ProgrammingLangAPIPath, ID, AncestorsPath), api.Ancestors)
+	g.GET(fmt.Sprintf("%s/:%s/%s", ProgrammingLangAPIPath, ID, DescendantsPath), api.Descendants)
+	g.POST(influencesPath, api.Add)
+	g.DELETE(fmt.Sprintf("%s/:%s", influencesPath, SubID), api.Remove)
+}
+
+// ListInfluencers は、ProgrammingLangに直接影響を与えたProgrammingLangの一覧を返す。
+func (api *InfluenceAPI) ListInfluencers(c *gin.Context) {
+	langID, err := getID(c)
+	if err != nil {
+		he := handleError(err)
+		c.JSON(he.code, he.message)
+		return
+	}
+
+	ctx := c.Request.Context()
+	langSlice, err := api.UseCase.ListInfluencers(ctx, langID)
+	if err != nil {
+		he := handleError(err)
+		c.JSON(he.code, he.message)
+		return
+	}
+
+	c.JSON(http.StatusOK, output.NewProgrammingLangOutputs(langSlice))
+}
+
+// Add は、ProgrammingLangがリクエストボディのinfluencedByIdのProgrammingLangから影響を受けたことを記録する。
+func (api *InfluenceAPI) Add(c *gin.Context) {
+	var params *addInfluenceParam
+	if err := c.BindJSON(&params); err != nil {
+		c.JSON(http.StatusBadRequest, err.Error())
+		return
+	}
+
+	langID, err := getID(c)
+	if err != nil {
+		he := handleError(err)
+		c.JSON(he.code, he.message)
+		return
+	}
+
+	ctx := c.Request.Context()
+	influence, err := api.UseCase.Add(ctx, langID, params.InfluencedByID)
+	if err != nil {
+		he := handleError(err)
+		c.JSON(he.code, he.message)
+		return
+	}
+
+	c.JSON(http.StatusOK, output.NewInfluenceOutput(influence))
+}
+
+// Remove は、ProgrammingLangがURLで指定したProgrammingLangから影響を受けたことの記録を削除する。
+func (api *InfluenceAPI) Remove(c *gin.Context) {
+	langID, err := getID(c)
+	if err != nil {
+		he := handleError(err)
+		c.JSON(he.code, he.message)
+		return
+	}
+
+	influencedByID, err := getSubID(c)
+	if err != nil {
+		he := handleError(err)
+		c.JSON(he.code, he.message)
+		return
+	}
+
+	ctx := c.Request.Context()
+	if err := api.UseCase.Remove(ctx, langID, influencedByID); err != nil {
+		he := handleError(err)
+		c.JSON(he.code, he.message)
+		return
+	}
+
+	c.JSON(http.StatusOK, nil)
+}
+
+// Ancestors は、ProgrammingLangに影響を与えたProgrammingLangを、depthまでさかのぼって返す。
+func (api *InfluenceAPI) Ancestors(c *gin.Context) {
+	api.traverse(c, api.UseCase.Ancestors)
+}
+
+// Descendants は、ProgrammingLangから影響を受けたProgrammingLangを、depthまでたどって返す。
+func (api *InfluenceAPI) Descendants(c *gin.Context) {
+	api.traverse(c, api.UseCase.Descendants)
+}
+
+// ShortestPath は、fromからtoまでの影響関係の最短経路を返す。
+func (api *InfluenceAPI) ShortestPath(c *gin.Context) {
+	from, err := getIntQuery(c, From)
+	if err != nil {
+		he := handleError(err)
+		c.JSON(he.code, he.message)
+		return
+	}
+
+	to, err := getIntQuery(c, To)
+	if err != nil {
+		he := handleError(err)
+		c.JSON(he.code, he.message)
+		return
+	}
+
+	ctx := c.Request.Context()
+	langSlice, err := api.UseCase.ShortestPath(ctx, from, to)
+	if err != nil {
+		he := handleError(err)
+		c.JSON(he.code, he.message)
+		return
+	}
+
+	c.JSON(http.StatusOK, output.NewProgrammingLangOutputs(langSlice))
+}
+
+// Graph は、影響関係の全体を返す。formatにdotが指定された場合は、GraphvizのDOT形式で返す。
+func (api *InfluenceAPI) Graph(c *gin.Context) {
+	format := c.DefaultQuery(Format, FormatJSON)
+	if format != FormatJSON && format != FormatDOT {
+		he := handleError(&model.InvalidParameterError{
+			Parameter: Format,
+			Message:   FormatErr,
+		})
+		c.JSON(he.code, he.message)
+		return
+	}
+
+	ctx := c.Request.Context()
+	graph, err := api.UseCase.Graph(ctx)
+	if err != nil {
+		he := handleError(err)
+		c.JSON(he.code, he.message)
+		return
+	}
+
+	if format == FormatDOT {
+		c.Data(http.StatusOK, DOTContentType, []byte(InfluenceGraphDOT(graph)))
+		return
+	}
+
+	c.JSON(http.StatusOK, output.NewInfluenceGraphOutput(graph))
+}
+
+// traverse は、URLで指定したProgrammingLangからdepthまで影響関係をたどった結果を返す。
+func (api *InfluenceAPI) traverse(c *gin.Context, traverse func(ctx context.Context, langID, depth int) ([]*model.InfluenceNode, error)) {
+	langID, err := getID(c)
+	if err != nil {
+		he := handleError(err)
+		c.JSON(he.code, he.message)
+		return
+	}
+
+	depth, err := getDepth(c)
+	if err != nil {
+		he := handleError(err)
+		c.JSON(he.code, he.message)
+		return
+	}
+
+	ctx := c.Request.Context()
+	nodes, err := traverse(ctx, langID, depth)
+	if err != nil {
+		he := handleError(err)
+		c.JSON(he.code, he.message)
+		return
+	}
+
+	c.JSON(http.StatusOK, output.NewInfluenceNodeOutputs(nodes))
+}
@@ -0,0 +1,166 @@
+package api_test
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/SekiguchiKai/clean-architecture-with-go/server/adapter/api"
+	"github.com/SekiguchiKai/clean-architecture-with-go/server/domain/model"
+	"github.com/SekiguchiKai/clean-architecture-with-go/server/usecase/mock"
+	"github.com/gin-gonic/gin"
+	"github.com/golang/mock/gomock"
+)
+
+func TestInfluenceAPI(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	langUseCase := mock_input.NewMockProgrammingLangInputPort(ctrl)
+	u := mock_input.NewMockInfluenceInputPort(ctrl)
+
+	graph := &model.InfluenceGraph{
+		Langs: []*model.ProgrammingLang{
+			{ID: 1, Name: "C"},
+			{ID: 2, Name: `Go "golang"`},
+		},
+		Influences: []*model.Influence{
+			{LangID: 2, InfluencedByID: 1},
+		},
+	}
+	nodes := []*model.InfluenceNode{
+		{Lang: graph.Langs[0], Depth: 1},
+	}
+
+	tests := []struct {
+		name     string
+		method   string
+		url      string
+		body     string
+		mock     func(ctx context.Context)
+		wantCode int
+		wantBody string
+	}{
+		{
+			name:   "影響を与えたProgrammingLangの一覧を取得する場合、ステータスコード200を返すこと",
+			method: api.Get,
+			url:    fmt.Sprintf("%s/2/%s", api.ProgrammingLangAPIPath, api.InfluencesPath),
+			mock: func(ctx context.Context) {
+				u.EXPECT().ListInfluencers(ctx, 2).Return(graph.Langs[:1], nil)
+			},
+			wantCode: http.StatusOK,
+		},
+		{
+			name:   "影響関係を追加する場合、リクエストボディのinfluencedByIdから影響を受けたことを記録すること",
+			method: api.Post,
+			url:    fmt.Sprintf("%s/2/%s", api.ProgrammingLangAPIPath, api.InfluencesPath),
+			body:   `{"influencedById":1}`,
+			mock: func(ctx context.Context) {
+				u.EXPECT().Add(ctx, 2, 1).Return(graph.Influences[0], nil)
+			},
+			wantCode: http.StatusOK,
+		},
+		{
+			name:   "影響関係を削除する場合、URLで指定した影響関係を削除すること",
+			method: api.Delete,
+			url:    fmt.Sprintf("%s/2/%s/1", api.ProgrammingLangAPIPath, api.InfluencesPath),
+			mock: func(ctx context.Context) {
+				u.EXPECT().Remove(ctx, 2, 1).Return(nil)
+			},
+			wantCode: http.StatusOK,
+		},
+		{
+			name:   "depthを指定しない場合、既定の距離までさかのぼること",
+			method: api.Get,
+			url:    fmt.Sprintf("%s/2/%s", api.ProgrammingLangAPIPath, api.AncestorsPath),
+			mock: func(ctx context.Context) {
+				u.EXPECT().Ancestors(ctx, 2, api.DefaultInfluenceDepth).Return(nodes, nil)
+			},
+			wantCode: http.StatusOK,
+		},
+		{
+			name:   "depthを指定した場合、その距離までたどること",
+			method: api.Get,
+			url:    fmt.Sprintf("%s/1/%s?%s=5", api.ProgrammingLangAPIPath, api.DescendantsPath, api.Depth),
+			mock: func(ctx context.Context) {
+				u.EXPECT().Descendants(ctx, 1, 5).Return(nodes, nil)
+			},
+			wantCode: http.StatusOK,
+		},
+		{
+			name:     "depthが上限を超える場合、ステータスコード400を返すこと",
+			method:   api.Get,
+			url:      fmt.Sprintf("%s/1/%s?%s=11", api.ProgrammingLangAPIPath, api.DescendantsPath, api.Depth),
+			mock:     func(ctx context.Context) {},
+			wantCode: http.StatusBadRequest,
+		},
+		{
+			name:   "最短経路が存在しない場合、ステータスコード404を返すこと",
+			method: api.Get,
+			url:    fmt.Sprintf("%s/%s?%s=1&%s=3", api.InfluenceAPIPath, api.PathPath, api.From, api.To),
+			mock: func(ctx context.Context) {
+				u.EXPECT().ShortestPath(ctx, 1, 3).Return(nil, &model.NoSuchDataError{
+					ID:        1,
+					Name:      "3",
+					ModelName: model.ModelNameInfluencePath,
+				})
+			},
+			wantCode: http.StatusNotFound,
+		},
+		{
+			name:     "toが数値でない場合、ステータスコード400を返すこと",
+			method:   api.Get,
+			url:      fmt.Sprintf("%s/%s?%s=1&%s=go", api.InfluenceAPIPath, api.PathPath, api.From, api.To),
+			mock:     func(ctx context.Context) {},
+			wantCode: http.StatusBadRequest,
+		},
+		{
+			name:   "formatにdotを指定した場合、DOT形式で返すこと",
+			method: api.Get,
+			url:    fmt.Sprintf("%s?%s=%s", api.InfluenceAPIPath, api.Format, api.FormatDOT),
+			mock: func(ctx context.Context) {
+				u.EXPECT().Graph(ctx).Return(graph, nil)
+			},
+			wantCode: http.StatusOK,
+			wantBody: "digraph influences {\n" +
+				"  1 [label=\"C\"];\n" +
+				"  2 [label=\"Go \\\"golang\\\"\"];\n" +
+				"  1 -> 2;\n" +
+				"}\n",
+		},
+		{
+			name:     "formatが定義されていない場合、ステータスコード400を返すこと",
+			method:   api.Get,
+			url:      fmt.Sprintf("%s?%s=svg", api.InfluenceAPIPath, api.Format),
+			mock:     func(ctx context.Context) {},
+			wantCode: http.StatusBadRequest,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			r := gin.New()
+			langAPI := api.NewProgrammingLangAPI(langUseCase)
+			langAPI.InitAPI(&r.RouterGroup)
+			api.NewInfluenceAPI(u).InitAPI(&r.RouterGroup)
+
+			tt.mock(context.Background())
+
+			rec := httptest.NewRecorder()
+			req, err := http.NewRequest(tt.method, tt.url, bytes.NewBufferString(tt.body))
+			if err != nil {
+				t.Fatal(err)
+			}
+			r.ServeHTTP(rec, req)
+
+			if rec.Code != tt.wantCode {
+				t.Errorf("Status Code = %v, want %v", rec.Code, tt.wantCode)
+			}
+			if tt.wantBody != "" && rec.Body.String() != tt.wantBody {
+				t.Errorf("Body = %v, want %v", rec.Body.String(), tt.wantBody)
+			}
+		})
+	}
+}
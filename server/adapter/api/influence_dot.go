@@ -0,0 +1,28 @@
+package api
+
+import (
+	"bytes"
+	"fmt"
+	"strings"
+
+	"github.com/SekiguchiKai/clean-architecture-with-go/server/domain/model"
+)
+
+// InfluenceGraphDOT は、影響関係をGraphvizのDOT形式で返す。辺は影響を与えたProgrammingLangから受けたProgrammingLangに向かう。
+func InfluenceGraphDOT(graph *model.InfluenceGraph) string {
+	var buf bytes.Buffer
+	buf.WriteString("digraph influences {\n")
+	for _, lang := range graph.Langs {
+		fmt.Fprintf(&buf, "  %d [label=%s];\n", lang.ID, quoteDOT(lang.Name))
+	}
+	for _, in := range graph.Influences {
+		fmt.Fprintf(&buf, "  %d -> %d;\n", in.InfluencedByID, in.LangID)
+	}
+	buf.WriteString("}\n")
+	return buf.String()
+}
+
+// quoteDOT は、DOT形式の文字列として引用符で囲む。
+func quoteDOT(s string) string {
+	return `"` + strings.NewReplacer(`\`, `\\`, `"`, `\"`, "\n", `\n`).Replace(s) + `"`
+}
@@ -0,0 +1,162 @@
+package api
+
+import (
+	"fmt"
+	"net/http"
+
+	"github.com/SekiguchiKai/clean-architecture-with-go/server/domain/model"
+	"github.com/SekiguchiKai/clean-architecture-with-go/server/usecase/input"
+	"github.com/SekiguchiKai/clean-architecture-with-go/server/usecase/output"
+	"github.com/gin-gonic/gin"
+)
+
+// LanguageVersionAPI は、LanguageVersionのAPI。
+type LanguageVersionAPI struct {
+	UseCase input.LanguageVersionInputPort
+}
+
+// NewLanguageVersionAPI は、LanguageVersionAPIを生成し、返す。
+func NewLanguageVersionAPI(useCase input.LanguageVersionInputPort) *LanguageVersionAPI {
+	return &LanguageVersionAPI{
+		UseCase: useCase,
+	}
+}
+
+// InitAPI は、APIを初期設定する。
+func (api *LanguageVersionAPI) InitAPI(g *gin.RouterGroup) {
+	versionsPath := fmt.Sprintf("%s/:%s/%s", ProgrammingLangAPIPath, ID, VersionsPath)
+	g.GET(versionsPath, api.List)
+	g.GET(fmt.Sprintf("%s/:%s", versionsPath, SubID), api.Get)
+	g.POST(versionsPath, api.Create)
+	g.PUT(fmt.Sprintf("%s/:%s", versionsPath, SubID), api.Update)
+	g.DELETE(fmt.Sprintf("%s/:%s", versionsPath, SubID), api.Delete)
+}
+
+// List は、LanguageVersionの一覧をバージョンの新しい順に返す。supportedOnが指定された場合は、その日にサポートされているものを返す。
+func (api *LanguageVersionAPI) List(c *gin.Context) {
+	langID, err := getID(c)
+	if err != nil {
+		he := handleError(err)
+		c.JSON(he.code, he.message)
+		return
+	}
+
+	ctx := c.Request.Context()
+
+	var versions []*model.LanguageVersion
+	if supportedOn := c.Query(SupportedOn); supportedOn != "" {
+		date, err := getDate(supportedOn, SupportedOn)
+		if err != nil {
+			he := handleError(err)
+			c.JSON(he.code, he.message)
+			return
+		}
+		versions, err = api.UseCase.ListSupportedOn(ctx, langID, date)
+	} else {
+		versions, err = api.UseCase.List(ctx, langID)
+	}
+	if err != nil {
+		he := handleError(err)
+		c.JSON(he.code, he.message)
+		return
+	}
+
+	c.JSON(http.StatusOK, output.NewLanguageVersionOutputs(versions))
+}
+
+// Get は、LanguageVersionを取得する。バージョンにlatestが指定された場合は、最新の安定版を返す。
+func (api *LanguageVersionAPI) Get(c *gin.Context) {
+	langID, err := getID(c)
+	if err != nil {
+		he := handleError(err)
+		c.JSON(he.code, he.message)
+		return
+	}
+
+	ctx := c.Request.Context()
+
+	var v *model.LanguageVersion
+	if version := c.Param(SubID); version == LatestPath {
+		v, err = api.UseCase.GetLatestStable(ctx, langID)
+	} else {
+		v, err = api.UseCase.Get(ctx, langID, version)
+	}
+	if err != nil {
+		he := handleError(err)
+		c.JSON(he.code, he.message)
+		return
+	}
+
+	c.JSON(http.StatusOK, output.NewLanguageVersionOutput(v))
+}
+
+// Create は、LanguageVersionを生成する。
+func (api *LanguageVersionAPI) Create(c *gin.Context) {
+	var params *input.LanguageVersionInput
+	if err := c.BindJSON(&params); err != nil {
+		c.JSON(http.StatusBadRequest, err.Error())
+		return
+	}
+
+	langID, err := getID(c)
+	if err != nil {
+		he := handleError(err)
+		c.JSON(he.code, he.message)
+		return
+	}
+
+	ctx := c.Request.Context()
+	v, err := api.UseCase.Create(ctx, langID, params.ToModel())
+	if err != nil {
+		he := handleError(err)
+		c.JSON(he.code, he.message)
+		return
+	}
+
+	c.JSON(http.StatusOK, output.NewLanguageVersionOutput(v))
+}
+
+// Update は、LanguageVersionを更新する。
+func (api *LanguageVersionAPI) Update(c *gin.Context) {
+	var params *input.LanguageVersionInput
+	if err := c.BindJSON(&params); err != nil {
+		c.JSON(http.StatusBadRequest, err.Error())
+		return
+	}
+
+	langID, err := getID(c)
+	if err != nil {
+		he := handleError(err)
+		c.JSON(he.code, he.message)
+		return
+	}
+
+	ctx := c.Request.Context()
+	v, err := api.UseCase.Update(ctx, langID, c.Param(SubID), params.ToModel())
+	if err != nil {
+		he := handleError(err)
+		c.JSON(he.code, he.message)
+		return
+	}
+
+	c.JSON(http.StatusOK, output.NewLanguageVersionOutput(v))
+}
+
+// Delete は、LanguageVersionを削除する。
+func (api *LanguageVersionAPI) Delete(c *gin.Context) {
+	langID, err := getID(c)
+	if err != nil {
+		he := handleError(err)
+		c.JSON(he.code, he.message)
+		return
+	}
+
+	ctx := c.Request.Context()
+	if err := api.UseCase.Delete(ctx, langID, c.Param(SubID)); err != nil {
+		he := handleError(err)
+		c.JSON(he.code, he.message)
+		return
+	}
+
+	c.JSON(http.StatusOK, nil)
+}
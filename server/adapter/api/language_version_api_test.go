@@ -0,0 +1,173 @@
+package api_test
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/SekiguchiKai/clean-architecture-with-go/server/adapter/api"
+	"github.com/SekiguchiKai/clean-architecture-with-go/server/domain/model"
+	"github.com/SekiguchiKai/clean-architecture-with-go/server/usecase/input"
+	"github.com/SekiguchiKai/clean-architecture-with-go/server/usecase/mock"
+	"github.com/gin-gonic/gin"
+	"github.com/golang/mock/gomock"
+)
+
+func TestLanguageVersionAPI(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	langUseCase := mock_input.NewMockProgrammingLangInputPort(ctrl)
+	u := mock_input.NewMockLanguageVersionInputPort(ctrl)
+
+	lang := model.CreateProgrammingLangs(1)[0]
+	versions := model.CreateLanguageVersions(1, 2)
+	body, err := json.Marshal(&input.LanguageVersionInput{Version: versions[0].Version, ReleaseDate: versions[0].ReleaseDate})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	versionsURL := fmt.Sprintf("%s/1/%s", api.ProgrammingLangAPIPath, api.VersionsPath)
+
+	tests := []struct {
+		name     string
+		method   string
+		url      string
+		body     []byte
+		mock     func(ctx context.Context)
+		wantCode int
+	}{
+		{
+			name:   "一覧を取得する場合、ステータスコード200を返すこと",
+			method: api.Get,
+			url:    versionsURL,
+			mock: func(ctx context.Context) {
+				u.EXPECT().List(ctx, 1).Return(versions, nil)
+			},
+			wantCode: http.StatusOK,
+		},
+		{
+			name:   "supportedOnを指定した場合、その日にサポートされているものを取得すること",
+			method: api.Get,
+			url:    fmt.Sprintf("%s?%s=2018-10-01", versionsURL, api.SupportedOn),
+			mock: func(ctx context.Context) {
+				u.EXPECT().ListSupportedOn(ctx, 1, time.Date(2018, time.October, 1, 0, 0, 0, 0, time.UTC)).Return(versions, nil)
+			},
+			wantCode: http.StatusOK,
+		},
+		{
+			name:     "supportedOnが日付の形式でない場合、ステータスコード400を返すこと",
+			method:   api.Get,
+			url:      fmt.Sprintf("%s?%s=yesterday", versionsURL, api.SupportedOn),
+			mock:     func(ctx context.Context) {},
+			wantCode: http.StatusBadRequest,
+		},
+		{
+			name:   "latestを指定した場合、最新の安定版を取得すること",
+			method: api.Get,
+			url:    fmt.Sprintf("%s/%s", versionsURL, api.LatestPath),
+			mock: func(ctx context.Context) {
+				u.EXPECT().GetLatestStable(ctx, 1).Return(versions[1], nil)
+			},
+			wantCode: http.StatusOK,
+		},
+		{
+			name:   "存在しないバージョンを指定した場合、ステータスコード404を返すこと",
+			method: api.Get,
+			url:    fmt.Sprintf("%s/%s", versionsURL, "9.9.9"),
+			mock: func(ctx context.Context) {
+				u.EXPECT().Get(ctx, 1, "9.9.9").Return(nil, &model.NoSuchDataError{
+					ID:        1,
+					Name:      "9.9.9",
+					ModelName: model.ModelNameLanguageVersion,
+				})
+			},
+			wantCode: http.StatusNotFound,
+		},
+		{
+			name:     "定義されていないサブリソースを指定した場合、ステータスコード404を返すこと",
+			method:   api.Get,
+			url:      fmt.Sprintf("%s/1/%s/%s", api.ProgrammingLangAPIPath, "unknown", model.TestVersion),
+			mock:     func(ctx context.Context) {},
+			wantCode: http.StatusNotFound,
+		},
+		{
+			name:   "slugがサブリソースと同じ名前の場合も、slugとして扱うこと",
+			method: api.Get,
+			url:    fmt.Sprintf("%s/%s", api.LangSlugAPIPath, api.VersionsPath),
+			mock: func(ctx context.Context) {
+				langUseCase.EXPECT().GetBySlug(ctx, api.VersionsPath).Return(lang, nil)
+			},
+			wantCode: http.StatusMovedPermanently,
+		},
+		{
+			name:   "生成する場合、ステータスコード200を返すこと",
+			method: api.Post,
+			url:    versionsURL,
+			body:   body,
+			mock: func(ctx context.Context) {
+				u.EXPECT().Create(ctx, 1, &model.LanguageVersion{Version: versions[0].Version, ReleaseDate: versions[0].ReleaseDate}).Return(versions[0], nil)
+			},
+			wantCode: http.StatusOK,
+		},
+		{
+			name:   "同じバージョンが既に存在する場合、ステータスコード409を返すこと",
+			method: api.Post,
+			url:    versionsURL,
+			body:   body,
+			mock: func(ctx context.Context) {
+				u.EXPECT().Create(ctx, 1, gomock.Any()).Return(nil, &model.AlreadyExistError{
+					ID:        1,
+					Name:      model.TestVersion,
+					ModelName: model.ModelNameLanguageVersion,
+				})
+			},
+			wantCode: http.StatusConflict,
+		},
+		{
+			name:   "更新する場合、URLで指定したバージョンを更新すること",
+			method: api.Put,
+			url:    fmt.Sprintf("%s/%s", versionsURL, model.TestVersion),
+			body:   body,
+			mock: func(ctx context.Context) {
+				u.EXPECT().Update(ctx, 1, model.TestVersion, gomock.Any()).Return(versions[0], nil)
+			},
+			wantCode: http.StatusOK,
+		},
+		{
+			name:   "削除する場合、URLで指定したバージョンを削除すること",
+			method: api.Delete,
+			url:    fmt.Sprintf("%s/%s", versionsURL, model.TestVersion),
+			mock: func(ctx context.Context) {
+				u.EXPECT().Delete(ctx, 1, model.TestVersion).Return(nil)
+			},
+			wantCode: http.StatusOK,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			r := gin.New()
+			langAPI := api.NewProgrammingLangAPI(langUseCase)
+			langAPI.InitAPI(&r.RouterGroup)
+			api.NewLanguageVersionAPI(u).InitAPI(&r.RouterGroup)
+
+			tt.mock(context.Background())
+
+			rec := httptest.NewRecorder()
+			req, err := http.NewRequest(tt.method, tt.url, bytes.NewReader(tt.body))
+			if err != nil {
+				t.Fatal(err)
+			}
+			r.ServeHTTP(rec, req)
+
+			if rec.Code != tt.wantCode {
+				t.Errorf("Status Code = %v, want %v", rec.Code, tt.wantCode)
+			}
+		})
+	}
+}
@@ -0,0 +1,240 @@
+package api
+
+import (
+	"encoding/json"
+	"strings"
+
+	"github.com/pkg/errors"
+)
+
+// OpenAPISpec は、リクエストの検証に使用するOpenAPI 3の文書の一部を表す。
+type OpenAPISpec struct {
+	OpenAPI    string                     `json:"openapi"`
+	Paths      map[string]OpenAPIPathItem `json:"paths"`
+	Components *OpenAPIComponents         `json:"components"`
+}
+
+// OpenAPIPathItem は、小文字のHTTPのメソッドごとのOperationを表す。
+type OpenAPIPathItem map[string]*OpenAPIOperation
+
+// OpenAPIOperation は、1つのルートに対する操作を表す。
+type OpenAPIOperation struct {
+	OperationID string                      `json:"operationId"`
+	Parameters  []*OpenAPIParameter         `json:"parameters"`
+	RequestBody *OpenAPIRequestBody         `json:"requestBody"`
+	Responses   map[string]*OpenAPIResponse `json:"responses"`
+}
+
+// OpenAPIParameter は、パス、Query String、ヘッダーで受け付ける値を表す。
+type OpenAPIParameter struct {
+	Ref      string         `json:"$ref"`
+	Name     string         `json:"name"`
+	In       string         `json:"in"`
+	Required bool           `json:"required"`
+	Schema   *OpenAPISchema `json:"schema"`
+}
+
+// OpenAPIRequestBody は、リクエストボディを表す。
+type OpenAPIRequestBody struct {
+	Required bool                         `json:"required"`
+	Content  map[string]*OpenAPIMediaType `json:"content"`
+}
+
+// OpenAPIResponse は、レスポンスを表す。
+type OpenAPIResponse struct {
+	Ref         string                       `json:"$ref"`
+	Description string                       `json:"description"`
+	Content     map[string]*OpenAPIMediaType `json:"content"`
+}
+
+// OpenAPIMediaType は、Content-Typeごとのスキーマを表す。
+type OpenAPIMediaType struct {
+	Schema *OpenAPISchema `json:"schema"`
+}
+
+// OpenAPISchema は、値のスキーマを表す。検証には、type、required、properties、items、enum、minimum、maximum、minLengthを使用する。
+type OpenAPISchema struct {
+	Ref        string                    `json:"$ref"`
+	Type       string                    `json:"type"`
+	Nullable   bool                      `json:"nullable"`
+	Required   []string                  `json:"required"`
+	Properties map[string]*OpenAPISchema `json:"properties"`
+	Items      *OpenAPISchema            `json:"items"`
+	Enum       []string                  `json:"enum"`
+	Minimum    *int                      `json:"minimum"`
+	Maximum    *int                      `json:"maximum"`
+	MinLength  int                       `json:"minLength"`
+}
+
+// OpenAPIComponents は、$refで参照するスキーマ、パラメータ、レスポンスを表す。
+type OpenAPIComponents struct {
+	Schemas    map[string]*OpenAPISchema    `json:"schemas"`
+	Parameters map[string]*OpenAPIParameter `json:"parameters"`
+	Responses  map[string]*OpenAPIResponse  `json:"responses"`
+}
+
+// OpenAPIDocument は、/openapi.jsonで返すOpenAPI 3の文書を返す。
+func OpenAPIDocument() []byte {
+	return []byte(openAPIDocument)
+}
+
+// LoadOpenAPISpec は、OpenAPI 3の文書を読み込み、$refを解決して返す。
+func LoadOpenAPISpec() (*OpenAPISpec, error) {
+	var spec *OpenAPISpec
+	if err := json.Unmarshal(OpenAPIDocument(), &spec); err != nil {
+		return nil, errors.WithStack(err)
+	}
+
+	if err := spec.resolve(); err != nil {
+		return nil, err
+	}
+	return spec, nil
+}
+
+// Operation は、メソッドとパスに一致するOperationと、そのパスのテンプレートを返す。
+// 複数のテンプレートに一致する場合は、固定のセグメントが多いものを返す。
+func (s *OpenAPISpec) Operation(method, path string) (string, *OpenAPIOperation) {
+	var (
+		template  string
+		operation *OpenAPIOperation
+		best      = -1
+	)
+	for t, item := range s.Paths {
+		op, ok := item[strings.ToLower(method)]
+		if !ok {
+			continue
+		}
+		if n, ok := matchTemplate(t, path); ok && n > best {
+			template, operation, best = t, op, n
+		}
+	}
+	return template, operation
+}
+
+// resolve は、Operationが参照するパラメータ、スキーマ、レスポンスの$refを解決する。
+func (s *OpenAPISpec) resolve() error {
+	for _, item := range s.Paths {
+		for _, op := range item {
+			for i, p := range op.Parameters {
+				resolved, err := s.parameter(p)
+				if err != nil {
+					return err
+				}
+				op.Parameters[i] = resolved
+			}
+
+			if op.RequestBody != nil {
+				for _, m := range op.RequestBody.Content {
+					if err := s.resolveSchema(&m.Schema); err != nil {
+						return err
+					}
+				}
+			}
+
+			for code, r := range op.Responses {
+				resolved, err := s.response(r)
+				if err != nil {
+					return err
+				}
+				op.Responses[code] = resolved
+			}
+		}
+	}
+	return nil
+}
+
+// resolveSchema は、スキーマとその配下の$refを解決する。循環する参照は扱わない。
+func (s *OpenAPISpec) resolveSchema(schema **OpenAPISchema) error {
+	if *schema == nil {
+		return nil
+	}
+
+	if ref := (*schema).Ref; ref != "" {
+		resolved, ok := s.Components.Schemas[strings.TrimPrefix(ref, "#/components/schemas/")]
+		if !ok {
+			return errors.Errorf("unknown $ref: %s", ref)
+		}
+		*schema = resolved
+	}
+
+	for name := range (*schema).Properties {
+		p := (*schema).Properties[name]
+		if err := s.resolveSchema(&p); err != nil {
+			return err
+		}
+		(*schema).Properties[name] = p
+	}
+	return s.resolveSchema(&(*schema).Items)
+}
+
+// parameter は、$refを解決したパラメータを返す。
+func (s *OpenAPISpec) parameter(p *OpenAPIParameter) (*OpenAPIParameter, error) {
+	if p.Ref != "" {
+		resolved, ok := s.Components.Parameters[strings.TrimPrefix(p.Ref, "#/components/parameters/")]
+		if !ok {
+			return nil, errors.Errorf("unknown $ref: %s", p.Ref)
+		}
+		p = resolved
+	}
+
+	if err := s.resolveSchema(&p.Schema); err != nil {
+		return nil, err
+	}
+	return p, nil
+}
+
+// response は、$refを解決したレスポンスを返す。
+func (s *OpenAPISpec) response(r *OpenAPIResponse) (*OpenAPIResponse, error) {
+	if r.Ref == "" {
+		return r, nil
+	}
+
+	resolved, ok := s.Components.Responses[strings.TrimPrefix(r.Ref, "#/components/responses/")]
+	if !ok {
+		return nil, errors.Errorf("unknown $ref: %s", r.Ref)
+	}
+	return resolved, nil
+}
+
+// matchTemplate は、パスが{name}を含むテンプレートに一致するかどうかと、一致した固定のセグメントの数を返す。
+func matchTemplate(template, path string) (int, bool) {
+	ts := strings.Split(strings.Trim(template, "/"), "/")
+	ps := strings.Split(strings.Trim(path, "/"), "/")
+	if len(ts) != len(ps) {
+		return 0, false
+	}
+
+	literals := 0
+	for i := range ts {
+		if isTemplateParam(ts[i]) {
+			if ps[i] == "" {
+				return 0, false
+			}
+			continue
+		}
+		if ts[i] != ps[i] {
+			return 0, false
+		}
+		literals++
+	}
+	return literals, true
+}
+
+// pathParams は、テンプレートに一致したパスから、{name}の値を取り出す。
+func pathParams(template, path string) map[string]string {
+	ts := strings.Split(strings.Trim(template, "/"), "/")
+	ps := strings.Split(strings.Trim(path, "/"), "/")
+
+	params := make(map[string]string)
+	for i := range ts {
+		if isTemplateParam(ts[i]) && i < len(ps) {
+			params[strings.Trim(ts[i], "{}")] = ps[i]
+		}
+	}
+	return params
+}
+
+// isTemplateParam は、テンプレートのセグメントが{name}であるかどうかを確認する。
+func isTemplateParam(segment string) bool {
+	return strings.HasPrefix(segment, "{") && strings.HasSuffix(segment, "}")
+}
@@ -0,0 +1,121 @@
+package api_test
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/SekiguchiKai/clean-architecture-with-go/server/adapter/api"
+	"github.com/gin-gonic/gin"
+)
+
+func TestOpenAPISpec_Operation(t *testing.T) {
+	spec, err := api.LoadOpenAPISpec()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	tests := []struct {
+		name         string
+		method       string
+		path         string
+		wantTemplate string
+	}{
+		{
+			name:         "パラメータを含むパスの場合、テンプレートに一致すること",
+			method:       api.Get,
+			path:         "/v1/langs/1",
+			wantTemplate: "/v1/langs/{id}",
+		},
+		{
+			name:         "固定のパスの場合、テンプレートに一致すること",
+			method:       api.Get,
+			path:         "/v1/langs-suggest",
+			wantTemplate: "/v1/langs-suggest",
+		},
+		{
+			name:         "メソッドが記述されていない場合、一致しないこと",
+			method:       api.Delete,
+			path:         "/v1/langs",
+			wantTemplate: "",
+		},
+		{
+			name:         "固定のパスとパラメータを含むパスの両方に一致する場合、固定のパスに一致すること",
+			method:       api.Get,
+			path:         "/v1/admin/webhooks/dead-letters",
+			wantTemplate: "/v1/admin/webhooks/dead-letters",
+		},
+		{
+			name:         "文書に記述されていないパスの場合、一致しないこと",
+			method:       api.Get,
+			path:         "/v1/unknown",
+			wantTemplate: "",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			template, op := spec.Operation(tt.method, tt.path)
+			if template != tt.wantTemplate {
+				t.Errorf("template = %v, want %v", template, tt.wantTemplate)
+			}
+			if (op != nil) != (tt.wantTemplate != "") {
+				t.Errorf("operation = %v, want found = %v", op, tt.wantTemplate != "")
+			}
+		})
+	}
+}
+
+func TestDocsAPI(t *testing.T) {
+	r := gin.New()
+	api.NewDocsAPI().InitAPI(&r.RouterGroup)
+
+	tests := []struct {
+		name            string
+		path            string
+		wantContentType string
+	}{
+		{
+			name:            "/openapi.jsonの場合、OpenAPI 3の文書を返すこと",
+			path:            api.OpenAPIPath,
+			wantContentType: api.JSONContentType,
+		},
+		{
+			name:            "/docsの場合、/openapi.jsonを表示するページを返すこと",
+			path:            api.DocsPath,
+			wantContentType: api.HTMLContentType,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			rec := httptest.NewRecorder()
+			req, err := http.NewRequest(api.Get, tt.path, nil)
+			if err != nil {
+				t.Fatal(err)
+			}
+			r.ServeHTTP(rec, req)
+
+			if rec.Code != http.StatusOK {
+				t.Errorf("Status Code = %v, want %v", rec.Code, http.StatusOK)
+			}
+			if got := rec.Header().Get("Content-Type"); got != tt.wantContentType {
+				t.Errorf("Content-Type = %v, want %v", got, tt.wantContentType)
+			}
+
+			if tt.path == api.OpenAPIPath {
+				var doc struct {
+					OpenAPI string `json:"openapi"`
+				}
+				if err := json.Unmarshal(rec.Body.Bytes(), &doc); err != nil {
+					t.Fatal(err)
+				}
+				if !strings.HasPrefix(doc.OpenAPI, "3.") {
+					t.Errorf("openapi = %v, want 3.x", doc.OpenAPI)
+				}
+			} else if !strings.Contains(rec.Body.String(), api.OpenAPIPath) {
+				t.Errorf("body does not load %s", api.OpenAPIPath)
+			}
+		})
+	}
+}
@@ -0,0 +1,103 @@
+package api
+
+import (
+	"encoding/json"
+	"net/http"
+	"strings"
+
+	"github.com/SekiguchiKai/clean-architecture-with-go/server/domain/model"
+	"github.com/gin-gonic/gin"
+	"github.com/pkg/errors"
+)
+
+// Problem は、/v2でエラーを返す際のapplication/problem+json(RFC 7807)の形式。
+// エラーの種類はTypeで示し、ドメインのエラーの属性は拡張メンバーで返す。クライアントはDetailを解析せずにエラーを復元できる。
+type Problem struct {
+	Type     string `json:"type"`
+	Title    string `json:"title"`
+	Status   int    `json:"status"`
+	Detail   string `json:"detail,omitempty"`
+	Instance string `json:"instance,omitempty"`
+	// Property は、不適切な属性、もしくは必須の属性の名前。
+	Property string `json:"property,omitempty"`
+	// Parameter は、不適切なパスやQuery String、ヘッダーのパラメータの名前。
+	Parameter string `json:"parameter,omitempty"`
+	// Reason は、属性やパラメータが不適切な理由。
+	Reason string `json:"reason,omitempty"`
+	// Model、ID、Name、DidYouMeanは、存在しない、もしくは既に存在するデータを表す。
+	Model      string   `json:"model,omitempty"`
+	ID         int      `json:"id,omitempty"`
+	Name       string   `json:"name,omitempty"`
+	DidYouMean []string `json:"didYouMean,omitempty"`
+}
+
+// newProblem は、エラーをハンドリングし、typeと拡張メンバーを設定したProblemを返す。
+func newProblem(err error) *Problem {
+	he := handleError(err)
+	p := &Problem{
+		Type:   ProblemTypeBlank,
+		Status: he.code,
+		Detail: he.message,
+	}
+
+	switch e := errors.Cause(err).(type) {
+	case *model.RequiredError:
+		p.Type = ProblemTypeRequired
+		p.Property = e.Property
+	case *model.InvalidPropertyError:
+		p.Type = ProblemTypeInvalidProperty
+		p.Property = e.Property
+		p.Reason = e.Message
+	case *model.InvalidParameterError:
+		p.Type = ProblemTypeInvalidParameter
+		p.Parameter = e.Parameter
+		p.Reason = e.Message
+	case *model.NoSuchDataError:
+		p.Type = ProblemTypeNotFound
+		p.Model = e.ModelName
+		p.ID = e.ID
+		p.Name = e.Name
+		p.DidYouMean = e.DidYouMean
+	case *model.AlreadyExistError:
+		p.Type = ProblemTypeAlreadyExists
+		p.Model = e.ModelName
+		p.ID = e.ID
+		p.Name = e.Name
+	}
+	return p
+}
+
+// respondProblem は、エラーをハンドリングし、application/problem+jsonで返す。
+func respondProblem(c *gin.Context, err error) {
+	abortWithProblem(c, newProblem(err))
+}
+
+// abortWithError は、Problemを、/v2のリクエストにはapplication/problem+jsonで、
+// それ以外のリクエストにはDetailをJSONの文字列で返し、以降の処理を中断する。
+// バージョンをまたいで使用するミドルウェアは、このメソッドでエラーを返す。
+func abortWithError(c *gin.Context, p *Problem) {
+	if path := c.Request.URL.Path; path == V2Path || strings.HasPrefix(path, V2Path+"/") {
+		abortWithProblem(c, p)
+		return
+	}
+	c.AbortWithStatusJSON(p.Status, p.Detail)
+}
+
+// abortWithProblem は、Problemをapplication/problem+jsonで返し、以降の処理を中断する。
+// Typeが空の場合はabout:blankとし、TitleとInstanceはステータスコードとリクエストから設定する。
+func abortWithProblem(c *gin.Context, p *Problem) {
+	if p.Type == "" {
+		p.Type = ProblemTypeBlank
+	}
+	p.Title = http.StatusText(p.Status)
+	p.Instance = c.Request.URL.Path
+
+	b, err := json.Marshal(p)
+	if err != nil {
+		c.AbortWithStatus(http.StatusInternalServerError)
+		return
+	}
+
+	c.Data(p.Status, ProblemContentType, b)
+	c.Abort()
+}
@@ -3,15 +3,20 @@ package api
 import (
 	"fmt"
 	"net/http"
+	"path"
+	"time"
 
 	"github.com/SekiguchiKai/clean-architecture-with-go/server/domain/model"
 	"github.com/SekiguchiKai/clean-architecture-with-go/server/usecase/input"
+	"github.com/SekiguchiKai/clean-architecture-with-go/server/usecase/output"
 	"github.com/gin-gonic/gin"
 )
 
 // ProgrammingLangAPI は、ProgrammingLangのAPI。
 type ProgrammingLangAPI struct {
 	UseCase input.ProgrammingLangInputPort
+	// Heartbeat は、/langs-eventsで接続を保つためにコメントを送信する間隔。0の場合は、DefaultEventHeartbeatを使用する。
+	Heartbeat time.Duration
 }
 
 // NewProgrammingLangAPI は、ProgrammingLangAPIを生成し、返す。
@@ -25,13 +30,27 @@ func NewProgrammingLangAPI(useCase input.ProgrammingLangInputPort) *ProgrammingL
 func (api *ProgrammingLangAPI) InitAPI(g *gin.RouterGroup) {
 	g.GET(ProgrammingLangAPIPath, api.List)
 	g.GET(fmt.Sprintf("%s/:%s", ProgrammingLangAPIPath, ID), api.Get)
+	g.GET(fmt.Sprintf("%s/:%s", LangSlugAPIPath, Slug), api.GetBySlug)
+	g.GET(LangSuggestAPIPath, api.Suggest)
+	g.GET(LangEventsAPIPath, api.Events)
 	g.POST(ProgrammingLangAPIPath, api.Create)
 	g.PUT(fmt.Sprintf("%s/:%s", ProgrammingLangAPIPath, ID), api.Update)
 	g.DELETE(fmt.Sprintf("%s/:%s", ProgrammingLangAPIPath, ID), api.Delete)
 }
 
-// List は、ProgrammingLangの一覧を返す。
+// List は、ProgrammingLangの一覧を返す。nameが指定された場合は、Nameが完全に一致するものを返す。
+// tagが指定された場合は、tagMatchに従ってタグで絞り込んだものを返す。
 func (api *ProgrammingLangAPI) List(c *gin.Context) {
+	if name := c.Query(Name); name != "" {
+		api.listByName(c, name)
+		return
+	}
+
+	if tags := c.QueryArray(Tag); len(tags) > 0 {
+		api.listByTags(c, tags)
+		return
+	}
+
 	limit, err := getLimit(c)
 	if err != nil {
 		he := handleError(err)
@@ -42,6 +61,28 @@ func (api *ProgrammingLangAPI) List(c *gin.Context) {
 	limit = ManageLimit(limit, MaxLimit, MinLimit, DefaultLimit)
 
 	ctx := c.Request.Context()
+
+	// 削除では一覧に含まれるProgrammingLangのUpdatedAtが進まないため、削除を含めた一覧の最終更新日時を使用する。
+	lastModified, err := api.UseCase.LastModified(ctx)
+	if err != nil {
+		he := handleError(err)
+		c.JSON(he.code, he.message)
+		return
+	}
+
+	etag, err := collectionETag(lastModified, limit)
+	if err != nil {
+		he := handleError(err)
+		c.JSON(he.code, he.message)
+		return
+	}
+
+	// 一覧を読み込む前に条件を確認し、変更がない場合はレコードを取得せずに304を返す。
+	if notModified(c, etag, lastModified) {
+		respondNotModified(c, etag, lastModified)
+		return
+	}
+
 	langSlice, err := api.UseCase.List(ctx, limit)
 	if err != nil {
 		he := handleError(err)
@@ -49,7 +90,8 @@ func (api *ProgrammingLangAPI) List(c *gin.Context) {
 		return
 	}
 
-	c.JSON(http.StatusOK, langSlice)
+	setCacheHeaders(c, etag, lastModified)
+	c.JSON(http.StatusOK, output.NewProgrammingLangOutputs(langSlice))
 }
 
 // Get は、ProgrammingLangを取得する。
@@ -69,31 +111,79 @@ func (api *ProgrammingLangAPI) Get(c *gin.Context) {
 		return
 	}
 
-	c.JSON(http.StatusOK, lang)
+	etag, err := weakETag(output.NewProgrammingLangOutput(lang))
+	if err != nil {
+		he := handleError(err)
+		c.JSON(he.code, he.message)
+		return
+	}
+
+	if notModified(c, etag, lang.UpdatedAt) {
+		respondNotModified(c, etag, lang.UpdatedAt)
+		return
+	}
+
+	setCacheHeaders(c, etag, lang.UpdatedAt)
+	c.JSON(http.StatusOK, output.NewProgrammingLangOutput(lang))
+}
+
+// GetBySlug は、slugで指定したProgrammingLangを取得する。変更前のslugが指定された場合は、現在のslugのURLに転送する。
+func (api *ProgrammingLangAPI) GetBySlug(c *gin.Context) {
+	slug := c.Param(Slug)
+
+	ctx := c.Request.Context()
+	lang, err := api.UseCase.GetBySlug(ctx, slug)
+	if err != nil {
+		he := handleError(err)
+		c.JSON(he.code, he.message)
+		return
+	}
+
+	if lang.Slug != slug {
+		location := *c.Request.URL
+		location.Path = path.Join(path.Dir(location.Path), lang.Slug)
+		c.Redirect(http.StatusMovedPermanently, location.String())
+		return
+	}
+
+	etag, err := weakETag(output.NewProgrammingLangOutput(lang))
+	if err != nil {
+		he := handleError(err)
+		c.JSON(he.code, he.message)
+		return
+	}
+
+	if notModified(c, etag, lang.UpdatedAt) {
+		respondNotModified(c, etag, lang.UpdatedAt)
+		return
+	}
+
+	setCacheHeaders(c, etag, lang.UpdatedAt)
+	c.JSON(http.StatusOK, output.NewProgrammingLangOutput(lang))
 }
 
 // Create は、ProgrammingLangを生成する。
 func (api *ProgrammingLangAPI) Create(c *gin.Context) {
-	var params *model.ProgrammingLang
+	var params *input.ProgrammingLangInput
 	if err := c.BindJSON(&params); err != nil {
 		c.JSON(http.StatusBadRequest, err.Error())
 		return
 	}
 
 	ctx := c.Request.Context()
-	lang, err := api.UseCase.Create(ctx, params)
+	lang, err := api.UseCase.Create(ctx, params.ToCreate())
 	if err != nil {
 		he := handleError(err)
 		c.JSON(he.code, he.message)
 		return
 	}
 
-	c.JSON(http.StatusOK, lang)
+	c.JSON(http.StatusOK, output.NewProgrammingLangOutput(lang))
 }
 
 // Update は、ProgrammingLangを更新する。
 func (api *ProgrammingLangAPI) Update(c *gin.Context) {
-	var params *model.ProgrammingLang
+	var params *input.ProgrammingLangInput
 	if err := c.BindJSON(&params); err != nil {
 		c.JSON(http.StatusBadRequest, err.Error())
 		return
@@ -107,14 +197,14 @@ func (api *ProgrammingLangAPI) Update(c *gin.Context) {
 		return
 	}
 
-	lang, err := api.UseCase.Update(ctx, id, params)
+	lang, err := api.UseCase.Update(ctx, id, params.ToUpdate())
 	if err != nil {
 		he := handleError(err)
 		c.JSON(he.code, he.message)
 		return
 	}
 
-	c.JSON(http.StatusOK, lang)
+	c.JSON(http.StatusOK, output.NewProgrammingLangOutput(lang))
 }
 
 // Delete は、ProgrammingLangを削除する。
@@ -135,3 +225,69 @@ func (api *ProgrammingLangAPI) Delete(c *gin.Context) {
 
 	c.JSON(http.StatusOK, nil)
 }
+
+// listByName は、Nameが完全に一致するProgrammingLangを一覧として返す。
+func (api *ProgrammingLangAPI) listByName(c *gin.Context, name string) {
+	ctx := c.Request.Context()
+	lang, err := api.UseCase.GetByName(ctx, name)
+	if err != nil {
+		he := handleError(err)
+		c.JSON(he.code, he.message)
+		return
+	}
+
+	c.JSON(http.StatusOK, output.NewProgrammingLangOutputs([]*model.ProgrammingLang{lang}))
+}
+
+// Suggest は、prefixから始まる、もしくはprefixに類似するNameもしくは別名を持つProgrammingLangの候補を返す。
+func (api *ProgrammingLangAPI) Suggest(c *gin.Context) {
+	limit, err := getLimit(c)
+	if err != nil {
+		he := handleError(err)
+		c.JSON(he.code, he.message)
+		return
+	}
+
+	limit = ManageLimit(limit, MaxSuggestLimit, MinSuggestLimit, DefaultSuggestLimit)
+
+	ctx := c.Request.Context()
+	suggestions, err := api.UseCase.Suggest(ctx, c.Query(Prefix), limit)
+	if err != nil {
+		he := handleError(err)
+		c.JSON(he.code, he.message)
+		return
+	}
+
+	c.JSON(http.StatusOK, output.NewLangSuggestionOutputs(suggestions))
+}
+
+// listByTags は、タグで絞り込んだProgrammingLangの一覧をNameの昇順で返す。
+func (api *ProgrammingLangAPI) listByTags(c *gin.Context, tags []string) {
+	limit, err := getLimit(c)
+	if err != nil {
+		he := handleError(err)
+		c.JSON(he.code, he.message)
+		return
+	}
+
+	match, err := getTagMatch(c)
+	if err != nil {
+		he := handleError(err)
+		c.JSON(he.code, he.message)
+		return
+	}
+
+	ctx := c.Request.Context()
+	langSlice, err := api.UseCase.ListByFilter(ctx, &model.ProgrammingLangFilter{
+		Tags:     tags,
+		TagMatch: match,
+		Limit:    ManageLimit(limit, MaxLimit, MinLimit, DefaultLimit),
+	})
+	if err != nil {
+		he := handleError(err)
+		c.JSON(he.code, he.message)
+		return
+	}
+
+	c.JSON(http.StatusOK, output.NewProgrammingLangOutputs(langSlice))
+}
@@ -9,11 +9,13 @@ import (
 	"reflect"
 	"testing"
 	"context"
+	"time"
 
 	"github.com/SekiguchiKai/clean-architecture-with-go/server/adapter/api"
 	"github.com/SekiguchiKai/clean-architecture-with-go/server/domain/model"
 	"github.com/SekiguchiKai/clean-architecture-with-go/server/usecase/input"
 	"github.com/SekiguchiKai/clean-architecture-with-go/server/usecase/mock"
+	"github.com/SekiguchiKai/clean-architecture-with-go/server/usecase/output"
 	"github.com/SekiguchiKai/clean-architecture-with-go/server/util"
 	"github.com/gin-gonic/gin"
 	"github.com/golang/mock/gomock"
@@ -230,6 +232,7 @@ func TestProgrammingLangAPI_List(t *testing.T) {
 
 			url := fmt.Sprintf("%s?%s=%s", api.ProgrammingLangAPIPath, api.Limit, tt.params.limit)
 			if !reflect.DeepEqual(tt.mock.err, paramErr) {
+				u.EXPECT().LastModified(tt.mock.ctx).Return(time.Time{}, nil)
 				u.EXPECT().List(tt.mock.ctx, tt.mock.limit).Return(tt.mock.result, tt.mock.err)
 			}
 
@@ -472,10 +475,10 @@ func TestProgrammingLangAPI_Create(t *testing.T) {
 			r := gin.New()
 			r.POST(api.ProgrammingLangAPIPath, handler)
 
-			u.EXPECT().Create(tt.mock.ctx, tt.mock.param).Return(tt.mock.result, tt.mock.err)
+			u.EXPECT().Create(tt.mock.ctx, input.NewProgrammingLangCreate(writableFields(tt.mock.param))).Return(tt.mock.result, tt.mock.err)
 
 			rec := httptest.NewRecorder()
-			b, err := json.Marshal(tt.mock.param)
+			b, err := json.Marshal(output.NewProgrammingLangOutput(tt.mock.param))
 			if err != nil {
 				t.Fatal(err)
 			}
@@ -620,10 +623,10 @@ func TestProgrammingLangAPI_Update(t *testing.T) {
 			r := gin.New()
 			r.PUT(fmt.Sprintf("%s/:%s", api.ProgrammingLangAPIPath, api.ID), handler)
 
-			u.EXPECT().Update(tt.mock.ctx, tt.mock.id, tt.mock.param).Return(tt.mock.result, tt.mock.err)
+			u.EXPECT().Update(tt.mock.ctx, tt.mock.id, input.NewProgrammingLangUpdate(tt.mock.param)).Return(tt.mock.result, tt.mock.err)
 
 			rec := httptest.NewRecorder()
-			b, err := json.Marshal(tt.mock.param)
+			b, err := json.Marshal(input.NewProgrammingLangInput(tt.mock.param))
 			if err != nil {
 				t.Fatal(err)
 			}
@@ -790,3 +793,256 @@ func TestProgrammingLangAPI_Delete(t *testing.T) {
 	}
 }
 
+
+func TestProgrammingLangAPI_GetBySlug(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	u := mock_input.NewMockProgrammingLangInputPort(ctrl)
+
+	langAPI := &api.ProgrammingLangAPI{
+		UseCase: u,
+	}
+
+	lang := model.CreateProgrammingLangs(1)[0]
+
+	noDataErr := &model.NoSuchDataError{
+		Name:      "unknown",
+		ModelName: model.ModelNameProgrammingLang,
+	}
+
+	type mock struct {
+		slug   string
+		result *model.ProgrammingLang
+		err    error
+	}
+
+	type want struct {
+		code     int
+		location string
+	}
+
+	tests := []struct {
+		name string
+		url  string
+		mock *mock
+		want want
+	}{
+		{
+			name: "現在のslugを指定した場合、ステータスコード200を返すこと",
+			url:  fmt.Sprintf("%s/%s", api.LangSlugAPIPath, lang.Slug),
+			mock: &mock{slug: lang.Slug, result: lang},
+			want: want{code: http.StatusOK},
+		},
+		{
+			name: "変更前のslugを指定した場合、現在のslugのURLに転送すること",
+			url:  fmt.Sprintf("%s/%s?%s=1", api.LangSlugAPIPath, "old", api.Limit),
+			mock: &mock{slug: "old", result: lang},
+			want: want{
+				code:     http.StatusMovedPermanently,
+				location: fmt.Sprintf("%s/%s?%s=1", api.LangSlugAPIPath, lang.Slug, api.Limit),
+			},
+		},
+		{
+			name: "存在しないslugを指定した場合、ステータスコード404を返すこと",
+			url:  fmt.Sprintf("%s/%s", api.LangSlugAPIPath, "unknown"),
+			mock: &mock{slug: "unknown", err: noDataErr},
+			want: want{code: http.StatusNotFound},
+		},
+		{
+			name: "ProgrammingLangの配下にslugを指定した場合、ステータスコード404を返すこと",
+			url:  fmt.Sprintf("%s/%s/%s", api.ProgrammingLangAPIPath, "other", lang.Slug),
+			want: want{code: http.StatusNotFound},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			r := gin.New()
+			langAPI.InitAPI(&r.RouterGroup)
+			if tt.mock != nil {
+				u.EXPECT().GetBySlug(context.Background(), tt.mock.slug).Return(tt.mock.result, tt.mock.err)
+			}
+
+			rec := httptest.NewRecorder()
+			req, err := http.NewRequest(api.Get, tt.url, nil)
+			if err != nil {
+				t.Fatal(err)
+			}
+			r.ServeHTTP(rec, req)
+
+			if rec.Code != tt.want.code {
+				t.Errorf("Status Code = %v, want %v", rec.Code, tt.want.code)
+			}
+			if got := rec.Header().Get("Location"); got != tt.want.location {
+				t.Errorf("Location = %v, want %v", got, tt.want.location)
+			}
+		})
+	}
+}
+
+func TestProgrammingLangAPI_List_ByName(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	u := mock_input.NewMockProgrammingLangInputPort(ctrl)
+
+	langAPI := &api.ProgrammingLangAPI{
+		UseCase: u,
+	}
+
+	lang := model.CreateProgrammingLangs(1)[0]
+
+	noDataErr := &model.NoSuchDataError{
+		Name:      "unknown",
+		ModelName: model.ModelNameProgrammingLang,
+	}
+
+	tests := []struct {
+		name     string
+		query    string
+		result   *model.ProgrammingLang
+		err      error
+		wantCode int
+		want     []*model.ProgrammingLang
+	}{
+		{
+			name:     "nameが一致するデータが存在する場合、ステータスコード200と1件のデータの一覧を返すこと",
+			query:    lang.Name,
+			result:   lang,
+			wantCode: http.StatusOK,
+			want:     []*model.ProgrammingLang{lang},
+		},
+		{
+			name:     "nameが一致するデータが存在しない場合、ステータスコード404を返すこと",
+			query:    "unknown",
+			err:      noDataErr,
+			wantCode: http.StatusNotFound,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			r := gin.New()
+			r.GET(api.ProgrammingLangAPIPath, langAPI.List)
+			u.EXPECT().GetByName(context.Background(), tt.query).Return(tt.result, tt.err)
+
+			rec := httptest.NewRecorder()
+			url := fmt.Sprintf("%s?%s=%s", api.ProgrammingLangAPIPath, api.Name, tt.query)
+			req, err := http.NewRequest(api.Get, url, nil)
+			if err != nil {
+				t.Fatal(err)
+			}
+			r.ServeHTTP(rec, req)
+
+			if rec.Code != tt.wantCode {
+				t.Errorf("Status Code = %v, want %v", rec.Code, tt.wantCode)
+			}
+			if tt.wantCode == http.StatusOK {
+				var got []*model.ProgrammingLang
+				if err := json.Unmarshal(rec.Body.Bytes(), &got); err != nil {
+					t.Fatal(err)
+				}
+				if !reflect.DeepEqual(got, tt.want) {
+					t.Errorf("Response Body = %v, want %v", got, tt.want)
+				}
+			}
+		})
+	}
+}
+
+func TestProgrammingLangAPI_Suggest(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	u := mock_input.NewMockProgrammingLangInputPort(ctrl)
+
+	langAPI := &api.ProgrammingLangAPI{
+		UseCase: u,
+	}
+
+	suggestions := []*model.LangSuggestion{
+		{ID: 1, Name: "Java", Slug: "java", Matched: "Java", Score: 0.5},
+		{ID: 2, Name: "JavaScript", Slug: "javascript", Matched: "JavaScript", Score: 0.2},
+	}
+
+	type mock struct {
+		prefix string
+		limit  int
+		result []*model.LangSuggestion
+		err    error
+	}
+
+	tests := []struct {
+		name     string
+		query    string
+		mock     *mock
+		wantCode int
+		want     []*output.LangSuggestionOutput
+	}{
+		{
+			name:     "prefixを指定した場合、ステータスコード200と候補の一覧を返すこと",
+			query:    fmt.Sprintf("%s=ja", api.Prefix),
+			mock:     &mock{prefix: "ja", limit: api.DefaultSuggestLimit, result: suggestions},
+			wantCode: http.StatusOK,
+			want:     output.NewLangSuggestionOutputs(suggestions),
+		},
+		{
+			name:     "limitを指定した場合、limitを上限として候補を取得すること",
+			query:    fmt.Sprintf("%s=ja&%s=2", api.Prefix, api.Limit),
+			mock:     &mock{prefix: "ja", limit: 2, result: suggestions},
+			wantCode: http.StatusOK,
+			want:     output.NewLangSuggestionOutputs(suggestions),
+		},
+		{
+			name:     "prefixを指定しない場合、ステータスコード400を返すこと",
+			query:    "",
+			mock:     &mock{prefix: "", limit: api.DefaultSuggestLimit, err: &model.RequiredError{Property: model.PropertyPrefix}},
+			wantCode: http.StatusBadRequest,
+		},
+		{
+			name:     "limitが数値でない場合、ステータスコード400を返すこと",
+			query:    fmt.Sprintf("%s=ja&%s=a", api.Prefix, api.Limit),
+			wantCode: http.StatusBadRequest,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			r := gin.New()
+			langAPI.InitAPI(&r.RouterGroup)
+			if tt.mock != nil {
+				u.EXPECT().Suggest(context.Background(), tt.mock.prefix, tt.mock.limit).Return(tt.mock.result, tt.mock.err)
+			}
+
+			rec := httptest.NewRecorder()
+			url := fmt.Sprintf("%s?%s", api.LangSuggestAPIPath, tt.query)
+			req, err := http.NewRequest(api.Get, url, nil)
+			if err != nil {
+				t.Fatal(err)
+			}
+			r.ServeHTTP(rec, req)
+
+			if rec.Code != tt.wantCode {
+				t.Errorf("Status Code = %v, want %v", rec.Code, tt.wantCode)
+			}
+			if tt.wantCode == http.StatusOK {
+				var got []*output.LangSuggestionOutput
+				if err := json.Unmarshal(rec.Body.Bytes(), &got); err != nil {
+					t.Fatal(err)
+				}
+				if !reflect.DeepEqual(got, tt.want) {
+					t.Errorf("Response Body = %v, want %v", got, tt.want)
+				}
+			}
+		})
+	}
+}
+
+// writableFields は、リクエストのボディとして受け付ける値だけを残したProgrammingLangを返す。
+// ID、Slug、生成日時、更新日時は、ボディで指定しても無視される。
+func writableFields(lang *model.ProgrammingLang) *model.ProgrammingLang {
+	l := *lang
+	l.ID = 0
+	l.Slug = ""
+	l.CreatedAt = time.Time{}
+	l.UpdatedAt = time.Time{}
+	return &l
+}
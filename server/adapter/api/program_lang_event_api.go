@@ -0,0 +1,126 @@
+package api
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"strconv"
+	"time"
+
+	"github.com/SekiguchiKai/clean-architecture-with-go/server/domain/model"
+	"github.com/SekiguchiKai/clean-architecture-with-go/server/usecase/output"
+	"github.com/gin-gonic/gin"
+)
+
+// Events は、ProgrammingLangの変更をServer-Sent Eventsで送信し続ける。
+// Last-Event-IDが指定された場合は、そのイベントより後の変更から送信する。受信し損ねた変更を全て送信できない場合は、
+// 一覧を取得し直すようにresetのイベントを送信する。
+// 受信が追いつかない場合やサーバーを停止する場合は接続を閉じ、再接続を待つ。
+func (api *ProgrammingLangAPI) Events(c *gin.Context) {
+	lastEventID, err := getLastEventID(c)
+	if err != nil {
+		he := handleError(err)
+		c.JSON(he.code, he.message)
+		return
+	}
+
+	ctx := c.Request.Context()
+	events, complete, err := api.UseCase.WatchFrom(ctx, lastEventID)
+	if err != nil {
+		he := handleError(err)
+		c.JSON(he.code, he.message)
+		return
+	}
+
+	w := c.Writer
+	w.Header().Set("Content-Type", EventStreamContentType)
+	w.Header().Set(CacheControlHeader, "no-cache")
+	w.WriteHeader(http.StatusOK)
+
+	if _, err := fmt.Fprintf(w, "retry: %d\n\n", EventRetry/time.Millisecond); err != nil {
+		return
+	}
+	if !complete {
+		if err := writeEvent(w, "", ResetEvent, []byte("{}")); err != nil {
+			return
+		}
+	}
+	w.Flush()
+
+	heartbeat := time.NewTicker(api.heartbeat())
+	defer heartbeat.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-heartbeat.C:
+			if _, err := io.WriteString(w, ": heartbeat\n\n"); err != nil {
+				return
+			}
+		case event, ok := <-events:
+			if !ok {
+				return
+			}
+
+			data, err := json.Marshal(output.NewProgrammingLangEventOutput(event))
+			if err != nil {
+				return
+			}
+			if err := writeEvent(w, eventID(event), string(event.Type), data); err != nil {
+				return
+			}
+		}
+		w.Flush()
+	}
+}
+
+// heartbeat は、接続を保つためにコメントを送信する間隔を返す。
+func (api *ProgrammingLangAPI) heartbeat() time.Duration {
+	if api.Heartbeat > 0 {
+		return api.Heartbeat
+	}
+	return DefaultEventHeartbeat
+}
+
+// getLastEventID は、Last-Event-IDのヘッダー、もしくはQuery Stringから最後に受信したイベントのIDを取得する。
+// 指定されていない場合は、0を返す。
+func getLastEventID(c *gin.Context) (int, error) {
+	v := c.GetHeader(LastEventIDHeader)
+	if v == "" {
+		v = c.Query(LastEventID)
+	}
+	if v == "" {
+		return 0, nil
+	}
+
+	id, err := strconv.Atoi(v)
+	if err != nil || id < 0 {
+		return -1, &model.InvalidParameterError{
+			Parameter: LastEventIDHeader,
+			Message:   ShouldBeIntErr,
+		}
+	}
+
+	return id, nil
+}
+
+// eventID は、イベントのIDを文字列で返す。IDが付いていない場合は、空文字を返す。
+func eventID(event *model.ProgrammingLangEvent) string {
+	if event.ID == 0 {
+		return ""
+	}
+	return strconv.Itoa(event.ID)
+}
+
+// writeEvent は、Server-Sent Eventsの形式で1件のイベントを書き込む。idが空の場合は、idを書き込まない。
+func writeEvent(w io.Writer, id, name string, data []byte) error {
+	if id != "" {
+		if _, err := fmt.Fprintf(w, "id: %s\n", id); err != nil {
+			return err
+		}
+	}
+	_, err := fmt.Fprintf(w, "event: %s\ndata: %s\n\n", name, data)
+	return err
+}
@@ -0,0 +1,118 @@
+package api_test
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/SekiguchiKai/clean-architecture-with-go/server/adapter/api"
+	"github.com/SekiguchiKai/clean-architecture-with-go/server/domain/model"
+	"github.com/SekiguchiKai/clean-architecture-with-go/server/usecase/mock"
+	"github.com/gin-gonic/gin"
+	"github.com/golang/mock/gomock"
+)
+
+func TestProgrammingLangAPI_Events(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	u := mock_input.NewMockProgrammingLangInputPort(ctrl)
+	langAPI := &api.ProgrammingLangAPI{
+		UseCase:   u,
+		Heartbeat: 10 * time.Millisecond,
+	}
+
+	deleted := &model.ProgrammingLangEvent{ID: 7, Type: model.EventTypeDeleted, OccurredAt: model.GetTestTime(time.September, 1)}
+
+	type mock struct {
+		lastEventID int
+		events      []*model.ProgrammingLangEvent
+		open        bool
+		complete    bool
+	}
+
+	tests := []struct {
+		name        string
+		lastEventID string
+		mock        *mock
+		wantCode    int
+		wantBody    []string
+	}{
+		{
+			name:     "変更があった場合、IDと種類を付けて送信すること",
+			mock:     &mock{events: []*model.ProgrammingLangEvent{deleted}, complete: true},
+			wantCode: http.StatusOK,
+			wantBody: []string{
+				"retry: 3000\n\n",
+				`id: 7` + "\nevent: deleted\ndata: " + `{"id":7,"type":"deleted","lang":null,"occurredAt":"2018-09-01T12:00:00Z"}` + "\n\n",
+			},
+		},
+		{
+			name:        "受信し損ねた変更を全て送信できない場合、resetのイベントを送信すること",
+			lastEventID: "5",
+			mock:        &mock{lastEventID: 5, complete: false},
+			wantCode:    http.StatusOK,
+			wantBody:    []string{"event: reset\ndata: {}\n\n"},
+		},
+		{
+			name:     "変更がない場合、接続を保つためにコメントを送信すること",
+			mock:     &mock{open: true, complete: true},
+			wantCode: http.StatusOK,
+			wantBody: []string{": heartbeat\n\n"},
+		},
+		{
+			name:        "Last-Event-IDが数値でない場合、ステータスコード400を返すこと",
+			lastEventID: "a",
+			wantCode:    http.StatusBadRequest,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			r := gin.New()
+			langAPI.InitAPI(&r.RouterGroup)
+
+			ctx, cancel := context.WithTimeout(context.Background(), 50*time.Millisecond)
+			defer cancel()
+
+			if tt.mock != nil {
+				events := make(chan *model.ProgrammingLangEvent, len(tt.mock.events))
+				for _, e := range tt.mock.events {
+					events <- e
+				}
+				if !tt.mock.open {
+					close(events)
+				}
+				u.EXPECT().WatchFrom(gomock.Any(), tt.mock.lastEventID).Return(events, tt.mock.complete, nil)
+			}
+
+			rec := httptest.NewRecorder()
+			url := api.LangEventsAPIPath
+			req, err := http.NewRequest(api.Get, url, nil)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if tt.lastEventID != "" {
+				req.Header.Set(api.LastEventIDHeader, tt.lastEventID)
+			}
+			r.ServeHTTP(rec, req.WithContext(ctx))
+
+			if rec.Code != tt.wantCode {
+				t.Errorf("Status Code = %v, want %v", rec.Code, tt.wantCode)
+			}
+			if tt.wantCode != http.StatusOK {
+				return
+			}
+			if got := rec.Header().Get("Content-Type"); got != api.EventStreamContentType {
+				t.Errorf("Content-Type = %v, want %v", got, api.EventStreamContentType)
+			}
+			for _, want := range tt.wantBody {
+				if !strings.Contains(rec.Body.String(), want) {
+					t.Errorf("body = %q, want to contain %q", rec.Body.String(), want)
+				}
+			}
+		})
+	}
+}
@@ -0,0 +1,157 @@
+package api
+
+import (
+	"fmt"
+	"net/http"
+	"strconv"
+
+	"github.com/SekiguchiKai/clean-architecture-with-go/server/domain/model"
+	"github.com/SekiguchiKai/clean-architecture-with-go/server/usecase/input"
+	"github.com/SekiguchiKai/clean-architecture-with-go/server/usecase/output"
+	"github.com/gin-gonic/gin"
+)
+
+// ProgrammingLangV2API は、/v2のProgrammingLangのAPI。/v1とUseCaseを共有し、リクエストとレスポンスの形式のみが異なる。
+// 一覧はカーソルで続きを取得するページの形式で返し、エラーはapplication/problem+jsonで返す。
+type ProgrammingLangV2API struct {
+	UseCase input.ProgrammingLangInputPort
+}
+
+// NewProgrammingLangV2API は、ProgrammingLangV2APIを生成し、返す。
+func NewProgrammingLangV2API(useCase input.ProgrammingLangInputPort) *ProgrammingLangV2API {
+	return &ProgrammingLangV2API{
+		UseCase: useCase,
+	}
+}
+
+// InitAPI は、APIを初期設定する。
+func (api *ProgrammingLangV2API) InitAPI(g *gin.RouterGroup) {
+	g.GET(ProgrammingLangAPIPath, api.List)
+	g.GET(fmt.Sprintf("%s/:%s", ProgrammingLangAPIPath, ID), api.Get)
+	g.POST(ProgrammingLangAPIPath, api.Create)
+	g.PUT(fmt.Sprintf("%s/:%s", ProgrammingLangAPIPath, ID), api.Update)
+	g.DELETE(fmt.Sprintf("%s/:%s", ProgrammingLangAPIPath, ID), api.Delete)
+}
+
+// List は、ProgrammingLangの一覧をNameの昇順でページに分けて返す。
+// cursorを指定した場合は、前のページの続きから返す。tagが指定された場合は、tagMatchに従ってタグで絞り込む。
+func (api *ProgrammingLangV2API) List(c *gin.Context) {
+	limit, err := getLimit(c)
+	if err != nil {
+		respondProblem(c, err)
+		return
+	}
+
+	limit = ManageLimit(limit, MaxLimit, MinLimit, DefaultLimit)
+
+	after, err := getCursor(c)
+	if err != nil {
+		respondProblem(c, err)
+		return
+	}
+
+	match, err := getTagMatch(c)
+	if err != nil {
+		respondProblem(c, err)
+		return
+	}
+
+	// 次のページが存在するかどうかを判定するため、1件多く取得する。
+	ctx := c.Request.Context()
+	langSlice, err := api.UseCase.ListByFilter(ctx, &model.ProgrammingLangFilter{
+		After:    after,
+		Tags:     c.QueryArray(Tag),
+		TagMatch: match,
+		Limit:    limit + 1,
+	})
+	if err != nil {
+		respondProblem(c, err)
+		return
+	}
+
+	var nextCursor string
+	if len(langSlice) > limit {
+		langSlice = langSlice[:limit]
+		nextCursor = encodeCursor(langSlice[limit-1].Name)
+	}
+
+	c.JSON(http.StatusOK, output.NewProgrammingLangPageV2Output(langSlice, nextCursor))
+}
+
+// Get は、ProgrammingLangを取得する。
+func (api *ProgrammingLangV2API) Get(c *gin.Context) {
+	id, err := getID(c)
+	if err != nil {
+		respondProblem(c, err)
+		return
+	}
+
+	ctx := c.Request.Context()
+	lang, err := api.UseCase.Get(ctx, id)
+	if err != nil {
+		respondProblem(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, output.NewProgrammingLangV2Output(lang))
+}
+
+// Create は、ProgrammingLangを生成し、ステータスコード201とLocationのヘッダーを付けて返す。
+func (api *ProgrammingLangV2API) Create(c *gin.Context) {
+	var params *input.ProgrammingLangV2Input
+	if err := c.ShouldBindJSON(&params); err != nil {
+		respondProblem(c, &model.InvalidPropertyError{Property: bodyProperty, Message: err.Error()})
+		return
+	}
+
+	ctx := c.Request.Context()
+	lang, err := api.UseCase.Create(ctx, params.ToCreate())
+	if err != nil {
+		respondProblem(c, err)
+		return
+	}
+
+	c.Header(LocationHeader, fmt.Sprintf("%s/%s", c.Request.URL.Path, strconv.Itoa(lang.ID)))
+	c.JSON(http.StatusCreated, output.NewProgrammingLangV2Output(lang))
+}
+
+// Update は、ProgrammingLangを更新する。
+func (api *ProgrammingLangV2API) Update(c *gin.Context) {
+	var params *input.ProgrammingLangV2Input
+	if err := c.ShouldBindJSON(&params); err != nil {
+		respondProblem(c, &model.InvalidPropertyError{Property: bodyProperty, Message: err.Error()})
+		return
+	}
+
+	id, err := getID(c)
+	if err != nil {
+		respondProblem(c, err)
+		return
+	}
+
+	ctx := c.Request.Context()
+	lang, err := api.UseCase.Update(ctx, id, params.ToUpdate())
+	if err != nil {
+		respondProblem(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, output.NewProgrammingLangV2Output(lang))
+}
+
+// Delete は、ProgrammingLangを削除し、ステータスコード204を返す。
+func (api *ProgrammingLangV2API) Delete(c *gin.Context) {
+	id, err := getID(c)
+	if err != nil {
+		respondProblem(c, err)
+		return
+	}
+
+	ctx := c.Request.Context()
+	if err := api.UseCase.Delete(ctx, id); err != nil {
+		respondProblem(c, err)
+		return
+	}
+
+	c.Status(http.StatusNoContent)
+}
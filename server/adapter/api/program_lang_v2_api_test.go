@@ -0,0 +1,310 @@
+package api_test
+
+import (
+	"context"
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/SekiguchiKai/clean-architecture-with-go/server/adapter/api"
+	"github.com/SekiguchiKai/clean-architecture-with-go/server/domain/model"
+	"github.com/SekiguchiKai/clean-architecture-with-go/server/usecase/input"
+	"github.com/SekiguchiKai/clean-architecture-with-go/server/usecase/mock"
+	"github.com/SekiguchiKai/clean-architecture-with-go/server/usecase/output"
+	"github.com/gin-gonic/gin"
+	"github.com/golang/mock/gomock"
+)
+
+// testCursor は、テスト用にNameからカーソルを生成し、返す。
+func testCursor(name string) string {
+	return base64.URLEncoding.EncodeToString([]byte(api.CursorPrefix + name))
+}
+
+func TestProgrammingLangV2API_List(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	u := mock_input.NewMockProgrammingLangInputPort(ctrl)
+	langAPI := api.NewProgrammingLangV2API(u)
+
+	langSlice := model.CreateProgrammingLangs(6)
+
+	type mock struct {
+		filter *model.ProgrammingLangFilter
+		result []*model.ProgrammingLang
+	}
+
+	tests := []struct {
+		name     string
+		query    string
+		mock     *mock
+		wantCode int
+		wantType string
+		want     *output.ProgrammingLangPageV2Output
+	}{
+		{
+			name:  "limitより多く存在する場合、limit件とnextCursorを返すこと",
+			query: "?limit=5",
+			mock: &mock{
+				filter: &model.ProgrammingLangFilter{Tags: []string{}, TagMatch: model.TagMatchAll, Limit: 6},
+				result: langSlice,
+			},
+			wantCode: http.StatusOK,
+			want:     output.NewProgrammingLangPageV2Output(langSlice[:5], testCursor(langSlice[4].Name)),
+		},
+		{
+			name:  "cursorを指定した場合、続きから返し、最後のページではnextCursorを返さないこと",
+			query: "?limit=5&cursor=" + testCursor(langSlice[4].Name),
+			mock: &mock{
+				filter: &model.ProgrammingLangFilter{After: langSlice[4].Name, Tags: []string{}, TagMatch: model.TagMatchAll, Limit: 6},
+				result: langSlice[5:],
+			},
+			wantCode: http.StatusOK,
+			want:     output.NewProgrammingLangPageV2Output(langSlice[5:], ""),
+		},
+		{
+			name:  "存在しない場合、空の配列を返すこと",
+			query: "",
+			mock: &mock{
+				filter: &model.ProgrammingLangFilter{Tags: []string{}, TagMatch: model.TagMatchAll, Limit: api.DefaultLimit + 1},
+				result: []*model.ProgrammingLang{},
+			},
+			wantCode: http.StatusOK,
+			want:     output.NewProgrammingLangPageV2Output(nil, ""),
+		},
+		{
+			name:     "cursorが不正な場合、application/problem+jsonでステータスコード400を返すこと",
+			query:    "?cursor=invalid",
+			wantCode: http.StatusBadRequest,
+			wantType: api.ProblemTypeInvalidParameter,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			r := gin.New()
+			langAPI.InitAPI(&r.RouterGroup)
+
+			if tt.mock != nil {
+				u.EXPECT().ListByFilter(gomock.Any(), tt.mock.filter).Return(tt.mock.result, nil)
+			}
+
+			rec := httptest.NewRecorder()
+			req, err := http.NewRequest(api.Get, api.ProgrammingLangAPIPath+tt.query, nil)
+			if err != nil {
+				t.Fatal(err)
+			}
+			r.ServeHTTP(rec, req)
+
+			if rec.Code != tt.wantCode {
+				t.Errorf("Status Code = %v, want %v", rec.Code, tt.wantCode)
+			}
+			if tt.want == nil {
+				testProblem(t, rec, tt.wantCode, tt.wantType)
+				return
+			}
+
+			want, err := json.Marshal(tt.want)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if rec.Body.String() != string(want) {
+				t.Errorf("Response Body = %v, want %s", rec.Body.String(), want)
+			}
+		})
+	}
+}
+
+func TestProgrammingLangV2API_Get(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	u := mock_input.NewMockProgrammingLangInputPort(ctrl)
+	langAPI := api.NewProgrammingLangV2API(u)
+
+	lang := model.CreateProgrammingLangs(1)[0]
+	lang.FirstAppeared = 2009
+
+	tests := []struct {
+		name     string
+		id       string
+		err      error
+		wantCode int
+		wantType string
+		want     string
+	}{
+		{
+			name:     "存在する場合、名前を変えた属性で返すこと",
+			id:       "1",
+			wantCode: http.StatusOK,
+			want:     fmt.Sprintf(`{"id":1,"name":"%s","description":"%s","slug":"%s","firstAppearedYear":2009,"createdAt":"2018-10-01T12:00:00Z","updatedAt":"2018-10-01T12:00:00Z"}`, lang.Name, lang.Feature, lang.Slug),
+		},
+		{
+			name:     "存在しない場合、application/problem+jsonでステータスコード404を返すこと",
+			id:       "1",
+			err:      &model.NoSuchDataError{ID: 1, ModelName: model.ModelNameProgrammingLang},
+			wantCode: http.StatusNotFound,
+			wantType: api.ProblemTypeNotFound,
+		},
+		{
+			name:     "IDが数値でない場合、application/problem+jsonでステータスコード400を返すこと",
+			id:       "a",
+			wantCode: http.StatusBadRequest,
+			wantType: api.ProblemTypeInvalidParameter,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			r := gin.New()
+			langAPI.InitAPI(&r.RouterGroup)
+
+			if tt.id == "1" {
+				var result *model.ProgrammingLang
+				if tt.err == nil {
+					result = lang
+				}
+				u.EXPECT().Get(gomock.Any(), 1).Return(result, tt.err)
+			}
+
+			rec := httptest.NewRecorder()
+			req, err := http.NewRequest(api.Get, fmt.Sprintf("%s/%s", api.ProgrammingLangAPIPath, tt.id), nil)
+			if err != nil {
+				t.Fatal(err)
+			}
+			r.ServeHTTP(rec, req)
+
+			if rec.Code != tt.wantCode {
+				t.Errorf("Status Code = %v, want %v", rec.Code, tt.wantCode)
+			}
+			if tt.want == "" {
+				testProblem(t, rec, tt.wantCode, tt.wantType)
+				return
+			}
+			if rec.Body.String() != tt.want {
+				t.Errorf("Response Body = %v, want %v", rec.Body.String(), tt.want)
+			}
+		})
+	}
+}
+
+func TestProgrammingLangV2API_Write(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	u := mock_input.NewMockProgrammingLangInputPort(ctrl)
+	langAPI := api.NewProgrammingLangV2API(u)
+
+	lang := model.CreateProgrammingLangs(1)[0]
+	param := &model.ProgrammingLang{Name: lang.Name, Feature: lang.Feature, FirstAppeared: 2009}
+	firstAppeared := 2009
+	body := fmt.Sprintf(`{"id":5,"name":"%s","description":"%s","firstAppearedYear":2009}`, lang.Name, lang.Feature)
+
+	tests := []struct {
+		name         string
+		method       string
+		path         string
+		body         string
+		call         func()
+		wantCode     int
+		wantType     string
+		wantLocation string
+	}{
+		{
+			name:   "生成した場合、ステータスコード201とLocationを返すこと",
+			method: api.Post,
+			path:   api.ProgrammingLangAPIPath,
+			body:   body,
+			call: func() {
+				u.EXPECT().Create(gomock.Any(), input.NewProgrammingLangCreate(param)).Return(lang, nil)
+			},
+			wantCode:     http.StatusCreated,
+			wantLocation: "/langs/1",
+		},
+		{
+			name:   "更新した場合、ステータスコード200を返すこと",
+			method: api.Put,
+			path:   api.ProgrammingLangAPIPath + "/1",
+			body:   body,
+			call: func() {
+				u.EXPECT().Update(gomock.Any(), 1, &input.ProgrammingLangUpdate{Name: lang.Name, Feature: lang.Feature, FirstAppeared: &firstAppeared}).Return(lang, nil)
+			},
+			wantCode: http.StatusOK,
+		},
+		{
+			name:     "ボディがJSONでない場合、application/problem+jsonでステータスコード400を返すこと",
+			method:   api.Post,
+			path:     api.ProgrammingLangAPIPath,
+			body:     "{",
+			wantCode: http.StatusBadRequest,
+			wantType: api.ProblemTypeInvalidProperty,
+		},
+		{
+			name:   "既に存在する場合、application/problem+jsonでステータスコード409を返すこと",
+			method: api.Post,
+			path:   api.ProgrammingLangAPIPath,
+			body:   body,
+			call: func() {
+				u.EXPECT().Create(gomock.Any(), input.NewProgrammingLangCreate(param)).Return(nil, &model.AlreadyExistError{ID: 1, Name: lang.Name, ModelName: model.ModelNameProgrammingLang})
+			},
+			wantCode: http.StatusConflict,
+			wantType: api.ProblemTypeAlreadyExists,
+		},
+		{
+			name:   "削除した場合、ステータスコード204を返すこと",
+			method: api.Delete,
+			path:   api.ProgrammingLangAPIPath + "/1",
+			call: func() {
+				u.EXPECT().Delete(gomock.Any(), 1).Return(nil)
+			},
+			wantCode: http.StatusNoContent,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			r := gin.New()
+			langAPI.InitAPI(&r.RouterGroup)
+
+			if tt.call != nil {
+				tt.call()
+			}
+
+			rec := httptest.NewRecorder()
+			req, err := http.NewRequest(tt.method, tt.path, strings.NewReader(tt.body))
+			if err != nil {
+				t.Fatal(err)
+			}
+			r.ServeHTTP(rec, req.WithContext(context.Background()))
+
+			if rec.Code != tt.wantCode {
+				t.Errorf("Status Code = %v, want %v", rec.Code, tt.wantCode)
+			}
+			if got := rec.Header().Get(api.LocationHeader); got != tt.wantLocation {
+				t.Errorf("%s = %v, want %v", api.LocationHeader, got, tt.wantLocation)
+			}
+			if rec.Code >= http.StatusBadRequest {
+				testProblem(t, rec, tt.wantCode, tt.wantType)
+			}
+		})
+	}
+}
+
+// testProblem は、レスポンスがapplication/problem+jsonであり、statusとtypeが一致することを確認する。
+func testProblem(t *testing.T, rec *httptest.ResponseRecorder, wantStatus int, wantType string) {
+	t.Helper()
+
+	if got := rec.Header().Get("Content-Type"); got != api.ProblemContentType {
+		t.Errorf("Content-Type = %v, want %v", got, api.ProblemContentType)
+	}
+
+	var got *api.Problem
+	if err := json.Unmarshal(rec.Body.Bytes(), &got); err != nil {
+		t.Fatal(err)
+	}
+
+	if got.Type != wantType || got.Title != http.StatusText(wantStatus) || got.Status != wantStatus || got.Detail == "" || got.Instance == "" {
+		t.Errorf("Problem = %+v, want type %v and status %v", got, wantType, wantStatus)
+	}
+}
@@ -0,0 +1,219 @@
+package api
+
+import (
+	"context"
+	"fmt"
+	"math"
+	"net/http"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	"github.com/pkg/errors"
+)
+
+// RateLimitQuota は、一定期間内に許可するリクエスト数を表す。
+// Limitはトークンバケットの容量であり、Per の期間で Limit 個のトークンが補充される。
+type RateLimitQuota struct {
+	Limit int
+	Per   time.Duration
+}
+
+// Validate は、Quotaの値を検証する。Limitが0以下の場合はトークンが補充されないため、受け付けない。
+func (q RateLimitQuota) Validate() error {
+	if q.Limit <= 0 {
+		return errors.Errorf("rate limit should be positive: %d", q.Limit)
+	}
+	if q.Per <= 0 {
+		return errors.Errorf("rate limit period should be positive: %s", q.Per)
+	}
+	return nil
+}
+
+// String は、ParseRateLimitQuotaで読み込める形式(例: 120/1m0s)で返す。
+func (q RateLimitQuota) String() string {
+	return fmt.Sprintf("%d/%s", q.Limit, q.Per)
+}
+
+// RouteQuota は、メソッドとパスのパターン(例: "/v1/langs/:id")に適用するQuota。
+type RouteQuota struct {
+	Method  string
+	Pattern string
+	Quota   RateLimitQuota
+}
+
+// RateLimitResult は、トークンを取得した結果を表す。
+type RateLimitResult struct {
+	Allowed    bool
+	Limit      int
+	Remaining  int
+	Reset      time.Duration
+	RetryAfter time.Duration
+}
+
+// RateLimitStore は、トークンバケットの状態を保持するStore。
+type RateLimitStore interface {
+	Take(ctx context.Context, key string, quota RateLimitQuota, now time.Time) (*RateLimitResult, error)
+}
+
+// RateLimiter は、クライアントとルートごとにリクエスト数を制限するミドルウェア。
+// ルートごとのQuotaは設定した順に照合し、最初に一致したものを適用する。
+type RateLimiter struct {
+	Store       RateLimitStore
+	Clients     *ClientIdentifier
+	ReadQuota   RateLimitQuota
+	WriteQuota  RateLimitQuota
+	RouteQuotas []RouteQuota
+	Now         func() time.Time
+}
+
+// NewRateLimiter は、RateLimiterを生成し、返す。Quotaが不正な場合は、エラーを返す。
+func NewRateLimiter(store RateLimitStore, clients *ClientIdentifier, readQuota, writeQuota RateLimitQuota) (*RateLimiter, error) {
+	if err := readQuota.Validate(); err != nil {
+		return nil, err
+	}
+	if err := writeQuota.Validate(); err != nil {
+		return nil, err
+	}
+
+	return &RateLimiter{
+		Store:      store,
+		Clients:    clients,
+		ReadQuota:  readQuota,
+		WriteQuota: writeQuota,
+		Now:        time.Now,
+	}, nil
+}
+
+// SetRouteQuota は、メソッドとパスのパターン(例: "/v1/langs/:id")に対するQuotaを追加する。
+// 先に追加したQuotaが優先される。Quotaが不正な場合は、エラーを返す。
+func (r *RateLimiter) SetRouteQuota(method, pattern string, quota RateLimitQuota) error {
+	if err := quota.Validate(); err != nil {
+		return errors.Wrapf(err, "%s %s", method, pattern)
+	}
+	r.RouteQuotas = append(r.RouteQuotas, RouteQuota{Method: method, Pattern: pattern, Quota: quota})
+	return nil
+}
+
+// Handle は、リクエスト数を制限する。
+func (r *RateLimiter) Handle(c *gin.Context) {
+	route, quota := r.quota(c.Request.Method, c.Request.URL.Path)
+	key := fmt.Sprintf("%s|%s", r.Clients.Identify(c.Request), route)
+
+	result, err := r.Store.Take(c.Request.Context(), key, quota, r.Now())
+	if err != nil {
+		abortWithError(c, newProblem(err))
+		return
+	}
+
+	c.Header(RateLimitLimitHeader, strconv.Itoa(result.Limit))
+	c.Header(RateLimitRemainingHeader, strconv.Itoa(result.Remaining))
+	c.Header(RateLimitResetHeader, strconv.Itoa(ceilSeconds(result.Reset)))
+
+	if !result.Allowed {
+		c.Header(RetryAfterHeader, strconv.Itoa(ceilSeconds(result.RetryAfter)))
+		abortWithError(c, &Problem{Type: ProblemTypeTooManyRequests, Status: http.StatusTooManyRequests, Detail: TooManyRequestsErr})
+		return
+	}
+
+	c.Next()
+}
+
+// quota は、リクエストに適用するルートのキーとQuotaを返す。
+func (r *RateLimiter) quota(method, path string) (string, RateLimitQuota) {
+	for _, rq := range r.RouteQuotas {
+		if rq.Method == method && matchPath(rq.Pattern, path) {
+			return routeKey(rq.Method, rq.Pattern), rq.Quota
+		}
+	}
+
+	if isReadMethod(method) {
+		return RateLimitRead, r.ReadQuota
+	}
+	return RateLimitWrite, r.WriteQuota
+}
+
+// isReadMethod は、参照系のメソッドかどうかを確認する。
+func isReadMethod(method string) bool {
+	return method == Get || method == http.MethodHead || method == http.MethodOptions
+}
+
+// routeKey は、メソッドとパスのパターンからルートのキーを生成する。
+func routeKey(method, pattern string) string {
+	return method + " " + pattern
+}
+
+// matchPath は、パスがパターンに一致するかどうかを確認する。":"で始まるセグメントは任意の値に一致する。
+func matchPath(pattern, path string) bool {
+	ps := strings.Split(strings.Trim(pattern, "/"), "/")
+	ts := strings.Split(strings.Trim(path, "/"), "/")
+	if len(ps) != len(ts) {
+		return false
+	}
+
+	for i := range ps {
+		if strings.HasPrefix(ps[i], ":") {
+			continue
+		}
+		if ps[i] != ts[i] {
+			return false
+		}
+	}
+	return true
+}
+
+// ceilSeconds は、Durationを秒に切り上げて返す。
+func ceilSeconds(d time.Duration) int {
+	if d <= 0 {
+		return 0
+	}
+	return int(math.Ceil(d.Seconds()))
+}
+
+// ParseRateLimitQuota は、"回数/期間"(例: 120/1m)の形式のQuotaを読み込み、検証する。
+func ParseRateLimitQuota(s string) (RateLimitQuota, error) {
+	kv := strings.SplitN(strings.TrimSpace(s), "/", 2)
+	if len(kv) != 2 {
+		return RateLimitQuota{}, errors.Errorf("rate limit should be LIMIT/PERIOD: %s", s)
+	}
+
+	limit, err := strconv.Atoi(kv[0])
+	if err != nil {
+		return RateLimitQuota{}, errors.Errorf("rate limit should be int: %s", s)
+	}
+	per, err := time.ParseDuration(kv[1])
+	if err != nil {
+		return RateLimitQuota{}, errors.Errorf("rate limit period should be duration: %s", s)
+	}
+
+	quota := RateLimitQuota{Limit: limit, Per: per}
+	if err := quota.Validate(); err != nil {
+		return RateLimitQuota{}, err
+	}
+	return quota, nil
+}
+
+// ParseRouteQuotas は、";"区切りの"メソッド パターン=回数/期間"(例: POST /v1/langs=10/1m)を記述した順に読み込む。
+func ParseRouteQuotas(s string) ([]RouteQuota, error) {
+	var quotas []RouteQuota
+	for _, v := range strings.Split(s, ";") {
+		v = strings.TrimSpace(v)
+		if v == "" {
+			continue
+		}
+
+		kv := strings.SplitN(v, "=", 2)
+		route := strings.Fields(kv[0])
+		if len(kv) != 2 || len(route) != 2 {
+			return nil, errors.Errorf("route rate limit should be METHOD PATTERN=LIMIT/PERIOD: %s", v)
+		}
+
+		quota, err := ParseRateLimitQuota(kv[1])
+		if err != nil {
+			return nil, errors.Wrapf(err, "%s %s", route[0], route[1])
+		}
+		quotas = append(quotas, RouteQuota{Method: strings.ToUpper(route[0]), Pattern: route[1], Quota: quota})
+	}
+	return quotas, nil
+}
@@ -0,0 +1,433 @@
+package api_test
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net"
+	"net/http"
+	"net/http/httptest"
+	"reflect"
+	"testing"
+	"time"
+
+	"github.com/SekiguchiKai/clean-architecture-with-go/server/adapter/api"
+	"github.com/SekiguchiKai/clean-architecture-with-go/server/domain/model"
+	"github.com/SekiguchiKai/clean-architecture-with-go/server/util"
+	"github.com/gin-gonic/gin"
+)
+
+// stubRateLimitStore は、テスト用のRateLimitStore。
+type stubRateLimitStore struct {
+	keys   []string
+	quotas []api.RateLimitQuota
+	result *api.RateLimitResult
+	err    error
+}
+
+// Take は、記録したうえで固定の結果を返す。
+func (s *stubRateLimitStore) Take(ctx context.Context, key string, quota api.RateLimitQuota, now time.Time) (*api.RateLimitResult, error) {
+	s.keys = append(s.keys, key)
+	s.quotas = append(s.quotas, quota)
+	return s.result, s.err
+}
+
+func TestRateLimiter_Handle(t *testing.T) {
+	readQuota := api.RateLimitQuota{Limit: 10, Per: time.Minute}
+	writeQuota := api.RateLimitQuota{Limit: 2, Per: time.Minute}
+	routeQuota := api.RateLimitQuota{Limit: 1, Per: time.Minute}
+	fallbackRouteQuota := api.RateLimitQuota{Limit: 5, Per: time.Minute}
+	_, proxies, err := net.ParseCIDR("10.0.0.0/8")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	dbErr := &model.DBError{
+		ModelName: model.ModelNameProgrammingLang,
+		DBMethod:  model.DBMethodRead,
+		Detail:    "Test",
+	}
+
+	type request struct {
+		method       string
+		url          string
+		apiKey       string
+		remoteAddr   string
+		forwardedFor string
+	}
+
+	type want struct {
+		code       int
+		key        string
+		quota      api.RateLimitQuota
+		remaining  string
+		reset      string
+		retryAfter string
+		errMessage string
+		problem    bool
+	}
+
+	tests := []struct {
+		name    string
+		request request
+		result  *api.RateLimitResult
+		err     error
+		want    want
+	}{
+		{
+			name:    "参照系のリクエストが許可された場合、ステータスコード200とRateLimitヘッダーを返すこと",
+			request: request{method: api.Get, url: "/v1/langs"},
+			result:  &api.RateLimitResult{Allowed: true, Limit: 10, Remaining: 9, Reset: 6 * time.Second},
+			want: want{
+				code:      http.StatusOK,
+				key:       "ip:192.0.2.1|read",
+				quota:     readQuota,
+				remaining: "9",
+				reset:     "6",
+			},
+		},
+		{
+			name:    "登録済みのAPI Keyが指定された場合、API Keyのハッシュをキーとして更新系のQuotaを適用すること",
+			request: request{method: api.Put, url: "/v1/langs/1", apiKey: "secret"},
+			result:  &api.RateLimitResult{Allowed: true, Limit: 2, Remaining: 1, Reset: 30 * time.Second},
+			want: want{
+				code:      http.StatusOK,
+				key:       "key:2bb80d537b1da3e3|write",
+				quota:     writeQuota,
+				remaining: "1",
+				reset:     "30",
+			},
+		},
+		{
+			name:    "登録されていないAPI Keyが指定された場合、IPアドレスをキーとすること",
+			request: request{method: api.Put, url: "/v1/langs/1", apiKey: "unknown"},
+			result:  &api.RateLimitResult{Allowed: true, Limit: 2, Remaining: 1, Reset: 30 * time.Second},
+			want: want{
+				code:      http.StatusOK,
+				key:       "ip:192.0.2.1|write",
+				quota:     writeQuota,
+				remaining: "1",
+				reset:     "30",
+			},
+		},
+		{
+			name:    "信頼していない接続元がX-Forwarded-Forを指定した場合、接続元のIPアドレスをキーとすること",
+			request: request{method: api.Get, url: "/v1/langs", forwardedFor: "198.51.100.7"},
+			result:  &api.RateLimitResult{Allowed: true, Limit: 10, Remaining: 9, Reset: 6 * time.Second},
+			want: want{
+				code:      http.StatusOK,
+				key:       "ip:192.0.2.1|read",
+				quota:     readQuota,
+				remaining: "9",
+				reset:     "6",
+			},
+		},
+		{
+			name:    "信頼するプロキシから届いた場合、X-Forwarded-Forのクライアントのアドレスをキーとすること",
+			request: request{method: api.Put, url: "/v1/langs/1", remoteAddr: "10.0.0.2:1234", forwardedFor: "203.0.113.9, 198.51.100.7, 10.0.0.1"},
+			result:  &api.RateLimitResult{Allowed: true, Limit: 2, Remaining: 1, Reset: 30 * time.Second},
+			want: want{
+				code:      http.StatusOK,
+				key:       "ip:198.51.100.7|write",
+				quota:     writeQuota,
+				remaining: "1",
+				reset:     "30",
+			},
+		},
+		{
+			name:    "ルートごとのQuotaが複数一致する場合、先に設定したQuotaを適用すること",
+			request: request{method: api.Post, url: "/v1/langs"},
+			result:  &api.RateLimitResult{Allowed: true, Limit: 1, Remaining: 0, Reset: time.Minute},
+			want: want{
+				code:      http.StatusOK,
+				key:       "ip:192.0.2.1|POST /v1/langs",
+				quota:     routeQuota,
+				remaining: "0",
+				reset:     "60",
+			},
+		},
+		{
+			name:    "ルートごとのQuotaのパターンが一致する場合、そのQuotaを適用すること",
+			request: request{method: api.Post, url: "/v1/tags"},
+			result:  &api.RateLimitResult{Allowed: true, Limit: 5, Remaining: 4, Reset: 12 * time.Second},
+			want: want{
+				code:      http.StatusOK,
+				key:       "ip:192.0.2.1|POST /v1/:resource",
+				quota:     fallbackRouteQuota,
+				remaining: "4",
+				reset:     "12",
+			},
+		},
+		{
+			name:    "トークンが不足している場合、ステータスコード429とRetry-Afterヘッダーとエラーメッセージを返すこと",
+			request: request{method: api.Delete, url: "/v1/langs/1"},
+			result:  &api.RateLimitResult{Allowed: false, Limit: 2, Remaining: 0, Reset: 45 * time.Second, RetryAfter: 1500 * time.Millisecond},
+			want: want{
+				code:       http.StatusTooManyRequests,
+				key:        "ip:192.0.2.1|write",
+				quota:      writeQuota,
+				remaining:  "0",
+				reset:      "45",
+				retryAfter: "2",
+				errMessage: api.TooManyRequestsErr,
+			},
+		},
+		{
+			name:    "/v2でトークンが不足している場合、ステータスコード429をapplication/problem+jsonで返すこと",
+			request: request{method: api.Delete, url: "/v2/langs/1"},
+			result:  &api.RateLimitResult{Allowed: false, Limit: 2, Remaining: 0, Reset: 45 * time.Second, RetryAfter: 1500 * time.Millisecond},
+			want: want{
+				code:       http.StatusTooManyRequests,
+				key:        "ip:192.0.2.1|write",
+				quota:      writeQuota,
+				remaining:  "0",
+				reset:      "45",
+				retryAfter: "2",
+				errMessage: api.TooManyRequestsErr,
+				problem:    true,
+			},
+		},
+		{
+			name:    "Storeでエラーが発生した場合、ステータスコード500とエラーメッセージを返すこと",
+			request: request{method: api.Get, url: "/v1/langs/1"},
+			err:     dbErr,
+			want: want{
+				code:       http.StatusInternalServerError,
+				key:        "ip:192.0.2.1|read",
+				quota:      readQuota,
+				errMessage: dbErr.Error(),
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			store := &stubRateLimitStore{result: tt.result, err: tt.err}
+			clients := api.NewClientIdentifier([]string{"secret", ""}, []*net.IPNet{proxies})
+			limiter, err := api.NewRateLimiter(store, clients, readQuota, writeQuota)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if err := limiter.SetRouteQuota(api.Post, "/v1/langs", routeQuota); err != nil {
+				t.Fatal(err)
+			}
+			if err := limiter.SetRouteQuota(api.Post, "/v1/:resource", fallbackRouteQuota); err != nil {
+				t.Fatal(err)
+			}
+
+			r := gin.New()
+			ok := func(c *gin.Context) { c.JSON(http.StatusOK, nil) }
+			for _, version := range []string{api.V1Path, api.V2Path} {
+				g := r.Group(version)
+				g.Use(limiter.Handle)
+				g.GET(api.ProgrammingLangAPIPath, ok)
+				g.GET(fmt.Sprintf("%s/:%s", api.ProgrammingLangAPIPath, api.ID), ok)
+				g.POST(api.ProgrammingLangAPIPath, ok)
+				g.POST(api.TagAPIPath, ok)
+				g.PUT(fmt.Sprintf("%s/:%s", api.ProgrammingLangAPIPath, api.ID), ok)
+				g.DELETE(fmt.Sprintf("%s/:%s", api.ProgrammingLangAPIPath, api.ID), ok)
+			}
+
+			req, err := http.NewRequest(tt.request.method, tt.request.url, nil)
+			if err != nil {
+				t.Fatal(err)
+			}
+			req.RemoteAddr = "192.0.2.1:1234"
+			if tt.request.remoteAddr != "" {
+				req.RemoteAddr = tt.request.remoteAddr
+			}
+			if tt.request.forwardedFor != "" {
+				req.Header.Set("X-Forwarded-For", tt.request.forwardedFor)
+			}
+			if tt.request.apiKey != "" {
+				req.Header.Set(api.APIKeyHeader, tt.request.apiKey)
+			}
+
+			rec := httptest.NewRecorder()
+			r.ServeHTTP(rec, req)
+
+			if rec.Code != tt.want.code {
+				t.Errorf("Status Code = %v, want %v", rec.Code, tt.want.code)
+			}
+			if len(store.keys) != 1 || store.keys[0] != tt.want.key {
+				t.Errorf("Store Key = %v, want %v", store.keys, tt.want.key)
+			}
+			if len(store.quotas) != 1 || store.quotas[0] != tt.want.quota {
+				t.Errorf("Store Quota = %v, want %v", store.quotas, tt.want.quota)
+			}
+			if got := rec.Header().Get(api.RateLimitRemainingHeader); got != tt.want.remaining {
+				t.Errorf("%s = %v, want %v", api.RateLimitRemainingHeader, got, tt.want.remaining)
+			}
+			if got := rec.Header().Get(api.RateLimitResetHeader); got != tt.want.reset {
+				t.Errorf("%s = %v, want %v", api.RateLimitResetHeader, got, tt.want.reset)
+			}
+			if got := rec.Header().Get(api.RetryAfterHeader); got != tt.want.retryAfter {
+				t.Errorf("%s = %v, want %v", api.RetryAfterHeader, got, tt.want.retryAfter)
+			}
+			if tt.want.problem {
+				var p api.Problem
+				if err := json.Unmarshal(rec.Body.Bytes(), &p); err != nil {
+					t.Fatal(err)
+				}
+				if got := rec.Header().Get("Content-Type"); got != api.ProblemContentType {
+					t.Errorf("Content-Type = %v, want %v", got, api.ProblemContentType)
+				}
+				if p.Type != api.ProblemTypeTooManyRequests || p.Status != tt.want.code || p.Detail != tt.want.errMessage {
+					t.Errorf("Problem = %+v, want type %v, status %v and detail %v", p, api.ProblemTypeTooManyRequests, tt.want.code, tt.want.errMessage)
+				}
+			} else if tt.want.code != http.StatusOK {
+				if util.TrimDoubleQuotes(rec.Body.String()) != tt.want.errMessage {
+					t.Errorf("Error Message = %v, want %v", util.TrimDoubleQuotes(rec.Body.String()), tt.want.errMessage)
+				}
+			}
+		})
+	}
+}
+
+func TestNewRateLimiter(t *testing.T) {
+	tests := []struct {
+		name       string
+		readQuota  api.RateLimitQuota
+		writeQuota api.RateLimitQuota
+		wantErr    bool
+	}{
+		{
+			name:       "Quotaが正しい場合、エラーを返さないこと",
+			readQuota:  api.RateLimitQuota{Limit: 10, Per: time.Minute},
+			writeQuota: api.RateLimitQuota{Limit: 2, Per: time.Minute},
+		},
+		{
+			name:       "Limitが0の場合、エラーを返すこと",
+			readQuota:  api.RateLimitQuota{Limit: 0, Per: time.Minute},
+			writeQuota: api.RateLimitQuota{Limit: 2, Per: time.Minute},
+			wantErr:    true,
+		},
+		{
+			name:       "Limitが負の場合、エラーを返すこと",
+			readQuota:  api.RateLimitQuota{Limit: 10, Per: time.Minute},
+			writeQuota: api.RateLimitQuota{Limit: -1, Per: time.Minute},
+			wantErr:    true,
+		},
+		{
+			name:       "期間が0の場合、エラーを返すこと",
+			readQuota:  api.RateLimitQuota{Limit: 10},
+			writeQuota: api.RateLimitQuota{Limit: 2, Per: time.Minute},
+			wantErr:    true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := api.NewRateLimiter(&stubRateLimitStore{}, api.NewClientIdentifier(nil, nil), tt.readQuota, tt.writeQuota)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("NewRateLimiter() error = %v, wantErr %v", err, tt.wantErr)
+			}
+		})
+	}
+}
+
+func TestRateLimiter_SetRouteQuota(t *testing.T) {
+	limiter, err := api.NewRateLimiter(&stubRateLimitStore{}, api.NewClientIdentifier(nil, nil), api.RateLimitQuota{Limit: 10, Per: time.Minute}, api.RateLimitQuota{Limit: 2, Per: time.Minute})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if err := limiter.SetRouteQuota(api.Post, "/v1/langs", api.RateLimitQuota{Limit: 0, Per: time.Minute}); err == nil {
+		t.Errorf("RateLimiter.SetRouteQuota() error = nil, want error")
+	}
+	if len(limiter.RouteQuotas) != 0 {
+		t.Errorf("RateLimiter.RouteQuotas = %v, want empty", limiter.RouteQuotas)
+	}
+}
+
+func TestParseRateLimitQuota(t *testing.T) {
+	tests := []struct {
+		name    string
+		s       string
+		want    api.RateLimitQuota
+		wantErr bool
+	}{
+		{
+			name: "回数と期間が指定された場合、Quotaを返すこと",
+			s:    "120/1m",
+			want: api.RateLimitQuota{Limit: 120, Per: time.Minute},
+		},
+		{
+			name:    "期間が指定されていない場合、エラーを返すこと",
+			s:       "120",
+			wantErr: true,
+		},
+		{
+			name:    "回数が数値ではない場合、エラーを返すこと",
+			s:       "many/1m",
+			wantErr: true,
+		},
+		{
+			name:    "期間が不正な場合、エラーを返すこと",
+			s:       "120/minute",
+			wantErr: true,
+		},
+		{
+			name:    "回数が0の場合、エラーを返すこと",
+			s:       "0/1m",
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := api.ParseRateLimitQuota(tt.s)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("ParseRateLimitQuota() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if got != tt.want {
+				t.Errorf("ParseRateLimitQuota() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestParseRouteQuotas(t *testing.T) {
+	tests := []struct {
+		name    string
+		s       string
+		want    []api.RouteQuota
+		wantErr bool
+	}{
+		{
+			name: "空の場合、Quotaを返さないこと",
+			s:    "",
+		},
+		{
+			name: "複数指定された場合、記述した順に返すこと",
+			s:    "post /v1/langs=10/1m; POST /v1/:resource=20/1m",
+			want: []api.RouteQuota{
+				{Method: api.Post, Pattern: "/v1/langs", Quota: api.RateLimitQuota{Limit: 10, Per: time.Minute}},
+				{Method: api.Post, Pattern: "/v1/:resource", Quota: api.RateLimitQuota{Limit: 20, Per: time.Minute}},
+			},
+		},
+		{
+			name:    "メソッドが指定されていない場合、エラーを返すこと",
+			s:       "/v1/langs=10/1m",
+			wantErr: true,
+		},
+		{
+			name:    "Quotaが指定されていない場合、エラーを返すこと",
+			s:       "POST /v1/langs",
+			wantErr: true,
+		},
+		{
+			name:    "Quotaの回数が0の場合、エラーを返すこと",
+			s:       "POST /v1/langs=0/1m",
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := api.ParseRouteQuotas(tt.s)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("ParseRouteQuotas() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("ParseRouteQuotas() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
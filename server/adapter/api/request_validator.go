@@ -0,0 +1,314 @@
+package api
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"sort"
+	"strconv"
+	"strings"
+	"unicode/utf8"
+
+	"github.com/SekiguchiKai/clean-architecture-with-go/server/domain/model"
+	"github.com/gin-gonic/gin"
+)
+
+// bodyProperty は、リクエストボディ全体に対するエラーで使用する名前。
+const bodyProperty = "body"
+
+// RequestValidator は、リクエストのパラメータとボディをOpenAPIの文書に照らして検証するミドルウェア。
+// 文書に記述されていないルートは、検証せずに処理する。
+// 400のレスポンスがapplication/problem+jsonで記述されたルートでは、エラーもその形式で返す。
+type RequestValidator struct {
+	Spec *OpenAPISpec
+}
+
+// NewRequestValidator は、RequestValidatorを生成し、返す。
+func NewRequestValidator(spec *OpenAPISpec) *RequestValidator {
+	return &RequestValidator{
+		Spec: spec,
+	}
+}
+
+// Handle は、リクエストを検証し、文書に従っていない場合はステータスコード400を返す。
+func (v *RequestValidator) Handle(c *gin.Context) {
+	template, op := v.Spec.Operation(c.Request.Method, c.Request.URL.Path)
+	if op == nil {
+		c.Next()
+		return
+	}
+
+	if err := validateRequest(c, template, op); err != nil {
+		if respondsProblem(op) {
+			respondProblem(c, err)
+			return
+		}
+		he := handleError(err)
+		c.AbortWithStatusJSON(he.code, he.message)
+		return
+	}
+
+	c.Next()
+}
+
+// validateRequest は、パスのテンプレートに一致したリクエストを、Operationのパラメータとボディに照らして検証する。
+func validateRequest(c *gin.Context, template string, op *OpenAPIOperation) error {
+	params := pathParams(template, c.Request.URL.Path)
+	for _, p := range op.Parameters {
+		if err := validateParameter(c, p, params); err != nil {
+			return err
+		}
+	}
+
+	if op.RequestBody == nil {
+		return nil
+	}
+	return validateBody(c, op.RequestBody)
+}
+
+// validateParameter は、パス、Query String、ヘッダーの値を検証する。空の値は指定されていないものとみなす。
+func validateParameter(c *gin.Context, p *OpenAPIParameter, pathValues map[string]string) error {
+	var values []string
+	switch p.In {
+	case "path":
+		if v := pathValues[p.Name]; v != "" {
+			values = []string{v}
+		}
+	case "query":
+		for _, v := range c.QueryArray(p.Name) {
+			if v != "" {
+				values = append(values, v)
+			}
+		}
+	case "header":
+		if v := c.GetHeader(p.Name); v != "" {
+			values = []string{v}
+		}
+	}
+
+	if len(values) == 0 {
+		if p.Required {
+			return &model.RequiredError{Property: p.Name}
+		}
+		return nil
+	}
+
+	if p.Schema == nil {
+		return nil
+	}
+
+	schema := p.Schema
+	if schema.Type == "array" {
+		schema = schema.Items
+	} else {
+		values = values[:1]
+	}
+
+	for _, v := range values {
+		if err := validateParameterValue(p.Name, schema, v); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// validateParameterValue は、文字列で受け取ったパラメータの値を、スキーマに照らして検証する。
+func validateParameterValue(name string, schema *OpenAPISchema, v string) error {
+	if schema == nil {
+		return nil
+	}
+
+	switch schema.Type {
+	case "integer":
+		n, err := strconv.Atoi(v)
+		if err != nil {
+			return &model.InvalidParameterError{Parameter: name, Message: intParameterErr(name)}
+		}
+		if msg := rangeErr(schema, n); msg != "" {
+			return &model.InvalidParameterError{Parameter: name, Message: msg}
+		}
+	case "string":
+		if msg := stringErr(schema, v); msg != "" {
+			return &model.InvalidParameterError{Parameter: name, Message: msg}
+		}
+	}
+	return nil
+}
+
+// validateBody は、JSONのリクエストボディを検証する。後続のハンドラが読めるように、ボディは読み直せるようにしておく。
+func validateBody(c *gin.Context, body *OpenAPIRequestBody) error {
+	media, ok := body.Content[JSONContentType]
+	if !ok || media.Schema == nil {
+		return nil
+	}
+
+	b, err := ioutil.ReadAll(c.Request.Body)
+	if err != nil {
+		return &model.InvalidPropertyError{Property: bodyProperty, Message: err.Error()}
+	}
+	c.Request.Body = ioutil.NopCloser(bytes.NewReader(b))
+
+	if len(bytes.TrimSpace(b)) == 0 {
+		if body.Required {
+			return &model.RequiredError{Property: bodyProperty}
+		}
+		return nil
+	}
+
+	var v interface{}
+	d := json.NewDecoder(bytes.NewReader(b))
+	d.UseNumber()
+	if err := d.Decode(&v); err != nil {
+		return &model.InvalidPropertyError{Property: bodyProperty, Message: InvalidJSONErr}
+	}
+
+	return validateValue(bodyProperty, media.Schema, v)
+}
+
+// validateValue は、JSONの値をスキーマに照らして検証する。propertyは、エラーで示す値の位置。
+// オブジェクトの属性のうち、requiredでないものはnullを指定しなかったものとみなす。
+func validateValue(property string, schema *OpenAPISchema, v interface{}) error {
+	typeErr := &model.InvalidPropertyError{Property: property, Message: fmt.Sprintf(ShouldBeTypeErr, schema.Type)}
+	if v == nil {
+		if schema.Nullable {
+			return nil
+		}
+		return typeErr
+	}
+
+	switch schema.Type {
+	case "object":
+		m, ok := v.(map[string]interface{})
+		if !ok {
+			return typeErr
+		}
+		return validateObject(property, schema, m)
+	case "array":
+		items, ok := v.([]interface{})
+		if !ok {
+			return typeErr
+		}
+		if schema.Items == nil {
+			return nil
+		}
+		for i, item := range items {
+			if err := validateValue(fmt.Sprintf("%s[%d]", property, i), schema.Items, item); err != nil {
+				return err
+			}
+		}
+	case "string":
+		s, ok := v.(string)
+		if !ok {
+			return typeErr
+		}
+		if msg := stringErr(schema, s); msg != "" {
+			return &model.InvalidPropertyError{Property: property, Message: msg}
+		}
+	case "integer":
+		n, ok := v.(json.Number)
+		if !ok {
+			return typeErr
+		}
+		i, err := strconv.Atoi(n.String())
+		if err != nil {
+			return typeErr
+		}
+		if msg := rangeErr(schema, i); msg != "" {
+			return &model.InvalidPropertyError{Property: property, Message: msg}
+		}
+	case "number":
+		if _, ok := v.(json.Number); !ok {
+			return typeErr
+		}
+	case "boolean":
+		if _, ok := v.(bool); !ok {
+			return typeErr
+		}
+	}
+	return nil
+}
+
+// validateObject は、オブジェクトの必須の属性と、各属性の値を検証する。エラーが毎回同じになるよう、属性は名前の順に検証する。
+func validateObject(property string, schema *OpenAPISchema, m map[string]interface{}) error {
+	for _, name := range schema.Required {
+		if m[name] == nil {
+			return &model.RequiredError{Property: childProperty(property, name)}
+		}
+	}
+
+	names := make([]string, 0, len(schema.Properties))
+	for name := range schema.Properties {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	for _, name := range names {
+		v, ok := m[name]
+		if !ok || v == nil {
+			continue
+		}
+		if err := validateValue(childProperty(property, name), schema.Properties[name], v); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// childProperty は、属性の位置を返す。ボディの直下の属性は、属性の名前のみとする。
+func childProperty(parent, name string) string {
+	if parent == bodyProperty {
+		return name
+	}
+	return parent + "." + name
+}
+
+// stringErr は、文字列がminLengthとenumに従っていない場合に、エラーの文言を返す。
+func stringErr(schema *OpenAPISchema, s string) string {
+	if utf8.RuneCountInString(s) < schema.MinLength {
+		return fmt.Sprintf(MinLengthErr, schema.MinLength)
+	}
+
+	if len(schema.Enum) == 0 {
+		return ""
+	}
+	for _, e := range schema.Enum {
+		if s == e {
+			return ""
+		}
+	}
+	return fmt.Sprintf(ShouldBeOneOfErr, strings.Join(schema.Enum, ", "))
+}
+
+// rangeErr は、整数がminimumとmaximumの範囲外である場合に、エラーの文言を返す。
+func rangeErr(schema *OpenAPISchema, n int) string {
+	if schema.Minimum != nil && n < *schema.Minimum {
+		return fmt.Sprintf(MinimumErr, *schema.Minimum)
+	}
+	if schema.Maximum != nil && n > *schema.Maximum {
+		return fmt.Sprintf(MaximumErr, *schema.Maximum)
+	}
+	return ""
+}
+
+// intParameterErr は、整数でないパラメータに対するエラーの文言を、ハンドラが返すものに合わせて返す。
+func intParameterErr(name string) string {
+	switch name {
+	case ID:
+		return IDShouldBeIntErr
+	case Limit:
+		return LimitShouldBeIntErr
+	default:
+		return ShouldBeIntErr
+	}
+}
+
+// respondsProblem は、Operationが400のエラーをapplication/problem+jsonで返すかどうかを確認する。
+func respondsProblem(op *OpenAPIOperation) bool {
+	r, ok := op.Responses["400"]
+	if !ok || r == nil {
+		return false
+	}
+	_, ok = r.Content[ProblemContentType]
+	return ok
+}
@@ -0,0 +1,182 @@
+package api_test
+
+import (
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/SekiguchiKai/clean-architecture-with-go/server/adapter/api"
+	"github.com/SekiguchiKai/clean-architecture-with-go/server/util"
+	"github.com/gin-gonic/gin"
+)
+
+func TestRequestValidator_Handle(t *testing.T) {
+	spec, err := api.LoadOpenAPISpec()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	tests := []struct {
+		name            string
+		method          string
+		path            string
+		body            string
+		wantCode        int
+		wantErrMessage  string
+		wantProblemType string
+	}{
+		{
+			name:     "文書に従ったリクエストの場合、ボディを変えずにハンドラに渡すこと",
+			method:   api.Post,
+			path:     "/v1/langs",
+			body:     `{"id":5,"name":"Go","firstAppeared":2009,"paradigms":["concurrent"],"designers":null}`,
+			wantCode: http.StatusOK,
+		},
+		{
+			name:           "IDが数値でない場合、ハンドラと同じエラーでステータスコード400を返すこと",
+			method:         api.Get,
+			path:           "/v1/langs/a",
+			wantCode:       http.StatusBadRequest,
+			wantErrMessage: "id is invalid. ID Should be int",
+		},
+		{
+			name:           "limitが数値でない場合、ハンドラと同じエラーでステータスコード400を返すこと",
+			method:         api.Get,
+			path:           "/v1/langs?limit=a",
+			wantCode:       http.StatusBadRequest,
+			wantErrMessage: "limit is invalid. Limit Should be int",
+		},
+		{
+			name:           "列挙されていない値の場合、ステータスコード400を返すこと",
+			method:         api.Get,
+			path:           "/v1/langs?tagMatch=some",
+			wantCode:       http.StatusBadRequest,
+			wantErrMessage: "tagMatch is invalid. should be one of all, any",
+		},
+		{
+			name:           "範囲外の値の場合、ステータスコード400を返すこと",
+			method:         api.Get,
+			path:           "/v1/langs/1/ancestors?depth=11",
+			wantCode:       http.StatusBadRequest,
+			wantErrMessage: "depth is invalid. should be 10 or less",
+		},
+		{
+			name:           "必須のパラメータがない場合、ステータスコード400を返すこと",
+			method:         api.Get,
+			path:           "/v1/langs-suggest",
+			wantCode:       http.StatusBadRequest,
+			wantErrMessage: "prefix is required",
+		},
+		{
+			name:           "生成時にnameがない場合、ステータスコード400を返すこと",
+			method:         api.Post,
+			path:           "/v1/langs",
+			body:           `{"feature":"Simple"}`,
+			wantCode:       http.StatusBadRequest,
+			wantErrMessage: "name is required",
+		},
+		{
+			name:     "更新時にnameがない場合、現在の値を保つためにハンドラに渡すこと",
+			method:   api.Put,
+			path:     "/v1/langs/1",
+			body:     `{"feature":"Simple"}`,
+			wantCode: http.StatusOK,
+		},
+		{
+			name:     "更新時に詳細な属性に空の値を指定した場合、値を消すためにハンドラに渡すこと",
+			method:   api.Put,
+			path:     "/v1/langs/1",
+			body:     `{"name":"Go","typeChecking":"","license":null,"designers":[]}`,
+			wantCode: http.StatusOK,
+		},
+		{
+			name:           "属性の型が異なる場合、ステータスコード400を返すこと",
+			method:         api.Post,
+			path:           "/v1/langs",
+			body:           `{"name":"Go","firstAppeared":"2009"}`,
+			wantCode:       http.StatusBadRequest,
+			wantErrMessage: "firstAppeared is invalid. should be integer",
+		},
+		{
+			name:           "配列の要素が列挙されていない値の場合、要素の位置を示してステータスコード400を返すこと",
+			method:         api.Post,
+			path:           "/v1/langs",
+			body:           `{"name":"Go","paradigms":["concurrent","unknown"]}`,
+			wantCode:       http.StatusBadRequest,
+			wantErrMessage: "paradigms[1] is invalid. should be one of imperative, procedural, object-oriented, functional, declarative, logic, concurrent, generic, event-driven, reflective, scripting, array",
+		},
+		{
+			name:           "ボディがJSONでない場合、ステータスコード400を返すこと",
+			method:         api.Post,
+			path:           "/v1/langs",
+			body:           `{`,
+			wantCode:       http.StatusBadRequest,
+			wantErrMessage: "body is invalid. should be JSON",
+		},
+		{
+			name:           "ボディがnullの場合、ステータスコード400を返すこと",
+			method:         api.Post,
+			path:           "/v1/langs",
+			body:           `null`,
+			wantCode:       http.StatusBadRequest,
+			wantErrMessage: "body is invalid. should be object",
+		},
+		{
+			name:            "/v2の場合、application/problem+jsonでステータスコード400を返すこと",
+			method:          api.Get,
+			path:            "/v2/langs/a",
+			wantCode:        http.StatusBadRequest,
+			wantProblemType: api.ProblemTypeInvalidParameter,
+		},
+		{
+			name:     "文書に記述されていないルートの場合、検証せずにハンドラに渡すこと",
+			method:   api.Get,
+			path:     "/v1/unknown/a",
+			wantCode: http.StatusOK,
+		},
+		{
+			name:     "JSONでないボディの場合、ボディを検証せずにハンドラに渡すこと",
+			method:   api.Post,
+			path:     "/v1/admin/import/linguist",
+			body:     "Go:\n  type: programming\n",
+			wantCode: http.StatusOK,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var got string
+			r := gin.New()
+			r.Use(api.NewRequestValidator(spec).Handle)
+			r.NoRoute(func(c *gin.Context) {
+				b, err := ioutil.ReadAll(c.Request.Body)
+				if err != nil {
+					t.Fatal(err)
+				}
+				got = string(b)
+				c.JSON(http.StatusOK, nil)
+			})
+
+			rec := httptest.NewRecorder()
+			req, err := http.NewRequest(tt.method, tt.path, strings.NewReader(tt.body))
+			if err != nil {
+				t.Fatal(err)
+			}
+			r.ServeHTTP(rec, req)
+
+			if rec.Code != tt.wantCode {
+				t.Errorf("Status Code = %v, want %v", rec.Code, tt.wantCode)
+			}
+			if tt.wantCode == http.StatusOK && got != tt.body {
+				t.Errorf("body = %v, want %v", got, tt.body)
+			}
+			if tt.wantErrMessage != "" && util.TrimDoubleQuotes(rec.Body.String()) != tt.wantErrMessage {
+				t.Errorf("Error Message = %v, want %v", util.TrimDoubleQuotes(rec.Body.String()), tt.wantErrMessage)
+			}
+			if tt.wantProblemType != "" {
+				testProblem(t, rec, tt.wantCode, tt.wantProblemType)
+			}
+		})
+	}
+}
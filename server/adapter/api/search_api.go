@@ -0,0 +1,65 @@
+package api
+
+import (
+	"net/http"
+
+	"github.com/SekiguchiKai/clean-architecture-with-go/server/usecase/input"
+	"github.com/SekiguchiKai/clean-architecture-with-go/server/usecase/output"
+	"github.com/gin-gonic/gin"
+)
+
+// SearchAPI は、ProgrammingLangの全文検索のAPI。
+type SearchAPI struct {
+	UseCase input.SearchInputPort
+}
+
+// NewSearchAPI は、SearchAPIを生成し、返す。
+func NewSearchAPI(useCase input.SearchInputPort) *SearchAPI {
+	return &SearchAPI{
+		UseCase: useCase,
+	}
+}
+
+// InitAPI は、APIを初期設定する。adminには、管理用のAPIの認証を設定したグループを渡す。
+func (api *SearchAPI) InitAPI(g *gin.RouterGroup, admin *gin.RouterGroup) {
+	g.GET(SearchAPIPath, api.Search)
+	admin.POST(SearchReindexPath, api.Reindex)
+}
+
+// Search は、qの語をNameもしくはFeatureに含むProgrammingLangを、スコアの高い順に返す。
+// limitが指定されていない場合は、DefaultSearchLimit件返す。
+func (api *SearchAPI) Search(c *gin.Context) {
+	limit := DefaultSearchLimit
+	if c.Query(Limit) != "" {
+		l, err := getLimit(c)
+		if err != nil {
+			he := handleError(err)
+			c.JSON(he.code, he.message)
+			return
+		}
+		limit = ManageLimit(l, MaxSearchLimit, MinSearchLimit, DefaultSearchLimit)
+	}
+
+	ctx := c.Request.Context()
+	results, err := api.UseCase.Search(ctx, c.Query(Query), limit)
+	if err != nil {
+		he := handleError(err)
+		c.JSON(he.code, he.message)
+		return
+	}
+
+	c.JSON(http.StatusOK, output.NewSearchResultOutputs(results))
+}
+
+// Reindex は、全文検索の索引を構築し直し、登録した件数を返す。
+func (api *SearchAPI) Reindex(c *gin.Context) {
+	ctx := c.Request.Context()
+	report, err := api.UseCase.Reindex(ctx)
+	if err != nil {
+		he := handleError(err)
+		c.JSON(he.code, he.message)
+		return
+	}
+
+	c.JSON(http.StatusOK, output.NewReindexReportOutput(report))
+}
@@ -0,0 +1,155 @@
+package api_test
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"reflect"
+	"testing"
+
+	"github.com/SekiguchiKai/clean-architecture-with-go/server/adapter/api"
+	"github.com/SekiguchiKai/clean-architecture-with-go/server/domain/model"
+	"github.com/SekiguchiKai/clean-architecture-with-go/server/usecase/mock"
+	"github.com/SekiguchiKai/clean-architecture-with-go/server/usecase/output"
+	"github.com/gin-gonic/gin"
+	"github.com/golang/mock/gomock"
+)
+
+func TestSearchAPI_Search(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	u := mock_input.NewMockSearchInputPort(ctrl)
+
+	results := []*model.SearchResult{
+		{ID: 1, Name: "Go", Slug: "go", Score: 1.5, Snippet: "<mark>garbage</mark> collection"},
+	}
+
+	type mock struct {
+		query  string
+		limit  int
+		result []*model.SearchResult
+		err    error
+	}
+
+	tests := []struct {
+		name     string
+		query    string
+		mock     *mock
+		wantCode int
+		want     []*output.SearchResultOutput
+	}{
+		{
+			name:     "qを指定した場合、ステータスコード200と検索結果を返すこと",
+			query:    fmt.Sprintf("%s=garbage", api.Query),
+			mock:     &mock{query: "garbage", limit: api.DefaultSearchLimit, result: results},
+			wantCode: http.StatusOK,
+			want:     output.NewSearchResultOutputs(results),
+		},
+		{
+			name:     "limitを指定した場合、limitを上限として検索すること",
+			query:    fmt.Sprintf("%s=garbage&%s=3", api.Query, api.Limit),
+			mock:     &mock{query: "garbage", limit: 3, result: results},
+			wantCode: http.StatusOK,
+			want:     output.NewSearchResultOutputs(results),
+		},
+		{
+			name:     "qを指定しない場合、ステータスコード400を返すこと",
+			query:    "",
+			mock:     &mock{query: "", limit: api.DefaultSearchLimit, err: &model.RequiredError{Property: model.PropertyQuery}},
+			wantCode: http.StatusBadRequest,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			r := gin.New()
+			api.NewSearchAPI(u).InitAPI(&r.RouterGroup, r.Group(api.AdminAPIPath))
+			if tt.mock != nil {
+				u.EXPECT().Search(context.Background(), tt.mock.query, tt.mock.limit).Return(tt.mock.result, tt.mock.err)
+			}
+
+			rec := httptest.NewRecorder()
+			url := fmt.Sprintf("%s?%s", api.SearchAPIPath, tt.query)
+			req, err := http.NewRequest(api.Get, url, nil)
+			if err != nil {
+				t.Fatal(err)
+			}
+			r.ServeHTTP(rec, req)
+
+			if rec.Code != tt.wantCode {
+				t.Errorf("Status Code = %v, want %v", rec.Code, tt.wantCode)
+			}
+			if tt.wantCode == http.StatusOK {
+				var got []*output.SearchResultOutput
+				if err := json.Unmarshal(rec.Body.Bytes(), &got); err != nil {
+					t.Fatal(err)
+				}
+				if !reflect.DeepEqual(got, tt.want) {
+					t.Errorf("Response Body = %v, want %v", got, tt.want)
+				}
+			}
+		})
+	}
+}
+
+func TestSearchAPI_Reindex(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	u := mock_input.NewMockSearchInputPort(ctrl)
+
+	const adminKey = "secret"
+	path := api.AdminAPIPath + api.SearchReindexPath
+
+	tests := []struct {
+		name     string
+		apiKey   string
+		mock     func(ctx context.Context)
+		wantCode int
+	}{
+		{
+			name:   "APIキーが一致する場合、登録した件数を返すこと",
+			apiKey: adminKey,
+			mock: func(ctx context.Context) {
+				u.EXPECT().Reindex(ctx).Return(&model.ReindexReport{Documents: 3}, nil)
+			},
+			wantCode: http.StatusOK,
+		},
+		{
+			name:   "索引の構築に失敗した場合、ステータスコード500を返すこと",
+			apiKey: adminKey,
+			mock: func(ctx context.Context) {
+				u.EXPECT().Reindex(ctx).Return(nil, &model.DBError{})
+			},
+			wantCode: http.StatusInternalServerError,
+		},
+		{
+			name:     "APIキーが一致しない場合、ステータスコード401を返すこと",
+			apiKey:   "wrong",
+			mock:     func(ctx context.Context) {},
+			wantCode: http.StatusUnauthorized,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			r := gin.New()
+			api.NewSearchAPI(u).InitAPI(&r.RouterGroup, r.Group(api.AdminAPIPath, api.NewAdminAuth(adminKey).Handle))
+
+			tt.mock(context.Background())
+
+			rec := httptest.NewRecorder()
+			req, err := http.NewRequest(api.Post, path, nil)
+			if err != nil {
+				t.Fatal(err)
+			}
+			req.Header.Set(api.APIKeyHeader, tt.apiKey)
+			r.ServeHTTP(rec, req)
+
+			if rec.Code != tt.wantCode {
+				t.Errorf("Status Code = %v, want %v", rec.Code, tt.wantCode)
+			}
+		})
+	}
+}
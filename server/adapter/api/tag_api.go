@@ -0,0 +1,222 @@
+package api
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+
+	"github.com/SekiguchiKai/clean-architecture-with-go/server/usecase/input"
+	"github.com/SekiguchiKai/clean-architecture-with-go/server/usecase/output"
+	"github.com/gin-gonic/gin"
+)
+
+// mergeTagParam は、Tagの統合のリクエストボディ。
+type mergeTagParam struct {
+	Into int `json:"into"`
+}
+
+// TagAPI は、TagのAPI。
+type TagAPI struct {
+	UseCase input.TagInputPort
+}
+
+// NewTagAPI は、TagAPIを生成し、返す。
+func NewTagAPI(useCase input.TagInputPort) *TagAPI {
+	return &TagAPI{
+		UseCase: useCase,
+	}
+}
+
+// InitAPI は、APIを初期設定する。
+func (api *TagAPI) InitAPI(g *gin.RouterGroup) {
+	g.GET(TagAPIPath, api.List)
+	g.GET(fmt.Sprintf("%s/:%s", TagAPIPath, ID), api.Get)
+	g.POST(TagAPIPath, api.Create)
+	g.POST(fmt.Sprintf("%s/:%s/%s", TagAPIPath, ID, MergePath), api.Merge)
+	g.PUT(fmt.Sprintf("%s/:%s", TagAPIPath, ID), api.Rename)
+	g.DELETE(fmt.Sprintf("%s/:%s", TagAPIPath, ID), api.Delete)
+
+	g.GET(fmt.Sprintf("%s/:%s/%s", ProgrammingLangAPIPath, ID, TagsPath), api.ListByLang)
+	langTagPath := fmt.Sprintf("%s/:%s/%s/:%s", ProgrammingLangAPIPath, ID, TagsPath, SubID)
+	g.PUT(langTagPath, api.Attach)
+	g.DELETE(langTagPath, api.Detach)
+}
+
+// List は、Tagの一覧を名前の昇順で返す。
+func (api *TagAPI) List(c *gin.Context) {
+	ctx := c.Request.Context()
+	tags, err := api.UseCase.List(ctx)
+	if err != nil {
+		he := handleError(err)
+		c.JSON(he.code, he.message)
+		return
+	}
+
+	c.JSON(http.StatusOK, output.NewTagOutputs(tags))
+}
+
+// Get は、Tagを取得する。
+func (api *TagAPI) Get(c *gin.Context) {
+	id, err := getID(c)
+	if err != nil {
+		he := handleError(err)
+		c.JSON(he.code, he.message)
+		return
+	}
+
+	ctx := c.Request.Context()
+	tag, err := api.UseCase.Get(ctx, id)
+	if err != nil {
+		he := handleError(err)
+		c.JSON(he.code, he.message)
+		return
+	}
+
+	c.JSON(http.StatusOK, output.NewTagOutput(tag))
+}
+
+// Create は、Tagを生成する。
+func (api *TagAPI) Create(c *gin.Context) {
+	var params input.TagInput
+	if err := c.BindJSON(&params); err != nil {
+		c.JSON(http.StatusBadRequest, err.Error())
+		return
+	}
+
+	ctx := c.Request.Context()
+	tag, err := api.UseCase.Create(ctx, params.Name)
+	if err != nil {
+		he := handleError(err)
+		c.JSON(he.code, he.message)
+		return
+	}
+
+	c.JSON(http.StatusOK, output.NewTagOutput(tag))
+}
+
+// Rename は、Tagの名前を変更する。
+func (api *TagAPI) Rename(c *gin.Context) {
+	var params input.TagInput
+	if err := c.BindJSON(&params); err != nil {
+		c.JSON(http.StatusBadRequest, err.Error())
+		return
+	}
+
+	id, err := getID(c)
+	if err != nil {
+		he := handleError(err)
+		c.JSON(he.code, he.message)
+		return
+	}
+
+	ctx := c.Request.Context()
+	tag, err := api.UseCase.Rename(ctx, id, params.Name)
+	if err != nil {
+		he := handleError(err)
+		c.JSON(he.code, he.message)
+		return
+	}
+
+	c.JSON(http.StatusOK, output.NewTagOutput(tag))
+}
+
+// Merge は、Tagをリクエストボディのintoで指定したTagに統合し、統合先のTagを返す。
+func (api *TagAPI) Merge(c *gin.Context) {
+	var params *mergeTagParam
+	if err := c.BindJSON(&params); err != nil {
+		c.JSON(http.StatusBadRequest, err.Error())
+		return
+	}
+
+	id, err := getID(c)
+	if err != nil {
+		he := handleError(err)
+		c.JSON(he.code, he.message)
+		return
+	}
+
+	ctx := c.Request.Context()
+	tag, err := api.UseCase.Merge(ctx, id, params.Into)
+	if err != nil {
+		he := handleError(err)
+		c.JSON(he.code, he.message)
+		return
+	}
+
+	c.JSON(http.StatusOK, output.NewTagOutput(tag))
+}
+
+// Delete は、Tagを削除する。
+func (api *TagAPI) Delete(c *gin.Context) {
+	id, err := getID(c)
+	if err != nil {
+		he := handleError(err)
+		c.JSON(he.code, he.message)
+		return
+	}
+
+	ctx := c.Request.Context()
+	if err := api.UseCase.Delete(ctx, id); err != nil {
+		he := handleError(err)
+		c.JSON(he.code, he.message)
+		return
+	}
+
+	c.JSON(http.StatusOK, nil)
+}
+
+// ListByLang は、ProgrammingLangに付いているTagの一覧を名前の昇順で返す。
+func (api *TagAPI) ListByLang(c *gin.Context) {
+	langID, err := getID(c)
+	if err != nil {
+		he := handleError(err)
+		c.JSON(he.code, he.message)
+		return
+	}
+
+	ctx := c.Request.Context()
+	tags, err := api.UseCase.ListByLang(ctx, langID)
+	if err != nil {
+		he := handleError(err)
+		c.JSON(he.code, he.message)
+		return
+	}
+
+	c.JSON(http.StatusOK, output.NewTagOutputs(tags))
+}
+
+// Attach は、ProgrammingLangにTagを付ける。
+func (api *TagAPI) Attach(c *gin.Context) {
+	api.changeLangTag(c, api.UseCase.Attach)
+}
+
+// Detach は、ProgrammingLangからTagを外す。
+func (api *TagAPI) Detach(c *gin.Context) {
+	api.changeLangTag(c, api.UseCase.Detach)
+}
+
+// changeLangTag は、URLで指定したProgrammingLangとTagに対して、changeを実行する。
+func (api *TagAPI) changeLangTag(c *gin.Context, change func(ctx context.Context, langID, tagID int) error) {
+	langID, err := getID(c)
+	if err != nil {
+		he := handleError(err)
+		c.JSON(he.code, he.message)
+		return
+	}
+
+	tagID, err := getSubID(c)
+	if err != nil {
+		he := handleError(err)
+		c.JSON(he.code, he.message)
+		return
+	}
+
+	ctx := c.Request.Context()
+	if err := change(ctx, langID, tagID); err != nil {
+		he := handleError(err)
+		c.JSON(he.code, he.message)
+		return
+	}
+
+	c.JSON(http.StatusOK, nil)
+}
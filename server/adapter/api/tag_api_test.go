@@ -0,0 +1,194 @@
+package api_test
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/SekiguchiKai/clean-architecture-with-go/server/adapter/api"
+	"github.com/SekiguchiKai/clean-architecture-with-go/server/domain/model"
+	"github.com/SekiguchiKai/clean-architecture-with-go/server/usecase/mock"
+	"github.com/gin-gonic/gin"
+	"github.com/golang/mock/gomock"
+)
+
+func TestTagAPI(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	langUseCase := mock_input.NewMockProgrammingLangInputPort(ctrl)
+	u := mock_input.NewMockTagInputPort(ctrl)
+
+	langs := model.CreateProgrammingLangs(2)
+	tag := &model.Tag{ID: 2, Name: model.TestTagName}
+
+	tests := []struct {
+		name     string
+		method   string
+		url      string
+		body     string
+		mock     func(ctx context.Context)
+		wantCode int
+	}{
+		{
+			name:   "一覧を取得する場合、ステータスコード200を返すこと",
+			method: api.Get,
+			url:    api.TagAPIPath,
+			mock: func(ctx context.Context) {
+				u.EXPECT().List(ctx).Return([]*model.Tag{tag}, nil)
+			},
+			wantCode: http.StatusOK,
+		},
+		{
+			name:   "生成する場合、リクエストボディのnameでTagを生成すること",
+			method: api.Post,
+			url:    api.TagAPIPath,
+			body:   `{"name":"Functional"}`,
+			mock: func(ctx context.Context) {
+				u.EXPECT().Create(ctx, "Functional").Return(tag, nil)
+			},
+			wantCode: http.StatusOK,
+		},
+		{
+			name:   "同じ名前のTagが既に存在する場合、ステータスコード409を返すこと",
+			method: api.Post,
+			url:    api.TagAPIPath,
+			body:   `{"name":"functional"}`,
+			mock: func(ctx context.Context) {
+				u.EXPECT().Create(ctx, model.TestTagName).Return(nil, &model.AlreadyExistError{
+					ID:        2,
+					Name:      model.TestTagName,
+					ModelName: model.ModelNameTag,
+				})
+			},
+			wantCode: http.StatusConflict,
+		},
+		{
+			name:   "名前を変更する場合、URLで指定したTagの名前を変更すること",
+			method: api.Put,
+			url:    fmt.Sprintf("%s/2", api.TagAPIPath),
+			body:   `{"name":"fp"}`,
+			mock: func(ctx context.Context) {
+				u.EXPECT().Rename(ctx, 2, "fp").Return(&model.Tag{ID: 2, Name: "fp"}, nil)
+			},
+			wantCode: http.StatusOK,
+		},
+		{
+			name:   "統合する場合、URLで指定したTagをintoのTagに統合すること",
+			method: api.Post,
+			url:    fmt.Sprintf("%s/1/%s", api.TagAPIPath, api.MergePath),
+			body:   `{"into":2}`,
+			mock: func(ctx context.Context) {
+				u.EXPECT().Merge(ctx, 1, 2).Return(tag, nil)
+			},
+			wantCode: http.StatusOK,
+		},
+		{
+			name:   "削除する場合、URLで指定したTagを削除すること",
+			method: api.Delete,
+			url:    fmt.Sprintf("%s/2", api.TagAPIPath),
+			mock: func(ctx context.Context) {
+				u.EXPECT().Delete(ctx, 2).Return(nil)
+			},
+			wantCode: http.StatusOK,
+		},
+		{
+			name:   "ProgrammingLangのTagの一覧を取得する場合、ステータスコード200を返すこと",
+			method: api.Get,
+			url:    fmt.Sprintf("%s/1/%s", api.ProgrammingLangAPIPath, api.TagsPath),
+			mock: func(ctx context.Context) {
+				u.EXPECT().ListByLang(ctx, 1).Return([]*model.Tag{tag}, nil)
+			},
+			wantCode: http.StatusOK,
+		},
+		{
+			name:     "ProgrammingLangのTagを1件取得する場合、ステータスコード404を返すこと",
+			method:   api.Get,
+			url:      fmt.Sprintf("%s/1/%s/2", api.ProgrammingLangAPIPath, api.TagsPath),
+			mock:     func(ctx context.Context) {},
+			wantCode: http.StatusNotFound,
+		},
+		{
+			name:   "ProgrammingLangにTagを付ける場合、ステータスコード200を返すこと",
+			method: api.Put,
+			url:    fmt.Sprintf("%s/1/%s/2", api.ProgrammingLangAPIPath, api.TagsPath),
+			mock: func(ctx context.Context) {
+				u.EXPECT().Attach(ctx, 1, 2).Return(nil)
+			},
+			wantCode: http.StatusOK,
+		},
+		{
+			name:   "ProgrammingLangからTagを外す場合、ステータスコード200を返すこと",
+			method: api.Delete,
+			url:    fmt.Sprintf("%s/1/%s/2", api.ProgrammingLangAPIPath, api.TagsPath),
+			mock: func(ctx context.Context) {
+				u.EXPECT().Detach(ctx, 1, 2).Return(nil)
+			},
+			wantCode: http.StatusOK,
+		},
+		{
+			name:     "TagのIDが数値でない場合、ステータスコード400を返すこと",
+			method:   api.Put,
+			url:      fmt.Sprintf("%s/1/%s/functional", api.ProgrammingLangAPIPath, api.TagsPath),
+			mock:     func(ctx context.Context) {},
+			wantCode: http.StatusBadRequest,
+		},
+		{
+			name:   "tagを複数指定した場合、全てのタグを持つProgrammingLangに絞り込むこと",
+			method: api.Get,
+			url:    fmt.Sprintf("%s?%s=functional&%s=compiled", api.ProgrammingLangAPIPath, api.Tag, api.Tag),
+			mock: func(ctx context.Context) {
+				langUseCase.EXPECT().ListByFilter(ctx, &model.ProgrammingLangFilter{
+					Tags:     []string{"functional", "compiled"},
+					TagMatch: model.TagMatchAll,
+					Limit:    api.DefaultLimit,
+				}).Return(langs, nil)
+			},
+			wantCode: http.StatusOK,
+		},
+		{
+			name:   "tagMatchにanyを指定した場合、いずれかのタグを持つProgrammingLangに絞り込むこと",
+			method: api.Get,
+			url:    fmt.Sprintf("%s?%s=functional&%s=compiled&%s=any", api.ProgrammingLangAPIPath, api.Tag, api.Tag, api.TagMatch),
+			mock: func(ctx context.Context) {
+				langUseCase.EXPECT().ListByFilter(ctx, &model.ProgrammingLangFilter{
+					Tags:     []string{"functional", "compiled"},
+					TagMatch: model.TagMatchAny,
+					Limit:    api.DefaultLimit,
+				}).Return(langs, nil)
+			},
+			wantCode: http.StatusOK,
+		},
+		{
+			name:     "tagMatchがallでもanyでもない場合、ステータスコード400を返すこと",
+			method:   api.Get,
+			url:      fmt.Sprintf("%s?%s=functional&%s=none", api.ProgrammingLangAPIPath, api.Tag, api.TagMatch),
+			mock:     func(ctx context.Context) {},
+			wantCode: http.StatusBadRequest,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			r := gin.New()
+			langAPI := api.NewProgrammingLangAPI(langUseCase)
+			langAPI.InitAPI(&r.RouterGroup)
+			api.NewTagAPI(u).InitAPI(&r.RouterGroup)
+
+			tt.mock(context.Background())
+
+			rec := httptest.NewRecorder()
+			req, err := http.NewRequest(tt.method, tt.url, bytes.NewBufferString(tt.body))
+			if err != nil {
+				t.Fatal(err)
+			}
+			r.ServeHTTP(rec, req)
+
+			if rec.Code != tt.wantCode {
+				t.Errorf("Status Code = %v, want %v", rec.Code, tt.wantCode)
+			}
+		})
+	}
+}
@@ -0,0 +1,222 @@
+package api_test
+
+import (
+	"encoding/json"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"path/filepath"
+	"reflect"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/SekiguchiKai/clean-architecture-with-go/server/adapter/api"
+	"github.com/SekiguchiKai/clean-architecture-with-go/server/domain/model"
+	"github.com/SekiguchiKai/clean-architecture-with-go/server/usecase/mock"
+	"github.com/gin-gonic/gin"
+	"github.com/golang/mock/gomock"
+)
+
+// TestProgrammingLangAPI_V1Contract は、/v1のレスポンスがtestdata/v1に記録した当初の/v1の形式を保っていることを確認する。
+// testdata/v1は、/v2の追加前のハンドラー(ProgrammingLangをそのままJSONで返していた)が同じ入力に対して返すレスポンスを、
+// id、name、feature、createdAt、updatedAtのみを持つ当初の形式で手書きしたもの。現在の出力から再生成してはならない。
+// 後から追加した属性は互換性を損なわないため許容し、当初の属性の値と、エラーなどオブジェクト以外のボディは完全に一致することを確認する。
+func TestProgrammingLangAPI_V1Contract(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	u := mock_input.NewMockProgrammingLangInputPort(ctrl)
+
+	r := gin.New()
+	v1 := r.Group(api.V1Path)
+	v1.Use(api.NewDeprecation(time.Date(2026, time.October, 1, 0, 0, 0, 0, time.UTC), time.Date(2027, time.October, 1, 0, 0, 0, 0, time.UTC), "/v1/langs", "/v2/langs").Handle)
+	api.NewProgrammingLangAPI(u).InitAPI(v1)
+
+	langSlice := model.CreateProgrammingLangs(2)
+	full := &model.ProgrammingLang{
+		ID:            3,
+		Name:          "Go",
+		Feature:       "Simple and concurrent.",
+		Slug:          "go",
+		FirstAppeared: 2009,
+		Designers:     []string{"Robert Griesemer", "Rob Pike", "Ken Thompson"},
+		TypeChecking:  model.TypeCheckingStatic,
+		TypeStrength:  model.TypeStrengthStrong,
+		Paradigms:     []model.Paradigm{model.ParadigmConcurrent, model.ParadigmImperative},
+		License:       "BSD-3-Clause",
+		Website:       "https://golang.org",
+		Extensions:    []string{".go"},
+		Filenames:     []string{"go.mod"},
+		Interpreters:  []string{"gorun"},
+		Aliases:       []string{"golang"},
+		Color:         "#00ADD8",
+		StableVersion: "1.11.1",
+		CreatedAt:     model.GetTestTime(time.September, 1),
+		UpdatedAt:     model.GetTestTime(time.September, 2),
+	}
+	body := `{"name":"Go","feature":"Simple and concurrent.","firstAppeared":2009,"designers":["Robert Griesemer","Rob Pike","Ken Thompson"],"typeChecking":"static","typeStrength":"strong","paradigms":["concurrent","imperative"],"license":"BSD-3-Clause","website":"https://golang.org","extensions":[".go"],"filenames":["go.mod"],"interpreters":["gorun"],"aliases":["golang"],"color":"#00ADD8","stableVersion":"1.11.1"}`
+
+	tests := []struct {
+		name     string
+		method   string
+		path     string
+		body     string
+		call     func()
+		wantCode int
+	}{
+		{
+			name:   "list",
+			method: api.Get,
+			path:   "/v1/langs",
+			call: func() {
+				u.EXPECT().LastModified(gomock.Any()).Return(time.Time{}, nil)
+				u.EXPECT().List(gomock.Any(), api.DefaultLimit).Return(append(langSlice, full), nil)
+			},
+			wantCode: http.StatusOK,
+		},
+		{
+			name:   "list_empty",
+			method: api.Get,
+			path:   "/v1/langs",
+			call: func() {
+				u.EXPECT().LastModified(gomock.Any()).Return(time.Time{}, nil)
+				u.EXPECT().List(gomock.Any(), api.DefaultLimit).Return([]*model.ProgrammingLang{}, nil)
+			},
+			wantCode: http.StatusOK,
+		},
+		{
+			name:   "get",
+			method: api.Get,
+			path:   "/v1/langs/3",
+			call: func() {
+				u.EXPECT().Get(gomock.Any(), 3).Return(full, nil)
+			},
+			wantCode: http.StatusOK,
+		},
+		{
+			name:   "get_not_found",
+			method: api.Get,
+			path:   "/v1/langs/4",
+			call: func() {
+				u.EXPECT().Get(gomock.Any(), 4).Return(nil, &model.NoSuchDataError{ID: 4, ModelName: model.ModelNameProgrammingLang})
+			},
+			wantCode: http.StatusNotFound,
+		},
+		{
+			name:     "get_invalid_id",
+			method:   api.Get,
+			path:     "/v1/langs/a",
+			wantCode: http.StatusBadRequest,
+		},
+		{
+			name:   "create",
+			method: api.Post,
+			path:   "/v1/langs",
+			body:   body,
+			call: func() {
+				u.EXPECT().Create(gomock.Any(), gomock.Any()).Return(full, nil)
+			},
+			wantCode: http.StatusOK,
+		},
+		{
+			name:   "create_conflict",
+			method: api.Post,
+			path:   "/v1/langs",
+			body:   body,
+			call: func() {
+				u.EXPECT().Create(gomock.Any(), gomock.Any()).Return(nil, &model.AlreadyExistError{ID: 3, Name: "Go", ModelName: model.ModelNameProgrammingLang})
+			},
+			wantCode: http.StatusConflict,
+		},
+		{
+			name:   "update",
+			method: api.Put,
+			path:   "/v1/langs/3",
+			body:   body,
+			call: func() {
+				u.EXPECT().Update(gomock.Any(), 3, gomock.Any()).Return(full, nil)
+			},
+			wantCode: http.StatusOK,
+		},
+		{
+			name:   "delete",
+			method: api.Delete,
+			path:   "/v1/langs/3",
+			call: func() {
+				u.EXPECT().Delete(gomock.Any(), 3).Return(nil)
+			},
+			wantCode: http.StatusOK,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if tt.call != nil {
+				tt.call()
+			}
+
+			rec := httptest.NewRecorder()
+			req, err := http.NewRequest(tt.method, tt.path, strings.NewReader(tt.body))
+			if err != nil {
+				t.Fatal(err)
+			}
+			r.ServeHTTP(rec, req)
+
+			if rec.Code != tt.wantCode {
+				t.Errorf("Status Code = %v, want %v", rec.Code, tt.wantCode)
+			}
+			if got := rec.Header().Get("Content-Type"); got != "application/json; charset=utf-8" {
+				t.Errorf("Content-Type = %v, want application/json; charset=utf-8", got)
+			}
+			if rec.Header().Get(api.DeprecationHeader) == "" || rec.Header().Get(api.SunsetHeader) == "" || rec.Header().Get(api.LinkHeader) == "" {
+				t.Errorf("deprecation headers are not set: %v", rec.Header())
+			}
+
+			want, err := ioutil.ReadFile(filepath.Join("testdata", "v1", tt.name+".golden"))
+			if err != nil {
+				t.Fatal(err)
+			}
+			var got, wantValue interface{}
+			if err := json.Unmarshal(rec.Body.Bytes(), &got); err != nil {
+				t.Fatalf("Response Body = %s is not JSON: %v", rec.Body.String(), err)
+			}
+			if err := json.Unmarshal(want, &wantValue); err != nil {
+				t.Fatal(err)
+			}
+			if !containsJSON(got, wantValue) {
+				t.Errorf("Response Body = %s, want %s", rec.Body.String(), want)
+			}
+		})
+	}
+}
+
+// containsJSON は、gotがwantの全ての値を含むかどうかを確認する。
+// オブジェクトはwantのメンバーのみを比較し、配列は要素数と各要素を、それ以外の値は完全に一致するかどうかを比較する。
+func containsJSON(got, want interface{}) bool {
+	switch w := want.(type) {
+	case map[string]interface{}:
+		g, ok := got.(map[string]interface{})
+		if !ok {
+			return false
+		}
+		for k, v := range w {
+			if gv, ok := g[k]; !ok || !containsJSON(gv, v) {
+				return false
+			}
+		}
+		return true
+	case []interface{}:
+		g, ok := got.([]interface{})
+		if !ok || len(g) != len(w) {
+			return false
+		}
+		for i := range w {
+			if !containsJSON(g[i], w[i]) {
+				return false
+			}
+		}
+		return true
+	default:
+		return reflect.DeepEqual(got, want)
+	}
+}
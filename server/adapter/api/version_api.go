@@ -0,0 +1,39 @@
+package api
+
+import (
+	"net/http"
+	"runtime"
+
+	"github.com/gin-gonic/gin"
+)
+
+// BuildInfo は、ビルド時に埋め込んだバージョン、コミット、ビルド日時。
+type BuildInfo struct {
+	Version   string `json:"version"`
+	Commit    string `json:"commit"`
+	BuildTime string `json:"buildTime"`
+	GoVersion string `json:"goVersion"`
+}
+
+// VersionAPI は、ビルドの情報を返すAPI。
+type VersionAPI struct {
+	Info BuildInfo
+}
+
+// NewVersionAPI は、VersionAPIを生成し、返す。GoVersionは、実行中のGoのバージョンで置き換える。
+func NewVersionAPI(info BuildInfo) *VersionAPI {
+	info.GoVersion = runtime.Version()
+	return &VersionAPI{
+		Info: info,
+	}
+}
+
+// InitAPI は、APIを初期設定する。
+func (api *VersionAPI) InitAPI(g *gin.RouterGroup) {
+	g.GET(VersionPath, api.Get)
+}
+
+// Get は、ビルドの情報を返す。
+func (api *VersionAPI) Get(c *gin.Context) {
+	c.JSON(http.StatusOK, api.Info)
+}
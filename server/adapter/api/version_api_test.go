@@ -0,0 +1,37 @@
+package api_test
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"runtime"
+	"testing"
+
+	"github.com/SekiguchiKai/clean-architecture-with-go/server/adapter/api"
+	"github.com/gin-gonic/gin"
+)
+
+func TestVersionAPI_Get(t *testing.T) {
+	r := gin.New()
+	api.NewVersionAPI(api.BuildInfo{Version: "v1.2.3", Commit: "abc1234", BuildTime: "2026-10-01T12:00:00Z"}).InitAPI(&r.RouterGroup)
+
+	rec := httptest.NewRecorder()
+	req, err := http.NewRequest(api.Get, api.VersionPath, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	r.ServeHTTP(rec, req)
+
+	if rec.Code != http.StatusOK {
+		t.Errorf("Status Code = %v, want %v", rec.Code, http.StatusOK)
+	}
+
+	var got api.BuildInfo
+	if err := json.Unmarshal(rec.Body.Bytes(), &got); err != nil {
+		t.Fatal(err)
+	}
+	want := api.BuildInfo{Version: "v1.2.3", Commit: "abc1234", BuildTime: "2026-10-01T12:00:00Z", GoVersion: runtime.Version()}
+	if got != want {
+		t.Errorf("body = %v, want %v", got, want)
+	}
+}
@@ -0,0 +1,243 @@
+package api
+
+import (
+	"fmt"
+	"net/http"
+
+	"github.com/SekiguchiKai/clean-architecture-with-go/server/domain/model"
+	"github.com/SekiguchiKai/clean-architecture-with-go/server/usecase/input"
+	"github.com/SekiguchiKai/clean-architecture-with-go/server/usecase/output"
+	"github.com/gin-gonic/gin"
+)
+
+// WebhookAPI は、WebhookのAPI。
+type WebhookAPI struct {
+	UseCase input.WebhookInputPort
+}
+
+// NewWebhookAPI は、WebhookAPIを生成し、返す。
+func NewWebhookAPI(useCase input.WebhookInputPort) *WebhookAPI {
+	return &WebhookAPI{
+		UseCase: useCase,
+	}
+}
+
+// InitAPI は、APIを初期設定する。通知先のURLとSecretを扱うため、管理用のAPIの認証を設定したグループに追加する。
+func (api *WebhookAPI) InitAPI(admin *gin.RouterGroup) {
+	webhookPath := fmt.Sprintf("%s/:%s", WebhookAPIPath, ID)
+	deliveryPath := fmt.Sprintf("%s/%s/:%s", webhookPath, DeliveriesPath, SubID)
+
+	admin.GET(WebhookAPIPath, api.List)
+	admin.GET(webhookPath, api.Get)
+	admin.POST(WebhookAPIPath, api.Create)
+	admin.PUT(webhookPath, api.Update)
+	admin.DELETE(webhookPath, api.Delete)
+	admin.GET(fmt.Sprintf("%s/%s", webhookPath, DeliveriesPath), api.ListDeliveries)
+	admin.GET(deliveryPath, api.GetDelivery)
+	admin.POST(fmt.Sprintf("%s/%s", deliveryPath, RedeliverPath), api.Redeliver)
+}
+
+// List は、Webhookの一覧を返す。
+func (api *WebhookAPI) List(c *gin.Context) {
+	ctx := c.Request.Context()
+	webhooks, err := api.UseCase.List(ctx)
+	if err != nil {
+		he := handleError(err)
+		c.JSON(he.code, he.message)
+		return
+	}
+
+	c.JSON(http.StatusOK, output.NewWebhookOutputs(webhooks))
+}
+
+// Get は、Webhookを取得する。
+// ginのルーティングでは:idと同じ位置に固定のパスを定義できないため、/webhooks/dead-lettersもここで受け付ける。
+func (api *WebhookAPI) Get(c *gin.Context) {
+	if c.Param(ID) == DeadLettersPath {
+		api.listDeadLetters(c)
+		return
+	}
+
+	id, err := getID(c)
+	if err != nil {
+		he := handleError(err)
+		c.JSON(he.code, he.message)
+		return
+	}
+
+	ctx := c.Request.Context()
+	webhook, err := api.UseCase.Get(ctx, id)
+	if err != nil {
+		he := handleError(err)
+		c.JSON(he.code, he.message)
+		return
+	}
+
+	c.JSON(http.StatusOK, output.NewWebhookOutput(webhook))
+}
+
+// Create は、Webhookを生成する。Secretを返すのは、この応答のみ。
+func (api *WebhookAPI) Create(c *gin.Context) {
+	var params *input.WebhookInput
+	if err := c.BindJSON(&params); err != nil {
+		c.JSON(http.StatusBadRequest, err.Error())
+		return
+	}
+
+	ctx := c.Request.Context()
+	webhook, err := api.UseCase.Create(ctx, params.ToModel())
+	if err != nil {
+		he := handleError(err)
+		c.JSON(he.code, he.message)
+		return
+	}
+
+	c.JSON(http.StatusOK, output.NewWebhookOutput(webhook))
+}
+
+// Update は、Webhookを更新する。
+func (api *WebhookAPI) Update(c *gin.Context) {
+	var params *input.WebhookInput
+	if err := c.BindJSON(&params); err != nil {
+		c.JSON(http.StatusBadRequest, err.Error())
+		return
+	}
+
+	id, err := getID(c)
+	if err != nil {
+		he := handleError(err)
+		c.JSON(he.code, he.message)
+		return
+	}
+
+	ctx := c.Request.Context()
+	webhook, err := api.UseCase.Update(ctx, id, params.ToModel())
+	if err != nil {
+		he := handleError(err)
+		c.JSON(he.code, he.message)
+		return
+	}
+
+	c.JSON(http.StatusOK, output.NewWebhookOutput(webhook))
+}
+
+// Delete は、Webhookを削除する。
+func (api *WebhookAPI) Delete(c *gin.Context) {
+	id, err := getID(c)
+	if err != nil {
+		he := handleError(err)
+		c.JSON(he.code, he.message)
+		return
+	}
+
+	ctx := c.Request.Context()
+	if err := api.UseCase.Delete(ctx, id); err != nil {
+		he := handleError(err)
+		c.JSON(he.code, he.message)
+		return
+	}
+
+	c.JSON(http.StatusOK, nil)
+}
+
+// ListDeliveries は、Webhookの通知の一覧を新しい順に返す。statusを指定した場合は、その状態の通知のみを返す。
+func (api *WebhookAPI) ListDeliveries(c *gin.Context) {
+	id, err := getID(c)
+	if err != nil {
+		he := handleError(err)
+		c.JSON(he.code, he.message)
+		return
+	}
+
+	limit, err := getLimit(c)
+	if err != nil {
+		he := handleError(err)
+		c.JSON(he.code, he.message)
+		return
+	}
+
+	ctx := c.Request.Context()
+	status := model.DeliveryStatus(c.Query(Status))
+	deliveries, err := api.UseCase.ListDeliveries(ctx, id, status, ManageLimit(limit, MaxLimit, MinLimit, DefaultLimit))
+	if err != nil {
+		he := handleError(err)
+		c.JSON(he.code, he.message)
+		return
+	}
+
+	c.JSON(http.StatusOK, output.NewWebhookDeliveryOutputs(deliveries))
+}
+
+// GetDelivery は、Webhookの通知を送信の記録とともに取得する。
+func (api *WebhookAPI) GetDelivery(c *gin.Context) {
+	id, err := getID(c)
+	if err != nil {
+		he := handleError(err)
+		c.JSON(he.code, he.message)
+		return
+	}
+
+	deliveryID, err := getSubID(c)
+	if err != nil {
+		he := handleError(err)
+		c.JSON(he.code, he.message)
+		return
+	}
+
+	ctx := c.Request.Context()
+	delivery, err := api.UseCase.GetDelivery(ctx, id, deliveryID)
+	if err != nil {
+		he := handleError(err)
+		c.JSON(he.code, he.message)
+		return
+	}
+
+	c.JSON(http.StatusOK, output.NewWebhookDeliveryOutput(delivery))
+}
+
+// Redeliver は、Webhookの通知を直ちに送信し直すように設定する。
+func (api *WebhookAPI) Redeliver(c *gin.Context) {
+	id, err := getID(c)
+	if err != nil {
+		he := handleError(err)
+		c.JSON(he.code, he.message)
+		return
+	}
+
+	deliveryID, err := getSubID(c)
+	if err != nil {
+		he := handleError(err)
+		c.JSON(he.code, he.message)
+		return
+	}
+
+	ctx := c.Request.Context()
+	delivery, err := api.UseCase.Redeliver(ctx, id, deliveryID)
+	if err != nil {
+		he := handleError(err)
+		c.JSON(he.code, he.message)
+		return
+	}
+
+	c.JSON(http.StatusOK, output.NewWebhookDeliveryOutput(delivery))
+}
+
+// listDeadLetters は、全てのWebhookのうち、再送の上限に達した通知の一覧を新しい順に返す。
+func (api *WebhookAPI) listDeadLetters(c *gin.Context) {
+	limit, err := getLimit(c)
+	if err != nil {
+		he := handleError(err)
+		c.JSON(he.code, he.message)
+		return
+	}
+
+	ctx := c.Request.Context()
+	deliveries, err := api.UseCase.ListDeadLetters(ctx, ManageLimit(limit, MaxLimit, MinLimit, DefaultLimit))
+	if err != nil {
+		he := handleError(err)
+		c.JSON(he.code, he.message)
+		return
+	}
+
+	c.JSON(http.StatusOK, output.NewWebhookDeliveryOutputs(deliveries))
+}
@@ -0,0 +1,149 @@
+package api_test
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/SekiguchiKai/clean-architecture-with-go/server/adapter/api"
+	"github.com/SekiguchiKai/clean-architecture-with-go/server/domain/model"
+	"github.com/SekiguchiKai/clean-architecture-with-go/server/usecase/mock"
+	"github.com/gin-gonic/gin"
+	"github.com/golang/mock/gomock"
+)
+
+func TestWebhookAPI(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	u := mock_input.NewMockWebhookInputPort(ctrl)
+
+	const adminKey = "secret"
+	base := api.AdminAPIPath + api.WebhookAPIPath
+
+	tests := []struct {
+		name     string
+		method   string
+		path     string
+		body     string
+		apiKey   string
+		mock     func(ctx context.Context)
+		wantCode int
+	}{
+		{
+			name:   "Webhookを生成した場合、ステータスコード200を返すこと",
+			method: api.Post,
+			path:   base,
+			body:   `{"url":"https://example.com/hooks","eventTypes":["created"]}`,
+			apiKey: adminKey,
+			mock: func(ctx context.Context) {
+				param := &model.Webhook{URL: "https://example.com/hooks", EventTypes: []model.EventType{model.EventTypeCreated}}
+				u.EXPECT().Create(ctx, param).Return(&model.Webhook{ID: 1}, nil)
+			},
+			wantCode: http.StatusOK,
+		},
+		{
+			name:   "IDや生成日時を指定した場合、無視して生成すること",
+			method: api.Post,
+			path:   base,
+			body:   `{"id":9,"url":"https://example.com/hooks","createdAt":"2018-09-01T12:00:00Z"}`,
+			apiKey: adminKey,
+			mock: func(ctx context.Context) {
+				u.EXPECT().Create(ctx, &model.Webhook{URL: "https://example.com/hooks"}).Return(&model.Webhook{ID: 1}, nil)
+			},
+			wantCode: http.StatusOK,
+		},
+		{
+			name:   "URLが不正な場合、ステータスコード400を返すこと",
+			method: api.Post,
+			path:   base,
+			body:   `{"url":"/hooks"}`,
+			apiKey: adminKey,
+			mock: func(ctx context.Context) {
+				u.EXPECT().Create(ctx, gomock.Any()).Return(nil, &model.InvalidPropertyError{Property: model.PropertyURL})
+			},
+			wantCode: http.StatusBadRequest,
+		},
+		{
+			name:   "存在しないWebhookを指定した場合、ステータスコード404を返すこと",
+			method: api.Get,
+			path:   base + "/1",
+			apiKey: adminKey,
+			mock: func(ctx context.Context) {
+				u.EXPECT().Get(ctx, 1).Return(nil, &model.NoSuchDataError{ID: 1, ModelName: model.ModelNameWebhook})
+			},
+			wantCode: http.StatusNotFound,
+		},
+		{
+			name:   "dead-lettersを指定した場合、再送の上限に達した通知の一覧を返すこと",
+			method: api.Get,
+			path:   fmt.Sprintf("%s/%s", base, api.DeadLettersPath),
+			apiKey: adminKey,
+			mock: func(ctx context.Context) {
+				u.EXPECT().ListDeadLetters(ctx, api.DefaultLimit).Return([]*model.WebhookDelivery{}, nil)
+			},
+			wantCode: http.StatusOK,
+		},
+		{
+			name:   "statusを指定した場合、その状態の通知の一覧を返すこと",
+			method: api.Get,
+			path:   fmt.Sprintf("%s/1/%s?%s=dead&%s=50", base, api.DeliveriesPath, api.Status, api.Limit),
+			apiKey: adminKey,
+			mock: func(ctx context.Context) {
+				u.EXPECT().ListDeliveries(ctx, 1, model.DeliveryStatusDead, 50).Return([]*model.WebhookDelivery{}, nil)
+			},
+			wantCode: http.StatusOK,
+		},
+		{
+			name:   "通知を指定した場合、送信の記録とともに返すこと",
+			method: api.Get,
+			path:   fmt.Sprintf("%s/1/%s/2", base, api.DeliveriesPath),
+			apiKey: adminKey,
+			mock: func(ctx context.Context) {
+				u.EXPECT().GetDelivery(ctx, 1, 2).Return(&model.WebhookDelivery{ID: 2, WebhookID: 1}, nil)
+			},
+			wantCode: http.StatusOK,
+		},
+		{
+			name:   "再送を指定した場合、ステータスコード200を返すこと",
+			method: api.Post,
+			path:   fmt.Sprintf("%s/1/%s/2/%s", base, api.DeliveriesPath, api.RedeliverPath),
+			apiKey: adminKey,
+			mock: func(ctx context.Context) {
+				u.EXPECT().Redeliver(ctx, 1, 2).Return(&model.WebhookDelivery{ID: 2, WebhookID: 1}, nil)
+			},
+			wantCode: http.StatusOK,
+		},
+		{
+			name:     "APIキーが一致しない場合、ステータスコード401を返すこと",
+			method:   api.Get,
+			path:     base,
+			apiKey:   "wrong",
+			mock:     func(ctx context.Context) {},
+			wantCode: http.StatusUnauthorized,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			r := gin.New()
+			api.NewWebhookAPI(u).InitAPI(r.Group(api.AdminAPIPath, api.NewAdminAuth(adminKey).Handle))
+
+			tt.mock(context.Background())
+
+			rec := httptest.NewRecorder()
+			req, err := http.NewRequest(tt.method, tt.path, bytes.NewBufferString(tt.body))
+			if err != nil {
+				t.Fatal(err)
+			}
+			req.Header.Set(api.APIKeyHeader, tt.apiKey)
+			r.ServeHTTP(rec, req)
+
+			if rec.Code != tt.wantCode {
+				t.Errorf("Status Code = %v, want %v", rec.Code, tt.wantCode)
+			}
+		})
+	}
+}
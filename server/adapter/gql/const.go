@@ -0,0 +1,67 @@
+package gql
+
+// パスの定義。
+const (
+	GraphQLPath = "/graphql"
+)
+
+// クエリの制限の初期値。
+const (
+	DefaultMaxDepth      = 10
+	DefaultMaxComplexity = 1000
+)
+
+// フィールドの名前。
+const (
+	FieldID            = "id"
+	FieldName          = "name"
+	FieldFeature       = "feature"
+	FieldSlug          = "slug"
+	FieldFirstAppeared = "firstAppeared"
+	FieldDesigners     = "designers"
+	FieldTypeChecking  = "typeChecking"
+	FieldTypeStrength  = "typeStrength"
+	FieldParadigms     = "paradigms"
+	FieldLicense       = "license"
+	FieldWebsite       = "website"
+	FieldExtensions    = "extensions"
+	FieldFilenames     = "filenames"
+	FieldInterpreters  = "interpreters"
+	FieldAliases       = "aliases"
+	FieldColor         = "color"
+	FieldStableVersion = "stableVersion"
+	FieldCreatedAt     = "createdAt"
+	FieldUpdatedAt     = "updatedAt"
+	FieldNodes         = "nodes"
+	FieldPageInfo      = "pageInfo"
+	FieldEndCursor     = "endCursor"
+	FieldHasNextPage   = "hasNextPage"
+	FieldLang          = "lang"
+	FieldLangs         = "langs"
+	FieldCreateLang    = "createLang"
+	FieldUpdateLang    = "updateLang"
+	FieldDeleteLang    = "deleteLang"
+)
+
+// 引数の名前。
+const (
+	ArgID            = "id"
+	ArgName          = "name"
+	ArgFeature       = "feature"
+	ArgFirst         = "first"
+	ArgAfter         = "after"
+	ArgNameContains  = "nameContains"
+	ArgFirstAppeared = "firstAppeared"
+	ArgDesigners     = "designers"
+	ArgTypeChecking  = "typeChecking"
+	ArgTypeStrength  = "typeStrength"
+	ArgParadigms     = "paradigms"
+	ArgLicense       = "license"
+	ArgWebsite       = "website"
+	ArgExtensions    = "extensions"
+	ArgFilenames     = "filenames"
+	ArgInterpreters  = "interpreters"
+	ArgAliases       = "aliases"
+	ArgColor         = "color"
+	ArgStableVersion = "stableVersion"
+)
@@ -0,0 +1,72 @@
+package gql
+
+import (
+	"github.com/SekiguchiKai/clean-architecture-with-go/server/domain/model"
+	"github.com/pkg/errors"
+)
+
+// エラーの定数。
+const (
+	OtherErr         = "some error has occurred"
+	InvalidCursorErr = "cursor is invalid"
+	TooDeepErr       = "query is too deep. depth: %d, max: %d"
+	TooComplexErr    = "query is too complex. complexity: %d, max: %d"
+)
+
+// エラーのコード。
+const (
+	CodeNotFound        = "NOT_FOUND"
+	CodeBadRequest      = "BAD_REQUEST"
+	CodeConflict        = "CONFLICT"
+	CodeInternal        = "INTERNAL"
+	CodeQueryTooComplex = "QUERY_TOO_COMPLEX"
+)
+
+// handledError は、GraphQLのレスポンスのextensionsにコードを含めるエラー。
+type handledError struct {
+	code    string
+	message string
+}
+
+// Error は、エラー文を返す。
+func (e *handledError) Error() string {
+	return e.message
+}
+
+// Extensions は、GraphQLのレスポンスのextensionsを返す。
+func (e *handledError) Extensions() map[string]interface{} {
+	return map[string]interface{}{
+		"code": e.code,
+	}
+}
+
+// handleError は、エラーをハンドリングする。
+func handleError(err error) error {
+	switch errors.Cause(err).(type) {
+	case *model.NoSuchDataError:
+		return &handledError{
+			code:    CodeNotFound,
+			message: errors.Cause(err).Error(),
+		}
+	case *model.RequiredError, *model.InvalidPropertyError, *model.InvalidParameterError:
+		return &handledError{
+			code:    CodeBadRequest,
+			message: errors.Cause(err).Error(),
+		}
+	case *model.AlreadyExistError:
+		return &handledError{
+			code:    CodeConflict,
+			message: errors.Cause(err).Error(),
+		}
+	case *model.DBError:
+		return &handledError{
+			code:    CodeInternal,
+			message: errors.Cause(err).Error(),
+		}
+	default:
+		return &handledError{
+			code:    CodeInternal,
+			message: OtherErr,
+		}
+	}
+}
@@ -0,0 +1,126 @@
+package gql
+
+import (
+	"context"
+	"net/http"
+
+	"github.com/SekiguchiKai/clean-architecture-with-go/server/usecase/input"
+	"github.com/gin-gonic/gin"
+	"github.com/graphql-go/graphql"
+	"github.com/graphql-go/graphql/gqlerrors"
+	"github.com/graphql-go/graphql/language/parser"
+	"github.com/graphql-go/graphql/language/source"
+)
+
+// request は、GraphQLのリクエストを表す。
+type request struct {
+	Query         string                 `json:"query"`
+	Variables     map[string]interface{} `json:"variables"`
+	OperationName string                 `json:"operationName"`
+}
+
+// ProgrammingLangGraphQL は、ProgrammingLangのGraphQLのAPI。
+type ProgrammingLangGraphQL struct {
+	UseCase       input.ProgrammingLangInputPort
+	Schema        graphql.Schema
+	MaxDepth      int
+	MaxComplexity int
+}
+
+// NewProgrammingLangGraphQL は、ProgrammingLangGraphQLを生成し、返す。
+func NewProgrammingLangGraphQL(useCase input.ProgrammingLangInputPort) (*ProgrammingLangGraphQL, error) {
+	schema, err := NewSchema(useCase)
+	if err != nil {
+		return nil, err
+	}
+
+	return &ProgrammingLangGraphQL{
+		UseCase:       useCase,
+		Schema:        schema,
+		MaxDepth:      DefaultMaxDepth,
+		MaxComplexity: DefaultMaxComplexity,
+	}, nil
+}
+
+// InitAPI は、APIを初期設定する。
+func (h *ProgrammingLangGraphQL) InitAPI(g *gin.RouterGroup) {
+	g.POST(GraphQLPath, h.Handle)
+}
+
+// Handle は、GraphQLのクエリを実行し、結果を返す。
+// クエリの検証に失敗した場合は、実行せずにエラーを返す。
+func (h *ProgrammingLangGraphQL) Handle(c *gin.Context) {
+	var req request
+	if err := c.BindJSON(&req); err != nil {
+		c.JSON(http.StatusBadRequest, &graphql.Result{
+			Errors: gqlerrors.FormatErrors(err),
+		})
+		return
+	}
+
+	c.JSON(http.StatusOK, h.do(c.Request.Context(), &req))
+}
+
+// do は、GraphQLのクエリを解析、検証し、実行する。
+func (h *ProgrammingLangGraphQL) do(ctx context.Context, req *request) *graphql.Result {
+	doc, err := parser.Parse(parser.ParseParams{
+		Source: source.NewSource(&source.Source{
+			Body: []byte(req.Query),
+			Name: "GraphQL request",
+		}),
+	})
+	if err != nil {
+		return &graphql.Result{Errors: gqlerrors.FormatErrors(err)}
+	}
+
+	if vr := graphql.ValidateDocument(&h.Schema, doc, nil); !vr.IsValid {
+		return &graphql.Result{Errors: vr.Errors}
+	}
+
+	if err := checkLimits(doc, req.Variables, h.MaxDepth, h.MaxComplexity); err != nil {
+		return &graphql.Result{Errors: gqlerrors.FormatErrors(gqlerrors.NewLocatedError(err, nil))}
+	}
+
+	ctx = withLoader(ctx, newLangLoader(ctx, h.UseCase))
+
+	result := graphql.Execute(graphql.ExecuteParams{
+		Schema:        h.Schema,
+		AST:           doc,
+		OperationName: req.OperationName,
+		Args:          req.Variables,
+		Context:       ctx,
+	})
+	restoreExtensions(result.Errors)
+	return result
+}
+
+// restoreExtensions は、extensionsが失われたエラーに、元のエラーのextensionsを設定する。
+// graphql-goは、遅延して解決したフィールドのエラーを二重に包むため、extensionsが失われる。
+func restoreExtensions(errs []gqlerrors.FormattedError) {
+	for i := range errs {
+		if errs[i].Extensions != nil {
+			continue
+		}
+
+		var err error = errs[i]
+		for err != nil {
+			if extended, ok := err.(gqlerrors.ExtendedError); ok {
+				errs[i].Extensions = extended.Extensions()
+				break
+			}
+			err = originalError(err)
+		}
+	}
+}
+
+// originalError は、graphql-goのエラーが包んでいる元のエラーを返す。
+func originalError(err error) error {
+	switch err := err.(type) {
+	case gqlerrors.FormattedError:
+		return err.OriginalError()
+	case *gqlerrors.Error:
+		return err.OriginalError
+	default:
+		return nil
+	}
+}
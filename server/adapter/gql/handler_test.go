@@ -0,0 +1,371 @@
+package gql_test
+
+import (
+	"bytes"
+	"encoding/base64"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"reflect"
+	"testing"
+
+	"github.com/SekiguchiKai/clean-architecture-with-go/server/adapter/gql"
+	"github.com/SekiguchiKai/clean-architecture-with-go/server/domain/model"
+	"github.com/SekiguchiKai/clean-architecture-with-go/server/usecase/input"
+	"github.com/SekiguchiKai/clean-architecture-with-go/server/usecase/mock"
+	"github.com/gin-gonic/gin"
+	"github.com/golang/mock/gomock"
+)
+
+// response は、テスト用のGraphQLのレスポンス。
+type response struct {
+	Data   map[string]interface{} `json:"data"`
+	Errors []struct {
+		Message    string                 `json:"message"`
+		Extensions map[string]interface{} `json:"extensions"`
+	} `json:"errors"`
+}
+
+// doRequest は、テスト用にGraphQLのリクエストを送信し、レスポンスを返す。
+func doRequest(t *testing.T, h *gql.ProgrammingLangGraphQL, query string, variables map[string]interface{}) (int, *response) {
+	r := gin.New()
+	h.InitAPI(&r.RouterGroup)
+
+	body, err := json.Marshal(map[string]interface{}{
+		"query":     query,
+		"variables": variables,
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	req, err := http.NewRequest(http.MethodPost, gql.GraphQLPath, bytes.NewReader(body))
+	if err != nil {
+		t.Fatal(err)
+	}
+	req.Header.Set("Content-Type", "application/json")
+
+	rec := httptest.NewRecorder()
+	r.ServeHTTP(rec, req)
+
+	var res response
+	if err := json.Unmarshal(rec.Body.Bytes(), &res); err != nil {
+		t.Fatal(err)
+	}
+	return rec.Code, &res
+}
+
+// errorCodes は、レスポンスのエラーのコードを返す。
+func errorCodes(res *response) []string {
+	var codes []string
+	for _, e := range res.Errors {
+		code, _ := e.Extensions["code"].(string)
+		codes = append(codes, code)
+	}
+	return codes
+}
+
+func TestProgrammingLangGraphQL_Lang(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	u := mock_input.NewMockProgrammingLangInputPort(ctrl)
+	h, err := gql.NewProgrammingLangGraphQL(u)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	langSlice := model.CreateProgrammingLangs(2)
+	detail := &model.ProgrammingLang{
+		ID:            3,
+		Name:          "Go",
+		FirstAppeared: 2009,
+		TypeChecking:  model.TypeCheckingStatic,
+		Paradigms:     []model.Paradigm{model.ParadigmConcurrent, model.ParadigmImperative},
+	}
+
+	tests := []struct {
+		name      string
+		query     string
+		ids       []int
+		result    []*model.ProgrammingLang
+		want      map[string]interface{}
+		wantCodes []string
+	}{
+		{
+			name:   "複数のIDを指定した場合、まとめて1回で取得すること",
+			query:  `{ a: lang(id: 1) { id name } b: lang(id: 2) { name } c: lang(id: 1) { feature } }`,
+			ids:    []int{1, 2},
+			result: langSlice,
+			want: map[string]interface{}{
+				"a": map[string]interface{}{"id": float64(1), "name": langSlice[0].Name},
+				"b": map[string]interface{}{"name": langSlice[1].Name},
+				"c": map[string]interface{}{"feature": langSlice[0].Feature},
+			},
+		},
+		{
+			name:   "存在しないIDを指定した場合、nullとNOT_FOUNDのエラーを返すこと",
+			query:  `{ a: lang(id: 1) { name } b: lang(id: 100) { name } }`,
+			ids:    []int{1, 100},
+			result: langSlice[:1],
+			want: map[string]interface{}{
+				"a": map[string]interface{}{"name": langSlice[0].Name},
+				"b": nil,
+			},
+			wantCodes: []string{gql.CodeNotFound},
+		},
+		{
+			name:   "詳細な属性を指定した場合、設定されていない属性はnullを返すこと",
+			query:  `{ lang(id: 3) { firstAppeared typeChecking typeStrength paradigms extensions } }`,
+			ids:    []int{3},
+			result: []*model.ProgrammingLang{detail},
+			want: map[string]interface{}{
+				"lang": map[string]interface{}{
+					"firstAppeared": float64(2009),
+					"typeChecking":  "static",
+					"typeStrength":  nil,
+					"paradigms":     []interface{}{"concurrent", "imperative"},
+					"extensions":    nil,
+				},
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			u.EXPECT().BatchGet(gomock.Any(), tt.ids).Return(tt.result, nil).Times(1)
+
+			code, res := doRequest(t, h, tt.query, nil)
+			if code != http.StatusOK {
+				t.Errorf("Status Code = %v, want %v", code, http.StatusOK)
+			}
+			if !reflect.DeepEqual(res.Data, tt.want) {
+				t.Errorf("data = %v, want %v", res.Data, tt.want)
+			}
+			if got := errorCodes(res); !reflect.DeepEqual(got, tt.wantCodes) {
+				t.Errorf("error codes = %v, want %v", got, tt.wantCodes)
+			}
+		})
+	}
+}
+
+func TestProgrammingLangGraphQL_Langs(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	u := mock_input.NewMockProgrammingLangInputPort(ctrl)
+	h, err := gql.NewProgrammingLangGraphQL(u)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	langSlice := model.CreateProgrammingLangs(6)
+	cursor := func(lang *model.ProgrammingLang) string {
+		return base64.URLEncoding.EncodeToString([]byte("name:" + lang.Name))
+	}
+	query := `query ($first: Int, $after: String) {
+		langs(first: $first, after: $after, nameContains: "Test") {
+			nodes { id }
+			pageInfo { endCursor hasNextPage }
+		}
+	}`
+
+	tests := []struct {
+		name            string
+		after           string
+		result          []*model.ProgrammingLang
+		wantAfter       string
+		wantNodes       int
+		wantHasNextPage bool
+		wantEndCursor   string
+	}{
+		{
+			name:            "取得件数より多く存在する場合、hasNextPageにtrueを返すこと",
+			result:          langSlice,
+			wantNodes:       5,
+			wantHasNextPage: true,
+			wantEndCursor:   cursor(langSlice[4]),
+		},
+		{
+			name:            "afterを指定した場合、カーソルのNameより後を取得すること",
+			after:           cursor(langSlice[4]),
+			wantAfter:       langSlice[4].Name,
+			result:          langSlice[5:],
+			wantNodes:       1,
+			wantHasNextPage: false,
+			wantEndCursor:   cursor(langSlice[5]),
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			filter := &model.ProgrammingLangFilter{
+				NameContains: "Test",
+				After:        tt.wantAfter,
+				Limit:        6,
+			}
+			u.EXPECT().ListByFilter(gomock.Any(), filter).Return(tt.result, nil)
+
+			variables := map[string]interface{}{"first": 5}
+			if tt.after != "" {
+				variables["after"] = tt.after
+			}
+
+			_, res := doRequest(t, h, query, variables)
+			if len(res.Errors) != 0 {
+				t.Fatalf("errors = %v", res.Errors)
+			}
+
+			langs := res.Data["langs"].(map[string]interface{})
+			if got := len(langs["nodes"].([]interface{})); got != tt.wantNodes {
+				t.Errorf("len(nodes) = %v, want %v", got, tt.wantNodes)
+			}
+			pageInfo := langs["pageInfo"].(map[string]interface{})
+			if got := pageInfo["hasNextPage"]; got != tt.wantHasNextPage {
+				t.Errorf("hasNextPage = %v, want %v", got, tt.wantHasNextPage)
+			}
+			if got := pageInfo["endCursor"]; got != tt.wantEndCursor {
+				t.Errorf("endCursor = %v, want %v", got, tt.wantEndCursor)
+			}
+		})
+	}
+}
+
+func TestProgrammingLangGraphQL_Mutation(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	u := mock_input.NewMockProgrammingLangInputPort(ctrl)
+	h, err := gql.NewProgrammingLangGraphQL(u)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	param := &model.ProgrammingLang{
+		Name:    model.TestName,
+		Feature: model.TestFeature,
+	}
+
+	tests := []struct {
+		name      string
+		query     string
+		mock      func()
+		want      map[string]interface{}
+		wantCodes []string
+	}{
+		{
+			name:  "createLangの場合、ProgrammingLangを生成して返すこと",
+			query: `mutation ($name: String!, $feature: String!) { createLang(name: $name, feature: $feature) { id } }`,
+			mock: func() {
+				u.EXPECT().Create(gomock.Any(), input.NewProgrammingLangCreate(param)).Return(&model.ProgrammingLang{ID: 1, Name: param.Name}, nil)
+			},
+			want: map[string]interface{}{
+				"createLang": map[string]interface{}{"id": float64(1)},
+			},
+		},
+		{
+			name:  "ProgrammingLangが既に存在する場合、CONFLICTのエラーを返すこと",
+			query: `mutation ($name: String!, $feature: String!) { createLang(name: $name, feature: $feature) { id } }`,
+			mock: func() {
+				u.EXPECT().Create(gomock.Any(), input.NewProgrammingLangCreate(param)).Return(nil, &model.AlreadyExistError{
+					ID:        1,
+					Name:      model.TestName,
+					ModelName: model.ModelNameProgrammingLang,
+				})
+			},
+			wantCodes: []string{gql.CodeConflict},
+		},
+		{
+			name:  "updateLangで詳細な属性を指定した場合、指定した属性をUseCaseに渡すこと",
+			query: `mutation ($name: String!, $feature: String!) { updateLang(id: 1, name: $name, feature: $feature, firstAppeared: 2009, paradigms: ["concurrent"]) { id } }`,
+			mock: func() {
+				firstAppeared := 2009
+				u.EXPECT().Update(gomock.Any(), 1, &input.ProgrammingLangUpdate{
+					Name:          param.Name,
+					Feature:       param.Feature,
+					FirstAppeared: &firstAppeared,
+					Paradigms:     []model.Paradigm{model.ParadigmConcurrent},
+				}).Return(&model.ProgrammingLang{ID: 1, Name: param.Name}, nil)
+			},
+			want: map[string]interface{}{
+				"updateLang": map[string]interface{}{"id": float64(1)},
+			},
+		},
+		{
+			name:  "deleteLangの場合、ProgrammingLangを削除してtrueを返すこと",
+			query: `mutation { deleteLang(id: 1) }`,
+			mock: func() {
+				u.EXPECT().Delete(gomock.Any(), 1).Return(nil)
+			},
+			want: map[string]interface{}{
+				"deleteLang": true,
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tt.mock()
+
+			_, res := doRequest(t, h, tt.query, map[string]interface{}{
+				"name":    param.Name,
+				"feature": param.Feature,
+			})
+			if !reflect.DeepEqual(res.Data, tt.want) {
+				t.Errorf("data = %v, want %v", res.Data, tt.want)
+			}
+			if got := errorCodes(res); !reflect.DeepEqual(got, tt.wantCodes) {
+				t.Errorf("error codes = %v, want %v", got, tt.wantCodes)
+			}
+		})
+	}
+}
+
+func TestProgrammingLangGraphQL_Limits(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	u := mock_input.NewMockProgrammingLangInputPort(ctrl)
+	h, err := gql.NewProgrammingLangGraphQL(u)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	tests := []struct {
+		name          string
+		query         string
+		maxDepth      int
+		maxComplexity int
+		wantCodes     []string
+	}{
+		{
+			name:          "深さが上限を超える場合、実行せずにエラーを返すこと",
+			query:         `{ langs(first: 5) { pageInfo { ...P } } } fragment P on PageInfo { hasNextPage }`,
+			maxDepth:      2,
+			maxComplexity: 1000,
+			wantCodes:     []string{gql.CodeQueryTooComplex},
+		},
+		{
+			name:          "取得件数を掛けた複雑さが上限を超える場合、実行せずにエラーを返すこと",
+			query:         `{ langs(first: 20) { nodes { id name feature } } }`,
+			maxDepth:      10,
+			maxComplexity: 50,
+			wantCodes:     []string{gql.CodeQueryTooComplex},
+		},
+		{
+			name:          "イントロスペクションは深さと複雑さに含めないこと",
+			query:         `{ __schema { types { fields { type { ofType { name } } } } } }`,
+			maxDepth:      2,
+			maxComplexity: 1,
+			wantCodes:     nil,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			h.MaxDepth = tt.maxDepth
+			h.MaxComplexity = tt.maxComplexity
+
+			_, res := doRequest(t, h, tt.query, nil)
+			if got := errorCodes(res); !reflect.DeepEqual(got, tt.wantCodes) {
+				t.Errorf("error codes = %v, want %v", got, tt.wantCodes)
+			}
+		})
+	}
+}
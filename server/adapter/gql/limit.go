@@ -0,0 +1,133 @@
+package gql
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/SekiguchiKai/clean-architecture-with-go/server/adapter/api"
+	"github.com/graphql-go/graphql/language/ast"
+)
+
+// paginatedFields は、firstで取得件数を指定するフィールド。
+// 子のフィールドの複雑さは、取得件数を掛けて計算する。
+var paginatedFields = map[string]bool{
+	FieldLangs: true,
+}
+
+// analyzer は、クエリの深さと複雑さを計算する。
+type analyzer struct {
+	fragments map[string]*ast.FragmentDefinition
+	variables map[string]interface{}
+}
+
+// checkLimits は、クエリの深さと複雑さが上限以下であるかどうかを確認する。
+// イントロスペクションのフィールドは計算の対象外とする。
+func checkLimits(doc *ast.Document, variables map[string]interface{}, maxDepth, maxComplexity int) error {
+	a := &analyzer{
+		fragments: make(map[string]*ast.FragmentDefinition),
+		variables: variables,
+	}
+	for _, def := range doc.Definitions {
+		if f, ok := def.(*ast.FragmentDefinition); ok {
+			a.fragments[f.Name.Value] = f
+		}
+	}
+
+	for _, def := range doc.Definitions {
+		op, ok := def.(*ast.OperationDefinition)
+		if !ok {
+			continue
+		}
+
+		depth, complexity := a.selectionSet(op.SelectionSet, 1, maxDepth)
+		if depth > maxDepth {
+			return &handledError{
+				code:    CodeQueryTooComplex,
+				message: fmt.Sprintf(TooDeepErr, depth, maxDepth),
+			}
+		}
+		if complexity > maxComplexity {
+			return &handledError{
+				code:    CodeQueryTooComplex,
+				message: fmt.Sprintf(TooComplexErr, complexity, maxComplexity),
+			}
+		}
+	}
+	return nil
+}
+
+// selectionSet は、SelectionSetの深さと複雑さを返す。
+// 深さが上限を超えた時点で、それ以上は計算しない。
+func (a *analyzer) selectionSet(set *ast.SelectionSet, depth, maxDepth int) (int, int) {
+	if set == nil {
+		return depth - 1, 0
+	}
+	if depth > maxDepth {
+		return depth, 0
+	}
+
+	maxChildDepth, complexity := depth, 0
+	for _, s := range set.Selections {
+		var d, c int
+		switch s := s.(type) {
+		case *ast.Field:
+			if strings.HasPrefix(s.Name.Value, "__") {
+				continue
+			}
+			d, c = a.selectionSet(s.SelectionSet, depth+1, maxDepth)
+			c = 1 + c*a.multiplier(s)
+			if d < depth {
+				d = depth
+			}
+		case *ast.InlineFragment:
+			d, c = a.selectionSet(s.SelectionSet, depth, maxDepth)
+		case *ast.FragmentSpread:
+			f, ok := a.fragments[s.Name.Value]
+			if !ok {
+				continue
+			}
+			d, c = a.selectionSet(f.SelectionSet, depth, maxDepth)
+		}
+
+		if d > maxChildDepth {
+			maxChildDepth = d
+		}
+		complexity += c
+	}
+	return maxChildDepth, complexity
+}
+
+// multiplier は、フィールドの子の複雑さに掛ける値を返す。
+func (a *analyzer) multiplier(f *ast.Field) int {
+	if !paginatedFields[f.Name.Value] {
+		return 1
+	}
+
+	for _, arg := range f.Arguments {
+		if arg.Name.Value != ArgFirst {
+			continue
+		}
+		if first, ok := a.intValue(arg.Value); ok {
+			return api.ManageLimit(first, api.MaxLimit, api.MinLimit, api.DefaultLimit)
+		}
+	}
+	return api.DefaultLimit
+}
+
+// intValue は、引数の値を整数として返す。変数の場合は、リクエストの変数から取得する。
+func (a *analyzer) intValue(v ast.Value) (int, bool) {
+	switch v := v.(type) {
+	case *ast.IntValue:
+		i, err := strconv.Atoi(v.Value)
+		return i, err == nil
+	case *ast.Variable:
+		switch i := a.variables[v.Name.Value].(type) {
+		case int:
+			return i, true
+		case float64:
+			return int(i), true
+		}
+	}
+	return 0, false
+}
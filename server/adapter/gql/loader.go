@@ -0,0 +1,127 @@
+package gql
+
+import (
+	"context"
+	"sort"
+	"sync"
+
+	"github.com/SekiguchiKai/clean-architecture-with-go/server/domain/model"
+	"github.com/SekiguchiKai/clean-architecture-with-go/server/usecase/input"
+)
+
+// loaderKey は、contextにlangLoaderを保持するためのキー。
+type loaderKey struct{}
+
+// loadResult は、langLoaderが取得した結果。
+type loadResult struct {
+	lang *model.ProgrammingLang
+	err  error
+}
+
+// langLoader は、1回のリクエストの中でIDによるProgrammingLangの取得をまとめる。
+// loadで登録したIDは、返された関数が最初に呼び出された時点でまとめて取得する。
+type langLoader struct {
+	ctx     context.Context
+	useCase input.ProgrammingLangInputPort
+
+	mu      sync.Mutex
+	pending []int
+	results map[int]*loadResult
+	batches int
+}
+
+// newLangLoader は、langLoaderを生成し、返す。
+func newLangLoader(ctx context.Context, useCase input.ProgrammingLangInputPort) *langLoader {
+	return &langLoader{
+		ctx:     ctx,
+		useCase: useCase,
+		results: make(map[int]*loadResult),
+	}
+}
+
+// withLoader は、langLoaderを保持するcontextを返す。
+func withLoader(ctx context.Context, l *langLoader) context.Context {
+	return context.WithValue(ctx, loaderKey{}, l)
+}
+
+// loaderFrom は、contextが保持するlangLoaderを返す。
+func loaderFrom(ctx context.Context) *langLoader {
+	l, _ := ctx.Value(loaderKey{}).(*langLoader)
+	return l
+}
+
+// load は、IDを取得対象に登録し、結果を返す関数を返す。
+func (l *langLoader) load(id int) func() (interface{}, error) {
+	l.mu.Lock()
+	if _, ok := l.results[id]; !ok && !l.isPending(id) {
+		l.pending = append(l.pending, id)
+	}
+	l.mu.Unlock()
+
+	return func() (interface{}, error) {
+		l.mu.Lock()
+		defer l.mu.Unlock()
+
+		if _, ok := l.results[id]; !ok {
+			l.dispatch()
+		}
+
+		r := l.results[id]
+		if r.err != nil {
+			return nil, r.err
+		}
+		return r.lang, nil
+	}
+}
+
+// prime は、取得済みのProgrammingLangを結果として登録する。
+func (l *langLoader) prime(langSlice []*model.ProgrammingLang) {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	for _, lang := range langSlice {
+		if _, ok := l.results[lang.ID]; !ok {
+			l.results[lang.ID] = &loadResult{lang: lang}
+		}
+	}
+}
+
+// dispatch は、登録されたIDをまとめて取得する。呼び出し側でロックを取得している必要がある。
+func (l *langLoader) dispatch() {
+	ids := l.pending
+	sort.Ints(ids)
+	l.pending = nil
+	l.batches++
+
+	langSlice, err := l.useCase.BatchGet(l.ctx, ids)
+	if err != nil {
+		for _, id := range ids {
+			l.results[id] = &loadResult{err: handleError(err)}
+		}
+		return
+	}
+
+	for _, lang := range langSlice {
+		l.results[lang.ID] = &loadResult{lang: lang}
+	}
+	for _, id := range ids {
+		if _, ok := l.results[id]; !ok {
+			l.results[id] = &loadResult{
+				err: handleError(&model.NoSuchDataError{
+					ID:        id,
+					ModelName: model.ModelNameProgrammingLang,
+				}),
+			}
+		}
+	}
+}
+
+// isPending は、IDが取得対象に登録済みかどうかを確認する。
+func (l *langLoader) isPending(id int) bool {
+	for _, p := range l.pending {
+		if p == id {
+			return true
+		}
+	}
+	return false
+}
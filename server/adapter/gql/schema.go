@@ -0,0 +1,352 @@
+package gql
+
+import (
+	"encoding/base64"
+	"reflect"
+	"strings"
+
+	"github.com/SekiguchiKai/clean-architecture-with-go/server/adapter/api"
+	"github.com/SekiguchiKai/clean-architecture-with-go/server/domain/model"
+	"github.com/SekiguchiKai/clean-architecture-with-go/server/usecase/input"
+	"github.com/graphql-go/graphql"
+)
+
+// cursorPrefix は、カーソルを生成する際に名前に付与するprefix。
+const cursorPrefix = "name:"
+
+// resolver は、GraphQLのフィールドの値をUseCaseから取得する。
+type resolver struct {
+	useCase input.ProgrammingLangInputPort
+}
+
+// langType は、ProgrammingLangのGraphQLの型。
+var langType = graphql.NewObject(graphql.ObjectConfig{
+	Name: "ProgrammingLang",
+	Fields: graphql.Fields{
+		FieldID:            &graphql.Field{Type: graphql.NewNonNull(graphql.Int)},
+		FieldName:          &graphql.Field{Type: graphql.NewNonNull(graphql.String)},
+		FieldFeature:       &graphql.Field{Type: graphql.NewNonNull(graphql.String)},
+		FieldSlug:          &graphql.Field{Type: graphql.NewNonNull(graphql.String)},
+		FieldFirstAppeared: &graphql.Field{Type: graphql.Int, Resolve: resolveNonZero(func(lang *model.ProgrammingLang) interface{} { return lang.FirstAppeared })},
+		FieldDesigners:     &graphql.Field{Type: graphql.NewList(graphql.NewNonNull(graphql.String)), Resolve: resolveNonZero(func(lang *model.ProgrammingLang) interface{} { return lang.Designers })},
+		FieldTypeChecking:  &graphql.Field{Type: graphql.String, Resolve: resolveNonZero(func(lang *model.ProgrammingLang) interface{} { return string(lang.TypeChecking) })},
+		FieldTypeStrength:  &graphql.Field{Type: graphql.String, Resolve: resolveNonZero(func(lang *model.ProgrammingLang) interface{} { return string(lang.TypeStrength) })},
+		FieldParadigms:     &graphql.Field{Type: graphql.NewList(graphql.NewNonNull(graphql.String)), Resolve: resolveNonZero(func(lang *model.ProgrammingLang) interface{} { return lang.Paradigms })},
+		FieldLicense:       &graphql.Field{Type: graphql.String, Resolve: resolveNonZero(func(lang *model.ProgrammingLang) interface{} { return lang.License })},
+		FieldWebsite:       &graphql.Field{Type: graphql.String, Resolve: resolveNonZero(func(lang *model.ProgrammingLang) interface{} { return lang.Website })},
+		FieldExtensions:    &graphql.Field{Type: graphql.NewList(graphql.NewNonNull(graphql.String)), Resolve: resolveNonZero(func(lang *model.ProgrammingLang) interface{} { return lang.Extensions })},
+		FieldFilenames:     &graphql.Field{Type: graphql.NewList(graphql.NewNonNull(graphql.String)), Resolve: resolveNonZero(func(lang *model.ProgrammingLang) interface{} { return lang.Filenames })},
+		FieldInterpreters:  &graphql.Field{Type: graphql.NewList(graphql.NewNonNull(graphql.String)), Resolve: resolveNonZero(func(lang *model.ProgrammingLang) interface{} { return lang.Interpreters })},
+		FieldAliases:       &graphql.Field{Type: graphql.NewList(graphql.NewNonNull(graphql.String)), Resolve: resolveNonZero(func(lang *model.ProgrammingLang) interface{} { return lang.Aliases })},
+		FieldColor:         &graphql.Field{Type: graphql.String, Resolve: resolveNonZero(func(lang *model.ProgrammingLang) interface{} { return lang.Color })},
+		FieldStableVersion: &graphql.Field{Type: graphql.String, Resolve: resolveNonZero(func(lang *model.ProgrammingLang) interface{} { return lang.StableVersion })},
+		FieldCreatedAt:     &graphql.Field{Type: graphql.NewNonNull(graphql.DateTime)},
+		FieldUpdatedAt:     &graphql.Field{Type: graphql.NewNonNull(graphql.DateTime)},
+	},
+})
+
+// pageInfoType は、ページングの情報のGraphQLの型。
+var pageInfoType = graphql.NewObject(graphql.ObjectConfig{
+	Name: "PageInfo",
+	Fields: graphql.Fields{
+		FieldEndCursor:   &graphql.Field{Type: graphql.String},
+		FieldHasNextPage: &graphql.Field{Type: graphql.NewNonNull(graphql.Boolean)},
+	},
+})
+
+// langConnectionType は、ProgrammingLangの一覧のGraphQLの型。
+var langConnectionType = graphql.NewObject(graphql.ObjectConfig{
+	Name: "ProgrammingLangConnection",
+	Fields: graphql.Fields{
+		FieldNodes:    &graphql.Field{Type: graphql.NewNonNull(graphql.NewList(graphql.NewNonNull(langType)))},
+		FieldPageInfo: &graphql.Field{Type: graphql.NewNonNull(pageInfoType)},
+	},
+})
+
+// langConnection は、ProgrammingLangの一覧を表す。
+type langConnection struct {
+	Nodes    []*model.ProgrammingLang `json:"nodes"`
+	PageInfo pageInfo                 `json:"pageInfo"`
+}
+
+// pageInfo は、ページングの情報を表す。
+type pageInfo struct {
+	EndCursor   *string `json:"endCursor"`
+	HasNextPage bool    `json:"hasNextPage"`
+}
+
+// NewSchema は、ProgrammingLangのGraphQLのスキーマを生成し、返す。
+func NewSchema(useCase input.ProgrammingLangInputPort) (graphql.Schema, error) {
+	r := &resolver{useCase: useCase}
+
+	query := graphql.NewObject(graphql.ObjectConfig{
+		Name: "Query",
+		Fields: graphql.Fields{
+			FieldLang: &graphql.Field{
+				Type: langType,
+				Args: graphql.FieldConfigArgument{
+					ArgID: &graphql.ArgumentConfig{Type: graphql.NewNonNull(graphql.Int)},
+				},
+				Resolve: r.lang,
+			},
+			FieldLangs: &graphql.Field{
+				Type: graphql.NewNonNull(langConnectionType),
+				Args: graphql.FieldConfigArgument{
+					ArgFirst:        &graphql.ArgumentConfig{Type: graphql.Int},
+					ArgAfter:        &graphql.ArgumentConfig{Type: graphql.String},
+					ArgNameContains: &graphql.ArgumentConfig{Type: graphql.String},
+				},
+				Resolve: r.langs,
+			},
+		},
+	})
+
+	mutation := graphql.NewObject(graphql.ObjectConfig{
+		Name: "Mutation",
+		Fields: graphql.Fields{
+			FieldCreateLang: &graphql.Field{
+				Type: graphql.NewNonNull(langType),
+				Args: detailArgs(graphql.FieldConfigArgument{
+					ArgName:    &graphql.ArgumentConfig{Type: graphql.NewNonNull(graphql.String)},
+					ArgFeature: &graphql.ArgumentConfig{Type: graphql.NewNonNull(graphql.String)},
+				}),
+				Resolve: r.createLang,
+			},
+			FieldUpdateLang: &graphql.Field{
+				Type: graphql.NewNonNull(langType),
+				Args: detailArgs(graphql.FieldConfigArgument{
+					ArgID:      &graphql.ArgumentConfig{Type: graphql.NewNonNull(graphql.Int)},
+					ArgName:    &graphql.ArgumentConfig{Type: graphql.NewNonNull(graphql.String)},
+					ArgFeature: &graphql.ArgumentConfig{Type: graphql.NewNonNull(graphql.String)},
+				}),
+				Resolve: r.updateLang,
+			},
+			FieldDeleteLang: &graphql.Field{
+				Type: graphql.NewNonNull(graphql.Boolean),
+				Args: graphql.FieldConfigArgument{
+					ArgID: &graphql.ArgumentConfig{Type: graphql.NewNonNull(graphql.Int)},
+				},
+				Resolve: r.deleteLang,
+			},
+		},
+	})
+
+	return graphql.NewSchema(graphql.SchemaConfig{
+		Query:    query,
+		Mutation: mutation,
+	})
+}
+
+// lang は、IDで指定したProgrammingLangを返す。取得はリクエスト内でまとめて行う。
+func (r *resolver) lang(p graphql.ResolveParams) (interface{}, error) {
+	id, _ := p.Args[ArgID].(int)
+
+	l := loaderFrom(p.Context)
+	if l == nil {
+		lang, err := r.useCase.Get(p.Context, id)
+		if err != nil {
+			return nil, handleError(err)
+		}
+		return lang, nil
+	}
+	return l.load(id), nil
+}
+
+// langs は、条件に一致するProgrammingLangの一覧を返す。
+func (r *resolver) langs(p graphql.ResolveParams) (interface{}, error) {
+	first, _ := p.Args[ArgFirst].(int)
+	nameContains, _ := p.Args[ArgNameContains].(string)
+	cursor, _ := p.Args[ArgAfter].(string)
+
+	after, err := decodeCursor(cursor)
+	if err != nil {
+		return nil, handleError(err)
+	}
+
+	limit := api.ManageLimit(first, api.MaxLimit, api.MinLimit, api.DefaultLimit)
+
+	// 次のページが存在するかどうかを判定するため、1件多く取得する。
+	langSlice, err := r.useCase.ListByFilter(p.Context, &model.ProgrammingLangFilter{
+		NameContains: nameContains,
+		After:        after,
+		Limit:        limit + 1,
+	})
+	if err != nil {
+		return nil, handleError(err)
+	}
+
+	conn := &langConnection{Nodes: langSlice}
+	if len(langSlice) > limit {
+		conn.Nodes = langSlice[:limit]
+		conn.PageInfo.HasNextPage = true
+	}
+	if len(conn.Nodes) > 0 {
+		endCursor := encodeCursor(conn.Nodes[len(conn.Nodes)-1].Name)
+		conn.PageInfo.EndCursor = &endCursor
+	}
+
+	if l := loaderFrom(p.Context); l != nil {
+		l.prime(conn.Nodes)
+	}
+
+	return conn, nil
+}
+
+// createLang は、ProgrammingLangを生成し、返す。
+func (r *resolver) createLang(p graphql.ResolveParams) (interface{}, error) {
+	name, _ := p.Args[ArgName].(string)
+	feature, _ := p.Args[ArgFeature].(string)
+
+	lang, err := r.useCase.Create(p.Context, input.NewProgrammingLangCreate(updateFromArgs(p.Args, name, feature).Apply(&model.ProgrammingLang{})))
+	if err != nil {
+		return nil, handleError(err)
+	}
+	return lang, nil
+}
+
+// updateLang は、ProgrammingLangを更新し、返す。
+func (r *resolver) updateLang(p graphql.ResolveParams) (interface{}, error) {
+	id, _ := p.Args[ArgID].(int)
+	name, _ := p.Args[ArgName].(string)
+	feature, _ := p.Args[ArgFeature].(string)
+
+	lang, err := r.useCase.Update(p.Context, id, updateFromArgs(p.Args, name, feature))
+	if err != nil {
+		return nil, handleError(err)
+	}
+	return lang, nil
+}
+
+// deleteLang は、ProgrammingLangを削除する。
+func (r *resolver) deleteLang(p graphql.ResolveParams) (interface{}, error) {
+	id, _ := p.Args[ArgID].(int)
+
+	if err := r.useCase.Delete(p.Context, id); err != nil {
+		return nil, handleError(err)
+	}
+	return true, nil
+}
+
+// detailArgs は、argsにProgrammingLangの詳細な属性の引数を追加し、返す。
+// 詳細な属性は省略可能で、更新時に省略した属性は変更せず、空文字や空のリストを指定した属性は値を消す。
+func detailArgs(args graphql.FieldConfigArgument) graphql.FieldConfigArgument {
+	stringList := graphql.NewList(graphql.NewNonNull(graphql.String))
+
+	args[ArgFirstAppeared] = &graphql.ArgumentConfig{Type: graphql.Int}
+	args[ArgDesigners] = &graphql.ArgumentConfig{Type: stringList}
+	args[ArgTypeChecking] = &graphql.ArgumentConfig{Type: graphql.String}
+	args[ArgTypeStrength] = &graphql.ArgumentConfig{Type: graphql.String}
+	args[ArgParadigms] = &graphql.ArgumentConfig{Type: stringList}
+	args[ArgLicense] = &graphql.ArgumentConfig{Type: graphql.String}
+	args[ArgWebsite] = &graphql.ArgumentConfig{Type: graphql.String}
+	args[ArgExtensions] = &graphql.ArgumentConfig{Type: stringList}
+	args[ArgFilenames] = &graphql.ArgumentConfig{Type: stringList}
+	args[ArgInterpreters] = &graphql.ArgumentConfig{Type: stringList}
+	args[ArgAliases] = &graphql.ArgumentConfig{Type: stringList}
+	args[ArgColor] = &graphql.ArgumentConfig{Type: graphql.String}
+	args[ArgStableVersion] = &graphql.ArgumentConfig{Type: graphql.String}
+	return args
+}
+
+// updateFromArgs は、引数で指定された属性からProgrammingLangUpdateを生成する。
+// 指定されていない詳細な属性はnilのままにし、既存の値を維持する。空文字や空のリストを指定した場合は値を消す。
+func updateFromArgs(args map[string]interface{}, name, feature string) *input.ProgrammingLangUpdate {
+	u := &input.ProgrammingLangUpdate{Name: name, Feature: feature}
+	if v, ok := args[ArgFirstAppeared].(int); ok {
+		u.FirstAppeared = &v
+	}
+	u.Designers = stringsArg(args[ArgDesigners])
+	if v, ok := args[ArgTypeChecking].(string); ok {
+		typeChecking := model.TypeChecking(v)
+		u.TypeChecking = &typeChecking
+	}
+	if v, ok := args[ArgTypeStrength].(string); ok {
+		typeStrength := model.TypeStrength(v)
+		u.TypeStrength = &typeStrength
+	}
+	if paradigms := stringsArg(args[ArgParadigms]); paradigms != nil {
+		u.Paradigms = make([]model.Paradigm, len(paradigms))
+		for i, p := range paradigms {
+			u.Paradigms[i] = model.Paradigm(p)
+		}
+	}
+	u.License = stringArg(args[ArgLicense])
+	u.Website = stringArg(args[ArgWebsite])
+	u.Extensions = stringsArg(args[ArgExtensions])
+	u.Filenames = stringsArg(args[ArgFilenames])
+	u.Interpreters = stringsArg(args[ArgInterpreters])
+	u.Aliases = stringsArg(args[ArgAliases])
+	u.Color = stringArg(args[ArgColor])
+	u.StableVersion = stringArg(args[ArgStableVersion])
+	return u
+}
+
+// stringArg は、文字列の引数をポインタに変換する。指定されていない場合は、nilを返す。
+func stringArg(v interface{}) *string {
+	s, ok := v.(string)
+	if !ok {
+		return nil
+	}
+	return &s
+}
+
+// stringsArg は、文字列のリストの引数をスライスに変換する。指定されていない場合は、nilを返す。
+func stringsArg(v interface{}) []string {
+	list, ok := v.([]interface{})
+	if !ok {
+		return nil
+	}
+
+	s := make([]string, 0, len(list))
+	for _, item := range list {
+		if str, ok := item.(string); ok {
+			s = append(s, str)
+		}
+	}
+	return s
+}
+
+// resolveNonZero は、fnで取得した値がゼロ値の場合にnullを返すResolveFnを生成する。
+// 設定されていない詳細な属性を、空文字や0や空のリストではなくnullとして返すために使用する。
+func resolveNonZero(fn func(lang *model.ProgrammingLang) interface{}) graphql.FieldResolveFn {
+	return func(p graphql.ResolveParams) (interface{}, error) {
+		lang, ok := p.Source.(*model.ProgrammingLang)
+		if !ok {
+			return nil, nil
+		}
+
+		v := reflect.ValueOf(fn(lang))
+		switch v.Kind() {
+		case reflect.Slice:
+			if v.Len() == 0 {
+				return nil, nil
+			}
+		case reflect.Int, reflect.String:
+			if v.Interface() == reflect.Zero(v.Type()).Interface() {
+				return nil, nil
+			}
+		}
+		return v.Interface(), nil
+	}
+}
+
+// encodeCursor は、Nameからカーソルを生成し、返す。
+func encodeCursor(name string) string {
+	return base64.URLEncoding.EncodeToString([]byte(cursorPrefix + name))
+}
+
+// decodeCursor は、カーソルからNameを取り出し、返す。
+func decodeCursor(cursor string) (string, error) {
+	if cursor == "" {
+		return "", nil
+	}
+
+	b, err := base64.URLEncoding.DecodeString(cursor)
+	if err != nil || !strings.HasPrefix(string(b), cursorPrefix) {
+		return "", &model.InvalidParameterError{
+			Parameter: ArgAfter,
+			Message:   InvalidCursorErr,
+		}
+	}
+	return strings.TrimPrefix(string(b), cursorPrefix), nil
+}
@@ -0,0 +1,74 @@
+package linguist
+
+import (
+	"io"
+	"io/ioutil"
+	"sort"
+
+	"github.com/SekiguchiKai/clean-architecture-with-go/server/domain/model"
+	"github.com/pkg/errors"
+	"gopkg.in/yaml.v2"
+)
+
+// sourceName は、エラーで示す取り込み元のデータの名前。
+const sourceName = "languages.yml"
+
+// language は、GitHub Linguistのlanguages.ymlの1つの言語の定義のうち、取り込む属性を表す。
+type language struct {
+	Type         string   `yaml:"type"`
+	Color        string   `yaml:"color"`
+	Extensions   []string `yaml:"extensions"`
+	Filenames    []string `yaml:"filenames"`
+	Interpreters []string `yaml:"interpreters"`
+	Aliases      []string `yaml:"aliases"`
+}
+
+// Parse は、GitHub Linguistのlanguages.ymlの形式のデータを読み込み、取り込むProgrammingLangをNameの昇順で返す。
+// 言語の種類(programming、markupなど)は、Tagの名前として扱う。
+func Parse(r io.Reader) ([]*model.LangImport, error) {
+	b, err := ioutil.ReadAll(r)
+	if err != nil {
+		return nil, errors.WithStack(err)
+	}
+
+	languages := make(map[string]*language)
+	if err := yaml.Unmarshal(b, &languages); err != nil {
+		return nil, &model.InvalidParameterError{
+			Parameter: sourceName,
+			Message:   err.Error(),
+		}
+	}
+
+	names := make([]string, 0, len(languages))
+	for name := range languages {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	imports := make([]*model.LangImport, 0, len(names))
+	for _, name := range names {
+		l := languages[name]
+		if l == nil {
+			l = &language{}
+		}
+
+		var tags []string
+		if l.Type != "" {
+			tags = []string{l.Type}
+		}
+
+		imports = append(imports, &model.LangImport{
+			Lang: &model.ProgrammingLang{
+				Name:         name,
+				Extensions:   l.Extensions,
+				Filenames:    l.Filenames,
+				Interpreters: l.Interpreters,
+				Aliases:      l.Aliases,
+				Color:        l.Color,
+			},
+			Tags: tags,
+		})
+	}
+
+	return imports, nil
+}
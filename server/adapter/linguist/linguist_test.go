@@ -0,0 +1,101 @@
+package linguist
+
+import (
+	"reflect"
+	"strings"
+	"testing"
+
+	"github.com/SekiguchiKai/clean-architecture-with-go/server/domain/model"
+)
+
+func TestParse(t *testing.T) {
+	tests := []struct {
+		name    string
+		arg     string
+		want    []*model.LangImport
+		wantErr bool
+	}{
+		{
+			name: "languages.ymlの形式の場合、Nameの昇順で取り込むProgrammingLangを返すこと",
+			arg: `---
+Ruby:
+  type: programming
+  color: "#701516"
+  aliases:
+  - rb
+  extensions:
+  - ".rb"
+  - ".rake"
+  filenames:
+  - Gemfile
+  interpreters:
+  - ruby
+  language_id: 326
+Go:
+  type: programming
+  color: "#00ADD8"
+  aliases:
+  - golang
+  extensions:
+  - ".go"
+  tm_scope: source.go
+`,
+			want: []*model.LangImport{
+				{
+					Lang: &model.ProgrammingLang{
+						Name:       "Go",
+						Extensions: []string{".go"},
+						Aliases:    []string{"golang"},
+						Color:      "#00ADD8",
+					},
+					Tags: []string{"programming"},
+				},
+				{
+					Lang: &model.ProgrammingLang{
+						Name:         "Ruby",
+						Extensions:   []string{".rb", ".rake"},
+						Filenames:    []string{"Gemfile"},
+						Interpreters: []string{"ruby"},
+						Aliases:      []string{"rb"},
+						Color:        "#701516",
+					},
+					Tags: []string{"programming"},
+				},
+			},
+		},
+		{
+			name: "属性のない言語を含む場合、Nameのみを設定したProgrammingLangを返すこと",
+			arg:  "Text:\n",
+			want: []*model.LangImport{
+				{Lang: &model.ProgrammingLang{Name: "Text"}},
+			},
+		},
+		{
+			name: "空の場合、空のスライスを返すこと",
+			arg:  "",
+			want: []*model.LangImport{},
+		},
+		{
+			name:    "YAMLとして不正な場合、エラーを返すこと",
+			arg:     "Go: [",
+			wantErr: true,
+		},
+		{
+			name:    "言語の定義の形式が異なる場合、エラーを返すこと",
+			arg:     "Go:\n  extensions: .go\n",
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := Parse(strings.NewReader(tt.arg))
+			if (err != nil) != tt.wantErr {
+				t.Errorf("Parse() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("Parse() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,161 @@
+package rpc
+
+import (
+	"time"
+
+	"github.com/SekiguchiKai/clean-architecture-with-go/server/adapter/rpc/pb"
+	"github.com/SekiguchiKai/clean-architecture-with-go/server/domain/model"
+	"github.com/SekiguchiKai/clean-architecture-with-go/server/usecase/input"
+	"github.com/golang/protobuf/ptypes/timestamp"
+)
+
+// eventTypes は、EventTypeとWatchEvent_Typeの対応。
+var eventTypes = map[model.EventType]pb.WatchEvent_Type{
+	model.EventTypeCreated: pb.WatchEvent_CREATED,
+	model.EventTypeUpdated: pb.WatchEvent_UPDATED,
+	model.EventTypeDeleted: pb.WatchEvent_DELETED,
+}
+
+// toPBLang は、ProgrammingLangをpb.ProgrammingLangに変換する。
+func toPBLang(lang *model.ProgrammingLang) *pb.ProgrammingLang {
+	if lang == nil {
+		return nil
+	}
+	return &pb.ProgrammingLang{
+		Id:        int64(lang.ID),
+		Name:      lang.Name,
+		Feature:   lang.Feature,
+		Slug:      lang.Slug,
+		Detail:    toPBDetail(lang),
+		CreatedAt: toPBTime(lang.CreatedAt),
+		UpdatedAt: toPBTime(lang.UpdatedAt),
+	}
+}
+
+// toPBDetail は、ProgrammingLangの詳細な属性をpb.ProgrammingLangDetailに変換する。
+func toPBDetail(lang *model.ProgrammingLang) *pb.ProgrammingLangDetail {
+	var paradigms []string
+	for _, p := range lang.Paradigms {
+		paradigms = append(paradigms, string(p))
+	}
+	return &pb.ProgrammingLangDetail{
+		FirstAppeared: int32(lang.FirstAppeared),
+		Designers:     lang.Designers,
+		TypeChecking:  string(lang.TypeChecking),
+		TypeStrength:  string(lang.TypeStrength),
+		Paradigms:     paradigms,
+		License:       lang.License,
+		Website:       lang.Website,
+		Extensions:    lang.Extensions,
+		StableVersion: lang.StableVersion,
+		Filenames:     lang.Filenames,
+		Interpreters:  lang.Interpreters,
+		Aliases:       lang.Aliases,
+		Color:         lang.Color,
+	}
+}
+
+// fromPBDetail は、pb.ProgrammingLangDetailの値をlangの詳細な属性に設定し、返す。
+func fromPBDetail(detail *pb.ProgrammingLangDetail, lang *model.ProgrammingLang) *model.ProgrammingLang {
+	if detail == nil {
+		return lang
+	}
+
+	lang.FirstAppeared = int(detail.FirstAppeared)
+	lang.Designers = detail.Designers
+	lang.TypeChecking = model.TypeChecking(detail.TypeChecking)
+	lang.TypeStrength = model.TypeStrength(detail.TypeStrength)
+	for _, p := range detail.Paradigms {
+		lang.Paradigms = append(lang.Paradigms, model.Paradigm(p))
+	}
+	lang.License = detail.License
+	lang.Website = detail.Website
+	lang.Extensions = detail.Extensions
+	lang.Filenames = detail.Filenames
+	lang.Interpreters = detail.Interpreters
+	lang.Aliases = detail.Aliases
+	lang.Color = detail.Color
+	lang.StableVersion = detail.StableVersion
+	return lang
+}
+
+// fromPBUpdate は、pb.UpdateRequestをProgrammingLangUpdateに変換する。
+// proto3では値を設定していないことと空の値を区別できないため、detailで空の属性は変更しない。
+func fromPBUpdate(req *pb.UpdateRequest) *input.ProgrammingLangUpdate {
+	u := &input.ProgrammingLangUpdate{Name: req.Name, Feature: req.Feature}
+	detail := req.Detail
+	if detail == nil {
+		return u
+	}
+
+	if detail.FirstAppeared != 0 {
+		firstAppeared := int(detail.FirstAppeared)
+		u.FirstAppeared = &firstAppeared
+	}
+	u.Designers = nonEmpty(detail.Designers)
+	if detail.TypeChecking != "" {
+		typeChecking := model.TypeChecking(detail.TypeChecking)
+		u.TypeChecking = &typeChecking
+	}
+	if detail.TypeStrength != "" {
+		typeStrength := model.TypeStrength(detail.TypeStrength)
+		u.TypeStrength = &typeStrength
+	}
+	for _, p := range detail.Paradigms {
+		u.Paradigms = append(u.Paradigms, model.Paradigm(p))
+	}
+	u.License = nonEmptyString(detail.License)
+	u.Website = nonEmptyString(detail.Website)
+	u.Extensions = nonEmpty(detail.Extensions)
+	u.Filenames = nonEmpty(detail.Filenames)
+	u.Interpreters = nonEmpty(detail.Interpreters)
+	u.Aliases = nonEmpty(detail.Aliases)
+	u.Color = nonEmptyString(detail.Color)
+	u.StableVersion = nonEmptyString(detail.StableVersion)
+	return u
+}
+
+// nonEmpty は、空のスライスをnilに置き換える。
+func nonEmpty(s []string) []string {
+	if len(s) == 0 {
+		return nil
+	}
+	return s
+}
+
+// nonEmptyString は、空文字の場合はnilを、それ以外の場合はsのポインタを返す。
+func nonEmptyString(s string) *string {
+	if s == "" {
+		return nil
+	}
+	return &s
+}
+
+// toPBLangs は、ProgrammingLangのスライスをpb.ProgrammingLangのスライスに変換する。
+func toPBLangs(langSlice []*model.ProgrammingLang) []*pb.ProgrammingLang {
+	pbSlice := make([]*pb.ProgrammingLang, len(langSlice))
+	for i, lang := range langSlice {
+		pbSlice[i] = toPBLang(lang)
+	}
+	return pbSlice
+}
+
+// toPBEvent は、ProgrammingLangEventをpb.WatchEventに変換する。
+func toPBEvent(event *model.ProgrammingLangEvent) *pb.WatchEvent {
+	return &pb.WatchEvent{
+		Type:       eventTypes[event.Type],
+		Lang:       toPBLang(event.Lang),
+		OccurredAt: toPBTime(event.OccurredAt),
+	}
+}
+
+// toPBTime は、time.Timeをtimestamp.Timestampに変換する。ゼロ値の場合は、nilを返す。
+func toPBTime(t time.Time) *timestamp.Timestamp {
+	if t.IsZero() {
+		return nil
+	}
+	return &timestamp.Timestamp{
+		Seconds: t.Unix(),
+		Nanos:   int32(t.Nanosecond()),
+	}
+}
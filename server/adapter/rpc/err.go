@@ -0,0 +1,33 @@
+package rpc
+
+import (
+	"github.com/SekiguchiKai/clean-architecture-with-go/server/domain/model"
+	"github.com/pkg/errors"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// エラーの定数。
+const (
+	OtherErr = "some error has occurred"
+)
+
+// handleError は、エラーをgRPCのステータスに変換する。
+func handleError(err error) error {
+	switch errors.Cause(err).(type) {
+	case *model.NoSuchDataError:
+		return status.Error(codes.NotFound, errors.Cause(err).Error())
+	case *model.RequiredError:
+		return status.Error(codes.InvalidArgument, errors.Cause(err).Error())
+	case *model.InvalidPropertyError:
+		return status.Error(codes.InvalidArgument, errors.Cause(err).Error())
+	case *model.InvalidParameterError:
+		return status.Error(codes.InvalidArgument, errors.Cause(err).Error())
+	case *model.AlreadyExistError:
+		return status.Error(codes.AlreadyExists, errors.Cause(err).Error())
+	case *model.DBError:
+		return status.Error(codes.Internal, errors.Cause(err).Error())
+	default:
+		return status.Error(codes.Internal, OtherErr)
+	}
+}
@@ -0,0 +1,963 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// source: programming_lang.proto
+
+package pb
+
+import proto "github.com/golang/protobuf/proto"
+import fmt "fmt"
+import math "math"
+import empty "github.com/golang/protobuf/ptypes/empty"
+import timestamp "github.com/golang/protobuf/ptypes/timestamp"
+
+import (
+	context "golang.org/x/net/context"
+	grpc "google.golang.org/grpc"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.ProtoPackageIsVersion2 // please upgrade the proto package
+
+type WatchEvent_Type int32
+
+const (
+	WatchEvent_TYPE_UNSPECIFIED WatchEvent_Type = 0
+	WatchEvent_CREATED          WatchEvent_Type = 1
+	WatchEvent_UPDATED          WatchEvent_Type = 2
+	WatchEvent_DELETED          WatchEvent_Type = 3
+)
+
+var WatchEvent_Type_name = map[int32]string{
+	0: "TYPE_UNSPECIFIED",
+	1: "CREATED",
+	2: "UPDATED",
+	3: "DELETED",
+}
+var WatchEvent_Type_value = map[string]int32{
+	"TYPE_UNSPECIFIED": 0,
+	"CREATED":          1,
+	"UPDATED":          2,
+	"DELETED":          3,
+}
+
+func (x WatchEvent_Type) String() string {
+	return proto.EnumName(WatchEvent_Type_name, int32(x))
+}
+func (WatchEvent_Type) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_programming_lang_c5e577e005882357, []int{9, 0}
+}
+
+type ProgrammingLang struct {
+	Id                   int64                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	Name                 string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	Feature              string                 `protobuf:"bytes,3,opt,name=feature,proto3" json:"feature,omitempty"`
+	CreatedAt            *timestamp.Timestamp   `protobuf:"bytes,4,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	UpdatedAt            *timestamp.Timestamp   `protobuf:"bytes,5,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
+	Slug                 string                 `protobuf:"bytes,6,opt,name=slug,proto3" json:"slug,omitempty"`
+	Detail               *ProgrammingLangDetail `protobuf:"bytes,7,opt,name=detail,proto3" json:"detail,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}               `json:"-"`
+	XXX_unrecognized     []byte                 `json:"-"`
+	XXX_sizecache        int32                  `json:"-"`
+}
+
+func (m *ProgrammingLang) Reset()         { *m = ProgrammingLang{} }
+func (m *ProgrammingLang) String() string { return proto.CompactTextString(m) }
+func (*ProgrammingLang) ProtoMessage()    {}
+func (*ProgrammingLang) Descriptor() ([]byte, []int) {
+	return fileDescriptor_programming_lang_c5e577e005882357, []int{0}
+}
+func (m *ProgrammingLang) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ProgrammingLang.Unmarshal(m, b)
+}
+func (m *ProgrammingLang) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ProgrammingLang.Marshal(b, m, deterministic)
+}
+func (dst *ProgrammingLang) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ProgrammingLang.Merge(dst, src)
+}
+func (m *ProgrammingLang) XXX_Size() int {
+	return xxx_messageInfo_ProgrammingLang.Size(m)
+}
+func (m *ProgrammingLang) XXX_DiscardUnknown() {
+	xxx_messageInfo_ProgrammingLang.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ProgrammingLang proto.InternalMessageInfo
+
+func (m *ProgrammingLang) GetId() int64 {
+	if m != nil {
+		return m.Id
+	}
+	return 0
+}
+
+func (m *ProgrammingLang) GetName() string {
+	if m != nil {
+		return m.Name
+	}
+	return ""
+}
+
+func (m *ProgrammingLang) GetFeature() string {
+	if m != nil {
+		return m.Feature
+	}
+	return ""
+}
+
+func (m *ProgrammingLang) GetCreatedAt() *timestamp.Timestamp {
+	if m != nil {
+		return m.CreatedAt
+	}
+	return nil
+}
+
+func (m *ProgrammingLang) GetUpdatedAt() *timestamp.Timestamp {
+	if m != nil {
+		return m.UpdatedAt
+	}
+	return nil
+}
+
+func (m *ProgrammingLang) GetSlug() string {
+	if m != nil {
+		return m.Slug
+	}
+	return ""
+}
+
+func (m *ProgrammingLang) GetDetail() *ProgrammingLangDetail {
+	if m != nil {
+		return m.Detail
+	}
+	return nil
+}
+
+type ProgrammingLangDetail struct {
+	FirstAppeared        int32    `protobuf:"varint,1,opt,name=first_appeared,json=firstAppeared,proto3" json:"first_appeared,omitempty"`
+	Designers            []string `protobuf:"bytes,2,rep,name=designers,proto3" json:"designers,omitempty"`
+	TypeChecking         string   `protobuf:"bytes,3,opt,name=type_checking,json=typeChecking,proto3" json:"type_checking,omitempty"`
+	TypeStrength         string   `protobuf:"bytes,4,opt,name=type_strength,json=typeStrength,proto3" json:"type_strength,omitempty"`
+	Paradigms            []string `protobuf:"bytes,5,rep,name=paradigms,proto3" json:"paradigms,omitempty"`
+	License              string   `protobuf:"bytes,6,opt,name=license,proto3" json:"license,omitempty"`
+	Website              string   `protobuf:"bytes,7,opt,name=website,proto3" json:"website,omitempty"`
+	Extensions           []string `protobuf:"bytes,8,rep,name=extensions,proto3" json:"extensions,omitempty"`
+	StableVersion        string   `protobuf:"bytes,9,opt,name=stable_version,json=stableVersion,proto3" json:"stable_version,omitempty"`
+	Filenames            []string `protobuf:"bytes,10,rep,name=filenames,proto3" json:"filenames,omitempty"`
+	Interpreters         []string `protobuf:"bytes,11,rep,name=interpreters,proto3" json:"interpreters,omitempty"`
+	Aliases              []string `protobuf:"bytes,12,rep,name=aliases,proto3" json:"aliases,omitempty"`
+	Color                string   `protobuf:"bytes,13,opt,name=color,proto3" json:"color,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *ProgrammingLangDetail) Reset()         { *m = ProgrammingLangDetail{} }
+func (m *ProgrammingLangDetail) String() string { return proto.CompactTextString(m) }
+func (*ProgrammingLangDetail) ProtoMessage()    {}
+func (*ProgrammingLangDetail) Descriptor() ([]byte, []int) {
+	return fileDescriptor_programming_lang_c5e577e005882357, []int{1}
+}
+func (m *ProgrammingLangDetail) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ProgrammingLangDetail.Unmarshal(m, b)
+}
+func (m *ProgrammingLangDetail) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ProgrammingLangDetail.Marshal(b, m, deterministic)
+}
+func (dst *ProgrammingLangDetail) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ProgrammingLangDetail.Merge(dst, src)
+}
+func (m *ProgrammingLangDetail) XXX_Size() int {
+	return xxx_messageInfo_ProgrammingLangDetail.Size(m)
+}
+func (m *ProgrammingLangDetail) XXX_DiscardUnknown() {
+	xxx_messageInfo_ProgrammingLangDetail.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ProgrammingLangDetail proto.InternalMessageInfo
+
+func (m *ProgrammingLangDetail) GetFirstAppeared() int32 {
+	if m != nil {
+		return m.FirstAppeared
+	}
+	return 0
+}
+
+func (m *ProgrammingLangDetail) GetDesigners() []string {
+	if m != nil {
+		return m.Designers
+	}
+	return nil
+}
+
+func (m *ProgrammingLangDetail) GetTypeChecking() string {
+	if m != nil {
+		return m.TypeChecking
+	}
+	return ""
+}
+
+func (m *ProgrammingLangDetail) GetTypeStrength() string {
+	if m != nil {
+		return m.TypeStrength
+	}
+	return ""
+}
+
+func (m *ProgrammingLangDetail) GetParadigms() []string {
+	if m != nil {
+		return m.Paradigms
+	}
+	return nil
+}
+
+func (m *ProgrammingLangDetail) GetLicense() string {
+	if m != nil {
+		return m.License
+	}
+	return ""
+}
+
+func (m *ProgrammingLangDetail) GetWebsite() string {
+	if m != nil {
+		return m.Website
+	}
+	return ""
+}
+
+func (m *ProgrammingLangDetail) GetExtensions() []string {
+	if m != nil {
+		return m.Extensions
+	}
+	return nil
+}
+
+func (m *ProgrammingLangDetail) GetStableVersion() string {
+	if m != nil {
+		return m.StableVersion
+	}
+	return ""
+}
+
+func (m *ProgrammingLangDetail) GetFilenames() []string {
+	if m != nil {
+		return m.Filenames
+	}
+	return nil
+}
+
+func (m *ProgrammingLangDetail) GetInterpreters() []string {
+	if m != nil {
+		return m.Interpreters
+	}
+	return nil
+}
+
+func (m *ProgrammingLangDetail) GetAliases() []string {
+	if m != nil {
+		return m.Aliases
+	}
+	return nil
+}
+
+func (m *ProgrammingLangDetail) GetColor() string {
+	if m != nil {
+		return m.Color
+	}
+	return ""
+}
+
+type ListRequest struct {
+	Limit                int32    `protobuf:"varint,1,opt,name=limit,proto3" json:"limit,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *ListRequest) Reset()         { *m = ListRequest{} }
+func (m *ListRequest) String() string { return proto.CompactTextString(m) }
+func (*ListRequest) ProtoMessage()    {}
+func (*ListRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_programming_lang_c5e577e005882357, []int{2}
+}
+func (m *ListRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ListRequest.Unmarshal(m, b)
+}
+func (m *ListRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ListRequest.Marshal(b, m, deterministic)
+}
+func (dst *ListRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ListRequest.Merge(dst, src)
+}
+func (m *ListRequest) XXX_Size() int {
+	return xxx_messageInfo_ListRequest.Size(m)
+}
+func (m *ListRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_ListRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ListRequest proto.InternalMessageInfo
+
+func (m *ListRequest) GetLimit() int32 {
+	if m != nil {
+		return m.Limit
+	}
+	return 0
+}
+
+type ListResponse struct {
+	Langs                []*ProgrammingLang `protobuf:"bytes,1,rep,name=langs,proto3" json:"langs,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}           `json:"-"`
+	XXX_unrecognized     []byte             `json:"-"`
+	XXX_sizecache        int32              `json:"-"`
+}
+
+func (m *ListResponse) Reset()         { *m = ListResponse{} }
+func (m *ListResponse) String() string { return proto.CompactTextString(m) }
+func (*ListResponse) ProtoMessage()    {}
+func (*ListResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_programming_lang_c5e577e005882357, []int{3}
+}
+func (m *ListResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ListResponse.Unmarshal(m, b)
+}
+func (m *ListResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ListResponse.Marshal(b, m, deterministic)
+}
+func (dst *ListResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ListResponse.Merge(dst, src)
+}
+func (m *ListResponse) XXX_Size() int {
+	return xxx_messageInfo_ListResponse.Size(m)
+}
+func (m *ListResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_ListResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ListResponse proto.InternalMessageInfo
+
+func (m *ListResponse) GetLangs() []*ProgrammingLang {
+	if m != nil {
+		return m.Langs
+	}
+	return nil
+}
+
+type GetRequest struct {
+	Id                   int64    `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *GetRequest) Reset()         { *m = GetRequest{} }
+func (m *GetRequest) String() string { return proto.CompactTextString(m) }
+func (*GetRequest) ProtoMessage()    {}
+func (*GetRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_programming_lang_c5e577e005882357, []int{4}
+}
+func (m *GetRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_GetRequest.Unmarshal(m, b)
+}
+func (m *GetRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_GetRequest.Marshal(b, m, deterministic)
+}
+func (dst *GetRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetRequest.Merge(dst, src)
+}
+func (m *GetRequest) XXX_Size() int {
+	return xxx_messageInfo_GetRequest.Size(m)
+}
+func (m *GetRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GetRequest proto.InternalMessageInfo
+
+func (m *GetRequest) GetId() int64 {
+	if m != nil {
+		return m.Id
+	}
+	return 0
+}
+
+type CreateRequest struct {
+	Name                 string                 `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Feature              string                 `protobuf:"bytes,2,opt,name=feature,proto3" json:"feature,omitempty"`
+	Detail               *ProgrammingLangDetail `protobuf:"bytes,3,opt,name=detail,proto3" json:"detail,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}               `json:"-"`
+	XXX_unrecognized     []byte                 `json:"-"`
+	XXX_sizecache        int32                  `json:"-"`
+}
+
+func (m *CreateRequest) Reset()         { *m = CreateRequest{} }
+func (m *CreateRequest) String() string { return proto.CompactTextString(m) }
+func (*CreateRequest) ProtoMessage()    {}
+func (*CreateRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_programming_lang_c5e577e005882357, []int{5}
+}
+func (m *CreateRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_CreateRequest.Unmarshal(m, b)
+}
+func (m *CreateRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_CreateRequest.Marshal(b, m, deterministic)
+}
+func (dst *CreateRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_CreateRequest.Merge(dst, src)
+}
+func (m *CreateRequest) XXX_Size() int {
+	return xxx_messageInfo_CreateRequest.Size(m)
+}
+func (m *CreateRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_CreateRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_CreateRequest proto.InternalMessageInfo
+
+func (m *CreateRequest) GetName() string {
+	if m != nil {
+		return m.Name
+	}
+	return ""
+}
+
+func (m *CreateRequest) GetFeature() string {
+	if m != nil {
+		return m.Feature
+	}
+	return ""
+}
+
+func (m *CreateRequest) GetDetail() *ProgrammingLangDetail {
+	if m != nil {
+		return m.Detail
+	}
+	return nil
+}
+
+type UpdateRequest struct {
+	Id                   int64                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	Name                 string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	Feature              string                 `protobuf:"bytes,3,opt,name=feature,proto3" json:"feature,omitempty"`
+	Detail               *ProgrammingLangDetail `protobuf:"bytes,4,opt,name=detail,proto3" json:"detail,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}               `json:"-"`
+	XXX_unrecognized     []byte                 `json:"-"`
+	XXX_sizecache        int32                  `json:"-"`
+}
+
+func (m *UpdateRequest) Reset()         { *m = UpdateRequest{} }
+func (m *UpdateRequest) String() string { return proto.CompactTextString(m) }
+func (*UpdateRequest) ProtoMessage()    {}
+func (*UpdateRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_programming_lang_c5e577e005882357, []int{6}
+}
+func (m *UpdateRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_UpdateRequest.Unmarshal(m, b)
+}
+func (m *UpdateRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_UpdateRequest.Marshal(b, m, deterministic)
+}
+func (dst *UpdateRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_UpdateRequest.Merge(dst, src)
+}
+func (m *UpdateRequest) XXX_Size() int {
+	return xxx_messageInfo_UpdateRequest.Size(m)
+}
+func (m *UpdateRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_UpdateRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_UpdateRequest proto.InternalMessageInfo
+
+func (m *UpdateRequest) GetId() int64 {
+	if m != nil {
+		return m.Id
+	}
+	return 0
+}
+
+func (m *UpdateRequest) GetName() string {
+	if m != nil {
+		return m.Name
+	}
+	return ""
+}
+
+func (m *UpdateRequest) GetFeature() string {
+	if m != nil {
+		return m.Feature
+	}
+	return ""
+}
+
+func (m *UpdateRequest) GetDetail() *ProgrammingLangDetail {
+	if m != nil {
+		return m.Detail
+	}
+	return nil
+}
+
+type DeleteRequest struct {
+	Id                   int64    `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *DeleteRequest) Reset()         { *m = DeleteRequest{} }
+func (m *DeleteRequest) String() string { return proto.CompactTextString(m) }
+func (*DeleteRequest) ProtoMessage()    {}
+func (*DeleteRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_programming_lang_c5e577e005882357, []int{7}
+}
+func (m *DeleteRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_DeleteRequest.Unmarshal(m, b)
+}
+func (m *DeleteRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_DeleteRequest.Marshal(b, m, deterministic)
+}
+func (dst *DeleteRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DeleteRequest.Merge(dst, src)
+}
+func (m *DeleteRequest) XXX_Size() int {
+	return xxx_messageInfo_DeleteRequest.Size(m)
+}
+func (m *DeleteRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_DeleteRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_DeleteRequest proto.InternalMessageInfo
+
+func (m *DeleteRequest) GetId() int64 {
+	if m != nil {
+		return m.Id
+	}
+	return 0
+}
+
+type WatchRequest struct {
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *WatchRequest) Reset()         { *m = WatchRequest{} }
+func (m *WatchRequest) String() string { return proto.CompactTextString(m) }
+func (*WatchRequest) ProtoMessage()    {}
+func (*WatchRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_programming_lang_c5e577e005882357, []int{8}
+}
+func (m *WatchRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_WatchRequest.Unmarshal(m, b)
+}
+func (m *WatchRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_WatchRequest.Marshal(b, m, deterministic)
+}
+func (dst *WatchRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_WatchRequest.Merge(dst, src)
+}
+func (m *WatchRequest) XXX_Size() int {
+	return xxx_messageInfo_WatchRequest.Size(m)
+}
+func (m *WatchRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_WatchRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_WatchRequest proto.InternalMessageInfo
+
+type WatchEvent struct {
+	Type                 WatchEvent_Type      `protobuf:"varint,1,opt,name=type,proto3,enum=pb.WatchEvent_Type" json:"type,omitempty"`
+	Lang                 *ProgrammingLang     `protobuf:"bytes,2,opt,name=lang,proto3" json:"lang,omitempty"`
+	OccurredAt           *timestamp.Timestamp `protobuf:"bytes,3,opt,name=occurred_at,json=occurredAt,proto3" json:"occurred_at,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}             `json:"-"`
+	XXX_unrecognized     []byte               `json:"-"`
+	XXX_sizecache        int32                `json:"-"`
+}
+
+func (m *WatchEvent) Reset()         { *m = WatchEvent{} }
+func (m *WatchEvent) String() string { return proto.CompactTextString(m) }
+func (*WatchEvent) ProtoMessage()    {}
+func (*WatchEvent) Descriptor() ([]byte, []int) {
+	return fileDescriptor_programming_lang_c5e577e005882357, []int{9}
+}
+func (m *WatchEvent) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_WatchEvent.Unmarshal(m, b)
+}
+func (m *WatchEvent) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_WatchEvent.Marshal(b, m, deterministic)
+}
+func (dst *WatchEvent) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_WatchEvent.Merge(dst, src)
+}
+func (m *WatchEvent) XXX_Size() int {
+	return xxx_messageInfo_WatchEvent.Size(m)
+}
+func (m *WatchEvent) XXX_DiscardUnknown() {
+	xxx_messageInfo_WatchEvent.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_WatchEvent proto.InternalMessageInfo
+
+func (m *WatchEvent) GetType() WatchEvent_Type {
+	if m != nil {
+		return m.Type
+	}
+	return WatchEvent_TYPE_UNSPECIFIED
+}
+
+func (m *WatchEvent) GetLang() *ProgrammingLang {
+	if m != nil {
+		return m.Lang
+	}
+	return nil
+}
+
+func (m *WatchEvent) GetOccurredAt() *timestamp.Timestamp {
+	if m != nil {
+		return m.OccurredAt
+	}
+	return nil
+}
+
+func init() {
+	proto.RegisterType((*ProgrammingLang)(nil), "pb.ProgrammingLang")
+	proto.RegisterType((*ProgrammingLangDetail)(nil), "pb.ProgrammingLangDetail")
+	proto.RegisterType((*ListRequest)(nil), "pb.ListRequest")
+	proto.RegisterType((*ListResponse)(nil), "pb.ListResponse")
+	proto.RegisterType((*GetRequest)(nil), "pb.GetRequest")
+	proto.RegisterType((*CreateRequest)(nil), "pb.CreateRequest")
+	proto.RegisterType((*UpdateRequest)(nil), "pb.UpdateRequest")
+	proto.RegisterType((*DeleteRequest)(nil), "pb.DeleteRequest")
+	proto.RegisterType((*WatchRequest)(nil), "pb.WatchRequest")
+	proto.RegisterType((*WatchEvent)(nil), "pb.WatchEvent")
+	proto.RegisterEnum("pb.WatchEvent_Type", WatchEvent_Type_name, WatchEvent_Type_value)
+}
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ context.Context
+var _ grpc.ClientConn
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+const _ = grpc.SupportPackageIsVersion4
+
+// ProgrammingLangServiceClient is the client API for ProgrammingLangService service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
+type ProgrammingLangServiceClient interface {
+	List(ctx context.Context, in *ListRequest, opts ...grpc.CallOption) (*ListResponse, error)
+	Get(ctx context.Context, in *GetRequest, opts ...grpc.CallOption) (*ProgrammingLang, error)
+	Create(ctx context.Context, in *CreateRequest, opts ...grpc.CallOption) (*ProgrammingLang, error)
+	Update(ctx context.Context, in *UpdateRequest, opts ...grpc.CallOption) (*ProgrammingLang, error)
+	Delete(ctx context.Context, in *DeleteRequest, opts ...grpc.CallOption) (*empty.Empty, error)
+	Watch(ctx context.Context, in *WatchRequest, opts ...grpc.CallOption) (ProgrammingLangService_WatchClient, error)
+}
+
+type programmingLangServiceClient struct {
+	cc *grpc.ClientConn
+}
+
+func NewProgrammingLangServiceClient(cc *grpc.ClientConn) ProgrammingLangServiceClient {
+	return &programmingLangServiceClient{cc}
+}
+
+func (c *programmingLangServiceClient) List(ctx context.Context, in *ListRequest, opts ...grpc.CallOption) (*ListResponse, error) {
+	out := new(ListResponse)
+	err := c.cc.Invoke(ctx, "/pb.ProgrammingLangService/List", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *programmingLangServiceClient) Get(ctx context.Context, in *GetRequest, opts ...grpc.CallOption) (*ProgrammingLang, error) {
+	out := new(ProgrammingLang)
+	err := c.cc.Invoke(ctx, "/pb.ProgrammingLangService/Get", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *programmingLangServiceClient) Create(ctx context.Context, in *CreateRequest, opts ...grpc.CallOption) (*ProgrammingLang, error) {
+	out := new(ProgrammingLang)
+	err := c.cc.Invoke(ctx, "/pb.ProgrammingLangService/Create", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *programmingLangServiceClient) Update(ctx context.Context, in *UpdateRequest, opts ...grpc.CallOption) (*ProgrammingLang, error) {
+	out := new(ProgrammingLang)
+	err := c.cc.Invoke(ctx, "/pb.ProgrammingLangService/Update", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *programmingLangServiceClient) Delete(ctx context.Context, in *DeleteRequest, opts ...grpc.CallOption) (*empty.Empty, error) {
+	out := new(empty.Empty)
+	err := c.cc.Invoke(ctx, "/pb.ProgrammingLangService/Delete", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *programmingLangServiceClient) Watch(ctx context.Context, in *WatchRequest, opts ...grpc.CallOption) (ProgrammingLangService_WatchClient, error) {
+	stream, err := c.cc.NewStream(ctx, &_ProgrammingLangService_serviceDesc.Streams[0], "/pb.ProgrammingLangService/Watch", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &programmingLangServiceWatchClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type ProgrammingLangService_WatchClient interface {
+	Recv() (*WatchEvent, error)
+	grpc.ClientStream
+}
+
+type programmingLangServiceWatchClient struct {
+	grpc.ClientStream
+}
+
+func (x *programmingLangServiceWatchClient) Recv() (*WatchEvent, error) {
+	m := new(WatchEvent)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+// ProgrammingLangServiceServer is the server API for ProgrammingLangService service.
+type ProgrammingLangServiceServer interface {
+	List(context.Context, *ListRequest) (*ListResponse, error)
+	Get(context.Context, *GetRequest) (*ProgrammingLang, error)
+	Create(context.Context, *CreateRequest) (*ProgrammingLang, error)
+	Update(context.Context, *UpdateRequest) (*ProgrammingLang, error)
+	Delete(context.Context, *DeleteRequest) (*empty.Empty, error)
+	Watch(*WatchRequest, ProgrammingLangService_WatchServer) error
+}
+
+func RegisterProgrammingLangServiceServer(s *grpc.Server, srv ProgrammingLangServiceServer) {
+	s.RegisterService(&_ProgrammingLangService_serviceDesc, srv)
+}
+
+func _ProgrammingLangService_List_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ProgrammingLangServiceServer).List(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/pb.ProgrammingLangService/List",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ProgrammingLangServiceServer).List(ctx, req.(*ListRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ProgrammingLangService_Get_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ProgrammingLangServiceServer).Get(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/pb.ProgrammingLangService/Get",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ProgrammingLangServiceServer).Get(ctx, req.(*GetRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ProgrammingLangService_Create_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ProgrammingLangServiceServer).Create(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/pb.ProgrammingLangService/Create",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ProgrammingLangServiceServer).Create(ctx, req.(*CreateRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ProgrammingLangService_Update_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ProgrammingLangServiceServer).Update(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/pb.ProgrammingLangService/Update",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ProgrammingLangServiceServer).Update(ctx, req.(*UpdateRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ProgrammingLangService_Delete_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ProgrammingLangServiceServer).Delete(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/pb.ProgrammingLangService/Delete",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ProgrammingLangServiceServer).Delete(ctx, req.(*DeleteRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ProgrammingLangService_Watch_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(WatchRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(ProgrammingLangServiceServer).Watch(m, &programmingLangServiceWatchServer{stream})
+}
+
+type ProgrammingLangService_WatchServer interface {
+	Send(*WatchEvent) error
+	grpc.ServerStream
+}
+
+type programmingLangServiceWatchServer struct {
+	grpc.ServerStream
+}
+
+func (x *programmingLangServiceWatchServer) Send(m *WatchEvent) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+var _ProgrammingLangService_serviceDesc = grpc.ServiceDesc{
+	ServiceName: "pb.ProgrammingLangService",
+	HandlerType: (*ProgrammingLangServiceServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "List",
+			Handler:    _ProgrammingLangService_List_Handler,
+		},
+		{
+			MethodName: "Get",
+			Handler:    _ProgrammingLangService_Get_Handler,
+		},
+		{
+			MethodName: "Create",
+			Handler:    _ProgrammingLangService_Create_Handler,
+		},
+		{
+			MethodName: "Update",
+			Handler:    _ProgrammingLangService_Update_Handler,
+		},
+		{
+			MethodName: "Delete",
+			Handler:    _ProgrammingLangService_Delete_Handler,
+		},
+	},
+	Streams: []grpc.StreamDesc{
+		{
+			StreamName:    "Watch",
+			Handler:       _ProgrammingLangService_Watch_Handler,
+			ServerStreams: true,
+		},
+	},
+	Metadata: "programming_lang.proto",
+}
+
+func init() {
+	proto.RegisterFile("programming_lang.proto", fileDescriptor_programming_lang_c5e577e005882357)
+}
+
+var fileDescriptor_programming_lang_c5e577e005882357 = []byte{
+	// 779 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xa4, 0x55, 0xdd, 0x6e, 0x23, 0x35,
+	0x14, 0x66, 0x66, 0x92, 0x94, 0x9c, 0xfc, 0x6c, 0x30, 0x4b, 0x65, 0xc2, 0x8a, 0x8d, 0x66, 0x85,
+	0x08, 0x20, 0x65, 0x97, 0xee, 0xd5, 0x8a, 0xab, 0x90, 0x0c, 0xab, 0x95, 0x2a, 0x54, 0x4d, 0x5b,
+	0x10, 0xdc, 0x44, 0xce, 0xe4, 0x74, 0x6a, 0x31, 0x3f, 0xc6, 0x76, 0x0a, 0xbd, 0xe3, 0x01, 0x78,
+	0x19, 0x2e, 0x78, 0x1f, 0x1e, 0x05, 0xd9, 0x9e, 0xc9, 0x34, 0x25, 0xd5, 0xae, 0xc4, 0x5d, 0xce,
+	0x77, 0xbe, 0x63, 0x7f, 0x3e, 0xe7, 0x3b, 0x13, 0x38, 0x16, 0xb2, 0x4c, 0x25, 0xcb, 0x73, 0x5e,
+	0xa4, 0xab, 0x8c, 0x15, 0xe9, 0x4c, 0xc8, 0x52, 0x97, 0xc4, 0x17, 0xeb, 0xf1, 0x27, 0x69, 0x59,
+	0xa6, 0x19, 0x3e, 0xb7, 0xc8, 0x7a, 0x7b, 0xf5, 0x1c, 0x73, 0xa1, 0x6f, 0x1d, 0x61, 0xfc, 0xf4,
+	0x7e, 0x52, 0xf3, 0x1c, 0x95, 0x66, 0xb9, 0x70, 0x84, 0xf0, 0x4f, 0x1f, 0x1e, 0x9d, 0x35, 0x87,
+	0x9f, 0xb2, 0x22, 0x25, 0x43, 0xf0, 0xf9, 0x86, 0x7a, 0x13, 0x6f, 0x1a, 0xc4, 0x3e, 0xdf, 0x10,
+	0x02, 0xad, 0x82, 0xe5, 0x48, 0xfd, 0x89, 0x37, 0xed, 0xc6, 0xf6, 0x37, 0xa1, 0x70, 0x74, 0x85,
+	0x4c, 0x6f, 0x25, 0xd2, 0xc0, 0xc2, 0x75, 0x48, 0x5e, 0x01, 0x24, 0x12, 0x99, 0xc6, 0xcd, 0x8a,
+	0x69, 0xda, 0x9a, 0x78, 0xd3, 0xde, 0xc9, 0x78, 0xe6, 0x74, 0xcc, 0x6a, 0x1d, 0xb3, 0x8b, 0x5a,
+	0x47, 0xdc, 0xad, 0xd8, 0x73, 0x6d, 0x4a, 0xb7, 0x62, 0x53, 0x97, 0xb6, 0xdf, 0x5e, 0x5a, 0xb1,
+	0xe7, 0xda, 0x68, 0x54, 0xd9, 0x36, 0xa5, 0x1d, 0xa7, 0xd1, 0xfc, 0x26, 0x5f, 0x43, 0x67, 0x83,
+	0x9a, 0xf1, 0x8c, 0x1e, 0xd9, 0xa3, 0x3e, 0x9e, 0x89, 0xf5, 0xec, 0xde, 0x63, 0x97, 0x96, 0x10,
+	0x57, 0xc4, 0xf0, 0xaf, 0x00, 0x3e, 0x3a, 0xc8, 0x20, 0x9f, 0xc1, 0xf0, 0x8a, 0x4b, 0xa5, 0x57,
+	0x4c, 0x08, 0x64, 0x12, 0x5d, 0x83, 0xda, 0xf1, 0xc0, 0xa2, 0xf3, 0x0a, 0x24, 0x4f, 0xa0, 0xbb,
+	0x41, 0xc5, 0xd3, 0x02, 0xa5, 0xa2, 0xfe, 0x24, 0x98, 0x76, 0xe3, 0x06, 0x20, 0xcf, 0x60, 0xa0,
+	0x6f, 0x05, 0xae, 0x92, 0x6b, 0x4c, 0x7e, 0xe1, 0x45, 0x5a, 0xf5, 0xae, 0x6f, 0xc0, 0x45, 0x85,
+	0xed, 0x48, 0x4a, 0x4b, 0x2c, 0x52, 0x7d, 0x4d, 0x5b, 0x0d, 0xe9, 0xbc, 0xc2, 0xcc, 0x3d, 0x82,
+	0x49, 0xb6, 0xe1, 0x69, 0xae, 0x68, 0xdb, 0xdd, 0xb3, 0x03, 0xcc, 0x74, 0x32, 0x9e, 0x60, 0xa1,
+	0xb0, 0x6a, 0x48, 0x1d, 0x9a, 0xcc, 0x6f, 0xb8, 0x56, 0x5c, 0xa3, 0x6d, 0x4a, 0x37, 0xae, 0x43,
+	0xf2, 0x29, 0x00, 0xfe, 0xae, 0xb1, 0x50, 0xbc, 0x2c, 0x14, 0x7d, 0xdf, 0x1e, 0x79, 0x07, 0x31,
+	0x0d, 0x50, 0x9a, 0xad, 0x33, 0x5c, 0xdd, 0xa0, 0x34, 0x10, 0xed, 0xda, 0x03, 0x06, 0x0e, 0xfd,
+	0xc1, 0x81, 0x46, 0xd8, 0x15, 0xcf, 0xd0, 0x98, 0x44, 0x51, 0x70, 0xc2, 0x76, 0x00, 0x09, 0xa1,
+	0xcf, 0x0b, 0x8d, 0x52, 0x48, 0xd4, 0xa6, 0x43, 0x3d, 0x4b, 0xd8, 0xc3, 0x8c, 0x44, 0x96, 0x71,
+	0xa6, 0x50, 0xd1, 0xbe, 0x4d, 0xd7, 0x21, 0x79, 0x0c, 0xed, 0xa4, 0xcc, 0x4a, 0x49, 0x07, 0xf6,
+	0x66, 0x17, 0x84, 0xcf, 0xa0, 0x77, 0xca, 0x95, 0x8e, 0xf1, 0xd7, 0x2d, 0x2a, 0x6d, 0x48, 0x19,
+	0xcf, 0xb9, 0xae, 0xe6, 0xe3, 0x82, 0xf0, 0x15, 0xf4, 0x1d, 0x49, 0x89, 0xd2, 0xf4, 0xe1, 0x0b,
+	0x68, 0x9b, 0x3d, 0x52, 0xd4, 0x9b, 0x04, 0xd3, 0xde, 0xc9, 0x87, 0x07, 0xac, 0x11, 0x3b, 0x46,
+	0xf8, 0x04, 0xe0, 0x35, 0xee, 0x8e, 0xbf, 0xb7, 0x1c, 0xa1, 0x80, 0xc1, 0xc2, 0x1a, 0xb8, 0x26,
+	0xd4, 0xdb, 0xe2, 0x1d, 0xde, 0x16, 0x7f, 0x7f, 0x5b, 0x1a, 0x8f, 0x06, 0xef, 0xea, 0xd1, 0x3f,
+	0x3c, 0x18, 0x5c, 0x8a, 0xcd, 0x9d, 0x2b, 0xff, 0xdf, 0xc2, 0x36, 0x12, 0x5a, 0xef, 0x2a, 0xe1,
+	0x29, 0x0c, 0x96, 0x98, 0xe1, 0x83, 0x0a, 0xc2, 0x21, 0xf4, 0x7f, 0x64, 0x3a, 0xb9, 0xae, 0xf2,
+	0xe1, 0x3f, 0x1e, 0x80, 0x05, 0xa2, 0x1b, 0x2c, 0x34, 0xf9, 0x1c, 0x5a, 0xc6, 0xcd, 0xb6, 0x60,
+	0xe8, 0x9a, 0xdf, 0x64, 0x67, 0x17, 0xb7, 0x02, 0x63, 0x4b, 0x30, 0x44, 0x33, 0x04, 0xfb, 0x92,
+	0x07, 0xa6, 0x64, 0x09, 0xe4, 0x1b, 0xe8, 0x95, 0x49, 0xb2, 0x95, 0xd2, 0x7d, 0x3b, 0x82, 0xb7,
+	0x7e, 0x3b, 0xa0, 0xa6, 0xcf, 0x75, 0xb8, 0x80, 0x96, 0xb9, 0x93, 0x3c, 0x86, 0xd1, 0xc5, 0x4f,
+	0x67, 0xd1, 0xea, 0xf2, 0xfb, 0xf3, 0xb3, 0x68, 0xf1, 0xe6, 0xbb, 0x37, 0xd1, 0x72, 0xf4, 0x1e,
+	0xe9, 0xc1, 0xd1, 0x22, 0x8e, 0xe6, 0x17, 0xd1, 0x72, 0xe4, 0x99, 0xe0, 0xf2, 0x6c, 0x69, 0x03,
+	0xdf, 0x04, 0xcb, 0xe8, 0x34, 0x32, 0x41, 0x70, 0xf2, 0xb7, 0x0f, 0xc7, 0xf7, 0xb4, 0x9d, 0xa3,
+	0xbc, 0xe1, 0x89, 0x31, 0x5b, 0xcb, 0x98, 0x8f, 0x3c, 0x32, 0xfa, 0xef, 0x78, 0x75, 0x3c, 0x6a,
+	0x80, 0xca, 0x97, 0x5f, 0x42, 0xf0, 0x1a, 0x35, 0x19, 0x9a, 0x44, 0xe3, 0xba, 0xf1, 0xa1, 0x97,
+	0x93, 0x17, 0xd0, 0x71, 0xd6, 0x23, 0x1f, 0x98, 0xf4, 0x9e, 0x0d, 0x1f, 0xac, 0x70, 0xce, 0x71,
+	0x15, 0x7b, 0x2e, 0x3a, 0x5c, 0xf1, 0x12, 0x3a, 0x6e, 0xd2, 0xae, 0x62, 0x6f, 0xea, 0xe3, 0xe3,
+	0xff, 0xf4, 0x37, 0x32, 0xff, 0x3d, 0xe4, 0x2b, 0x68, 0xdb, 0x71, 0x92, 0xd1, 0x6e, 0xb2, 0x75,
+	0xc9, 0x70, 0x7f, 0xd6, 0x2f, 0xbc, 0x6f, 0x5b, 0x3f, 0xfb, 0x62, 0xbd, 0xee, 0xd8, 0x23, 0x5e,
+	0xfe, 0x3b, 0x00, 0xb8, 0x55, 0x6d, 0x19, 0xea, 0x06, 0x00, 0x00,
+}
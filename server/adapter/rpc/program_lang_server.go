@@ -0,0 +1,123 @@
+package rpc
+
+import (
+	"context"
+
+	"github.com/SekiguchiKai/clean-architecture-with-go/server/adapter/api"
+	"github.com/SekiguchiKai/clean-architecture-with-go/server/adapter/rpc/pb"
+	"github.com/SekiguchiKai/clean-architecture-with-go/server/domain/model"
+	"github.com/SekiguchiKai/clean-architecture-with-go/server/usecase/input"
+	"github.com/golang/protobuf/ptypes/empty"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// エラーの定数。
+const (
+	WatchClosedErr = "watch stream was closed because the client could not keep up"
+)
+
+// ProgrammingLangServer は、ProgrammingLangのgRPCのServer。
+type ProgrammingLangServer struct {
+	UseCase input.ProgrammingLangInputPort
+}
+
+// NewProgrammingLangServer は、ProgrammingLangServerを生成し、返す。
+func NewProgrammingLangServer(useCase input.ProgrammingLangInputPort) *ProgrammingLangServer {
+	return &ProgrammingLangServer{
+		UseCase: useCase,
+	}
+}
+
+// Register は、gRPCのServerにServiceを登録する。
+func (s *ProgrammingLangServer) Register(g *grpc.Server) {
+	pb.RegisterProgrammingLangServiceServer(g, s)
+}
+
+// List は、ProgrammingLangの一覧を返す。
+func (s *ProgrammingLangServer) List(ctx context.Context, req *pb.ListRequest) (*pb.ListResponse, error) {
+	limit := api.ManageLimit(int(req.Limit), api.MaxLimit, api.MinLimit, api.DefaultLimit)
+
+	langSlice, err := s.UseCase.List(ctx, limit)
+	if err != nil {
+		return nil, handleError(err)
+	}
+
+	return &pb.ListResponse{
+		Langs: toPBLangs(langSlice),
+	}, nil
+}
+
+// Get は、ProgrammingLangを取得する。
+func (s *ProgrammingLangServer) Get(ctx context.Context, req *pb.GetRequest) (*pb.ProgrammingLang, error) {
+	lang, err := s.UseCase.Get(ctx, int(req.Id))
+	if err != nil {
+		return nil, handleError(err)
+	}
+
+	return toPBLang(lang), nil
+}
+
+// Create は、ProgrammingLangを生成する。
+func (s *ProgrammingLangServer) Create(ctx context.Context, req *pb.CreateRequest) (*pb.ProgrammingLang, error) {
+	param := fromPBDetail(req.Detail, &model.ProgrammingLang{
+		Name:    req.Name,
+		Feature: req.Feature,
+	})
+
+	lang, err := s.UseCase.Create(ctx, input.NewProgrammingLangCreate(param))
+	if err != nil {
+		return nil, handleError(err)
+	}
+
+	return toPBLang(lang), nil
+}
+
+// Update は、ProgrammingLangを更新する。
+func (s *ProgrammingLangServer) Update(ctx context.Context, req *pb.UpdateRequest) (*pb.ProgrammingLang, error) {
+	param := fromPBUpdate(req)
+
+	lang, err := s.UseCase.Update(ctx, int(req.Id), param)
+	if err != nil {
+		return nil, handleError(err)
+	}
+
+	return toPBLang(lang), nil
+}
+
+// Delete は、ProgrammingLangを削除する。
+func (s *ProgrammingLangServer) Delete(ctx context.Context, req *pb.DeleteRequest) (*empty.Empty, error) {
+	if err := s.UseCase.Delete(ctx, int(req.Id)); err != nil {
+		return nil, handleError(err)
+	}
+
+	return &empty.Empty{}, nil
+}
+
+// Watch は、ProgrammingLangの変更をクライアントが切断するまで配信する。
+func (s *ProgrammingLangServer) Watch(req *pb.WatchRequest, stream pb.ProgrammingLangService_WatchServer) error {
+	ctx := stream.Context()
+
+	events, err := s.UseCase.Watch(ctx)
+	if err != nil {
+		return handleError(err)
+	}
+
+	for {
+		select {
+		case <-ctx.Done():
+			return nil
+		case event, ok := <-events:
+			if !ok {
+				if ctx.Err() != nil {
+					return nil
+				}
+				return status.Error(codes.ResourceExhausted, WatchClosedErr)
+			}
+			if err := stream.Send(toPBEvent(event)); err != nil {
+				return err
+			}
+		}
+	}
+}
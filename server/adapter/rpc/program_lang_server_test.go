@@ -0,0 +1,314 @@
+package rpc_test
+
+import (
+	"context"
+	"reflect"
+	"testing"
+	"time"
+
+	"github.com/SekiguchiKai/clean-architecture-with-go/server/adapter/api"
+	"github.com/SekiguchiKai/clean-architecture-with-go/server/adapter/rpc"
+	"github.com/SekiguchiKai/clean-architecture-with-go/server/adapter/rpc/pb"
+	"github.com/SekiguchiKai/clean-architecture-with-go/server/domain/model"
+	"github.com/SekiguchiKai/clean-architecture-with-go/server/usecase/input"
+	"github.com/SekiguchiKai/clean-architecture-with-go/server/usecase/mock"
+	"github.com/golang/mock/gomock"
+	"github.com/golang/protobuf/ptypes"
+	"github.com/golang/protobuf/ptypes/timestamp"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// watchStream は、テスト用のProgrammingLangService_WatchServer。
+type watchStream struct {
+	grpc.ServerStream
+	ctx    context.Context
+	sent   []*pb.WatchEvent
+	onSend func(sent int)
+}
+
+// Context は、Streamのcontextを返す。
+func (s *watchStream) Context() context.Context {
+	return s.ctx
+}
+
+// Send は、送信したイベントを記録する。
+func (s *watchStream) Send(event *pb.WatchEvent) error {
+	s.sent = append(s.sent, event)
+	if s.onSend != nil {
+		s.onSend(len(s.sent))
+	}
+	return nil
+}
+
+// testTimestamp は、テスト用にtime.Timeをtimestamp.Timestampに変換する。
+func testTimestamp(t *testing.T, tm time.Time) *timestamp.Timestamp {
+	ts, err := ptypes.TimestampProto(tm)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return ts
+}
+
+func TestProgrammingLangServer_Get(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	u := mock_input.NewMockProgrammingLangInputPort(ctrl)
+	s := rpc.NewProgrammingLangServer(u)
+
+	lang := model.CreateProgrammingLangs(1)[0]
+
+	tests := []struct {
+		name     string
+		id       int
+		result   *model.ProgrammingLang
+		err      error
+		want     *pb.ProgrammingLang
+		wantCode codes.Code
+	}{
+		{
+			name:   "IDで指定したProgrammingLangが存在する場合、ProgrammingLangを返すこと",
+			id:     1,
+			result: lang,
+			want: &pb.ProgrammingLang{
+				Id:        int64(lang.ID),
+				Name:      lang.Name,
+				Feature:   lang.Feature,
+				Slug:      lang.Slug,
+				Detail:    &pb.ProgrammingLangDetail{},
+				CreatedAt: testTimestamp(t, lang.CreatedAt),
+				UpdatedAt: testTimestamp(t, lang.UpdatedAt),
+			},
+			wantCode: codes.OK,
+		},
+		{
+			name: "IDで指定したProgrammingLangが存在しない場合、NotFoundを返すこと",
+			id:   100,
+			err: &model.NoSuchDataError{
+				ID:        100,
+				ModelName: model.ModelNameProgrammingLang,
+			},
+			wantCode: codes.NotFound,
+		},
+		{
+			name: "DBのエラーが発生した場合、Internalを返すこと",
+			id:   1,
+			err: &model.DBError{
+				ModelName: model.ModelNameProgrammingLang,
+				DBMethod:  model.DBMethodRead,
+				Detail:    model.TestDBSomeErr,
+			},
+			wantCode: codes.Internal,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			u.EXPECT().Get(context.Background(), tt.id).Return(tt.result, tt.err)
+
+			got, err := s.Get(context.Background(), &pb.GetRequest{Id: int64(tt.id)})
+			if code := status.Code(err); code != tt.wantCode {
+				t.Errorf("ProgrammingLangServer.Get() code = %v, want %v", code, tt.wantCode)
+			}
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("ProgrammingLangServer.Get() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestProgrammingLangServer_Create(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	u := mock_input.NewMockProgrammingLangInputPort(ctrl)
+	s := rpc.NewProgrammingLangServer(u)
+
+	param := &model.ProgrammingLang{
+		Name:    model.TestName,
+		Feature: model.TestFeature,
+	}
+
+	tests := []struct {
+		name      string
+		detail    *pb.ProgrammingLangDetail
+		wantParam *model.ProgrammingLang
+		err       error
+		wantCode  codes.Code
+	}{
+		{
+			name:      "ProgrammingLangが存在しない場合、ProgrammingLangを生成すること",
+			wantParam: param,
+			wantCode:  codes.OK,
+		},
+		{
+			name: "詳細な属性を指定した場合、詳細な属性を含むProgrammingLangを生成すること",
+			detail: &pb.ProgrammingLangDetail{
+				FirstAppeared: 2009,
+				TypeChecking:  string(model.TypeCheckingStatic),
+				Paradigms:     []string{string(model.ParadigmConcurrent)},
+				Extensions:    []string{".go"},
+			},
+			wantParam: &model.ProgrammingLang{
+				Name:          model.TestName,
+				Feature:       model.TestFeature,
+				FirstAppeared: 2009,
+				TypeChecking:  model.TypeCheckingStatic,
+				Paradigms:     []model.Paradigm{model.ParadigmConcurrent},
+				Extensions:    []string{".go"},
+			},
+			wantCode: codes.OK,
+		},
+		{
+			name:      "ProgrammingLangが既に存在する場合、AlreadyExistsを返すこと",
+			wantParam: param,
+			err: &model.AlreadyExistError{
+				ID:        1,
+				Name:      model.TestName,
+				ModelName: model.ModelNameProgrammingLang,
+			},
+			wantCode: codes.AlreadyExists,
+		},
+		{
+			name:      "Nameが不適切な場合、InvalidArgumentを返すこと",
+			wantParam: param,
+			err: &model.InvalidPropertyError{
+				Property: model.PropertyName,
+				Message:  model.NameShouldBeMoreThanOneUnderTheTwenty,
+			},
+			wantCode: codes.InvalidArgument,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var result *model.ProgrammingLang
+			if tt.err == nil {
+				result = &model.ProgrammingLang{ID: 1, Name: param.Name, Feature: param.Feature}
+			}
+			u.EXPECT().Create(context.Background(), input.NewProgrammingLangCreate(tt.wantParam)).Return(result, tt.err)
+
+			got, err := s.Create(context.Background(), &pb.CreateRequest{Name: param.Name, Feature: param.Feature, Detail: tt.detail})
+			if code := status.Code(err); code != tt.wantCode {
+				t.Errorf("ProgrammingLangServer.Create() code = %v, want %v", code, tt.wantCode)
+			}
+			if tt.err == nil && (got == nil || got.Id != 1) {
+				t.Errorf("ProgrammingLangServer.Create() = %v, want id 1", got)
+			}
+		})
+	}
+}
+
+func TestProgrammingLangServer_List(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	u := mock_input.NewMockProgrammingLangInputPort(ctrl)
+	s := rpc.NewProgrammingLangServer(u)
+
+	tests := []struct {
+		name      string
+		limit     int32
+		wantLimit int
+	}{
+		{
+			name:      "limitが指定された場合、そのlimitで一覧を取得すること",
+			limit:     10,
+			wantLimit: 10,
+		},
+		{
+			name:      "limitが指定されない場合、デフォルトのlimitで一覧を取得すること",
+			limit:     0,
+			wantLimit: api.DefaultLimit,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			u.EXPECT().List(context.Background(), tt.wantLimit).Return(model.CreateProgrammingLangs(tt.wantLimit), nil)
+
+			got, err := s.List(context.Background(), &pb.ListRequest{Limit: tt.limit})
+			if err != nil {
+				t.Fatal(err)
+			}
+			if len(got.Langs) != tt.wantLimit {
+				t.Errorf("ProgrammingLangServer.List() len = %v, want %v", len(got.Langs), tt.wantLimit)
+			}
+		})
+	}
+}
+
+func TestProgrammingLangServer_Watch(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	u := mock_input.NewMockProgrammingLangInputPort(ctrl)
+	s := rpc.NewProgrammingLangServer(u)
+
+	lang := model.CreateProgrammingLangs(1)[0]
+
+	tests := []struct {
+		name     string
+		events   []*model.ProgrammingLangEvent
+		cancel   bool
+		want     []pb.WatchEvent_Type
+		wantCode codes.Code
+	}{
+		{
+			name: "クライアントが切断した場合、それまでの変更を配信して終了すること",
+			events: []*model.ProgrammingLangEvent{
+				{Type: model.EventTypeCreated, Lang: lang},
+				{Type: model.EventTypeDeleted, Lang: lang},
+			},
+			cancel:   true,
+			want:     []pb.WatchEvent_Type{pb.WatchEvent_CREATED, pb.WatchEvent_DELETED},
+			wantCode: codes.OK,
+		},
+		{
+			name: "受信が追いつかずに購読が解除された場合、ResourceExhaustedを返すこと",
+			events: []*model.ProgrammingLangEvent{
+				{Type: model.EventTypeUpdated, Lang: lang},
+			},
+			cancel:   false,
+			want:     []pb.WatchEvent_Type{pb.WatchEvent_UPDATED},
+			wantCode: codes.ResourceExhausted,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctx, cancel := context.WithCancel(context.Background())
+			defer cancel()
+
+			ch := make(chan *model.ProgrammingLangEvent, len(tt.events))
+			for _, e := range tt.events {
+				ch <- e
+			}
+
+			stream := &watchStream{ctx: ctx}
+			if tt.cancel {
+				// 全てのイベントを送信した後に、クライアントが切断したことを表す。
+				stream.onSend = func(sent int) {
+					if sent == len(tt.events) {
+						cancel()
+					}
+				}
+			} else {
+				close(ch)
+			}
+
+			u.EXPECT().Watch(ctx).Return((<-chan *model.ProgrammingLangEvent)(ch), nil)
+
+			err := s.Watch(&pb.WatchRequest{}, stream)
+			if code := status.Code(err); code != tt.wantCode {
+				t.Errorf("ProgrammingLangServer.Watch() code = %v, want %v", code, tt.wantCode)
+			}
+
+			got := make([]pb.WatchEvent_Type, len(stream.sent))
+			for i, e := range stream.sent {
+				got[i] = e.Type
+			}
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("ProgrammingLangServer.Watch() sent = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,253 @@
+package main
+
+import (
+	"context"
+	"fmt"
+	"io/ioutil"
+	"net"
+	"net/http"
+	"os"
+	"strings"
+	"time"
+
+	"github.com/SekiguchiKai/clean-architecture-with-go/server/adapter/api"
+	"github.com/SekiguchiKai/clean-architecture-with-go/server/adapter/linguist"
+	"github.com/SekiguchiKai/clean-architecture-with-go/server/infra/config"
+	"github.com/SekiguchiKai/clean-architecture-with-go/server/infra/dao/rdb"
+	"github.com/SekiguchiKai/clean-architecture-with-go/server/infra/migration"
+	"github.com/SekiguchiKai/clean-architecture-with-go/server/infra/router"
+	"github.com/SekiguchiKai/clean-architecture-with-go/server/usecase/output"
+	"github.com/pkg/errors"
+)
+
+// defaultPurgeRetention は、purgeで配信を終えた通知を残す期間の既定値。
+const defaultPurgeRetention = 30 * 24 * time.Hour
+
+// reindexTimeout は、reindexでサーバーの応答を待つ時間の上限。
+const reindexTimeout = 5 * time.Minute
+
+// migrate は、適用していないマイグレーションを適用する。
+// -baselineを指定した場合は、そのバージョンまでを実行せずに適用済みとして記録する。
+func migrate(args []string) error {
+	fs := newFlagSet(migrateCommand, "")
+	dir := fs.String("dir", "", "directory of the migrations (default: "+config.MigrationsDirEnv+")")
+	baseline := fs.Int("baseline", 0, "record migrations up to this version as applied without running them")
+	status := fs.Bool("status", false, "only list pending migrations")
+	if err := fs.Parse(args); err != nil {
+		return err
+	}
+
+	cfg, err := loadConfig()
+	if err != nil {
+		return err
+	}
+	if *dir == "" {
+		*dir = cfg.MigrationsDir
+	}
+
+	m := migration.NewMigrator(rdb.NewSQLManager(cfg.DatabaseDSN), *dir)
+	ctx := context.Background()
+
+	var done []*migration.Migration
+	verb := "applied"
+	switch {
+	case *status:
+		done, err = m.Pending(ctx)
+		verb = "pending"
+	case *baseline > 0:
+		done, err = m.Baseline(ctx, *baseline)
+		verb = "baselined"
+	default:
+		done, err = m.Up(ctx)
+	}
+
+	for _, mig := range done {
+		fmt.Printf("%s %03d_%s\n", verb, mig.Version, mig.Name)
+	}
+	if err == nil && len(done) == 0 && !*status {
+		fmt.Println("no pending migrations")
+	}
+	return err
+}
+
+// seed は、SEED_FILEまたは-fileで指定した、GitHub Linguistの形式の初期データを取り込む。
+func seed(args []string) error {
+	fs := newFlagSet(seedCommand, "")
+	file := fs.String("file", "", "languages.yml to import (default: "+config.SeedFileEnv+")")
+	if err := fs.Parse(args); err != nil {
+		return err
+	}
+
+	cfg, err := loadConfig()
+	if err != nil {
+		return err
+	}
+	if *file == "" {
+		*file = cfg.SeedFile
+	}
+
+	router.InitAdmin(cfg)
+	return importFile(*file)
+}
+
+// importLinguist は、引数で指定したlanguages.ymlを取り込み、生成、更新、スキップした件数を出力する。
+func importLinguist(args []string) error {
+	fs := newFlagSet(importLinguistCommand, " <languages.yml>")
+	if err := fs.Parse(args); err != nil {
+		return err
+	}
+	if fs.NArg() != 1 {
+		return fmt.Errorf("usage: %s %s <languages.yml>", os.Args[0], importLinguistCommand)
+	}
+
+	cfg, err := loadConfig()
+	if err != nil {
+		return err
+	}
+
+	router.InitAdmin(cfg)
+	return importFile(fs.Arg(0))
+}
+
+// importFile は、pathのlanguages.ymlを取り込み、生成、更新、スキップした件数を出力する。
+// DBを直接更新するため、起動中のサーバーのキャッシュと索引は更新されない。取り込んだ後はサーバーを再起動する。
+func importFile(path string) error {
+	f, err := os.Open(path)
+	if err != nil {
+		return err
+	}
+	defer f.Close()
+
+	imports, err := linguist.Parse(f)
+	if err != nil {
+		return err
+	}
+
+	report, err := router.Import.Import(context.Background(), imports)
+	if err != nil {
+		return err
+	}
+
+	return writeJSON(os.Stdout, output.NewImportReportOutput(report))
+}
+
+// reindex は、起動中のサーバーに全文検索の索引を構築し直させ、結果を出力する。
+// 索引はサーバーのメモリにあるため、管理用のAPIを呼び出す。
+func reindex(args []string) error {
+	cfg, err := loadConfig()
+	if err != nil {
+		return err
+	}
+
+	fs := newFlagSet(reindexCommand, "")
+	server := fs.String("server", serverURL(cfg.HTTPAddr), "base URL of the running server")
+	if err := fs.Parse(args); err != nil {
+		return err
+	}
+
+	if cfg.AdminAPIKey == "" {
+		return errors.Errorf("%s is required", config.AdminAPIKeyEnv)
+	}
+
+	req, err := http.NewRequest(http.MethodPost, strings.TrimRight(*server, "/")+api.V1Path+api.AdminAPIPath+api.SearchReindexPath, nil)
+	if err != nil {
+		return err
+	}
+	req.Header.Set(api.APIKeyHeader, cfg.AdminAPIKey)
+
+	client := &http.Client{Timeout: reindexTimeout}
+	res, err := client.Do(req)
+	if err != nil {
+		return err
+	}
+	defer res.Body.Close()
+
+	body, err := ioutil.ReadAll(res.Body)
+	if err != nil {
+		return err
+	}
+	if res.StatusCode != http.StatusOK {
+		return errors.Errorf("reindex failed: %s: %s", res.Status, strings.TrimSpace(string(body)))
+	}
+
+	_, err = os.Stdout.Write(body)
+	return err
+}
+
+// serverURL は、HTTP_ADDRで待ち受けているサーバーのURLを返す。ホストが省略されている場合は、localhostとする。
+func serverURL(addr string) string {
+	if strings.HasPrefix(addr, ":") {
+		addr = "localhost" + addr
+	}
+	return "http://" + addr
+}
+
+// purge は、配信を終えてから-older-thanより時間が経過したWebhookの通知を削除し、削除した件数を出力する。
+func purge(args []string) error {
+	fs := newFlagSet(purgeCommand, "")
+	olderThan := fs.Duration("older-than", defaultPurgeRetention, "delete deliveries finished before this duration ago")
+	if err := fs.Parse(args); err != nil {
+		return err
+	}
+
+	cfg, err := loadConfig()
+	if err != nil {
+		return err
+	}
+
+	router.InitAdmin(cfg)
+	deleted, err := router.Webhook.PurgeDeliveries(context.Background(), *olderThan)
+	if err != nil {
+		return err
+	}
+
+	return writeJSON(os.Stdout, map[string]int{"deleted": deleted})
+}
+
+// checkConfig は、設定を読み込んで検証し、環境変数の形式で秘密の値を伏せて表示する。DBには接続しない。
+func checkConfig(args []string) error {
+	fs := newFlagSet(checkConfigCommand, "")
+	if err := fs.Parse(args); err != nil {
+		return err
+	}
+
+	cfg, err := loadConfig()
+	if err != nil {
+		return errors.Wrap(err, "invalid configuration")
+	}
+
+	masked := cfg.Masked()
+	fmt.Printf("%s=%s\n", config.HTTPAddrEnv, masked.HTTPAddr)
+	fmt.Printf("%s=%s\n", config.GRPCAddrEnv, masked.GRPCAddr)
+	fmt.Printf("%s=%s\n", config.DatabaseDSNEnv, masked.DatabaseDSN)
+	fmt.Printf("%s=%s\n", config.AdminAPIKeyEnv, masked.AdminAPIKey)
+	fmt.Printf("%s=%s\n", config.MigrationsDirEnv, masked.MigrationsDir)
+	fmt.Printf("%s=%s\n", config.SeedFileEnv, masked.SeedFile)
+	fmt.Printf("%s=%s\n", config.ShutdownTimeoutEnv, masked.ShutdownTimeout)
+	fmt.Printf("%s=%s\n", config.ClientAPIKeysEnv, strings.Join(masked.ClientAPIKeys, ","))
+	fmt.Printf("%s=%s\n", config.TrustedProxiesEnv, joinNets(masked.TrustedProxies))
+	fmt.Printf("%s=%s\n", config.RateLimitReadEnv, masked.RateLimitRead)
+	fmt.Printf("%s=%s\n", config.RateLimitWriteEnv, masked.RateLimitWrite)
+	fmt.Printf("%s=%s\n", config.RateLimitRoutesEnv, joinRouteQuotas(masked.RateLimitRoutes))
+	fmt.Printf("%s=%s\n", config.V1DeprecatedAtEnv, masked.V1DeprecatedAt.Format(config.DateLayout))
+	fmt.Printf("%s=%s\n", config.V1SunsetEnv, masked.V1Sunset.Format(config.DateLayout))
+	return nil
+}
+
+// joinNets は、IPアドレスの範囲をカンマ区切りで返す。
+func joinNets(nets []*net.IPNet) string {
+	values := make([]string, len(nets))
+	for i, n := range nets {
+		values[i] = n.String()
+	}
+	return strings.Join(values, ",")
+}
+
+// joinRouteQuotas は、ルートごとのQuotaを設定と同じ形式で返す。
+func joinRouteQuotas(quotas []api.RouteQuota) string {
+	values := make([]string, len(quotas))
+	for i, rq := range quotas {
+		values[i] = fmt.Sprintf("%s %s=%s", rq.Method, rq.Pattern, rq.Quota)
+	}
+	return strings.Join(values, ";")
+}
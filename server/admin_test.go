@@ -0,0 +1,92 @@
+package main
+
+import (
+	"encoding/json"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"reflect"
+	"testing"
+
+	"github.com/SekiguchiKai/clean-architecture-with-go/server/domain/model"
+	"github.com/SekiguchiKai/clean-architecture-with-go/server/infra/config"
+	"github.com/SekiguchiKai/clean-architecture-with-go/server/infra/router"
+	"github.com/SekiguchiKai/clean-architecture-with-go/server/usecase/mock"
+	"github.com/SekiguchiKai/clean-architecture-with-go/server/usecase/output"
+	"github.com/golang/mock/gomock"
+)
+
+// unreachableDSN は、接続を直ちに拒否されるDSN。InitAdminは接続しないため、初期設定に影響しない。
+const unreachableDSN = "root:@tcp(127.0.0.1:1)/sample?charset=utf8mb4&parseTime=True"
+
+// captureStdout は、fnを実行する間に標準出力に書かれた内容を返す。
+func captureStdout(t *testing.T, fn func()) []byte {
+	r, w, err := os.Pipe()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	stdout := os.Stdout
+	os.Stdout = w
+	defer func() { os.Stdout = stdout }()
+
+	fn()
+
+	if err := w.Close(); err != nil {
+		t.Fatal(err)
+	}
+	b, err := ioutil.ReadAll(r)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return b
+}
+
+// TestImportFile_Stdout は、InitAdminで初期設定したimport-linguistの標準出力が、取り込みの結果のJSONのみであることを確認する。
+func TestImportFile_Stdout(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	dir, err := ioutil.TempDir("", "import-linguist")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+
+	path := filepath.Join(dir, "languages.yml")
+	if err := ioutil.WriteFile(path, []byte("---\nGo:\n  type: programming\n  extensions:\n  - \".go\"\n"), 0644); err != nil {
+		t.Fatal(err)
+	}
+
+	cfg, err := config.Load(func(key string) string {
+		if key == config.DatabaseDSNEnv {
+			return unreachableDSN
+		}
+		return ""
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	report := &model.ImportReport{Created: 1}
+	u := mock_input.NewMockImportInputPort(ctrl)
+	u.EXPECT().Import(gomock.Any(), gomock.Any()).Return(report, nil)
+
+	var runErr error
+	got := captureStdout(t, func() {
+		router.InitAdmin(cfg)
+		router.Import = u
+		runErr = importFile(path)
+	})
+	if runErr != nil {
+		t.Fatal(runErr)
+	}
+
+	var gotReport output.ImportReportOutput
+	if err := json.Unmarshal(got, &gotReport); err != nil {
+		t.Fatalf("stdout = %q is not JSON: %v", got, err)
+	}
+	if want := output.NewImportReportOutput(report); !reflect.DeepEqual(&gotReport, want) {
+		t.Errorf("stdout = %+v, want %+v", gotReport, want)
+	}
+}
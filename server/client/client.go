@@ -0,0 +1,258 @@
+package client
+
+import (
+	"bytes"
+	"context"
+	"crypto/rand"
+	"encoding/hex"
+	"encoding/json"
+	"io"
+	"io/ioutil"
+	"net/http"
+	"net/url"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/SekiguchiKai/clean-architecture-with-go/server/domain/model"
+	"github.com/SekiguchiKai/clean-architecture-with-go/server/usecase/input"
+	"github.com/SekiguchiKai/clean-architecture-with-go/server/usecase/output"
+	"github.com/pkg/errors"
+)
+
+// Client は、/v2/langsのAPIを呼び出すクライアント。
+// エラーのレスポンスは、application/problem+jsonのtypeに従って、サーバーが返したものと同じドメインのエラー(*model.NoSuchDataError等)に変換して返す。
+type Client struct {
+	BaseURL    string
+	HTTPClient *http.Client
+	// APIKey は、X-API-Keyヘッダーで送信するAPIキー。空の場合は送信しない。
+	APIKey string
+	// MaxRetries は、ステータスコード5xx、429の場合に再送する最大の回数。
+	MaxRetries int
+	MinBackoff time.Duration
+	MaxBackoff time.Duration
+}
+
+// NewClient は、Clientを生成し、返す。baseURLには、http://localhost:8080のように/v2より前の部分を指定する。
+func NewClient(baseURL string) *Client {
+	return &Client{
+		BaseURL:    strings.TrimRight(baseURL, "/"),
+		HTTPClient: &http.Client{Timeout: DefaultTimeout},
+		MaxRetries: DefaultMaxRetries,
+		MinBackoff: DefaultMinBackoff,
+		MaxBackoff: DefaultMaxBackoff,
+	}
+}
+
+// List は、ProgrammingLangの一覧を、Nameの昇順で最大limit件返す。
+func (c *Client) List(ctx context.Context, limit int) ([]*model.ProgrammingLang, error) {
+	langs, _, err := c.ListPage(ctx, "", limit)
+	return langs, err
+}
+
+// ListPage は、/v2/langsからcursorの次のページのProgrammingLangの一覧と、さらに次のページのcursorを返す。
+// cursorが空の場合は、最初のページを返す。次のページが存在しない場合は、空のcursorを返す。
+func (c *Client) ListPage(ctx context.Context, cursor string, limit int) ([]*model.ProgrammingLang, string, error) {
+	q := url.Values{}
+	q.Set(Limit, strconv.Itoa(limit))
+	if cursor != "" {
+		q.Set(Cursor, cursor)
+	}
+
+	var page *output.ProgrammingLangPageV2Output
+	r := &request{method: http.MethodGet, path: ProgrammingLangV2Path + "?" + q.Encode()}
+	if err := c.do(ctx, r, &page); err != nil {
+		return nil, "", err
+	}
+
+	langs := make([]*model.ProgrammingLang, 0, len(page.Items))
+	for _, o := range page.Items {
+		langs = append(langs, o.ToModel())
+	}
+	return langs, page.NextCursor, nil
+}
+
+// ListAll は、ListPageで最後のページまで取得し、すべてのProgrammingLangの一覧を返す。
+func (c *Client) ListAll(ctx context.Context) ([]*model.ProgrammingLang, error) {
+	var langs []*model.ProgrammingLang
+	cursor := ""
+	for {
+		page, next, err := c.ListPage(ctx, cursor, PageLimit)
+		if err != nil {
+			return nil, err
+		}
+
+		langs = append(langs, page...)
+		if next == "" {
+			return langs, nil
+		}
+		cursor = next
+	}
+}
+
+// Get は、ProgrammingLangを返す。
+func (c *Client) Get(ctx context.Context, id int) (*model.ProgrammingLang, error) {
+	var out *output.ProgrammingLangV2Output
+	r := &request{method: http.MethodGet, path: langPath(id)}
+	if err := c.do(ctx, r, &out); err != nil {
+		return nil, err
+	}
+	return out.ToModel(), nil
+}
+
+// Create は、ProgrammingLangを生成し、返す。
+// 再送しても二重に生成されないよう、すべての再送で同じIdempotency-Keyを送信する。
+func (c *Client) Create(ctx context.Context, param *model.ProgrammingLang) (*model.ProgrammingLang, error) {
+	key, err := newIdempotencyKey()
+	if err != nil {
+		return nil, err
+	}
+
+	var out *output.ProgrammingLangV2Output
+	r := &request{method: http.MethodPost, path: ProgrammingLangV2Path, in: input.NewProgrammingLangV2Input(param), idempotencyKey: key}
+	if err := c.do(ctx, r, &out); err != nil {
+		return nil, err
+	}
+	return out.ToModel(), nil
+}
+
+// Update は、ProgrammingLangを更新し、返す。
+func (c *Client) Update(ctx context.Context, id int, param *model.ProgrammingLang) (*model.ProgrammingLang, error) {
+	var out *output.ProgrammingLangV2Output
+	r := &request{method: http.MethodPut, path: langPath(id), in: input.NewProgrammingLangV2Input(param)}
+	if err := c.do(ctx, r, &out); err != nil {
+		return nil, err
+	}
+	return out.ToModel(), nil
+}
+
+// Delete は、ProgrammingLangを削除する。
+func (c *Client) Delete(ctx context.Context, id int) error {
+	return c.do(ctx, &request{method: http.MethodDelete, path: langPath(id)}, nil)
+}
+
+// request は、送信するリクエストを表す。
+type request struct {
+	method string
+	path   string
+	in     interface{}
+	// idempotencyKey は、Idempotency-Keyヘッダーで送信する値。空の場合は送信しない。
+	idempotencyKey string
+}
+
+// do は、リクエストを送信し、レスポンスのボディをoutに格納する。
+// ステータスコード5xx、429の場合は、MaxRetriesまで待機時間を延ばしながら再送する。
+func (c *Client) do(ctx context.Context, r *request, out interface{}) error {
+	var body []byte
+	if r.in != nil {
+		b, err := json.Marshal(r.in)
+		if err != nil {
+			return errors.WithStack(err)
+		}
+		body = b
+	}
+
+	for attempt := 0; ; attempt++ {
+		res, err := c.send(ctx, r, body)
+		if err != nil {
+			return err
+		}
+
+		resBody, err := ioutil.ReadAll(res.Body)
+		res.Body.Close()
+		if err != nil {
+			return errors.WithStack(err)
+		}
+
+		if res.StatusCode >= http.StatusOK && res.StatusCode < http.StatusMultipleChoices {
+			if out == nil {
+				return nil
+			}
+			return errors.WithStack(json.Unmarshal(resBody, out))
+		}
+
+		if !retryable(res.StatusCode) || attempt >= c.MaxRetries {
+			return decodeError(res.StatusCode, resBody)
+		}
+
+		if err := sleep(ctx, c.backoff(attempt, res.Header.Get(RetryAfterHeader))); err != nil {
+			return err
+		}
+	}
+}
+
+// send は、リクエストを1回送信する。
+func (c *Client) send(ctx context.Context, r *request, body []byte) (*http.Response, error) {
+	var br io.Reader
+	if body != nil {
+		br = bytes.NewReader(body)
+	}
+
+	req, err := http.NewRequest(r.method, c.BaseURL+r.path, br)
+	if err != nil {
+		return nil, errors.WithStack(err)
+	}
+	req = req.WithContext(ctx)
+
+	req.Header.Set(AcceptHeader, JSONContentType)
+	if body != nil {
+		req.Header.Set(ContentTypeHeader, JSONContentType)
+	}
+	if c.APIKey != "" {
+		req.Header.Set(APIKeyHeader, c.APIKey)
+	}
+	if r.idempotencyKey != "" {
+		req.Header.Set(IdempotencyKeyHeader, r.idempotencyKey)
+	}
+
+	res, err := c.HTTPClient.Do(req)
+	if err != nil {
+		return nil, errors.WithStack(err)
+	}
+	return res, nil
+}
+
+// backoff は、attempt回目の再送の前に待機する時間を返す。
+// Retry-Afterヘッダーで秒数が指定されている場合はそれに従う。いずれの場合もMaxBackoffを超えない。
+func (c *Client) backoff(attempt int, retryAfter string) time.Duration {
+	d := c.MinBackoff << uint(attempt)
+	if sec, err := strconv.Atoi(retryAfter); err == nil && sec >= 0 {
+		d = time.Duration(sec) * time.Second
+	}
+	if d > c.MaxBackoff || d < 0 {
+		d = c.MaxBackoff
+	}
+	return d
+}
+
+// retryable は、再送するステータスコードであるかどうかを返す。
+func retryable(statusCode int) bool {
+	return statusCode == http.StatusTooManyRequests || statusCode >= http.StatusInternalServerError
+}
+
+// sleep は、dの間待機する。待機中にctxが終了した場合は、そのエラーを返す。
+func sleep(ctx context.Context, d time.Duration) error {
+	t := time.NewTimer(d)
+	defer t.Stop()
+
+	select {
+	case <-ctx.Done():
+		return errors.WithStack(ctx.Err())
+	case <-t.C:
+		return nil
+	}
+}
+
+// langPath は、idのProgrammingLangのパスを返す。
+func langPath(id int) string {
+	return ProgrammingLangV2Path + "/" + strconv.Itoa(id)
+}
+
+// newIdempotencyKey は、ランダムなIdempotency-Keyを生成し、返す。
+func newIdempotencyKey() (string, error) {
+	b := make([]byte, IdempotencyKeyBytes)
+	if _, err := rand.Read(b); err != nil {
+		return "", errors.WithStack(err)
+	}
+	return hex.EncodeToString(b), nil
+}
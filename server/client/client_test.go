@@ -0,0 +1,572 @@
+package client_test
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"reflect"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/SekiguchiKai/clean-architecture-with-go/server/adapter/api"
+	"github.com/SekiguchiKai/clean-architecture-with-go/server/client"
+	"github.com/SekiguchiKai/clean-architecture-with-go/server/domain/model"
+	"github.com/SekiguchiKai/clean-architecture-with-go/server/usecase/input"
+	"github.com/SekiguchiKai/clean-architecture-with-go/server/usecase/mock"
+	"github.com/gin-gonic/gin"
+	"github.com/golang/mock/gomock"
+)
+
+// newTestServer は、実際のハンドラでUseCaseを呼び出すサーバーと、それに接続するClientを生成し、返す。
+func newTestServer(t *testing.T, u *mock_input.MockProgrammingLangInputPort) (*httptest.Server, *client.Client) {
+	r := gin.New()
+	api.NewProgrammingLangAPI(u).InitAPI(r.Group(api.V1Path))
+	api.NewProgrammingLangV2API(u).InitAPI(r.Group(api.V2Path))
+	s := httptest.NewServer(r)
+
+	c := client.NewClient(s.URL)
+	c.MaxRetries = 0
+	return s, c
+}
+
+// writableFields は、生成、更新の際に送信されない属性を取り除いたProgrammingLangを返す。
+func writableFields(lang *model.ProgrammingLang) *model.ProgrammingLang {
+	l := *lang
+	l.ID = 0
+	l.Slug = ""
+	l.CreatedAt = time.Time{}
+	l.UpdatedAt = time.Time{}
+	return &l
+}
+
+var (
+	noDataErr = &model.NoSuchDataError{
+		ID:         100,
+		Name:       model.TestName,
+		ModelName:  model.ModelNameProgrammingLang,
+		DidYouMean: []string{"Go", "Rust"},
+	}
+	alreadyExistErr = &model.AlreadyExistError{
+		ID:        1,
+		Name:      model.TestName,
+		ModelName: model.ModelNameProgrammingLang,
+	}
+	requiredErr = &model.RequiredError{
+		Property: "name",
+	}
+	invalidPropertyErr = &model.InvalidPropertyError{
+		Property: "name",
+		Message:  "Test",
+	}
+	dbErr = &model.DBError{
+		ModelName: model.ModelNameProgrammingLang,
+		DBMethod:  model.DBMethodRead,
+		Detail:    "Test",
+	}
+)
+
+func TestNewClient(t *testing.T) {
+	got := client.NewClient("http://localhost:8080/")
+	want := &client.Client{
+		BaseURL:    "http://localhost:8080",
+		HTTPClient: &http.Client{Timeout: client.DefaultTimeout},
+		MaxRetries: client.DefaultMaxRetries,
+		MinBackoff: client.DefaultMinBackoff,
+		MaxBackoff: client.DefaultMaxBackoff,
+	}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("NewClient() = %v, want %v", got, want)
+	}
+}
+
+func TestClient_List(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	u := mock_input.NewMockProgrammingLangInputPort(ctrl)
+	s, c := newTestServer(t, u)
+	defer s.Close()
+
+	tests := []struct {
+		name    string
+		limit   int
+		result  []*model.ProgrammingLang
+		err     error
+		want    []*model.ProgrammingLang
+		wantErr error
+	}{
+		{
+			name:   "一覧を取得した場合、ProgrammingLangの一覧を返すこと",
+			limit:  20,
+			result: model.CreateProgrammingLangs(5),
+			want:   model.CreateProgrammingLangs(5),
+		},
+		{
+			name:   "1件も存在しない場合、空の一覧を返すこと",
+			limit:  20,
+			result: []*model.ProgrammingLang{},
+			want:   []*model.ProgrammingLang{},
+		},
+		{
+			name:    "サーバー側のエラーが発生した場合、ステータスコードとエラー文を持つAPIErrorを返すこと",
+			limit:   20,
+			err:     dbErr,
+			wantErr: &client.APIError{StatusCode: http.StatusInternalServerError, Type: api.ProblemTypeBlank, Message: dbErr.Error()},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			filter := &model.ProgrammingLangFilter{Tags: []string{}, TagMatch: model.TagMatchAll, Limit: tt.limit + 1}
+			u.EXPECT().ListByFilter(gomock.Any(), filter).Return(tt.result, tt.err)
+
+			got, err := c.List(context.Background(), tt.limit)
+			if !reflect.DeepEqual(err, tt.wantErr) {
+				t.Errorf("Client.List() error = %#v, wantErr %#v", err, tt.wantErr)
+				return
+			}
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("Client.List() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestClient_ListAll(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	u := mock_input.NewMockProgrammingLangInputPort(ctrl)
+	s, c := newTestServer(t, u)
+	defer s.Close()
+
+	langs := model.CreateProgrammingLangs(client.PageLimit + 50)
+
+	tests := []struct {
+		name    string
+		pages   map[string][]*model.ProgrammingLang
+		err     error
+		want    []*model.ProgrammingLang
+		wantErr error
+	}{
+		{
+			name: "複数のページがある場合、最後のページまで取得してすべてを返すこと",
+			pages: map[string][]*model.ProgrammingLang{
+				"":                             langs[:client.PageLimit+1],
+				langs[client.PageLimit-1].Name: langs[client.PageLimit:],
+			},
+			want: langs,
+		},
+		{
+			name: "1件も存在しない場合、空の一覧を返すこと",
+			pages: map[string][]*model.ProgrammingLang{
+				"": {},
+			},
+			want: nil,
+		},
+		{
+			name: "application/problem+jsonのエラーの場合、サーバーと同じドメインのエラーを返すこと",
+			pages: map[string][]*model.ProgrammingLang{
+				"": nil,
+			},
+			err: &model.InvalidParameterError{
+				Parameter: client.Cursor,
+				Message:   "Test",
+			},
+			wantErr: &model.InvalidParameterError{
+				Parameter: client.Cursor,
+				Message:   "Test",
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			u.EXPECT().ListByFilter(gomock.Any(), gomock.Any()).Times(len(tt.pages)).DoAndReturn(
+				func(ctx context.Context, filter *model.ProgrammingLangFilter) ([]*model.ProgrammingLang, error) {
+					return tt.pages[filter.After], tt.err
+				})
+
+			got, err := c.ListAll(context.Background())
+			if !reflect.DeepEqual(err, tt.wantErr) {
+				t.Errorf("Client.ListAll() error = %#v, wantErr %#v", err, tt.wantErr)
+				return
+			}
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("Client.ListAll() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestClient_Get(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	u := mock_input.NewMockProgrammingLangInputPort(ctrl)
+	s, c := newTestServer(t, u)
+	defer s.Close()
+
+	tests := []struct {
+		name    string
+		id      int
+		result  *model.ProgrammingLang
+		err     error
+		want    *model.ProgrammingLang
+		wantErr error
+	}{
+		{
+			name:   "存在するIDの場合、ProgrammingLangを返すこと",
+			id:     1,
+			result: model.CreateProgrammingLangs(1)[0],
+			want:   model.CreateProgrammingLangs(1)[0],
+		},
+		{
+			name:    "存在しないIDの場合、候補を含めてサーバーと同じNoSuchDataErrorを返すこと",
+			id:      100,
+			err:     noDataErr,
+			wantErr: noDataErr,
+		},
+		{
+			name: "パラメータが不適切な場合、サーバーと同じInvalidParameterErrorを返すこと",
+			id:   1,
+			err: &model.InvalidParameterError{
+				Parameter: api.ID,
+				Message:   "Test",
+			},
+			wantErr: &model.InvalidParameterError{
+				Parameter: api.ID,
+				Message:   "Test",
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			u.EXPECT().Get(gomock.Any(), tt.id).Return(tt.result, tt.err)
+
+			got, err := c.Get(context.Background(), tt.id)
+			if !reflect.DeepEqual(err, tt.wantErr) {
+				t.Errorf("Client.Get() error = %#v, wantErr %#v", err, tt.wantErr)
+				return
+			}
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("Client.Get() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestClient_Create(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	u := mock_input.NewMockProgrammingLangInputPort(ctrl)
+	s, c := newTestServer(t, u)
+	defer s.Close()
+
+	lang := model.CreateProgrammingLangs(1)[0]
+	lang.Paradigms = []model.Paradigm{model.ParadigmConcurrent}
+	lang.Designers = []string{"Robert Griesemer", "Rob Pike", "Ken Thompson"}
+
+	tests := []struct {
+		name    string
+		param   *model.ProgrammingLang
+		result  *model.ProgrammingLang
+		err     error
+		want    *model.ProgrammingLang
+		wantErr error
+	}{
+		{
+			name:   "生成した場合、書き込み可能な属性のみを送信し、生成したProgrammingLangを返すこと",
+			param:  lang,
+			result: lang,
+			want:   lang,
+		},
+		{
+			name:    "既に存在する場合、サーバーと同じAlreadyExistErrorを返すこと",
+			param:   lang,
+			err:     alreadyExistErr,
+			wantErr: alreadyExistErr,
+		},
+		{
+			name:    "必要な属性がない場合、サーバーと同じRequiredErrorを返すこと",
+			param:   &model.ProgrammingLang{},
+			err:     requiredErr,
+			wantErr: requiredErr,
+		},
+		{
+			name:    "属性が不適切な場合、サーバーと同じInvalidPropertyErrorを返すこと",
+			param:   lang,
+			err:     invalidPropertyErr,
+			wantErr: invalidPropertyErr,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			u.EXPECT().Create(gomock.Any(), input.NewProgrammingLangCreate(writableFields(tt.param))).Return(tt.result, tt.err)
+
+			got, err := c.Create(context.Background(), tt.param)
+			if !reflect.DeepEqual(err, tt.wantErr) {
+				t.Errorf("Client.Create() error = %#v, wantErr %#v", err, tt.wantErr)
+				return
+			}
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("Client.Create() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestClient_Update(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	u := mock_input.NewMockProgrammingLangInputPort(ctrl)
+	s, c := newTestServer(t, u)
+	defer s.Close()
+
+	lang := model.CreateProgrammingLangs(1)[0]
+
+	tests := []struct {
+		name    string
+		id      int
+		param   *model.ProgrammingLang
+		result  *model.ProgrammingLang
+		err     error
+		want    *model.ProgrammingLang
+		wantErr error
+	}{
+		{
+			name:   "更新した場合、更新したProgrammingLangを返すこと",
+			id:     1,
+			param:  lang,
+			result: lang,
+			want:   lang,
+		},
+		{
+			name:    "存在しないIDの場合、サーバーと同じNoSuchDataErrorを返すこと",
+			id:      100,
+			param:   lang,
+			err:     noDataErr,
+			wantErr: noDataErr,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			u.EXPECT().Update(gomock.Any(), tt.id, input.NewProgrammingLangUpdate(tt.param)).Return(tt.result, tt.err)
+
+			got, err := c.Update(context.Background(), tt.id, tt.param)
+			if !reflect.DeepEqual(err, tt.wantErr) {
+				t.Errorf("Client.Update() error = %#v, wantErr %#v", err, tt.wantErr)
+				return
+			}
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("Client.Update() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestClient_Delete(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	u := mock_input.NewMockProgrammingLangInputPort(ctrl)
+	s, c := newTestServer(t, u)
+	defer s.Close()
+
+	tests := []struct {
+		name    string
+		id      int
+		err     error
+		wantErr error
+	}{
+		{
+			name: "削除した場合、エラーを返さないこと",
+			id:   1,
+		},
+		{
+			name:    "存在しないIDの場合、サーバーと同じNoSuchDataErrorを返すこと",
+			id:      100,
+			err:     noDataErr,
+			wantErr: noDataErr,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			u.EXPECT().Delete(gomock.Any(), tt.id).Return(tt.err)
+
+			if err := c.Delete(context.Background(), tt.id); !reflect.DeepEqual(err, tt.wantErr) {
+				t.Errorf("Client.Delete() error = %#v, wantErr %#v", err, tt.wantErr)
+			}
+		})
+	}
+}
+
+func TestClient_ProblemType(t *testing.T) {
+	tests := []struct {
+		name    string
+		code    int
+		body    string
+		wantErr error
+	}{
+		{
+			name:    "typeがnot-foundの場合、エラー文によらず拡張メンバーからNoSuchDataErrorを返すこと",
+			code:    http.StatusNotFound,
+			body:    `{"type":"` + api.ProblemTypeNotFound + `","title":"Not Found","status":404,"detail":"見つかりません","model":"ProgrammingLang","id":100,"didYouMean":["Go"]}`,
+			wantErr: &model.NoSuchDataError{ModelName: model.ModelNameProgrammingLang, ID: 100, DidYouMean: []string{"Go"}},
+		},
+		{
+			name:    "typeがinvalid-parameterの場合、拡張メンバーからInvalidParameterErrorを返すこと",
+			code:    http.StatusBadRequest,
+			body:    `{"type":"` + api.ProblemTypeInvalidParameter + `","title":"Bad Request","status":400,"detail":"-","parameter":"id","reason":"Test"}`,
+			wantErr: &model.InvalidParameterError{Parameter: api.ID, Message: "Test"},
+		},
+		{
+			name:    "ドメインのエラーでないtypeの場合、typeとdetailを持つAPIErrorを返すこと",
+			code:    http.StatusUnprocessableEntity,
+			body:    `{"type":"` + api.ProblemTypeIdempotencyKeyReused + `","title":"Unprocessable Entity","status":422,"detail":"Test"}`,
+			wantErr: &client.APIError{StatusCode: http.StatusUnprocessableEntity, Type: api.ProblemTypeIdempotencyKeyReused, Message: "Test"},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			s := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				w.Header().Set("Content-Type", api.ProblemContentType)
+				w.WriteHeader(tt.code)
+				w.Write([]byte(tt.body))
+			}))
+			defer s.Close()
+
+			_, err := client.NewClient(s.URL).Get(context.Background(), 100)
+			if !reflect.DeepEqual(err, tt.wantErr) {
+				t.Errorf("Client.Get() error = %#v, wantErr %#v", err, tt.wantErr)
+			}
+		})
+	}
+}
+
+func TestProblemTypes(t *testing.T) {
+	tests := []struct {
+		name string
+		got  string
+		want string
+	}{
+		{name: "required", got: client.ProblemTypeRequired, want: api.ProblemTypeRequired},
+		{name: "invalid-property", got: client.ProblemTypeInvalidProperty, want: api.ProblemTypeInvalidProperty},
+		{name: "invalid-parameter", got: client.ProblemTypeInvalidParameter, want: api.ProblemTypeInvalidParameter},
+		{name: "not-found", got: client.ProblemTypeNotFound, want: api.ProblemTypeNotFound},
+		{name: "already-exists", got: client.ProblemTypeAlreadyExists, want: api.ProblemTypeAlreadyExists},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name+"のtypeがサーバーと一致すること", func(t *testing.T) {
+			if tt.got != tt.want {
+				t.Errorf("type = %v, want %v", tt.got, tt.want)
+			}
+		})
+	}
+}
+
+// recordedRequest は、サーバーが受け取ったリクエストのヘッダーを表す。
+type recordedRequest struct {
+	apiKey         string
+	idempotencyKey string
+}
+
+func TestClient_Retry(t *testing.T) {
+	tests := []struct {
+		name       string
+		statuses   []int
+		retryAfter string
+		wantCalls  int
+		wantErr    error
+	}{
+		{
+			name:      "ステータスコード503の後に成功した場合、再送して結果を返すこと",
+			statuses:  []int{http.StatusServiceUnavailable, http.StatusServiceUnavailable, http.StatusOK},
+			wantCalls: 3,
+		},
+		{
+			name:       "ステータスコード429の場合、Retry-Afterに従って再送すること",
+			statuses:   []int{http.StatusTooManyRequests, http.StatusOK},
+			retryAfter: "0",
+			wantCalls:  2,
+		},
+		{
+			name:      "MaxRetriesを超えて失敗した場合、最後のエラーを返すこと",
+			statuses:  []int{http.StatusBadGateway, http.StatusBadGateway, http.StatusBadGateway, http.StatusBadGateway},
+			wantCalls: 3,
+			wantErr:   &client.APIError{StatusCode: http.StatusBadGateway, Message: "Test"},
+		},
+		{
+			name:      "ステータスコード4xxの場合、再送しないこと",
+			statuses:  []int{http.StatusConflict, http.StatusOK},
+			wantCalls: 1,
+			wantErr:   &client.APIError{StatusCode: http.StatusConflict, Message: "Test"},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var mu sync.Mutex
+			var requests []recordedRequest
+			s := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				mu.Lock()
+				status := tt.statuses[len(requests)]
+				requests = append(requests, recordedRequest{
+					apiKey:         r.Header.Get(client.APIKeyHeader),
+					idempotencyKey: r.Header.Get(client.IdempotencyKeyHeader),
+				})
+				mu.Unlock()
+
+				if tt.retryAfter != "" {
+					w.Header().Set(client.RetryAfterHeader, tt.retryAfter)
+				}
+				w.WriteHeader(status)
+				if status == http.StatusOK {
+					w.Write([]byte(`{"id":1,"name":"Go"}`))
+					return
+				}
+				w.Write([]byte(`"Test"`))
+			}))
+			defer s.Close()
+
+			c := client.NewClient(s.URL)
+			c.APIKey = "key"
+			c.MaxRetries = 2
+			c.MinBackoff = time.Millisecond
+
+			_, err := c.Create(context.Background(), &model.ProgrammingLang{Name: "Go"})
+			if !reflect.DeepEqual(err, tt.wantErr) {
+				t.Errorf("Client.Create() error = %#v, wantErr %#v", err, tt.wantErr)
+			}
+			if len(requests) != tt.wantCalls {
+				t.Errorf("calls = %v, want %v", len(requests), tt.wantCalls)
+			}
+			for _, r := range requests {
+				if r.apiKey != "key" {
+					t.Errorf("%s = %v, want %v", client.APIKeyHeader, r.apiKey, "key")
+				}
+				if r.idempotencyKey == "" || r.idempotencyKey != requests[0].idempotencyKey {
+					t.Errorf("%s = %v, want the same key as the first request %v", client.IdempotencyKeyHeader, r.idempotencyKey, requests[0].idempotencyKey)
+				}
+			}
+		})
+	}
+}
+
+func TestClient_RetryCanceled(t *testing.T) {
+	s := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusServiceUnavailable)
+	}))
+	defer s.Close()
+
+	c := client.NewClient(s.URL)
+	c.MinBackoff = time.Hour
+	c.MaxBackoff = time.Hour
+
+	ctx, cancel := context.WithTimeout(context.Background(), 50*time.Millisecond)
+	defer cancel()
+
+	if _, err := c.Get(ctx, 1); err == nil || ctx.Err() == nil {
+		t.Errorf("Client.Get() error = %v, want an error after the context is done", err)
+	}
+}
@@ -0,0 +1,59 @@
+package client
+
+import "time"
+
+// パスの定義。
+const (
+	ProgrammingLangV2Path = "/v2/langs"
+)
+
+// クエリストリングの属性。
+const (
+	Limit  = "limit"
+	Cursor = "cursor"
+)
+
+// HTTPのヘッダー。
+const (
+	APIKeyHeader         = "X-API-Key"
+	IdempotencyKeyHeader = "Idempotency-Key"
+	RetryAfterHeader     = "Retry-After"
+	ContentTypeHeader    = "Content-Type"
+	AcceptHeader         = "Accept"
+)
+
+// Content-Typeの値。
+const (
+	JSONContentType = "application/json"
+)
+
+// /v2のエラー(application/problem+json)のtype。サーバーのadapter/apiの値と同じ。
+const (
+	ProblemTypeRequired         = "urn:problem-type:required"
+	ProblemTypeInvalidProperty  = "urn:problem-type:invalid-property"
+	ProblemTypeInvalidParameter = "urn:problem-type:invalid-parameter"
+	ProblemTypeNotFound         = "urn:problem-type:not-found"
+	ProblemTypeAlreadyExists    = "urn:problem-type:already-exists"
+)
+
+// 1ページで取得する件数。サーバーの上限と同じ。
+const (
+	PageLimit = 100
+)
+
+// 再送の初期値。
+const (
+	DefaultMaxRetries = 3
+	DefaultMinBackoff = 100 * time.Millisecond
+	DefaultMaxBackoff = 5 * time.Second
+)
+
+// HTTPの通信の初期値。
+const (
+	DefaultTimeout = 30 * time.Second
+)
+
+// Idempotency-Keyの長さ。
+const (
+	IdempotencyKeyBytes = 16
+)
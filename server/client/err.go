@@ -0,0 +1,69 @@
+package client
+
+import (
+	"encoding/json"
+	"fmt"
+	"strings"
+
+	"github.com/SekiguchiKai/clean-architecture-with-go/server/domain/model"
+)
+
+// APIError は、ドメインのエラーに変換できないエラーのレスポンスを表す。
+type APIError struct {
+	StatusCode int
+	// Type は、application/problem+jsonのtype。problem+jsonでないレスポンスの場合は空。
+	Type    string
+	Message string
+}
+
+// Error は、エラー文を返す。
+func (e *APIError) Error() string {
+	return fmt.Sprintf("unexpected response. status: %d, message: %s", e.StatusCode, e.Message)
+}
+
+// problem は、/v2が返すapplication/problem+jsonのエラー。typeでエラーの種類を、拡張メンバーでその属性を表す。
+type problem struct {
+	Type       string   `json:"type"`
+	Detail     string   `json:"detail"`
+	Property   string   `json:"property"`
+	Parameter  string   `json:"parameter"`
+	Reason     string   `json:"reason"`
+	Model      string   `json:"model"`
+	ID         int      `json:"id"`
+	Name       string   `json:"name"`
+	DidYouMean []string `json:"didYouMean"`
+}
+
+// decodeError は、エラーのレスポンスを、typeに従ってサーバーが返したものと同じドメインのエラーに変換する。
+// エラー文は解析しないため、typeが不明なエラーやapplication/problem+jsonでないエラーはAPIErrorとして返す。
+func decodeError(statusCode int, body []byte) error {
+	var p problem
+	if err := json.Unmarshal(body, &p); err != nil || p.Type == "" {
+		return &APIError{StatusCode: statusCode, Message: errorMessage(body)}
+	}
+
+	switch p.Type {
+	case ProblemTypeRequired:
+		return &model.RequiredError{Property: p.Property}
+	case ProblemTypeInvalidProperty:
+		return &model.InvalidPropertyError{Property: p.Property, Message: p.Reason}
+	case ProblemTypeInvalidParameter:
+		return &model.InvalidParameterError{Parameter: p.Parameter, Message: p.Reason}
+	case ProblemTypeNotFound:
+		return &model.NoSuchDataError{ModelName: p.Model, ID: p.ID, Name: p.Name, DidYouMean: p.DidYouMean}
+	case ProblemTypeAlreadyExists:
+		return &model.AlreadyExistError{ModelName: p.Model, ID: p.ID, Name: p.Name}
+	}
+
+	return &APIError{StatusCode: statusCode, Type: p.Type, Message: p.Detail}
+}
+
+// errorMessage は、application/problem+jsonでないエラーのレスポンスのボディからエラー文を取り出す。
+// ボディがJSONの文字列の場合はその値を、それ以外の場合はボディをそのまま返す。
+func errorMessage(body []byte) string {
+	var msg string
+	if err := json.Unmarshal(body, &msg); err == nil {
+		return msg
+	}
+	return strings.TrimSpace(string(body))
+}
@@ -0,0 +1,524 @@
+package main
+
+import (
+	"bytes"
+	"context"
+	"flag"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"strconv"
+	"text/tabwriter"
+
+	"github.com/SekiguchiKai/clean-architecture-with-go/server/client"
+	"github.com/SekiguchiKai/clean-architecture-with-go/server/domain/model"
+	"github.com/SekiguchiKai/clean-architecture-with-go/server/usecase/output"
+	"github.com/pkg/errors"
+)
+
+// app は、サブコマンドの実行に必要な入出力と環境変数を保持する。
+type app struct {
+	ctx    context.Context
+	stdin  io.Reader
+	stdout io.Writer
+	stderr io.Writer
+	getenv func(string) string
+}
+
+// runner は、フラグを読み込んだ後に、位置引数を受け取ってサブコマンドを実行する関数。
+type runner func(a *app, args []string) error
+
+// command は、サブコマンドを表す。setupは、FlagSetにフラグを定義し、それを使用するrunnerを返す。
+type command struct {
+	name    string
+	args    string
+	summary string
+	setup   func(fs *flag.FlagSet) runner
+}
+
+// usageError は、サブコマンドの使い方が誤っていることを表すエラー。
+type usageError struct {
+	message string
+}
+
+// Error は、エラー文を返す。
+func (e *usageError) Error() string {
+	return e.message
+}
+
+// connection は、接続先を指定するフラグの値を表す。
+type connection struct {
+	profile string
+	server  string
+	apiKey  string
+}
+
+// commands は、サブコマンドの一覧を返す。
+func commands() []*command {
+	return []*command{
+		{name: listCommand, summary: "List programming languages", setup: setupList},
+		{name: getCommand, args: "<id>", summary: "Show a programming language", setup: setupGet},
+		{name: createCommand, summary: "Create a programming language from a JSON or YAML file", setup: setupCreate},
+		{name: updateCommand, args: "<id>", summary: "Update the attributes given in a JSON or YAML file", setup: setupUpdate},
+		{name: deleteCommand, args: "<id>...", summary: "Delete programming languages", setup: setupDelete},
+		{name: importCommand, summary: "Create or update every programming language in a file", setup: setupImport},
+		{name: exportCommand, summary: "Write every programming language as JSON or YAML", setup: setupExport},
+		{name: profileCommand, args: "list | set <name> | use <name>", summary: "Manage server profiles", setup: setupProfile},
+		{name: completionCommand, args: "bash | zsh", summary: "Print a shell completion script", setup: setupCompletion},
+		{name: helpCommand, args: "[command]", summary: "Show help", setup: setupHelp},
+	}
+}
+
+// findCommand は、nameのサブコマンドを返す。存在しない場合は、nilを返す。
+func findCommand(name string) *command {
+	for _, cmd := range commands() {
+		if cmd.name == name {
+			return cmd
+		}
+	}
+	return nil
+}
+
+// run は、引数で指定したサブコマンドを実行し、終了コードを返す。
+func run(a *app, args []string) int {
+	if len(args) == 0 {
+		printUsage(a.stderr)
+		return exitUsage
+	}
+
+	cmd := findCommand(args[0])
+	if cmd == nil {
+		fmt.Fprintf(a.stderr, "unknown command: %s\n", args[0])
+		printUsage(a.stderr)
+		return exitUsage
+	}
+
+	fs := newFlagSet(cmd, a.stderr)
+	r := cmd.setup(fs)
+	positional, err := parseFlags(fs, args[1:])
+	if err == flag.ErrHelp {
+		return exitOK
+	}
+	if err != nil {
+		return exitUsage
+	}
+
+	if err := r(a, positional); err != nil {
+		fmt.Fprintln(a.stderr, err.Error())
+		if _, ok := err.(*usageError); ok {
+			fs.Usage()
+			return exitUsage
+		}
+		return exitError
+	}
+	return exitOK
+}
+
+// newFlagSet は、サブコマンドのFlagSetを生成し、返す。
+func newFlagSet(cmd *command, stderr io.Writer) *flag.FlagSet {
+	fs := flag.NewFlagSet(cmd.name, flag.ContinueOnError)
+	fs.SetOutput(stderr)
+	fs.Usage = func() {
+		fmt.Fprintf(stderr, "Usage: %s %s [flags] %s\n\n%s\n", commandName, cmd.name, cmd.args, cmd.summary)
+		fs.PrintDefaults()
+	}
+	return fs
+}
+
+// parseFlags は、位置引数の後ろに指定したフラグも含めて読み込み、位置引数を返す。
+func parseFlags(fs *flag.FlagSet, args []string) ([]string, error) {
+	var positional []string
+	for {
+		if err := fs.Parse(args); err != nil {
+			return nil, err
+		}
+
+		args = fs.Args()
+		if len(args) == 0 {
+			return positional, nil
+		}
+		positional = append(positional, args[0])
+		args = args[1:]
+	}
+}
+
+// printUsage は、サブコマンドの一覧を書き込む。
+func printUsage(w io.Writer) {
+	fmt.Fprintf(w, "Usage: %s <command> [flags] [args]\n\nCommands:\n", commandName)
+	tw := tabwriter.NewWriter(w, 0, 8, 2, ' ', 0)
+	for _, cmd := range commands() {
+		fmt.Fprintf(tw, "  %s %s\t%s\n", cmd.name, cmd.args, cmd.summary)
+	}
+	tw.Flush()
+	fmt.Fprintf(w, "\nRun '%s help <command>' for the flags of a command.\n", commandName)
+}
+
+// connectionFlags は、接続先を指定するフラグを定義する。
+func connectionFlags(fs *flag.FlagSet) *connection {
+	c := &connection{}
+	fs.StringVar(&c.profile, "profile", "", "profile in the config file (env "+profileEnv+")")
+	fs.StringVar(&c.server, "server", "", "server URL, overriding the profile (env "+serverEnv+")")
+	fs.StringVar(&c.apiKey, "api-key", "", "API key sent as X-API-Key, overriding the profile (env "+apiKeyEnv+")")
+	return c
+}
+
+// outputFlag は、出力の形式を指定するフラグを定義する。
+func outputFlag(fs *flag.FlagSet, value string) *string {
+	return fs.String("o", value, "output format: "+tableFormat+", "+jsonFormat+" or "+yamlFormat)
+}
+
+// client は、フラグ、環境変数、設定ファイルのProfileの順に優先して接続先を決め、Clientを生成し、返す。
+func (a *app) client(conn *connection) (*client.Client, error) {
+	cfg, err := LoadConfig(configPath(a.getenv))
+	if err != nil {
+		return nil, err
+	}
+
+	p, err := cfg.Profile(firstNonEmpty(conn.profile, a.getenv(profileEnv)))
+	if err != nil {
+		return nil, err
+	}
+
+	c := client.NewClient(firstNonEmpty(conn.server, a.getenv(serverEnv), p.Server, defaultServer))
+	c.APIKey = firstNonEmpty(conn.apiKey, a.getenv(apiKeyEnv), p.APIKey)
+	return c, nil
+}
+
+// readInput は、pathのファイルを読み込む。pathが-の場合は、標準入力から読み込む。
+func (a *app) readInput(path string) ([]byte, error) {
+	if path == "" {
+		return nil, &usageError{message: "-f is required"}
+	}
+	if path == stdinFile {
+		b, err := ioutil.ReadAll(a.stdin)
+		return b, errors.WithStack(err)
+	}
+
+	b, err := ioutil.ReadFile(path)
+	return b, errors.WithStack(err)
+}
+
+// firstNonEmpty は、values のうち、最初の空でない値を返す。
+func firstNonEmpty(values ...string) string {
+	for _, v := range values {
+		if v != "" {
+			return v
+		}
+	}
+	return ""
+}
+
+// checkFormat は、出力の形式が適切であるかどうかを確認する。
+func checkFormat(format string) error {
+	if !validFormat(format) {
+		return &usageError{message: fmt.Sprintf("unknown output format: %s", format)}
+	}
+	return nil
+}
+
+// parseID は、位置引数をIDとして読み込む。
+func parseID(arg string) (int, error) {
+	id, err := strconv.Atoi(arg)
+	if err != nil {
+		return 0, &usageError{message: fmt.Sprintf("id should be int: %s", arg)}
+	}
+	return id, nil
+}
+
+// exactArgs は、位置引数の数がnであることを確認する。
+func exactArgs(args []string, n int) error {
+	if len(args) != n {
+		return &usageError{message: fmt.Sprintf("expected %d argument(s), got %d", n, len(args))}
+	}
+	return nil
+}
+
+// writeLangs は、ProgrammingLangの一覧をformatの形式でwに書き込む。
+func writeLangs(w io.Writer, format string, langs []*model.ProgrammingLang) error {
+	outs := output.NewProgrammingLangOutputs(langs)
+	if outs == nil {
+		outs = []*output.ProgrammingLangOutput{}
+	}
+	return write(w, format, outs, langsTable(outs))
+}
+
+// writeLang は、ProgrammingLangをformatの形式でwに書き込む。
+func writeLang(w io.Writer, format string, lang *model.ProgrammingLang) error {
+	out := output.NewProgrammingLangOutput(lang)
+	return write(w, format, out, langsTable([]*output.ProgrammingLangOutput{out}))
+}
+
+// setupList は、listのフラグを定義する。
+func setupList(fs *flag.FlagSet) runner {
+	conn := connectionFlags(fs)
+	format := outputFlag(fs, tableFormat)
+	limit := fs.Int("limit", defaultLimit, "maximum number of languages")
+	all := fs.Bool("all", false, "list every language, ignoring -limit")
+
+	return func(a *app, args []string) error {
+		if err := exactArgs(args, 0); err != nil {
+			return err
+		}
+		if err := checkFormat(*format); err != nil {
+			return err
+		}
+
+		c, err := a.client(conn)
+		if err != nil {
+			return err
+		}
+
+		var langs []*model.ProgrammingLang
+		if *all {
+			langs, err = c.ListAll(a.ctx)
+		} else {
+			langs, err = c.List(a.ctx, *limit)
+		}
+		if err != nil {
+			return err
+		}
+		return writeLangs(a.stdout, *format, langs)
+	}
+}
+
+// setupGet は、getのフラグを定義する。
+func setupGet(fs *flag.FlagSet) runner {
+	conn := connectionFlags(fs)
+	format := outputFlag(fs, tableFormat)
+
+	return func(a *app, args []string) error {
+		if err := exactArgs(args, 1); err != nil {
+			return err
+		}
+		if err := checkFormat(*format); err != nil {
+			return err
+		}
+		id, err := parseID(args[0])
+		if err != nil {
+			return err
+		}
+
+		c, err := a.client(conn)
+		if err != nil {
+			return err
+		}
+
+		lang, err := c.Get(a.ctx, id)
+		if err != nil {
+			return err
+		}
+		return writeLang(a.stdout, *format, lang)
+	}
+}
+
+// setupCreate は、createのフラグを定義する。
+func setupCreate(fs *flag.FlagSet) runner {
+	conn := connectionFlags(fs)
+	format := outputFlag(fs, tableFormat)
+	file := fs.String("f", "", "JSON or YAML file of the language, - for stdin")
+
+	return func(a *app, args []string) error {
+		if err := exactArgs(args, 0); err != nil {
+			return err
+		}
+		if err := checkFormat(*format); err != nil {
+			return err
+		}
+		b, err := a.readInput(*file)
+		if err != nil {
+			return err
+		}
+
+		in := &output.ProgrammingLangOutput{}
+		if err := decode(b, in); err != nil {
+			return err
+		}
+
+		c, err := a.client(conn)
+		if err != nil {
+			return err
+		}
+
+		lang, err := c.Create(a.ctx, in.ToModel())
+		if err != nil {
+			return err
+		}
+		return writeLang(a.stdout, *format, lang)
+	}
+}
+
+// setupUpdate は、updateのフラグを定義する。
+// ファイルに含まれない属性は現在の値を保つよう、現在の値にファイルの属性を上書きして送信する。
+func setupUpdate(fs *flag.FlagSet) runner {
+	conn := connectionFlags(fs)
+	format := outputFlag(fs, tableFormat)
+	file := fs.String("f", "", "JSON or YAML file of the attributes to change, - for stdin")
+
+	return func(a *app, args []string) error {
+		if err := exactArgs(args, 1); err != nil {
+			return err
+		}
+		if err := checkFormat(*format); err != nil {
+			return err
+		}
+		id, err := parseID(args[0])
+		if err != nil {
+			return err
+		}
+		b, err := a.readInput(*file)
+		if err != nil {
+			return err
+		}
+
+		c, err := a.client(conn)
+		if err != nil {
+			return err
+		}
+
+		current, err := c.Get(a.ctx, id)
+		if err != nil {
+			return err
+		}
+
+		in := output.NewProgrammingLangOutput(current)
+		if err := decode(b, in); err != nil {
+			return err
+		}
+
+		lang, err := c.Update(a.ctx, id, in.ToModel())
+		if err != nil {
+			return err
+		}
+		return writeLang(a.stdout, *format, lang)
+	}
+}
+
+// setupDelete は、deleteのフラグを定義する。
+func setupDelete(fs *flag.FlagSet) runner {
+	conn := connectionFlags(fs)
+
+	return func(a *app, args []string) error {
+		if len(args) == 0 {
+			return &usageError{message: "at least one id is required"}
+		}
+
+		ids := make([]int, 0, len(args))
+		for _, arg := range args {
+			id, err := parseID(arg)
+			if err != nil {
+				return err
+			}
+			ids = append(ids, id)
+		}
+
+		c, err := a.client(conn)
+		if err != nil {
+			return err
+		}
+
+		for _, id := range ids {
+			if err := c.Delete(a.ctx, id); err != nil {
+				return err
+			}
+			fmt.Fprintf(a.stdout, "deleted %d\n", id)
+		}
+		return nil
+	}
+}
+
+// setupImport は、importのフラグを定義する。
+// ファイルの形式はexportと同じであり、同じ名前のものが存在する場合は、そのIDのものを更新する。
+func setupImport(fs *flag.FlagSet) runner {
+	conn := connectionFlags(fs)
+	format := outputFlag(fs, tableFormat)
+	file := fs.String("f", "", "JSON or YAML file written by export, - for stdin")
+
+	return func(a *app, args []string) error {
+		if err := exactArgs(args, 0); err != nil {
+			return err
+		}
+		if err := checkFormat(*format); err != nil {
+			return err
+		}
+		b, err := a.readInput(*file)
+		if err != nil {
+			return err
+		}
+
+		var ins []*output.ProgrammingLangOutput
+		if err := decode(b, &ins); err != nil {
+			return err
+		}
+
+		c, err := a.client(conn)
+		if err != nil {
+			return err
+		}
+
+		results := make([]*importResult, 0, len(ins))
+		for _, in := range ins {
+			r, err := importLang(a.ctx, c, in.ToModel())
+			if err != nil {
+				write(a.stdout, *format, results, importResultsTable(results))
+				return errors.Wrapf(err, "failed to import %s", in.Name)
+			}
+			results = append(results, r)
+		}
+		return write(a.stdout, *format, results, importResultsTable(results))
+	}
+}
+
+// importLang は、langを生成する。同じ名前のものが既に存在する場合は、そのIDのものを更新する。
+func importLang(ctx context.Context, c *client.Client, lang *model.ProgrammingLang) (*importResult, error) {
+	created, err := c.Create(ctx, lang)
+	if err == nil {
+		return &importResult{ID: created.ID, Name: created.Name, Action: importCreated}, nil
+	}
+
+	exist, ok := err.(*model.AlreadyExistError)
+	if !ok || exist.ID == 0 {
+		return nil, err
+	}
+
+	updated, err := c.Update(ctx, exist.ID, lang)
+	if err != nil {
+		return nil, err
+	}
+	return &importResult{ID: updated.ID, Name: updated.Name, Action: importUpdated}, nil
+}
+
+// setupExport は、exportのフラグを定義する。
+func setupExport(fs *flag.FlagSet) runner {
+	conn := connectionFlags(fs)
+	format := fs.String("o", jsonFormat, "output format: "+jsonFormat+" or "+yamlFormat)
+	file := fs.String("f", "", "file to write, stdout if omitted")
+
+	return func(a *app, args []string) error {
+		if err := exactArgs(args, 0); err != nil {
+			return err
+		}
+		if *format != jsonFormat && *format != yamlFormat {
+			return &usageError{message: fmt.Sprintf("export supports %s or %s, got %s", jsonFormat, yamlFormat, *format)}
+		}
+
+		c, err := a.client(conn)
+		if err != nil {
+			return err
+		}
+
+		langs, err := c.ListAll(a.ctx)
+		if err != nil {
+			return err
+		}
+
+		if *file == "" {
+			return writeLangs(a.stdout, *format, langs)
+		}
+
+		var buf bytes.Buffer
+		if err := writeLangs(&buf, *format, langs); err != nil {
+			return err
+		}
+		return errors.WithStack(ioutil.WriteFile(*file, buf.Bytes(), 0644))
+	}
+}
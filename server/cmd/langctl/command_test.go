@@ -0,0 +1,465 @@
+package main
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/SekiguchiKai/clean-architecture-with-go/server/adapter/api"
+	"github.com/SekiguchiKai/clean-architecture-with-go/server/domain/model"
+	"github.com/SekiguchiKai/clean-architecture-with-go/server/usecase/input"
+	"github.com/SekiguchiKai/clean-architecture-with-go/server/usecase/mock"
+	"github.com/SekiguchiKai/clean-architecture-with-go/server/usecase/output"
+	"github.com/gin-gonic/gin"
+	"github.com/golang/mock/gomock"
+)
+
+// runCommand は、環境変数と標準入力を与えてサブコマンドを実行し、終了コードと出力を返す。
+func runCommand(env map[string]string, stdin string, args ...string) (int, string, string) {
+	var stdout, stderr bytes.Buffer
+	a := &app{
+		ctx:    context.Background(),
+		stdin:  strings.NewReader(stdin),
+		stdout: &stdout,
+		stderr: &stderr,
+		getenv: func(key string) string { return env[key] },
+	}
+	code := run(a, args)
+	return code, stdout.String(), stderr.String()
+}
+
+// jsonString は、vをjson出力と同じ形式に変換する。
+func jsonString(t *testing.T, v interface{}) string {
+	var buf bytes.Buffer
+	if err := write(&buf, jsonFormat, v, nil); err != nil {
+		t.Fatal(err)
+	}
+	return buf.String()
+}
+
+// writableFields は、生成、更新の際に送信されない属性を取り除いたProgrammingLangを返す。
+func writableFields(lang *model.ProgrammingLang) *model.ProgrammingLang {
+	l := *lang
+	l.ID = 0
+	l.Slug = ""
+	l.CreatedAt = time.Time{}
+	l.UpdatedAt = time.Time{}
+	return &l
+}
+
+func TestRun(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	u := mock_input.NewMockProgrammingLangInputPort(ctrl)
+	r := gin.New()
+	api.NewProgrammingLangAPI(u).InitAPI(r.Group(api.V1Path))
+	api.NewProgrammingLangV2API(u).InitAPI(r.Group(api.V2Path))
+	s := httptest.NewServer(r)
+	defer s.Close()
+
+	dir := tempDir(t)
+	defer os.RemoveAll(dir)
+	env := map[string]string{
+		configEnv: filepath.Join(dir, defaultConfigFile),
+		serverEnv: s.URL,
+	}
+
+	langs := model.CreateProgrammingLangs(2)
+	goLang := &model.ProgrammingLang{Name: "Go", FirstAppeared: 2009}
+	merged := *langs[0]
+	merged.FirstAppeared = 2009
+	noDataErr := &model.NoSuchDataError{ID: 100, ModelName: model.ModelNameProgrammingLang}
+
+	tests := []struct {
+		name       string
+		args       []string
+		stdin      string
+		setup      func()
+		wantCode   int
+		wantStdout string
+		wantStderr string
+	}{
+		{
+			name: "listの場合、limitを指定して一覧を取得し、表の形式で書き込むこと",
+			args: []string{listCommand, "-limit", "5"},
+			setup: func() {
+				u.EXPECT().ListByFilter(gomock.Any(), &model.ProgrammingLangFilter{Tags: []string{}, TagMatch: model.TagMatchAll, Limit: 6}).Return(langs, nil)
+			},
+			wantCode: exitOK,
+			wantStdout: "ID  NAME       SLUG       FIRST APPEARED  PARADIGMS\n" +
+				"1   testName0  testname0                  \n" +
+				"2   testName1  testname1                  \n",
+		},
+		{
+			name: "list -allの場合、すべてのページを取得し、指定した形式で書き込むこと",
+			args: []string{listCommand, "-all", "-o", jsonFormat},
+			setup: func() {
+				u.EXPECT().ListByFilter(gomock.Any(), gomock.Any()).Return(langs, nil)
+			},
+			wantCode:   exitOK,
+			wantStdout: jsonString(t, output.NewProgrammingLangOutputs(langs)),
+		},
+		{
+			name: "getの場合、位置引数の後ろのフラグも読み込むこと",
+			args: []string{getCommand, "1", "-o", jsonFormat},
+			setup: func() {
+				u.EXPECT().Get(gomock.Any(), 1).Return(langs[0], nil)
+			},
+			wantCode:   exitOK,
+			wantStdout: jsonString(t, output.NewProgrammingLangOutput(langs[0])),
+		},
+		{
+			name: "getでサーバーのエラーが発生した場合、エラー文を書き込み、終了コード1を返すこと",
+			args: []string{getCommand, "100"},
+			setup: func() {
+				u.EXPECT().Get(gomock.Any(), 100).Return(nil, noDataErr)
+			},
+			wantCode:   exitError,
+			wantStderr: noDataErr.Error() + "\n",
+		},
+		{
+			name:     "getのIDが数値でない場合、終了コード2を返すこと",
+			args:     []string{getCommand, "a"},
+			setup:    func() {},
+			wantCode: exitUsage,
+		},
+		{
+			name:  "createの場合、標準入力のYAMLから生成すること",
+			args:  []string{createCommand, "-f", stdinFile, "-o", jsonFormat},
+			stdin: "name: Go\nfirstAppeared: 2009\n",
+			setup: func() {
+				u.EXPECT().Create(gomock.Any(), input.NewProgrammingLangCreate(goLang)).Return(goLang, nil)
+			},
+			wantCode:   exitOK,
+			wantStdout: jsonString(t, output.NewProgrammingLangOutput(goLang)),
+		},
+		{
+			name:     "createで-fがない場合、終了コード2を返すこと",
+			args:     []string{createCommand},
+			setup:    func() {},
+			wantCode: exitUsage,
+		},
+		{
+			name:  "updateの場合、現在の値に入力の属性を上書きして更新すること",
+			args:  []string{updateCommand, "1", "-f", stdinFile, "-o", jsonFormat},
+			stdin: `{"firstAppeared":2009}`,
+			setup: func() {
+				u.EXPECT().Get(gomock.Any(), 1).Return(langs[0], nil)
+				u.EXPECT().Update(gomock.Any(), 1, input.NewProgrammingLangUpdate(&merged)).Return(&merged, nil)
+			},
+			wantCode:   exitOK,
+			wantStdout: jsonString(t, output.NewProgrammingLangOutput(&merged)),
+		},
+		{
+			name: "deleteの場合、指定したすべてのIDを削除すること",
+			args: []string{deleteCommand, "1", "2"},
+			setup: func() {
+				u.EXPECT().Delete(gomock.Any(), 1).Return(nil)
+				u.EXPECT().Delete(gomock.Any(), 2).Return(nil)
+			},
+			wantCode:   exitOK,
+			wantStdout: "deleted 1\ndeleted 2\n",
+		},
+		{
+			name:  "importの場合、存在しないものは生成し、同じ名前のものが存在する場合は更新すること",
+			args:  []string{importCommand, "-f", stdinFile, "-o", jsonFormat},
+			stdin: jsonString(t, output.NewProgrammingLangOutputs([]*model.ProgrammingLang{goLang, langs[1]})),
+			setup: func() {
+				gomock.InOrder(
+					u.EXPECT().Create(gomock.Any(), input.NewProgrammingLangCreate(goLang)).Return(&model.ProgrammingLang{ID: 3, Name: "Go"}, nil),
+					u.EXPECT().Create(gomock.Any(), input.NewProgrammingLangCreate(writableFields(langs[1]))).Return(nil, &model.AlreadyExistError{ID: 2, Name: langs[1].Name}),
+					u.EXPECT().Update(gomock.Any(), 2, input.NewProgrammingLangUpdate(langs[1])).Return(langs[1], nil),
+				)
+			},
+			wantCode: exitOK,
+			wantStdout: jsonString(t, []*importResult{
+				{ID: 3, Name: "Go", Action: importCreated},
+				{ID: 2, Name: langs[1].Name, Action: importUpdated},
+			}),
+		},
+		{
+			name:     "exportで表の形式を指定した場合、終了コード2を返すこと",
+			args:     []string{exportCommand, "-o", tableFormat},
+			setup:    func() {},
+			wantCode: exitUsage,
+		},
+		{
+			name:     "不明なサブコマンドの場合、終了コード2を返すこと",
+			args:     []string{"apply"},
+			setup:    func() {},
+			wantCode: exitUsage,
+		},
+		{
+			name:     "サブコマンドがない場合、終了コード2を返すこと",
+			args:     []string{},
+			setup:    func() {},
+			wantCode: exitUsage,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tt.setup()
+
+			code, stdout, stderr := runCommand(env, tt.stdin, tt.args...)
+			if code != tt.wantCode {
+				t.Errorf("run() = %v, want %v. stderr: %s", code, tt.wantCode, stderr)
+			}
+			if tt.wantCode == exitOK && stdout != tt.wantStdout {
+				t.Errorf("stdout = %q, want %q", stdout, tt.wantStdout)
+			}
+			if tt.wantStderr != "" && stderr != tt.wantStderr {
+				t.Errorf("stderr = %q, want %q", stderr, tt.wantStderr)
+			}
+		})
+	}
+}
+
+func TestRun_Export(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	u := mock_input.NewMockProgrammingLangInputPort(ctrl)
+	r := gin.New()
+	api.NewProgrammingLangV2API(u).InitAPI(r.Group(api.V2Path))
+	s := httptest.NewServer(r)
+	defer s.Close()
+
+	dir := tempDir(t)
+	defer os.RemoveAll(dir)
+	env := map[string]string{
+		configEnv: filepath.Join(dir, defaultConfigFile),
+		serverEnv: s.URL,
+	}
+
+	langs := model.CreateProgrammingLangs(3)
+	langs[0].Paradigms = []model.Paradigm{model.ParadigmConcurrent}
+
+	for _, format := range []string{jsonFormat, yamlFormat} {
+		t.Run(format+"で書き出したファイルをimportと同じ方法で読み込めること", func(t *testing.T) {
+			u.EXPECT().ListByFilter(gomock.Any(), gomock.Any()).Return(langs, nil)
+
+			file := filepath.Join(dir, "langs."+format)
+			if code, _, stderr := runCommand(env, "", exportCommand, "-o", format, "-f", file); code != exitOK {
+				t.Fatalf("run() = %v, want %v. stderr: %s", code, exitOK, stderr)
+			}
+
+			b, err := ioutil.ReadFile(file)
+			if err != nil {
+				t.Fatal(err)
+			}
+			var got []*output.ProgrammingLangOutput
+			if err := decode(b, &got); err != nil {
+				t.Fatal(err)
+			}
+
+			want, _ := json.Marshal(output.NewProgrammingLangOutputs(langs))
+			if g, _ := json.Marshal(got); string(g) != string(want) {
+				t.Errorf("exported = %s, want %s", g, want)
+			}
+		})
+	}
+}
+
+func TestRun_Connection(t *testing.T) {
+	var gotAPIKey string
+	s := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		gotAPIKey = r.Header.Get("X-API-Key")
+		w.Write([]byte(`{"items":[]}`))
+	}))
+	defer s.Close()
+
+	dir := tempDir(t)
+	defer os.RemoveAll(dir)
+	path := filepath.Join(dir, defaultConfigFile)
+	cfg := &Config{
+		CurrentProfile: "prod",
+		Profiles: map[string]*Profile{
+			"prod":    {Server: s.URL, APIKey: "profile-key"},
+			"staging": {Server: s.URL, APIKey: "staging-key"},
+		},
+	}
+	if err := cfg.Save(path); err != nil {
+		t.Fatal(err)
+	}
+
+	tests := []struct {
+		name       string
+		env        map[string]string
+		args       []string
+		wantCode   int
+		wantAPIKey string
+	}{
+		{
+			name:       "フラグも環境変数もない場合、CurrentProfileの接続先を使用すること",
+			env:        map[string]string{configEnv: path},
+			args:       []string{listCommand},
+			wantCode:   exitOK,
+			wantAPIKey: "profile-key",
+		},
+		{
+			name:       "LANGCTL_PROFILEを指定した場合、そのProfileの接続先を使用すること",
+			env:        map[string]string{configEnv: path, profileEnv: "staging"},
+			args:       []string{listCommand},
+			wantCode:   exitOK,
+			wantAPIKey: "staging-key",
+		},
+		{
+			name:       "LANGCTL_API_KEYを指定した場合、ProfileのAPIキーより優先すること",
+			env:        map[string]string{configEnv: path, apiKeyEnv: "env-key"},
+			args:       []string{listCommand},
+			wantCode:   exitOK,
+			wantAPIKey: "env-key",
+		},
+		{
+			name:       "-api-keyを指定した場合、環境変数より優先すること",
+			env:        map[string]string{configEnv: path, apiKeyEnv: "env-key"},
+			args:       []string{listCommand, "-api-key", "flag-key"},
+			wantCode:   exitOK,
+			wantAPIKey: "flag-key",
+		},
+		{
+			name:     "存在しないProfileを指定した場合、終了コード1を返すこと",
+			env:      map[string]string{configEnv: path},
+			args:     []string{listCommand, "-profile", "dev"},
+			wantCode: exitError,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			gotAPIKey = ""
+
+			code, _, stderr := runCommand(tt.env, "", tt.args...)
+			if code != tt.wantCode {
+				t.Errorf("run() = %v, want %v. stderr: %s", code, tt.wantCode, stderr)
+			}
+			if gotAPIKey != tt.wantAPIKey {
+				t.Errorf("X-API-Key = %v, want %v", gotAPIKey, tt.wantAPIKey)
+			}
+		})
+	}
+}
+
+func TestRun_Profile(t *testing.T) {
+	dir := tempDir(t)
+	defer os.RemoveAll(dir)
+	env := map[string]string{configEnv: filepath.Join(dir, defaultConfigFile)}
+
+	steps := []struct {
+		name       string
+		args       []string
+		wantCode   int
+		wantStdout string
+	}{
+		{
+			name:     "setの場合、Profileを保存すること",
+			args:     []string{profileCommand, profileSetCommand, "prod", "-server", "https://langs.example.com", "-api-key", "secret"},
+			wantCode: exitOK,
+		},
+		{
+			name:     "set -useの場合、Profileを保存し、現在のProfileにすること",
+			args:     []string{profileCommand, profileSetCommand, "local", "-server", defaultServer, "-use"},
+			wantCode: exitOK,
+		},
+		{
+			name:     "useの場合、現在のProfileを変更すること",
+			args:     []string{profileCommand, profileUseCommand, "prod"},
+			wantCode: exitOK,
+		},
+		{
+			name:     "存在しないProfileをuseした場合、終了コード1を返すこと",
+			args:     []string{profileCommand, profileUseCommand, "staging"},
+			wantCode: exitError,
+		},
+		{
+			name:     "setでフラグを指定しない値は、現在の値を保つこと",
+			args:     []string{profileCommand, profileSetCommand, "prod", "-server", "https://langs2.example.com"},
+			wantCode: exitOK,
+		},
+		{
+			name:     "listの場合、APIキーを除いて一覧を書き込むこと",
+			args:     []string{profileCommand, profileListCommand},
+			wantCode: exitOK,
+			wantStdout: "CURRENT  NAME   SERVER\n" +
+				"         local  http://localhost:8080\n" +
+				"*        prod   https://langs2.example.com\n",
+		},
+	}
+	for _, tt := range steps {
+		t.Run(tt.name, func(t *testing.T) {
+			code, stdout, stderr := runCommand(env, "", tt.args...)
+			if code != tt.wantCode {
+				t.Errorf("run() = %v, want %v. stderr: %s", code, tt.wantCode, stderr)
+			}
+			if tt.wantStdout != "" && stdout != tt.wantStdout {
+				t.Errorf("stdout = %q, want %q", stdout, tt.wantStdout)
+			}
+		})
+	}
+
+	cfg, err := LoadConfig(env[configEnv])
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got := cfg.Profiles["prod"].APIKey; got != "secret" {
+		t.Errorf("APIKey = %v, want %v", got, "secret")
+	}
+}
+
+func TestRun_Completion(t *testing.T) {
+	tests := []struct {
+		name       string
+		shell      string
+		wantCode   int
+		wantPrefix string
+	}{
+		{
+			name:       "bashの場合、補完の関数を登録するスクリプトを書き込むこと",
+			shell:      bashShell,
+			wantCode:   exitOK,
+			wantPrefix: "_langctl() {",
+		},
+		{
+			name:       "zshの場合、bashcompinitを読み込むスクリプトを書き込むこと",
+			shell:      zshShell,
+			wantCode:   exitOK,
+			wantPrefix: "autoload -U +X bashcompinit && bashcompinit\n_langctl() {",
+		},
+		{
+			name:     "不明なシェルの場合、終了コード2を返すこと",
+			shell:    "fish",
+			wantCode: exitUsage,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			code, stdout, _ := runCommand(nil, "", completionCommand, tt.shell)
+			if code != tt.wantCode {
+				t.Errorf("run() = %v, want %v", code, tt.wantCode)
+			}
+			if tt.wantCode != exitOK {
+				return
+			}
+
+			if !strings.HasPrefix(stdout, tt.wantPrefix) {
+				t.Errorf("script = %q, want prefix %q", stdout, tt.wantPrefix)
+			}
+			for _, cmd := range commands() {
+				if !strings.Contains(stdout, "        "+cmd.name+") words=") {
+					t.Errorf("script does not complete the flags of %s", cmd.name)
+				}
+			}
+			if !strings.Contains(stdout, `list) words="-all -api-key -limit -o -profile -server"`) {
+				t.Errorf("script does not complete the flags defined by list")
+			}
+		})
+	}
+}
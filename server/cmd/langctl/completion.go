@@ -0,0 +1,101 @@
+package main
+
+import (
+	"flag"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"strings"
+)
+
+// setupCompletion は、completionのフラグを定義する。
+func setupCompletion(fs *flag.FlagSet) runner {
+	return func(a *app, args []string) error {
+		if err := exactArgs(args, 1); err != nil {
+			return err
+		}
+
+		switch args[0] {
+		case bashShell:
+			writeBashCompletion(a.stdout)
+		case zshShell:
+			// zshでは、bashの補完をbashcompinitで読み込む。
+			fmt.Fprintln(a.stdout, "autoload -U +X bashcompinit && bashcompinit")
+			writeBashCompletion(a.stdout)
+		default:
+			return &usageError{message: fmt.Sprintf("unknown shell: %s", args[0])}
+		}
+		return nil
+	}
+}
+
+// setupHelp は、helpのフラグを定義する。
+func setupHelp(fs *flag.FlagSet) runner {
+	return func(a *app, args []string) error {
+		if len(args) == 0 {
+			printUsage(a.stdout)
+			return nil
+		}
+
+		cmd := findCommand(args[0])
+		if cmd == nil {
+			return &usageError{message: fmt.Sprintf("unknown command: %s", args[0])}
+		}
+
+		f := newFlagSet(cmd, a.stdout)
+		cmd.setup(f)
+		f.Usage()
+		return nil
+	}
+}
+
+// commandFlags は、サブコマンドに定義されたフラグの名前の一覧を返す。
+func commandFlags(cmd *command) []string {
+	fs := flag.NewFlagSet(cmd.name, flag.ContinueOnError)
+	fs.SetOutput(ioutil.Discard)
+	cmd.setup(fs)
+
+	var names []string
+	fs.VisitAll(func(f *flag.Flag) {
+		names = append(names, "-"+f.Name)
+	})
+	return names
+}
+
+// writeBashCompletion は、サブコマンドとそのフラグを補完するbashのスクリプトを書き込む。
+// サブコマンドとフラグの一覧は、commandsから生成する。
+func writeBashCompletion(w io.Writer) {
+	names := make([]string, 0, len(commands()))
+	for _, cmd := range commands() {
+		names = append(names, cmd.name)
+	}
+
+	fmt.Fprintf(w, "_%s() {\n", commandName)
+	fmt.Fprintln(w, `    local cur="${COMP_WORDS[COMP_CWORD]}"`)
+	fmt.Fprintln(w, `    if [ "$COMP_CWORD" -eq 1 ]; then`)
+	fmt.Fprintf(w, "        COMPREPLY=($(compgen -W %q -- \"$cur\"))\n", strings.Join(names, " "))
+	fmt.Fprintln(w, "        return")
+	fmt.Fprintln(w, "    fi")
+	fmt.Fprintln(w, `    local words=""`)
+	fmt.Fprintln(w, `    case "${COMP_WORDS[1]}" in`)
+	for _, cmd := range commands() {
+		words := commandFlags(cmd)
+		switch cmd.name {
+		case profileCommand:
+			words = append(words, profileListCommand, profileSetCommand, profileUseCommand)
+		case completionCommand:
+			words = append(words, bashShell, zshShell)
+		case helpCommand:
+			words = append(words, names...)
+		}
+		fmt.Fprintf(w, "        %s) words=%q ;;\n", cmd.name, strings.Join(words, " "))
+	}
+	fmt.Fprintln(w, "    esac")
+	fmt.Fprintln(w, `    case "${COMP_WORDS[COMP_CWORD-1]}" in`)
+	fmt.Fprintf(w, "        -o) words=%q ;;\n", strings.Join([]string{tableFormat, jsonFormat, yamlFormat}, " "))
+	fmt.Fprintln(w, `        -f) COMPREPLY=($(compgen -f -- "$cur")); return ;;`)
+	fmt.Fprintln(w, "    esac")
+	fmt.Fprintln(w, `    COMPREPLY=($(compgen -W "$words" -- "$cur"))`)
+	fmt.Fprintln(w, "}")
+	fmt.Fprintf(w, "complete -o default -F _%s %s\n", commandName, commandName)
+}
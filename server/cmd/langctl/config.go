@@ -0,0 +1,96 @@
+package main
+
+import (
+	"fmt"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"sort"
+
+	"github.com/pkg/errors"
+	"gopkg.in/yaml.v2"
+)
+
+// Config は、langctlの設定ファイルを表す。Profilesには、接続先ごとのサーバーのURLと認証情報を保持する。
+type Config struct {
+	CurrentProfile string              `yaml:"currentProfile,omitempty"`
+	Profiles       map[string]*Profile `yaml:"profiles,omitempty"`
+}
+
+// Profile は、接続先のサーバーのURLと認証情報を表す。
+type Profile struct {
+	Server string `yaml:"server,omitempty"`
+	APIKey string `yaml:"apiKey,omitempty"`
+}
+
+// configPath は、設定ファイルのパスを返す。LANGCTL_CONFIGが指定されていない場合は、ホームディレクトリの.langctl.ymlとする。
+func configPath(getenv func(string) string) string {
+	if path := getenv(configEnv); path != "" {
+		return path
+	}
+	return filepath.Join(getenv(homeEnv), defaultConfigFile)
+}
+
+// LoadConfig は、設定ファイルを読み込み、返す。ファイルが存在しない場合は、空の設定を返す。
+func LoadConfig(path string) (*Config, error) {
+	b, err := ioutil.ReadFile(path)
+	if os.IsNotExist(err) {
+		return &Config{}, nil
+	}
+	if err != nil {
+		return nil, errors.WithStack(err)
+	}
+
+	var c Config
+	if err := yaml.Unmarshal(b, &c); err != nil {
+		return nil, errors.Wrapf(err, "invalid config file %s", path)
+	}
+	return &c, nil
+}
+
+// Save は、設定ファイルを書き込む。APIキーを含むため、所有者のみが読み書きできるようにする。
+func (c *Config) Save(path string) error {
+	b, err := yaml.Marshal(c)
+	if err != nil {
+		return errors.WithStack(err)
+	}
+	return errors.WithStack(ioutil.WriteFile(path, b, 0600))
+}
+
+// Profile は、nameのProfileを返す。nameが空の場合は、CurrentProfile、それも空の場合はdefaultを返す。
+// 明示的に指定したnameが存在しない場合はエラーを返し、指定していない場合は空のProfileを返す。
+func (c *Config) Profile(name string) (*Profile, error) {
+	explicit := name != ""
+	if name == "" {
+		name = c.CurrentProfile
+	}
+	if name == "" {
+		name = defaultProfile
+	}
+
+	if p, ok := c.Profiles[name]; ok && p != nil {
+		return p, nil
+	}
+	if explicit {
+		return nil, fmt.Errorf("no such profile: %s", name)
+	}
+	return &Profile{}, nil
+}
+
+// SetProfile は、nameのProfileを追加、または置き換える。
+func (c *Config) SetProfile(name string, p *Profile) {
+	if c.Profiles == nil {
+		c.Profiles = map[string]*Profile{}
+	}
+	c.Profiles[name] = p
+}
+
+// ProfileNames は、Profileの名前の一覧を昇順で返す。
+func (c *Config) ProfileNames() []string {
+	names := make([]string, 0, len(c.Profiles))
+	for name := range c.Profiles {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	return names
+}
@@ -0,0 +1,162 @@
+package main
+
+import (
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"reflect"
+	"testing"
+)
+
+// tempDir は、テスト用のディレクトリを生成し、返す。
+func tempDir(t *testing.T) string {
+	dir, err := ioutil.TempDir("", commandName)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return dir
+}
+
+func TestConfigPath(t *testing.T) {
+	tests := []struct {
+		name string
+		env  map[string]string
+		want string
+	}{
+		{
+			name: "LANGCTL_CONFIGが指定された場合、そのパスを返すこと",
+			env:  map[string]string{configEnv: "/etc/langctl.yml", homeEnv: "/home/ops"},
+			want: "/etc/langctl.yml",
+		},
+		{
+			name: "LANGCTL_CONFIGが指定されていない場合、ホームディレクトリの.langctl.ymlを返すこと",
+			env:  map[string]string{homeEnv: "/home/ops"},
+			want: "/home/ops/.langctl.yml",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			getenv := func(key string) string { return tt.env[key] }
+			if got := configPath(getenv); got != tt.want {
+				t.Errorf("configPath() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestLoadConfig(t *testing.T) {
+	dir := tempDir(t)
+	defer os.RemoveAll(dir)
+
+	cfg := &Config{
+		CurrentProfile: "prod",
+		Profiles: map[string]*Profile{
+			"prod": {Server: "https://langs.example.com", APIKey: "secret"},
+		},
+	}
+	path := filepath.Join(dir, defaultConfigFile)
+	if err := cfg.Save(path); err != nil {
+		t.Fatal(err)
+	}
+
+	invalid := filepath.Join(dir, "invalid.yml")
+	if err := ioutil.WriteFile(invalid, []byte("profiles: ["), 0600); err != nil {
+		t.Fatal(err)
+	}
+
+	tests := []struct {
+		name    string
+		path    string
+		want    *Config
+		wantErr bool
+	}{
+		{
+			name: "Saveで書き込んだファイルの場合、同じ設定を返すこと",
+			path: path,
+			want: cfg,
+		},
+		{
+			name: "ファイルが存在しない場合、空の設定を返すこと",
+			path: filepath.Join(dir, "none.yml"),
+			want: &Config{},
+		},
+		{
+			name:    "YAMLとして読み込めない場合、エラーを返すこと",
+			path:    invalid,
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := LoadConfig(tt.path)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("LoadConfig() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("LoadConfig() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+
+	info, err := os.Stat(path)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if info.Mode().Perm() != 0600 {
+		t.Errorf("permission = %v, want %v", info.Mode().Perm(), os.FileMode(0600))
+	}
+}
+
+func TestConfig_Profile(t *testing.T) {
+	prod := &Profile{Server: "https://langs.example.com", APIKey: "secret"}
+	local := &Profile{Server: "http://localhost:8080"}
+
+	tests := []struct {
+		name    string
+		config  *Config
+		profile string
+		want    *Profile
+		wantErr bool
+	}{
+		{
+			name:    "名前を指定した場合、そのProfileを返すこと",
+			config:  &Config{CurrentProfile: "prod", Profiles: map[string]*Profile{"prod": prod, defaultProfile: local}},
+			profile: defaultProfile,
+			want:    local,
+		},
+		{
+			name:   "名前を指定しない場合、CurrentProfileのProfileを返すこと",
+			config: &Config{CurrentProfile: "prod", Profiles: map[string]*Profile{"prod": prod, defaultProfile: local}},
+			want:   prod,
+		},
+		{
+			name:   "CurrentProfileもない場合、defaultのProfileを返すこと",
+			config: &Config{Profiles: map[string]*Profile{"prod": prod, defaultProfile: local}},
+			want:   local,
+		},
+		{
+			name:   "名前を指定せずにProfileが存在しない場合、空のProfileを返すこと",
+			config: &Config{},
+			want:   &Profile{},
+		},
+		{
+			name:    "指定した名前のProfileが存在しない場合、エラーを返すこと",
+			config:  &Config{Profiles: map[string]*Profile{"prod": prod}},
+			profile: "staging",
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := tt.config.Profile(tt.profile)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("Config.Profile() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("Config.Profile() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
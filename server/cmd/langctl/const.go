@@ -0,0 +1,75 @@
+package main
+
+// コマンドの名前。
+const (
+	commandName = "langctl"
+)
+
+// サブコマンドの名前。
+const (
+	listCommand       = "list"
+	getCommand        = "get"
+	createCommand     = "create"
+	updateCommand     = "update"
+	deleteCommand     = "delete"
+	importCommand     = "import"
+	exportCommand     = "export"
+	profileCommand    = "profile"
+	completionCommand = "completion"
+	helpCommand       = "help"
+)
+
+// profileのサブコマンドの名前。
+const (
+	profileListCommand = "list"
+	profileSetCommand  = "set"
+	profileUseCommand  = "use"
+)
+
+// 出力の形式。
+const (
+	tableFormat = "table"
+	jsonFormat  = "json"
+	yamlFormat  = "yaml"
+)
+
+// 補完のスクリプトを出力するシェル。
+const (
+	bashShell = "bash"
+	zshShell  = "zsh"
+)
+
+// 環境変数の名前。
+const (
+	configEnv  = "LANGCTL_CONFIG"
+	profileEnv = "LANGCTL_PROFILE"
+	serverEnv  = "LANGCTL_SERVER"
+	apiKeyEnv  = "LANGCTL_API_KEY"
+	homeEnv    = "HOME"
+)
+
+// 設定の初期値。
+const (
+	defaultConfigFile = ".langctl.yml"
+	defaultProfile    = "default"
+	defaultServer     = "http://localhost:8080"
+	defaultLimit      = 20
+)
+
+// ファイルの指定。
+const (
+	stdinFile = "-"
+)
+
+// 終了コード。
+const (
+	exitOK    = 0
+	exitError = 1
+	exitUsage = 2
+)
+
+// importで行った処理。
+const (
+	importCreated = "created"
+	importUpdated = "updated"
+)
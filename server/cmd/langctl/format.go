@@ -0,0 +1,133 @@
+package main
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"io"
+	"strconv"
+	"strings"
+	"text/tabwriter"
+
+	"github.com/SekiguchiKai/clean-architecture-with-go/server/usecase/output"
+	"github.com/pkg/errors"
+	"gopkg.in/yaml.v2"
+)
+
+// tableWriter は、値を表の形式で書き込む関数。
+type tableWriter func(w *tabwriter.Writer)
+
+// validFormat は、出力の形式として指定できる値であるかどうかを返す。
+func validFormat(format string) bool {
+	return format == tableFormat || format == jsonFormat || format == yamlFormat
+}
+
+// write は、vをformatの形式でwに書き込む。tableの場合は、tableで書き込む。
+func write(w io.Writer, format string, v interface{}, table tableWriter) error {
+	switch format {
+	case jsonFormat:
+		enc := json.NewEncoder(w)
+		enc.SetIndent("", "  ")
+		return errors.WithStack(enc.Encode(v))
+	case yamlFormat:
+		b, err := toYAML(v)
+		if err != nil {
+			return err
+		}
+		_, err = w.Write(b)
+		return errors.WithStack(err)
+	case tableFormat:
+		tw := tabwriter.NewWriter(w, 0, 8, 2, ' ', 0)
+		table(tw)
+		return errors.WithStack(tw.Flush())
+	}
+	return fmt.Errorf("unknown output format: %s", format)
+}
+
+// toYAML は、vをJSONと同じ属性の名前と順序のYAMLに変換する。
+func toYAML(v interface{}) ([]byte, error) {
+	b, err := json.Marshal(v)
+	if err != nil {
+		return nil, errors.WithStack(err)
+	}
+
+	// JSONはYAMLとしても読み込めるため、順序を保持するMapSliceに読み込んでから書き出す。
+	var out interface{} = &yaml.MapSlice{}
+	if bytes.HasPrefix(bytes.TrimSpace(b), []byte("[")) {
+		out = &[]yaml.MapSlice{}
+	}
+	if err := yaml.Unmarshal(b, out); err != nil {
+		return nil, errors.WithStack(err)
+	}
+
+	b, err = yaml.Marshal(out)
+	return b, errors.WithStack(err)
+}
+
+// decode は、JSONかYAMLのbをvに読み込む。vが値を保持している場合は、bに含まれる属性のみを上書きする。
+func decode(b []byte, v interface{}) error {
+	var raw interface{}
+	if err := yaml.Unmarshal(b, &raw); err != nil {
+		return errors.Wrap(err, "input should be JSON or YAML")
+	}
+
+	j, err := json.Marshal(jsonCompatible(raw))
+	if err != nil {
+		return errors.WithStack(err)
+	}
+	return errors.Wrap(json.Unmarshal(j, v), "invalid input")
+}
+
+// jsonCompatible は、YAMLから読み込んだ値の、キーが文字列でないmapを、JSONに変換できるmapに変換する。
+func jsonCompatible(v interface{}) interface{} {
+	switch t := v.(type) {
+	case map[interface{}]interface{}:
+		m := make(map[string]interface{}, len(t))
+		for k, e := range t {
+			m[fmt.Sprint(k)] = jsonCompatible(e)
+		}
+		return m
+	case []interface{}:
+		for i, e := range t {
+			t[i] = jsonCompatible(e)
+		}
+		return t
+	}
+	return v
+}
+
+// langsTable は、ProgrammingLangの一覧を表の形式で書き込む関数を返す。
+func langsTable(langs []*output.ProgrammingLangOutput) tableWriter {
+	return func(w *tabwriter.Writer) {
+		fmt.Fprintln(w, "ID\tNAME\tSLUG\tFIRST APPEARED\tPARADIGMS")
+		for _, l := range langs {
+			paradigms := make([]string, 0, len(l.Paradigms))
+			for _, p := range l.Paradigms {
+				paradigms = append(paradigms, string(p))
+			}
+
+			firstAppeared := ""
+			if l.FirstAppeared != 0 {
+				firstAppeared = strconv.Itoa(l.FirstAppeared)
+			}
+			fmt.Fprintf(w, "%d\t%s\t%s\t%s\t%s\n", l.ID, l.Name, l.Slug, firstAppeared, strings.Join(paradigms, ","))
+		}
+	}
+}
+
+// importResult は、importで1件のProgrammingLangに行った処理を表す。
+type importResult struct {
+	ID     int    `json:"id"`
+	Name   string `json:"name"`
+	Action string `json:"action"`
+}
+
+// importResultsTable は、importの結果を表の形式で書き込む関数を返す。
+func importResultsTable(results []*importResult) tableWriter {
+	return func(w *tabwriter.Writer) {
+		fmt.Fprintln(w, "ID\tNAME\tACTION")
+		for _, r := range results {
+			fmt.Fprintf(w, "%d\t%s\t%s\n", r.ID, r.Name, r.Action)
+		}
+	}
+}
@@ -0,0 +1,117 @@
+package main
+
+import (
+	"bytes"
+	"reflect"
+	"testing"
+
+	"github.com/SekiguchiKai/clean-architecture-with-go/server/domain/model"
+	"github.com/SekiguchiKai/clean-architecture-with-go/server/usecase/output"
+)
+
+func TestWrite(t *testing.T) {
+	lang := model.CreateProgrammingLangs(1)[0]
+	lang.Feature = "Simple"
+	lang.FirstAppeared = 2009
+	lang.Paradigms = []model.Paradigm{model.ParadigmConcurrent, model.ParadigmImperative}
+	outs := []*output.ProgrammingLangOutput{output.NewProgrammingLangOutput(lang)}
+
+	tests := []struct {
+		name    string
+		format  string
+		want    string
+		wantErr bool
+	}{
+		{
+			name:   "tableの場合、列を揃えた表を書き込むこと",
+			format: tableFormat,
+			want: "ID  NAME       SLUG       FIRST APPEARED  PARADIGMS\n" +
+				"1   testName0  testname0  2009            concurrent,imperative\n",
+		},
+		{
+			name:   "yamlの場合、JSONと同じ属性の名前と順序で書き込むこと",
+			format: yamlFormat,
+			want: "- id: 1\n" +
+				"  name: testName0\n" +
+				"  feature: Simple\n" +
+				"  slug: testname0\n" +
+				"  firstAppeared: 2009\n" +
+				"  paradigms:\n" +
+				"  - concurrent\n" +
+				"  - imperative\n" +
+				"  createdAt: \"2018-10-01T12:00:00Z\"\n" +
+				"  updatedAt: \"2018-10-01T12:00:00Z\"\n",
+		},
+		{
+			name:    "不明な形式の場合、エラーを返すこと",
+			format:  "xml",
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var buf bytes.Buffer
+			err := write(&buf, tt.format, outs, langsTable(outs))
+			if (err != nil) != tt.wantErr {
+				t.Errorf("write() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+			if got := buf.String(); got != tt.want {
+				t.Errorf("write() = %q, want %q", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestDecode(t *testing.T) {
+	tests := []struct {
+		name    string
+		current *output.ProgrammingLangOutput
+		input   string
+		want    *output.ProgrammingLangOutput
+		wantErr bool
+	}{
+		{
+			name:    "JSONの場合、属性を読み込むこと",
+			current: &output.ProgrammingLangOutput{},
+			input:   `{"name":"Go","firstAppeared":2009,"paradigms":["concurrent"]}`,
+			want:    &output.ProgrammingLangOutput{Name: "Go", FirstAppeared: 2009, Paradigms: []model.Paradigm{model.ParadigmConcurrent}},
+		},
+		{
+			name:    "YAMLの場合、JSONと同じ属性の名前で読み込むこと",
+			current: &output.ProgrammingLangOutput{},
+			input:   "name: Go\nfirstAppeared: 2009\nparadigms:\n  - concurrent\n",
+			want:    &output.ProgrammingLangOutput{Name: "Go", FirstAppeared: 2009, Paradigms: []model.Paradigm{model.ParadigmConcurrent}},
+		},
+		{
+			name:    "値を保持している場合、入力に含まれる属性のみを上書きすること",
+			current: &output.ProgrammingLangOutput{ID: 1, Name: "Go", Feature: "Simple"},
+			input:   "firstAppeared: 2009\n",
+			want:    &output.ProgrammingLangOutput{ID: 1, Name: "Go", Feature: "Simple", FirstAppeared: 2009},
+		},
+		{
+			name:    "JSONにもYAMLにも該当しない場合、エラーを返すこと",
+			current: &output.ProgrammingLangOutput{},
+			input:   "name: [",
+			wantErr: true,
+		},
+		{
+			name:    "属性の型が異なる場合、エラーを返すこと",
+			current: &output.ProgrammingLangOutput{},
+			input:   "firstAppeared: soon\n",
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := decode([]byte(tt.input), tt.current)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("decode() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+			if !tt.wantErr && !reflect.DeepEqual(tt.current, tt.want) {
+				t.Errorf("decode() = %v, want %v", tt.current, tt.want)
+			}
+		})
+	}
+}
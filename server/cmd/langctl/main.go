@@ -0,0 +1,18 @@
+// langctl は、/v2/langsのAPIを通じてプログラミング言語のカタログを管理するコマンド。
+package main
+
+import (
+	"context"
+	"os"
+)
+
+func main() {
+	a := &app{
+		ctx:    context.Background(),
+		stdin:  os.Stdin,
+		stdout: os.Stdout,
+		stderr: os.Stderr,
+		getenv: os.Getenv,
+	}
+	os.Exit(run(a, os.Args[1:]))
+}
@@ -0,0 +1,102 @@
+package main
+
+import (
+	"flag"
+	"fmt"
+	"text/tabwriter"
+)
+
+// profileSummary は、profile listで表示するProfileの情報。APIキーは表示しない。
+type profileSummary struct {
+	Name    string `json:"name"`
+	Server  string `json:"server"`
+	Current bool   `json:"current"`
+}
+
+// setupProfile は、profileのフラグを定義する。
+// setでは、指定したフラグの値のみを変更し、それ以外は現在の値を保つ。
+func setupProfile(fs *flag.FlagSet) runner {
+	format := outputFlag(fs, tableFormat)
+	server := fs.String("server", "", "server URL of the profile (set)")
+	apiKey := fs.String("api-key", "", "API key of the profile (set)")
+	use := fs.Bool("use", false, "also make the profile current (set)")
+
+	return func(a *app, args []string) error {
+		if len(args) == 0 {
+			return &usageError{message: "profile subcommand is required"}
+		}
+
+		path := configPath(a.getenv)
+		cfg, err := LoadConfig(path)
+		if err != nil {
+			return err
+		}
+
+		switch args[0] {
+		case profileListCommand:
+			if err := exactArgs(args, 1); err != nil {
+				return err
+			}
+			if err := checkFormat(*format); err != nil {
+				return err
+			}
+			return writeProfiles(a, *format, cfg)
+		case profileSetCommand:
+			if err := exactArgs(args, 2); err != nil {
+				return err
+			}
+
+			p, err := cfg.Profile(args[1])
+			if err != nil {
+				p = &Profile{}
+			}
+			p.Server = firstNonEmpty(*server, p.Server)
+			p.APIKey = firstNonEmpty(*apiKey, p.APIKey)
+			cfg.SetProfile(args[1], p)
+			if *use {
+				cfg.CurrentProfile = args[1]
+			}
+		case profileUseCommand:
+			if err := exactArgs(args, 2); err != nil {
+				return err
+			}
+			if _, err := cfg.Profile(args[1]); err != nil {
+				return err
+			}
+			cfg.CurrentProfile = args[1]
+		default:
+			return &usageError{message: fmt.Sprintf("unknown profile subcommand: %s", args[0])}
+		}
+
+		if err := cfg.Save(path); err != nil {
+			return err
+		}
+		fmt.Fprintf(a.stdout, "saved profile %s to %s (current: %s)\n", args[1], path, firstNonEmpty(cfg.CurrentProfile, defaultProfile))
+		return nil
+	}
+}
+
+// writeProfiles は、Profileの一覧をformatの形式で書き込む。
+func writeProfiles(a *app, format string, cfg *Config) error {
+	current := firstNonEmpty(cfg.CurrentProfile, defaultProfile)
+
+	summaries := make([]*profileSummary, 0, len(cfg.Profiles))
+	for _, name := range cfg.ProfileNames() {
+		summaries = append(summaries, &profileSummary{
+			Name:    name,
+			Server:  cfg.Profiles[name].Server,
+			Current: name == current,
+		})
+	}
+
+	return write(a.stdout, format, summaries, func(w *tabwriter.Writer) {
+		fmt.Fprintln(w, "CURRENT\tNAME\tSERVER")
+		for _, s := range summaries {
+			mark := ""
+			if s.Current {
+				mark = "*"
+			}
+			fmt.Fprintf(w, "%s\t%s\t%s\n", mark, s.Name, s.Server)
+		}
+	})
+}
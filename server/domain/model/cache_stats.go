@@ -0,0 +1,11 @@
+package model
+
+// CacheStats は、Repositoryの読み込み結果のキャッシュの統計情報を表す。
+// Sharedは、同時に行われた同じ読み込みの結果を共有した回数。
+type CacheStats struct {
+	Hits      uint64
+	Misses    uint64
+	Shared    uint64
+	Evictions uint64
+	Size      int
+}
@@ -2,12 +2,66 @@ package model
 
 // プロパティの名称。
 const (
-	PropertyName = "Name"
+	PropertyName          = "Name"
+	PropertyFirstAppeared = "FirstAppeared"
+	PropertyDesigners     = "Designers"
+	PropertyTypeChecking  = "TypeChecking"
+	PropertyTypeStrength  = "TypeStrength"
+	PropertyParadigms     = "Paradigms"
+	PropertyLicense       = "License"
+	PropertyWebsite       = "Website"
+	PropertyExtensions    = "Extensions"
+	PropertyFilenames     = "Filenames"
+	PropertyInterpreters  = "Interpreters"
+	PropertyAliases       = "Aliases"
+	PropertyColor         = "Color"
+	PropertyStableVersion = "StableVersion"
+	PropertyVersion       = "Version"
+	PropertyReleaseDate   = "ReleaseDate"
+	PropertyEOLDate       = "EOLDate"
+	PropertyChangelogURL  = "ChangelogURL"
+	PropertyTagName       = "TagName"
+	PropertyTagID         = "TagID"
+	PropertyInfluencedBy  = "InfluencedBy"
+	PropertyFilename      = "Filename"
+	PropertyPrefix        = "Prefix"
+	PropertyQuery         = "Query"
+	PropertyURL           = "URL"
+	PropertySecret        = "Secret"
+	PropertyEventTypes    = "EventTypes"
+	PropertyStatus        = "Status"
+	PropertyRetention     = "Retention"
 )
 
 // エラー系。
 const (
 	NameShouldBeMoreThanOneUnderTheTwenty = "Length of Name should be 0 < name < 21"
+	FirstAppearedIsOutOfRange             = "FirstAppeared should be between 1940 and next year"
+	DesignerIsInvalid                     = "Designers should be 1 to 100 characters each"
+	TypeCheckingIsUnknown                 = "TypeChecking should be static, dynamic or gradual"
+	TypeStrengthIsUnknown                 = "TypeStrength should be strong or weak"
+	ParadigmIsUnknown                     = "Paradigms should be known and not duplicated"
+	LicenseIsTooLong                      = "Length of License should be under 65"
+	WebsiteIsInvalid                      = "Website should be an absolute http or https URL under 256 characters"
+	ExtensionIsInvalid                    = "Extensions should be like .go and not duplicated"
+	FilenameIsInvalid                     = "Filenames should be like Makefile and not duplicated"
+	InterpreterIsInvalid                  = "Interpreters should be like python3 and not duplicated"
+	AliasIsInvalid                        = "Aliases should be 1 to 32 characters each and differ from Name and each other ignoring case"
+	ColorIsInvalid                        = "Color should be like #00ADD8"
+	StableVersionIsTooLong                = "Length of StableVersion should be under 33"
+	VersionIsNotSemver                    = "Version should be a semantic version like 1.2.3"
+	ReleaseDateIsRequired                 = "ReleaseDate is required"
+	EOLDateIsBeforeReleaseDate            = "EOLDate should not be before ReleaseDate"
+	ChangelogURLIsInvalid                 = "ChangelogURL should be an absolute http or https URL under 256 characters"
+	TagNameIsInvalid                      = "TagName should be 1 to 32 characters of a-z, 0-9, +, #, . or -"
+	TagCannotBeMergedIntoItself           = "Tag cannot be merged into itself"
+	LangCannotInfluenceItself             = "ProgrammingLang cannot be influenced by itself"
+	QueryIsTooLong                        = "Length of Query should be under 257"
+	WebhookURLIsInvalid                   = "URL should be an absolute http or https URL under 256 characters"
+	WebhookSecretIsInvalid                = "Secret should be 16 to 128 characters"
+	EventTypeIsUnknown                    = "EventTypes should be created, updated or deleted and not duplicated"
+	DeliveryStatusIsUnknown               = "Status should be pending, succeeded or dead"
+	RetentionShouldBePositive             = "Retention should be positive"
 )
 
 // エラー用の名称。
@@ -19,20 +73,41 @@ const (
 // モデル名。
 const (
 	ModelNameProgrammingLang = "ProgrammingLang"
+	ModelNameLanguageVersion = "LanguageVersion"
+	ModelNameTag             = "Tag"
+	ModelNameInfluence       = "Influence"
+	ModelNameInfluencePath   = "InfluencePath"
+	ModelNameWebhook         = "Webhook"
+	ModelNameWebhookDelivery = "WebhookDelivery"
+	ModelNameOutboxEvent     = "OutboxEvent"
+	ModelNameTransaction     = "Transaction"
 )
 
 // テスト用の定数。
 const (
 	TestName      = "testName"
+	TestSlug      = "testname"
+	TestVersion   = "1.0.0"
+	TestTagName   = "functional"
 	TestFeature   = "testFeature, testFeature, testFeature, testFeature, testFeature, testFeature, testFeature"
 	TestDBSomeErr = "DB some error"
 )
 
 // DBの操作。
 const (
-	DBMethodCreate = "Create"
-	DBMethodList   = "List"
-	DBMethodRead   = "Read"
-	DBMethodUpdate = "Update"
-	DBMethodDelete = "Delete"
+	DBMethodCreate       = "Create"
+	DBMethodList         = "List"
+	DBMethodRead         = "Read"
+	DBMethodUpdate       = "Update"
+	DBMethodDelete       = "Delete"
+	DBMethodLastModified = "LastModified"
+	DBMethodSlugHistory  = "SlugHistory"
+	DBMethodMerge        = "Merge"
+	DBMethodAttach       = "Attach"
+	DBMethodDetach       = "Detach"
+	DBMethodAlias        = "Alias"
+	DBMethodClaim        = "Claim"
+	DBMethodAttempt      = "Attempt"
+	DBMethodBegin        = "Begin"
+	DBMethodCommit       = "Commit"
 )
@@ -0,0 +1,30 @@
+package model
+
+// DetectionCandidate は、ファイルの言語として推定されたProgrammingLangと、推定の根拠を表す。
+type DetectionCandidate struct {
+	Lang    *ProgrammingLang
+	Score   int
+	Reasons []DetectionReason
+}
+
+// DetectionReason は、言語を推定した根拠を表す。
+type DetectionReason string
+
+// 言語を推定した根拠。
+const (
+	DetectionReasonFilename  DetectionReason = "filename"
+	DetectionReasonShebang   DetectionReason = "shebang"
+	DetectionReasonExtension DetectionReason = "extension"
+	DetectionReasonContent   DetectionReason = "content"
+)
+
+// Add は、根拠のスコアを加算する。同じ根拠は1度だけ記録する。
+func (c *DetectionCandidate) Add(score int, reason DetectionReason) {
+	c.Score += score
+	for _, r := range c.Reasons {
+		if r == reason {
+			return
+		}
+	}
+	c.Reasons = append(c.Reasons, reason)
+}
@@ -1,6 +1,9 @@
 package model
 
-import "fmt"
+import (
+	"fmt"
+	"strings"
+)
 
 // RequiredError は、必要なものが存在しない場合のエラー。
 type RequiredError struct {
@@ -58,14 +61,19 @@ func (e *AlreadyExistError) Error() string {
 	return fmt.Sprintf("already exists. model: %s, id: %d, name: %s", e.ModelName, e.ID, e.Name)
 }
 
-// NoSuchDataError は、データが存在しないことを表すエラー。
+// NoSuchDataError は、データが存在しないことを表すエラー。DidYouMeanには、Nameに近い名前の候補を設定する。
 type NoSuchDataError struct {
-	ID        int
-	Name      string
-	ModelName string
+	ID         int
+	Name       string
+	ModelName  string
+	DidYouMean []string
 }
 
 // Error は、エラーメッセージを返す。
 func (e *NoSuchDataError) Error() string {
-	return fmt.Sprintf("no such model.model: %s, id: %d, name: %s", e.ModelName, e.ID, e.Name)
+	msg := fmt.Sprintf("no such model.model: %s, id: %d, name: %s", e.ModelName, e.ID, e.Name)
+	if len(e.DidYouMean) > 0 {
+		msg += fmt.Sprintf(". did you mean: %s", strings.Join(e.DidYouMean, ", "))
+	}
+	return msg
 }
@@ -0,0 +1,21 @@
+package model
+
+// LangImport は、外部のデータから取り込むProgrammingLangと、付与するTagの名前を表す。
+type LangImport struct {
+	Lang *ProgrammingLang
+	Tags []string
+}
+
+// ImportReport は、ProgrammingLangの取り込みの結果を表す。
+type ImportReport struct {
+	Created int
+	Updated int
+	Skipped int
+	Errors  []*ImportError
+}
+
+// ImportError は、取り込めなかったProgrammingLangとその理由を表す。
+type ImportError struct {
+	Name    string
+	Message string
+}
@@ -0,0 +1,22 @@
+package model
+
+import "time"
+
+// Influence は、LangIDのProgrammingLangがInfluencedByIDのProgrammingLangから影響を受けたことを表す。
+type Influence struct {
+	LangID         int
+	InfluencedByID int
+	CreatedAt      time.Time
+}
+
+// InfluenceNode は、影響関係をたどって見つかったProgrammingLangと、起点からの距離を表す。
+type InfluenceNode struct {
+	Lang  *ProgrammingLang
+	Depth int
+}
+
+// InfluenceGraph は、ProgrammingLangの影響関係の全体を表す。
+type InfluenceGraph struct {
+	Langs      []*ProgrammingLang
+	Influences []*Influence
+}
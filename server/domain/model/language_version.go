@@ -0,0 +1,24 @@
+package model
+
+import "time"
+
+// LanguageVersion は、プログラミング言語のリリースされたバージョンを表す。
+type LanguageVersion struct {
+	ID           int
+	LangID       int
+	Version      string
+	ReleaseDate  time.Time
+	EOLDate      *time.Time
+	LTS          bool
+	ChangelogURL string
+	CreatedAt    time.Time
+	UpdatedAt    time.Time
+}
+
+// IsSupportedOn は、指定した日にサポートされているかどうかを返す。リリース日からEOLの日までをサポート期間とする。
+func (v *LanguageVersion) IsSupportedOn(date time.Time) bool {
+	if date.Before(v.ReleaseDate) {
+		return false
+	}
+	return v.EOLDate == nil || !date.After(*v.EOLDate)
+}
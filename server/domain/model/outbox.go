@@ -0,0 +1,28 @@
+package model
+
+import (
+	"encoding/json"
+	"time"
+)
+
+// 領域イベントの名前。
+const (
+	DomainEventLangCreated = "LangCreated"
+	DomainEventLangUpdated = "LangUpdated"
+	DomainEventLangDeleted = "LangDeleted"
+)
+
+// OutboxEvent は、変更と同じトランザクションでOutboxに記録した領域イベントを表す。
+// Payloadは、ProgrammingLangEventのJSON。配信に成功するまで、NextAttemptAtを延ばしながら配信し直す。
+// Publishedは、既に配信に成功した配信先の名前で、配信し直す際はそれ以外の配信先にだけ配信する。
+type OutboxEvent struct {
+	ID            int
+	Name          string
+	AggregateID   int
+	Payload       json.RawMessage
+	Published     []string
+	Attempts      int
+	NextAttemptAt time.Time
+	LastError     string
+	CreatedAt     time.Time
+}
@@ -3,10 +3,79 @@ package model
 import "time"
 
 // ProgrammingLang は、プログラミング言語を表す。
+// 通信の形式には依存しないため、JSONのタグは付けない。リクエストとレスポンスの形式は、usecase/inputとusecase/outputで定義する。
 type ProgrammingLang struct {
-	ID        int       `json:"id"`
-	Name      string    `json:"name"`
-	Feature   string    `json:"feature"`
-	CreatedAt time.Time `json:"createdAt"`
-	UpdatedAt time.Time `json:"updatedAt"`
+	ID            int
+	Name          string
+	Feature       string
+	Slug          string
+	FirstAppeared int
+	Designers     []string
+	TypeChecking  TypeChecking
+	TypeStrength  TypeStrength
+	Paradigms     []Paradigm
+	License       string
+	Website       string
+	Extensions    []string
+	Filenames     []string
+	Interpreters  []string
+	Aliases       []string
+	Color         string
+	StableVersion string
+	CreatedAt     time.Time
+	UpdatedAt     time.Time
+}
+
+// TypeChecking は、型検査を行う時期を表す。
+type TypeChecking string
+
+// 型検査を行う時期。
+const (
+	TypeCheckingStatic  TypeChecking = "static"
+	TypeCheckingDynamic TypeChecking = "dynamic"
+	TypeCheckingGradual TypeChecking = "gradual"
+)
+
+// TypeStrength は、暗黙の型変換を許容する度合いを表す。
+type TypeStrength string
+
+// 暗黙の型変換を許容する度合い。
+const (
+	TypeStrengthStrong TypeStrength = "strong"
+	TypeStrengthWeak   TypeStrength = "weak"
+)
+
+// Paradigm は、プログラミングパラダイムを表す。
+type Paradigm string
+
+// プログラミングパラダイム。
+const (
+	ParadigmImperative     Paradigm = "imperative"
+	ParadigmProcedural     Paradigm = "procedural"
+	ParadigmObjectOriented Paradigm = "object-oriented"
+	ParadigmFunctional     Paradigm = "functional"
+	ParadigmDeclarative    Paradigm = "declarative"
+	ParadigmLogic          Paradigm = "logic"
+	ParadigmConcurrent     Paradigm = "concurrent"
+	ParadigmGeneric        Paradigm = "generic"
+	ParadigmEventDriven    Paradigm = "event-driven"
+	ParadigmReflective     Paradigm = "reflective"
+	ParadigmScripting      Paradigm = "scripting"
+	ParadigmArray          Paradigm = "array"
+)
+
+// Paradigms は、定義されているプログラミングパラダイムの一覧。
+var Paradigms = []Paradigm{
+	ParadigmImperative,
+	ParadigmProcedural,
+	ParadigmObjectOriented,
+	ParadigmFunctional,
+	ParadigmDeclarative,
+	ParadigmLogic,
+	ParadigmConcurrent,
+	ParadigmGeneric,
+	ParadigmEventDriven,
+	ParadigmReflective,
+	ParadigmScripting,
+	ParadigmArray,
 }
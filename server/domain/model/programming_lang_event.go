@@ -0,0 +1,29 @@
+package model
+
+import "time"
+
+// EventType は、ProgrammingLangに対する変更の種類を表す。
+type EventType string
+
+// 変更の種類。
+const (
+	EventTypeCreated EventType = "created"
+	EventTypeUpdated EventType = "updated"
+	EventTypeDeleted EventType = "deleted"
+)
+
+// EventTypes は、定義されている変更の種類の一覧。
+var EventTypes = []EventType{
+	EventTypeCreated,
+	EventTypeUpdated,
+	EventTypeDeleted,
+}
+
+// ProgrammingLangEvent は、ProgrammingLangに対する変更を表す。
+// 削除の場合、Langは削除前の状態を保持する。IDは、Outboxに記録した際に採番され、重複して受信した変更の判別に使用する。
+type ProgrammingLangEvent struct {
+	ID         int
+	Type       EventType
+	Lang       *ProgrammingLang
+	OccurredAt time.Time
+}
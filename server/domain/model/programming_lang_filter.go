@@ -0,0 +1,12 @@
+package model
+
+// ProgrammingLangFilter は、ProgrammingLangの一覧を絞り込む条件を表す。
+// 一覧はNameの昇順であり、Afterを指定した場合はAfterより後のNameを持つものを返す。
+// Tagsを指定した場合は、TagMatchがanyであればいずれかのタグを、それ以外であれば全てのタグを持つものを返す。
+type ProgrammingLangFilter struct {
+	NameContains string
+	After        string
+	Tags         []string
+	TagMatch     TagMatch
+	Limit        int
+}
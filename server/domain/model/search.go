@@ -0,0 +1,16 @@
+package model
+
+// SearchResult は、全文検索に一致したProgrammingLangを表す。
+// Snippetは、Featureのうち検索語に一致した部分の抜粋で、一致した語をmarkで囲む。
+type SearchResult struct {
+	ID      int
+	Name    string
+	Slug    string
+	Score   float64
+	Snippet string
+}
+
+// ReindexReport は、全文検索の索引を構築し直した結果を表す。
+type ReindexReport struct {
+	Documents int
+}
@@ -0,0 +1,11 @@
+package model
+
+// LangSuggestion は、入力に近いNameもしくは別名を持つProgrammingLangの候補を表す。
+// Matchedは、入力に一致したNameもしくは別名。
+type LangSuggestion struct {
+	ID      int
+	Name    string
+	Slug    string
+	Matched string
+	Score   float64
+}
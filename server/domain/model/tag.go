@@ -0,0 +1,20 @@
+package model
+
+import "time"
+
+// Tag は、ProgrammingLangを分類するタグを表す。
+type Tag struct {
+	ID        int
+	Name      string
+	CreatedAt time.Time
+	UpdatedAt time.Time
+}
+
+// TagMatch は、複数のタグで絞り込む際の条件の組み合わせ方を表す。
+type TagMatch string
+
+// 複数のタグの組み合わせ方。
+const (
+	TagMatchAll TagMatch = "all"
+	TagMatchAny TagMatch = "any"
+)
@@ -18,9 +18,27 @@ func CreateProgrammingLangs(num int) []*ProgrammingLang {
 			ID:        i + 1,
 			Name:      fmt.Sprintf("%s%d", TestName, i),
 			Feature:   fmt.Sprintf("%s%d", TestFeature, i),
+			Slug:      fmt.Sprintf("%s%d", TestSlug, i),
 			CreatedAt: GetTestTime(time.October, i+1),
 			UpdatedAt: GetTestTime(time.October, i+1),
 		}
 	}
 	return langSlice
 }
+
+// CreateLanguageVersions は、引数で与えられた数だけLanguageVersionを生成し、返す。
+// Versionは、1.0.0から順にマイナーバージョンを上げたものとする。
+func CreateLanguageVersions(langID, num int) []*LanguageVersion {
+	versions := make([]*LanguageVersion, num, num)
+	for i := range versions {
+		versions[i] = &LanguageVersion{
+			ID:          i + 1,
+			LangID:      langID,
+			Version:     fmt.Sprintf("1.%d.0", i),
+			ReleaseDate: time.Date(2018, time.January, i+1, 0, 0, 0, 0, time.UTC),
+			CreatedAt:   GetTestTime(time.October, i+1),
+			UpdatedAt:   GetTestTime(time.October, i+1),
+		}
+	}
+	return versions
+}
@@ -0,0 +1,71 @@
+package model
+
+import (
+	"encoding/json"
+	"time"
+)
+
+// Webhook は、ProgrammingLangの変更を通知する先を表す。
+// EventTypesが空の場合は、全ての種類の変更を通知する。Secretは、通知の署名に使用する。
+type Webhook struct {
+	ID         int
+	URL        string
+	Secret     string
+	EventTypes []EventType
+	Disabled   bool
+	CreatedAt  time.Time
+	UpdatedAt  time.Time
+}
+
+// DeliveryStatus は、Webhookの通知の状態を表す。
+type DeliveryStatus string
+
+// 通知の状態。deadは、再送の上限に達して送信を諦めたことを表す。
+const (
+	DeliveryStatusPending   DeliveryStatus = "pending"
+	DeliveryStatusSucceeded DeliveryStatus = "succeeded"
+	DeliveryStatusDead      DeliveryStatus = "dead"
+)
+
+// DeliveryStatuses は、定義されている通知の状態の一覧。
+var DeliveryStatuses = []DeliveryStatus{
+	DeliveryStatusPending,
+	DeliveryStatusSucceeded,
+	DeliveryStatusDead,
+}
+
+// WebhookDelivery は、1件の変更に対するWebhookの通知を表す。
+// Payloadは、送信するProgrammingLangEventのJSON。Logは、送信の記録を古い順に保持する。
+type WebhookDelivery struct {
+	ID             int
+	WebhookID      int
+	EventType      EventType
+	Payload        json.RawMessage
+	Status         DeliveryStatus
+	Attempts       int
+	NextAttemptAt  time.Time
+	LastStatusCode int
+	LastError      string
+	CreatedAt      time.Time
+	UpdatedAt      time.Time
+	Log            []*DeliveryAttempt
+}
+
+// DeliveryAttempt は、Webhookの通知を1回送信した記録を表す。
+// StatusCodeは、応答を受け取れなかった場合は0で、その理由をErrorに保持する。
+type DeliveryAttempt struct {
+	ID          int
+	DeliveryID  int
+	Attempt     int
+	StatusCode  int
+	Error       string
+	DurationMS  int
+	AttemptedAt time.Time
+}
+
+// WebhookRequest は、Webhookの通知として送信するリクエストを表す。
+type WebhookRequest struct {
+	URL     string
+	Headers map[string]string
+	Body    []byte
+}
@@ -0,0 +1,16 @@
+package repository
+
+import (
+	"context"
+
+	"github.com/SekiguchiKai/clean-architecture-with-go/server/domain/model"
+)
+
+// InfluenceRepository は、InfluenceのRepository。
+type InfluenceRepository interface {
+	List(ctx context.Context) ([]*model.Influence, error)
+	ListByLang(ctx context.Context, langID int) ([]*model.Influence, error)
+	Read(ctx context.Context, langID, influencedByID int) (*model.Influence, error)
+	Create(ctx context.Context, influence *model.Influence) (*model.Influence, error)
+	Delete(ctx context.Context, langID, influencedByID int) error
+}
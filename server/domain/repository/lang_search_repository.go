@@ -0,0 +1,13 @@
+package repository
+
+import (
+	"context"
+
+	"github.com/SekiguchiKai/clean-architecture-with-go/server/domain/model"
+)
+
+// LangSearchRepository は、ProgrammingLangの全文検索の索引のRepository。
+type LangSearchRepository interface {
+	Search(ctx context.Context, query string, limit int) ([]*model.SearchResult, error)
+	Reindex(ctx context.Context) (*model.ReindexReport, error)
+}
@@ -0,0 +1,13 @@
+package repository
+
+import (
+	"context"
+
+	"github.com/SekiguchiKai/clean-architecture-with-go/server/domain/model"
+)
+
+// LangSuggestRepository は、ProgrammingLangのNameと別名の索引のRepository。
+type LangSuggestRepository interface {
+	Suggest(ctx context.Context, prefix string, limit int) ([]*model.LangSuggestion, error)
+	Similar(ctx context.Context, name string, limit int) ([]*model.LangSuggestion, error)
+}
@@ -0,0 +1,16 @@
+package repository
+
+import (
+	"context"
+
+	"github.com/SekiguchiKai/clean-architecture-with-go/server/domain/model"
+)
+
+// LanguageVersionRepository は、LanguageVersionのRepository。
+type LanguageVersionRepository interface {
+	ListByLang(ctx context.Context, langID int) ([]*model.LanguageVersion, error)
+	Read(ctx context.Context, langID int, version string) (*model.LanguageVersion, error)
+	Create(ctx context.Context, v *model.LanguageVersion) (*model.LanguageVersion, error)
+	Update(ctx context.Context, v *model.LanguageVersion) (*model.LanguageVersion, error)
+	Delete(ctx context.Context, langID int, version string) error
+}
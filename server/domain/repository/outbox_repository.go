@@ -0,0 +1,25 @@
+package repository
+
+import (
+	"context"
+	"time"
+
+	"github.com/SekiguchiKai/clean-architecture-with-go/server/domain/model"
+)
+
+// OutboxRepository は、配信前の領域イベントを保持するOutboxのRepository。
+type OutboxRepository interface {
+	Create(ctx context.Context, event *model.OutboxEvent) (*model.OutboxEvent, error)
+	ListDue(ctx context.Context, now time.Time, limit int) ([]*model.OutboxEvent, error)
+	Claim(ctx context.Context, event *model.OutboxEvent, until time.Time) (bool, error)
+	Update(ctx context.Context, event *model.OutboxEvent) (*model.OutboxEvent, error)
+	Delete(ctx context.Context, id int) error
+}
+
+// EventPublisher は、Outboxから取り出したProgrammingLangの変更を配信する。
+// 同じ変更を重ねて配信することがあるため、受信側ではIDで重複を判別する。
+// Nameは、配信に成功したことをOutboxに記録するための、配信先ごとに異なる名前。
+type EventPublisher interface {
+	Name() string
+	Publish(ctx context.Context, event *model.ProgrammingLangEvent) error
+}
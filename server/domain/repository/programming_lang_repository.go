@@ -2,6 +2,7 @@ package repository
 
 import (
 	"context"
+	"time"
 
 	"github.com/SekiguchiKai/clean-architecture-with-go/server/domain/model"
 )
@@ -9,9 +10,15 @@ import (
 // ProgrammingLangRepository は、ProgrammingLangのRepository。
 type ProgrammingLangRepository interface {
 	List(ctx context.Context, limit int) ([]*model.ProgrammingLang, error)
+	ListByFilter(ctx context.Context, filter *model.ProgrammingLangFilter) ([]*model.ProgrammingLang, error)
+	ListByIDs(ctx context.Context, ids []int) ([]*model.ProgrammingLang, error)
 	Create(ctx context.Context, lang *model.ProgrammingLang) (*model.ProgrammingLang, error)
 	Read(ctx context.Context, id int) (*model.ProgrammingLang, error)
 	ReadByName(ctx context.Context, name string) (*model.ProgrammingLang, error)
+	ReadBySlug(ctx context.Context, slug string) (*model.ProgrammingLang, error)
+	ReadByPreviousSlug(ctx context.Context, slug string) (*model.ProgrammingLang, error)
+	CreateSlugHistory(ctx context.Context, id int, slug string) error
 	Update(ctx context.Context, lang *model.ProgrammingLang) (*model.ProgrammingLang, error)
 	Delete(ctx context.Context, id int) error
+	LastModified(ctx context.Context) (time.Time, error)
 }
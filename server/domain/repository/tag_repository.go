@@ -0,0 +1,21 @@
+package repository
+
+import (
+	"context"
+
+	"github.com/SekiguchiKai/clean-architecture-with-go/server/domain/model"
+)
+
+// TagRepository は、TagのRepository。
+type TagRepository interface {
+	List(ctx context.Context) ([]*model.Tag, error)
+	ListByLang(ctx context.Context, langID int) ([]*model.Tag, error)
+	Read(ctx context.Context, id int) (*model.Tag, error)
+	ReadByName(ctx context.Context, name string) (*model.Tag, error)
+	Create(ctx context.Context, tag *model.Tag) (*model.Tag, error)
+	Update(ctx context.Context, tag *model.Tag) (*model.Tag, error)
+	Delete(ctx context.Context, id int) error
+	Merge(ctx context.Context, fromID, toID int) error
+	Attach(ctx context.Context, langID, tagID int) error
+	Detach(ctx context.Context, langID, tagID int) error
+}
@@ -0,0 +1,9 @@
+package repository
+
+import "context"
+
+// Transactor は、fnの中でctxを引き継いだRepositoryへの書き込みを、1つのトランザクションで行う。
+// fnがエラーを返した場合は、全ての書き込みを取り消す。
+type Transactor interface {
+	Transaction(ctx context.Context, fn func(ctx context.Context) error) error
+}
@@ -0,0 +1,36 @@
+package repository
+
+import (
+	"context"
+	"time"
+
+	"github.com/SekiguchiKai/clean-architecture-with-go/server/domain/model"
+)
+
+// WebhookRepository は、WebhookのRepository。
+type WebhookRepository interface {
+	List(ctx context.Context) ([]*model.Webhook, error)
+	Read(ctx context.Context, id int) (*model.Webhook, error)
+	Create(ctx context.Context, webhook *model.Webhook) (*model.Webhook, error)
+	Update(ctx context.Context, webhook *model.Webhook) (*model.Webhook, error)
+	Delete(ctx context.Context, id int) error
+}
+
+// WebhookDeliveryRepository は、Webhookの通知とその送信の記録のRepository。
+type WebhookDeliveryRepository interface {
+	ListByWebhook(ctx context.Context, webhookID int, status model.DeliveryStatus, limit int) ([]*model.WebhookDelivery, error)
+	ListByStatus(ctx context.Context, status model.DeliveryStatus, limit int) ([]*model.WebhookDelivery, error)
+	ListDue(ctx context.Context, now time.Time, limit int) ([]*model.WebhookDelivery, error)
+	Read(ctx context.Context, id int) (*model.WebhookDelivery, error)
+	Create(ctx context.Context, delivery *model.WebhookDelivery) (*model.WebhookDelivery, error)
+	Update(ctx context.Context, delivery *model.WebhookDelivery) (*model.WebhookDelivery, error)
+	Claim(ctx context.Context, delivery *model.WebhookDelivery, until time.Time) (bool, error)
+	CreateAttempt(ctx context.Context, attempt *model.DeliveryAttempt) (*model.DeliveryAttempt, error)
+	ListAttempts(ctx context.Context, deliveryID int) ([]*model.DeliveryAttempt, error)
+	DeleteFinishedBefore(ctx context.Context, before time.Time) (int, error)
+}
+
+// WebhookSender は、Webhookの通知を送信し、応答のステータスコードを返す。
+type WebhookSender interface {
+	Send(ctx context.Context, req *model.WebhookRequest) (int, error)
+}
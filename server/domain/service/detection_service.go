@@ -0,0 +1,190 @@
+package service
+
+import (
+	"path"
+	"regexp"
+	"sort"
+	"strings"
+
+	"github.com/SekiguchiKai/clean-architecture-with-go/server/domain/model"
+)
+
+// 言語の推定の制限。
+const (
+	// MaxDetectionContentLength は、言語の推定に使用する内容の先頭からのバイト数。
+	MaxDetectionContentLength = 4096
+	// MaxDetectionCandidates は、返す候補の最大数。
+	MaxDetectionCandidates = 10
+)
+
+// 根拠ごとのスコア。ファイル名が一致する場合を最も確かなものとする。
+const (
+	scoreFilename  = 100
+	scoreShebang   = 80
+	scoreExtension = 50
+	scoreContent   = 10
+)
+
+// trailingVersionPattern は、python3.7のようなインタプリタの名前の末尾のバージョン。
+var trailingVersionPattern = regexp.MustCompile(`[0-9.]+$`)
+
+// contentHints は、slugごとの、内容に現れやすい記述のパターン。
+var contentHints = map[string][]*regexp.Regexp{
+	"go": {
+		regexp.MustCompile(`(?m)^package \w+\s*$`),
+		regexp.MustCompile(`(?m)^func (\(\w+ \*?\w+\) )?\w+\(`),
+	},
+	"python": {
+		regexp.MustCompile(`(?m)^\s*def \w+\(.*\):`),
+		regexp.MustCompile(`(?m)^(from [\w.]+ )?import \w+`),
+	},
+	"ruby": {
+		regexp.MustCompile(`(?m)^\s*require ['"]`),
+		regexp.MustCompile(`(?m)^\s*def \w+[?!]?(\(.*\))?\s*$`),
+	},
+	"php": {
+		regexp.MustCompile(`<\?php`),
+	},
+	"rust": {
+		regexp.MustCompile(`(?m)^\s*(pub )?fn \w+`),
+		regexp.MustCompile(`(?m)^use \w+::`),
+	},
+	"java": {
+		regexp.MustCompile(`(?m)^\s*public (final |abstract )?class \w+`),
+		regexp.MustCompile(`(?m)^import java\.`),
+	},
+	"c": {
+		regexp.MustCompile(`(?m)^#include <\w+\.h>`),
+	},
+	"cpp": {
+		regexp.MustCompile(`(?m)^#include <\w+>`),
+		regexp.MustCompile(`std::`),
+	},
+	"javascript": {
+		regexp.MustCompile(`require\(['"]`),
+		regexp.MustCompile(`console\.log\(`),
+	},
+	"bash": {
+		regexp.MustCompile(`(?m)^\s*fi\s*$`),
+		regexp.MustCompile(`(?m)^\s*esac\s*$`),
+	},
+}
+
+// DetectLanguages は、ファイル名と内容から、ファイルの言語の候補をスコアの高い順に返す。
+// ファイル名、shebangのインタプリタ、拡張子、内容の記述の順に確かな根拠とし、一致した根拠のスコアを合計する。
+// スコアが同じ場合は、Nameの昇順とする。
+func DetectLanguages(langs []*model.ProgrammingLang, filename, content string) []*model.DetectionCandidate {
+	base := path.Base(strings.Replace(filename, `\`, "/", -1))
+	if filename == "" {
+		base = ""
+	}
+	if len(content) > MaxDetectionContentLength {
+		content = content[:MaxDetectionContentLength]
+	}
+	interpreter := shebangInterpreter(content)
+
+	candidates := make([]*model.DetectionCandidate, 0)
+	for _, lang := range langs {
+		c := &model.DetectionCandidate{
+			Lang:    lang,
+			Reasons: make([]model.DetectionReason, 0),
+		}
+
+		if base != "" && containsFold(lang.Filenames, base) {
+			c.Add(scoreFilename, model.DetectionReasonFilename)
+		}
+		if interpreter != "" && matchesInterpreter(lang.Interpreters, interpreter) {
+			c.Add(scoreShebang, model.DetectionReasonShebang)
+		}
+		if base != "" && hasExtension(lang.Extensions, base) {
+			c.Add(scoreExtension, model.DetectionReasonExtension)
+		}
+		if content != "" {
+			for _, hint := range contentHints[lang.Slug] {
+				if hint.MatchString(content) {
+					c.Add(scoreContent, model.DetectionReasonContent)
+				}
+			}
+		}
+
+		if c.Score > 0 {
+			candidates = append(candidates, c)
+		}
+	}
+
+	sort.SliceStable(candidates, func(i, j int) bool {
+		if candidates[i].Score != candidates[j].Score {
+			return candidates[i].Score > candidates[j].Score
+		}
+		return candidates[i].Lang.Name < candidates[j].Lang.Name
+	})
+
+	if len(candidates) > MaxDetectionCandidates {
+		candidates = candidates[:MaxDetectionCandidates]
+	}
+	return candidates
+}
+
+// shebangInterpreter は、内容の1行目のshebangからインタプリタの名前を返す。/usr/bin/envを経由する場合は、envが実行するものを返す。
+// shebangが存在しない場合は、空文字を返す。
+func shebangInterpreter(content string) string {
+	if !strings.HasPrefix(content, "#!") {
+		return ""
+	}
+
+	line := content[2:]
+	if i := strings.IndexByte(line, '\n'); i >= 0 {
+		line = line[:i]
+	}
+
+	fields := strings.Fields(line)
+	if len(fields) == 0 {
+		return ""
+	}
+
+	name := path.Base(fields[0])
+	if name != "env" {
+		return name
+	}
+
+	// envのオプションと環境変数の設定を読み飛ばす
+	for _, f := range fields[1:] {
+		if strings.HasPrefix(f, "-") || strings.Contains(f, "=") {
+			continue
+		}
+		return path.Base(f)
+	}
+	return ""
+}
+
+// matchesInterpreter は、インタプリタの名前が、末尾のバージョンを除いて一覧に含まれるかどうかを確認する。
+func matchesInterpreter(interpreters []string, name string) bool {
+	unversioned := trailingVersionPattern.ReplaceAllString(name, "")
+	for _, i := range interpreters {
+		if i == name || i == unversioned {
+			return true
+		}
+	}
+	return false
+}
+
+// hasExtension は、ファイル名がいずれかの拡張子で終わるかどうかを、大文字と小文字を区別せずに確認する。
+func hasExtension(extensions []string, base string) bool {
+	lower := strings.ToLower(base)
+	for _, ext := range extensions {
+		if len(lower) > len(ext) && strings.HasSuffix(lower, ext) {
+			return true
+		}
+	}
+	return false
+}
+
+// containsFold は、大文字と小文字を区別せずに、値が一覧に含まれるかどうかを確認する。
+func containsFold(values []string, s string) bool {
+	for _, v := range values {
+		if strings.EqualFold(v, s) {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,117 @@
+package service
+
+import (
+	"reflect"
+	"strings"
+	"testing"
+
+	"github.com/SekiguchiKai/clean-architecture-with-go/server/domain/model"
+)
+
+func TestDetectLanguages(t *testing.T) {
+	langs := []*model.ProgrammingLang{
+		{ID: 1, Name: "C", Slug: "c", Extensions: []string{".c", ".h"}},
+		{ID: 2, Name: "C++", Slug: "cpp", Extensions: []string{".cpp", ".h"}},
+		{ID: 3, Name: "Go", Slug: "go", Extensions: []string{".go"}},
+		{ID: 4, Name: "Make", Slug: "make", Filenames: []string{"Makefile"}, Extensions: []string{".mk"}},
+		{ID: 5, Name: "Python", Slug: "python", Extensions: []string{".py"}, Interpreters: []string{"python"}},
+		{ID: 6, Name: "Rust", Slug: "rust", Extensions: []string{".rs"}},
+	}
+
+	tests := []struct {
+		name     string
+		filename string
+		content  string
+		want     map[string][]model.DetectionReason
+		wantTop  string
+	}{
+		{
+			name:     "拡張子が一致する場合、その言語を返すこと",
+			filename: "src/main.RS",
+			want:     map[string][]model.DetectionReason{"Rust": {model.DetectionReasonExtension}},
+			wantTop:  "Rust",
+		},
+		{
+			name:     "ファイル名が一致する場合、大文字と小文字を区別せずにその言語を返すこと",
+			filename: `C:\project\makefile`,
+			want:     map[string][]model.DetectionReason{"Make": {model.DetectionReasonFilename}},
+			wantTop:  "Make",
+		},
+		{
+			name:     "envを経由するshebangの場合、バージョンを除いたインタプリタで推定すること",
+			filename: "manage",
+			content:  "#!/usr/bin/env -S python3.7 -u\nprint('hi')\n",
+			want:     map[string][]model.DetectionReason{"Python": {model.DetectionReasonShebang}},
+			wantTop:  "Python",
+		},
+		{
+			name:     "拡張子が複数の言語に一致する場合、内容の記述で順位を付けること",
+			filename: "vector.h",
+			content:  "#include <vector>\nstd::vector<int> v;\n",
+			want: map[string][]model.DetectionReason{
+				"C++": {model.DetectionReasonExtension, model.DetectionReasonContent},
+				"C":   {model.DetectionReasonExtension},
+			},
+			wantTop: "C++",
+		},
+		{
+			name:    "内容のみの場合、内容の記述で推定すること",
+			content: "package main\n\nfunc main() {\n}\n",
+			want:    map[string][]model.DetectionReason{"Go": {model.DetectionReasonContent}},
+			wantTop: "Go",
+		},
+		{
+			name:     "上限を超える内容は、推定に使用しないこと",
+			filename: "README",
+			content:  strings.Repeat(" ", MaxDetectionContentLength) + "<?php\npackage main\n",
+			want:     map[string][]model.DetectionReason{},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := DetectLanguages(langs, tt.filename, tt.content)
+
+			reasons := make(map[string][]model.DetectionReason, len(got))
+			for _, c := range got {
+				reasons[c.Lang.Name] = c.Reasons
+			}
+			if !reflect.DeepEqual(reasons, tt.want) {
+				t.Errorf("DetectLanguages() = %v, want %v", reasons, tt.want)
+			}
+			if tt.wantTop != "" && got[0].Lang.Name != tt.wantTop {
+				t.Errorf("DetectLanguages()[0] = %v, want %v", got[0].Lang.Name, tt.wantTop)
+			}
+		})
+	}
+}
+
+func TestShebangInterpreter(t *testing.T) {
+	tests := []struct {
+		name    string
+		content string
+		want    string
+	}{
+		{
+			name:    "インタプリタのパスが記述されている場合、その名前を返すこと",
+			content: "#!/bin/bash -e\necho hi\n",
+			want:    "bash",
+		},
+		{
+			name:    "envが環境変数を設定する場合、それを読み飛ばすこと",
+			content: "#!/usr/bin/env NODE_ENV=production node\n",
+			want:    "node",
+		},
+		{
+			name:    "shebangが存在しない場合、空文字を返すこと",
+			content: "echo hi\n",
+			want:    "",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := shebangInterpreter(tt.content); got != tt.want {
+				t.Errorf("shebangInterpreter() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
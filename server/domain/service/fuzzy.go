@@ -0,0 +1,103 @@
+package service
+
+import (
+	"sort"
+	"unicode/utf8"
+)
+
+// MinSimilarity は、名前の候補として扱う類似度の下限。
+const MinSimilarity = 0.6
+
+// EditDistance は、2つの文字列のレーベンシュタイン距離を文字単位で返す。
+func EditDistance(a, b string) int {
+	ra, rb := []rune(a), []rune(b)
+	prev := make([]int, len(rb)+1)
+	cur := make([]int, len(rb)+1)
+	for j := range prev {
+		prev[j] = j
+	}
+
+	for i := 1; i <= len(ra); i++ {
+		cur[0] = i
+		for j := 1; j <= len(rb); j++ {
+			cost := 1
+			if ra[i-1] == rb[j-1] {
+				cost = 0
+			}
+			cur[j] = minInt(prev[j]+1, cur[j-1]+1, prev[j-1]+cost)
+		}
+		prev, cur = cur, prev
+	}
+
+	return prev[len(rb)]
+}
+
+// Trigrams は、文字列の3文字ずつの組を重複を除いて昇順で返す。
+// 短い文字列や先頭の一致を考慮するため、先頭に2つ、末尾に1つの空白を補ってから分割する。
+func Trigrams(s string) []string {
+	r := []rune("  " + s + " ")
+	seen := make(map[string]bool, len(r))
+	trigrams := make([]string, 0, len(r))
+	for i := 0; i+3 <= len(r); i++ {
+		t := string(r[i : i+3])
+		if seen[t] {
+			continue
+		}
+		seen[t] = true
+		trigrams = append(trigrams, t)
+	}
+	sort.Strings(trigrams)
+	return trigrams
+}
+
+// TrigramSimilarity は、2つの文字列の3文字ずつの組の一致度を、0から1の範囲で返す。
+func TrigramSimilarity(a, b string) float64 {
+	ta, tb := Trigrams(a), Trigrams(b)
+	set := make(map[string]bool, len(ta))
+	for _, t := range ta {
+		set[t] = true
+	}
+
+	shared := 0
+	for _, t := range tb {
+		if set[t] {
+			shared++
+		}
+	}
+
+	union := len(ta) + len(tb) - shared
+	if union == 0 {
+		return 0
+	}
+	return float64(shared) / float64(union)
+}
+
+// Similarity は、照合用のキー同士の類似度を0から1の範囲で返す。
+// 短い名前の誤字はレーベンシュタイン距離で、長い名前の部分的な一致は3文字ずつの組で評価し、高い方を採用する。
+func Similarity(a, b string) float64 {
+	if a == b {
+		return 1
+	}
+
+	longer := utf8.RuneCountInString(a)
+	if n := utf8.RuneCountInString(b); n > longer {
+		longer = n
+	}
+	byDistance := 1 - float64(EditDistance(a, b))/float64(longer)
+
+	if byTrigram := TrigramSimilarity(a, b); byTrigram > byDistance {
+		return byTrigram
+	}
+	return byDistance
+}
+
+// minInt は、引数の最小値を返す。
+func minInt(values ...int) int {
+	m := values[0]
+	for _, v := range values[1:] {
+		if v < m {
+			m = v
+		}
+	}
+	return m
+}
@@ -0,0 +1,56 @@
+package service
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestEditDistance(t *testing.T) {
+	tests := []struct {
+		name string
+		a    string
+		b    string
+		want int
+	}{
+		{name: "同じ文字列の場合、0を返すこと", a: "go", b: "go", want: 0},
+		{name: "1文字欠けている場合、1を返すこと", a: "pyton", b: "python", want: 1},
+		{name: "1文字異なる場合、1を返すこと", a: "rust", b: "rest", want: 1},
+		{name: "空文字列の場合、もう一方の文字数を返すこと", a: "", b: "java", want: 4},
+		{name: "マルチバイト文字の場合、文字単位で数えること", a: "なでしこ", b: "なでしこ2", want: 1},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := EditDistance(tt.a, tt.b); got != tt.want {
+				t.Errorf("EditDistance() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestTrigrams(t *testing.T) {
+	want := []string{"  g", " go", "go "}
+	if got := Trigrams("go"); !reflect.DeepEqual(got, want) {
+		t.Errorf("Trigrams() = %q, want %q", got, want)
+	}
+}
+
+func TestSimilarity(t *testing.T) {
+	tests := []struct {
+		name    string
+		a       string
+		b       string
+		similar bool
+	}{
+		{name: "1文字の誤字の場合、類似していると判定すること", a: "javscript", b: "javascript", similar: true},
+		{name: "短い名前の1文字の欠落の場合、類似していると判定すること", a: "jva", b: "java", similar: true},
+		{name: "半分の文字が異なる場合、類似していないと判定すること", a: "rust", b: "ruby", similar: false},
+		{name: "全く異なる場合、類似していないと判定すること", a: "go", b: "haskell", similar: false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := Similarity(tt.a, tt.b) >= MinSimilarity; got != tt.similar {
+				t.Errorf("Similarity() = %v, want similar %v", Similarity(tt.a, tt.b), tt.similar)
+			}
+		})
+	}
+}
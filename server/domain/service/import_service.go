@@ -0,0 +1,73 @@
+package service
+
+import (
+	"reflect"
+	"strings"
+	"unicode/utf8"
+
+	"github.com/SekiguchiKai/clean-architecture-with-go/server/domain/model"
+)
+
+// SanitizeImportedLang は、外部から取り込むProgrammingLangの属性のうち、保存できない値を取り除く。
+// 外部のデータには形式の異なる値が含まれるため、1つの値のために言語全体を取り込めなくならないようにする。
+func SanitizeImportedLang(lang *model.ProgrammingLang) {
+	lang.Name = strings.TrimSpace(lang.Name)
+
+	extensions := make([]string, 0, len(lang.Extensions))
+	for _, ext := range lang.Extensions {
+		extensions = append(extensions, strings.ToLower(ext))
+	}
+	lang.Extensions = filterUniqueMatch(extensions, extensionPattern.MatchString)
+	lang.Filenames = filterUniqueMatch(lang.Filenames, filenamePattern.MatchString)
+	lang.Interpreters = filterUniqueMatch(lang.Interpreters, interpreterPattern.MatchString)
+
+	// 大文字と小文字の違いを除いてNameや他の別名と同じ別名は、照合に影響しないため取り除く。
+	var aliases []string
+	seenAlias := map[string]bool{NameKey(lang.Name): true}
+	for _, a := range lang.Aliases {
+		a = strings.TrimSpace(a)
+		key := NameKey(a)
+		if seenAlias[key] || key == "" || utf8.RuneCountInString(a) > MaxAliasLength {
+			continue
+		}
+		seenAlias[key] = true
+		aliases = append(aliases, a)
+	}
+	lang.Aliases = aliases
+
+	if !colorPattern.MatchString(lang.Color) {
+		lang.Color = ""
+	}
+}
+
+// IsImportChanged は、取り込むProgrammingLangに設定されている属性が、既存のProgrammingLangと異なるかどうかを確認する。
+// 取り込むデータに含まれない属性は、既存の値を維持するため比較しない。
+func IsImportChanged(existing, imported *model.ProgrammingLang) bool {
+	switch {
+	case imported.Extensions != nil && !reflect.DeepEqual(existing.Extensions, imported.Extensions):
+		return true
+	case imported.Filenames != nil && !reflect.DeepEqual(existing.Filenames, imported.Filenames):
+		return true
+	case imported.Interpreters != nil && !reflect.DeepEqual(existing.Interpreters, imported.Interpreters):
+		return true
+	case imported.Aliases != nil && !reflect.DeepEqual(existing.Aliases, imported.Aliases):
+		return true
+	case imported.Color != "" && existing.Color != imported.Color:
+		return true
+	}
+	return false
+}
+
+// filterUniqueMatch は、matchを満たす値を重複を除いて返す。該当する値がない場合は、nilを返す。
+func filterUniqueMatch(values []string, match func(string) bool) []string {
+	var filtered []string
+	seen := make(map[string]bool, len(values))
+	for _, v := range values {
+		if seen[v] || !match(v) {
+			continue
+		}
+		seen[v] = true
+		filtered = append(filtered, v)
+	}
+	return filtered
+}
@@ -0,0 +1,133 @@
+package service
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/SekiguchiKai/clean-architecture-with-go/server/domain/model"
+)
+
+func TestSanitizeImportedLang(t *testing.T) {
+	tests := []struct {
+		name string
+		arg  *model.ProgrammingLang
+		want *model.ProgrammingLang
+	}{
+		{
+			name: "保存できる値のみの場合、そのままの値を返すこと",
+			arg: &model.ProgrammingLang{
+				Name:         "Go",
+				Extensions:   []string{".go"},
+				Filenames:    []string{"go.mod"},
+				Interpreters: []string{"gorun"},
+				Aliases:      []string{"golang"},
+				Color:        "#00ADD8",
+			},
+			want: &model.ProgrammingLang{
+				Name:         "Go",
+				Extensions:   []string{".go"},
+				Filenames:    []string{"go.mod"},
+				Interpreters: []string{"gorun"},
+				Aliases:      []string{"golang"},
+				Color:        "#00ADD8",
+			},
+		},
+		{
+			name: "大文字の拡張子や重複を含む場合、小文字にして重複やNameと同じ別名を取り除くこと",
+			arg: &model.ProgrammingLang{
+				Name:       " Ruby ",
+				Extensions: []string{".rb", ".RB", ".rake"},
+				Aliases:    []string{" rb ", "RB", "ruby"},
+			},
+			want: &model.ProgrammingLang{
+				Name:       "Ruby",
+				Extensions: []string{".rb", ".rake"},
+				Aliases:    []string{"rb"},
+			},
+		},
+		{
+			name: "形式の異なる値を含む場合、その値を取り除くこと",
+			arg: &model.ProgrammingLang{
+				Name:         "Shell",
+				Extensions:   []string{".sh", ".sh.in", "sh"},
+				Filenames:    []string{".bashrc", "bash profile"},
+				Interpreters: []string{"bash", "Bash"},
+				Color:        "#89e05",
+			},
+			want: &model.ProgrammingLang{
+				Name:         "Shell",
+				Extensions:   []string{".sh"},
+				Filenames:    []string{".bashrc"},
+				Interpreters: []string{"bash"},
+			},
+		},
+		{
+			name: "保存できる値がない場合、nilにすること",
+			arg: &model.ProgrammingLang{
+				Name:       "Text",
+				Extensions: []string{"txt"},
+				Aliases:    []string{" "},
+			},
+			want: &model.ProgrammingLang{
+				Name: "Text",
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			SanitizeImportedLang(tt.arg)
+			if !reflect.DeepEqual(tt.arg, tt.want) {
+				t.Errorf("SanitizeImportedLang() = %+v, want %+v", tt.arg, tt.want)
+			}
+		})
+	}
+}
+
+func TestIsImportChanged(t *testing.T) {
+	existing := &model.ProgrammingLang{
+		Name:       "Go",
+		Feature:    "Goroutine",
+		Extensions: []string{".go"},
+		Aliases:    []string{"golang"},
+		Color:      "#00ADD8",
+	}
+
+	tests := []struct {
+		name     string
+		imported *model.ProgrammingLang
+		want     bool
+	}{
+		{
+			name:     "設定されている属性が全て同じ場合、falseを返すこと",
+			imported: &model.ProgrammingLang{Name: "Go", Extensions: []string{".go"}, Color: "#00ADD8"},
+			want:     false,
+		},
+		{
+			name:     "既存の値がある属性が設定されていない場合、falseを返すこと",
+			imported: &model.ProgrammingLang{Name: "Go"},
+			want:     false,
+		},
+		{
+			name:     "配列の属性が異なる場合、trueを返すこと",
+			imported: &model.ProgrammingLang{Name: "Go", Aliases: []string{"golang", "go"}},
+			want:     true,
+		},
+		{
+			name:     "既存の値がない属性が設定されている場合、trueを返すこと",
+			imported: &model.ProgrammingLang{Name: "Go", Interpreters: []string{"gorun"}},
+			want:     true,
+		},
+		{
+			name:     "色が異なる場合、trueを返すこと",
+			imported: &model.ProgrammingLang{Name: "Go", Color: "#375EAB"},
+			want:     true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := IsImportChanged(existing, tt.imported); got != tt.want {
+				t.Errorf("IsImportChanged() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
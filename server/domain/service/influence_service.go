@@ -0,0 +1,121 @@
+package service
+
+import (
+	"sort"
+
+	"github.com/SekiguchiKai/clean-architecture-with-go/server/domain/model"
+)
+
+// InfluenceDirection は、影響関係をたどる向きを表す。
+type InfluenceDirection int
+
+// 影響関係をたどる向き。
+const (
+	// InfluenceAncestors は、影響を与えたProgrammingLangの方向にたどる。
+	InfluenceAncestors InfluenceDirection = iota
+	// InfluenceDescendants は、影響を受けたProgrammingLangの方向にたどる。
+	InfluenceDescendants
+)
+
+// InfluenceHop は、影響関係をたどって見つかったProgrammingLangのIDと、起点からの距離。
+type InfluenceHop struct {
+	LangID int
+	Depth  int
+}
+
+// ValidateInfluence は、Influenceの属性をチェックする。
+func ValidateInfluence(influence *model.Influence) error {
+	if influence.LangID == influence.InfluencedByID {
+		return invalidProperty(model.PropertyInfluencedBy, model.LangCannotInfluenceItself)
+	}
+	return nil
+}
+
+// TraverseInfluences は、langIDから指定した向きに影響関係をmaxDepthまでたどり、見つかったProgrammingLangを距離とIDの昇順で返す。
+// 一度見つかったProgrammingLangは再びたどらないため、影響関係が循環していても終了する。起点のProgrammingLangは含まない。
+func TraverseInfluences(influences []*model.Influence, langID, maxDepth int, direction InfluenceDirection) []*InfluenceHop {
+	next := make(map[int][]int)
+	for _, in := range influences {
+		if direction == InfluenceAncestors {
+			next[in.LangID] = append(next[in.LangID], in.InfluencedByID)
+		} else {
+			next[in.InfluencedByID] = append(next[in.InfluencedByID], in.LangID)
+		}
+	}
+
+	visited := map[int]bool{langID: true}
+	hops := make([]*InfluenceHop, 0)
+	current := []int{langID}
+	for depth := 1; depth <= maxDepth && len(current) > 0; depth++ {
+		found := make([]int, 0)
+		for _, id := range current {
+			for _, n := range next[id] {
+				if visited[n] {
+					continue
+				}
+				visited[n] = true
+				found = append(found, n)
+			}
+		}
+
+		sort.Ints(found)
+		for _, id := range found {
+			hops = append(hops, &InfluenceHop{LangID: id, Depth: depth})
+		}
+		current = found
+	}
+
+	return hops
+}
+
+// ShortestInfluencePath は、fromからtoまでの影響関係の最短経路を、両端を含むProgrammingLangのIDで返す。
+// 影響関係の向きは問わない。同じ長さの経路が複数ある場合は、IDの小さいものを優先する。経路が存在しない場合は、nilを返す。
+func ShortestInfluencePath(influences []*model.Influence, from, to int) []int {
+	if from == to {
+		return []int{from}
+	}
+
+	neighbors := make(map[int][]int)
+	for _, in := range influences {
+		neighbors[in.LangID] = append(neighbors[in.LangID], in.InfluencedByID)
+		neighbors[in.InfluencedByID] = append(neighbors[in.InfluencedByID], in.LangID)
+	}
+	for _, n := range neighbors {
+		sort.Ints(n)
+	}
+
+	previous := map[int]int{from: from}
+	queue := []int{from}
+	for len(queue) > 0 {
+		id := queue[0]
+		queue = queue[1:]
+
+		for _, n := range neighbors[id] {
+			if _, ok := previous[n]; ok {
+				continue
+			}
+			previous[n] = id
+
+			if n == to {
+				return pathTo(previous, from, to)
+			}
+			queue = append(queue, n)
+		}
+	}
+
+	return nil
+}
+
+// pathTo は、直前のIDをたどってfromからtoまでの経路を返す。
+func pathTo(previous map[int]int, from, to int) []int {
+	path := []int{to}
+	for id := to; id != from; {
+		id = previous[id]
+		path = append(path, id)
+	}
+
+	for i, j := 0, len(path)-1; i < j; i, j = i+1, j-1 {
+		path[i], path[j] = path[j], path[i]
+	}
+	return path
+}
@@ -0,0 +1,116 @@
+package service
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/SekiguchiKai/clean-architecture-with-go/server/domain/model"
+)
+
+// testInfluences は、C(1) → Oberon(2)、C(1) → Go(3)、Oberon(2) → Go(3)、Go(3) → Rust(4) と、4と5が循環する影響関係。
+var testInfluences = []*model.Influence{
+	{LangID: 2, InfluencedByID: 1},
+	{LangID: 3, InfluencedByID: 1},
+	{LangID: 3, InfluencedByID: 2},
+	{LangID: 4, InfluencedByID: 3},
+	{LangID: 5, InfluencedByID: 4},
+	{LangID: 4, InfluencedByID: 5},
+}
+
+func TestTraverseInfluences(t *testing.T) {
+	tests := []struct {
+		name      string
+		langID    int
+		maxDepth  int
+		direction InfluenceDirection
+		want      []*InfluenceHop
+	}{
+		{
+			name:      "影響を与えた方向にたどる場合、距離とIDの昇順で返すこと",
+			langID:    4,
+			maxDepth:  10,
+			direction: InfluenceAncestors,
+			want: []*InfluenceHop{
+				{LangID: 3, Depth: 1},
+				{LangID: 5, Depth: 1},
+				{LangID: 1, Depth: 2},
+				{LangID: 2, Depth: 2},
+			},
+		},
+		{
+			name:      "影響を受けた方向にたどる場合、循環していても1度ずつ返すこと",
+			langID:    3,
+			maxDepth:  10,
+			direction: InfluenceDescendants,
+			want: []*InfluenceHop{
+				{LangID: 4, Depth: 1},
+				{LangID: 5, Depth: 2},
+			},
+		},
+		{
+			name:      "maxDepthを指定した場合、その距離までたどること",
+			langID:    1,
+			maxDepth:  1,
+			direction: InfluenceDescendants,
+			want: []*InfluenceHop{
+				{LangID: 2, Depth: 1},
+				{LangID: 3, Depth: 1},
+			},
+		},
+		{
+			name:      "影響関係が存在しない場合、空のスライスを返すこと",
+			langID:    1,
+			maxDepth:  10,
+			direction: InfluenceAncestors,
+			want:      []*InfluenceHop{},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := TraverseInfluences(testInfluences, tt.langID, tt.maxDepth, tt.direction); !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("TraverseInfluences() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestShortestInfluencePath(t *testing.T) {
+	tests := []struct {
+		name string
+		from int
+		to   int
+		want []int
+	}{
+		{
+			name: "影響関係の向きに沿った経路の場合、最短の経路を返すこと",
+			from: 1,
+			to:   5,
+			want: []int{1, 3, 4, 5},
+		},
+		{
+			name: "影響関係の向きに逆らう経路の場合も、経路を返すこと",
+			from: 2,
+			to:   1,
+			want: []int{2, 1},
+		},
+		{
+			name: "同じProgrammingLangの場合、そのIDのみを返すこと",
+			from: 3,
+			to:   3,
+			want: []int{3},
+		},
+		{
+			name: "経路が存在しない場合、nilを返すこと",
+			from: 1,
+			to:   6,
+			want: nil,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := ShortestInfluencePath(testInfluences, tt.from, tt.to); !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("ShortestInfluencePath() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
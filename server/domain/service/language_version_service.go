@@ -0,0 +1,56 @@
+package service
+
+import (
+	"time"
+
+	"github.com/SekiguchiKai/clean-architecture-with-go/server/domain/model"
+)
+
+// ValidateLanguageVersion は、LanguageVersionの属性をチェックする。
+func ValidateLanguageVersion(v *model.LanguageVersion) error {
+	if _, ok := ParseSemver(v.Version); !ok {
+		return invalidProperty(model.PropertyVersion, model.VersionIsNotSemver)
+	}
+
+	if v.ReleaseDate.IsZero() {
+		return invalidProperty(model.PropertyReleaseDate, model.ReleaseDateIsRequired)
+	}
+
+	if v.EOLDate != nil && v.EOLDate.Before(v.ReleaseDate) {
+		return invalidProperty(model.PropertyEOLDate, model.EOLDateIsBeforeReleaseDate)
+	}
+
+	if v.ChangelogURL != "" && !isWebsite(v.ChangelogURL) {
+		return invalidProperty(model.PropertyChangelogURL, model.ChangelogURLIsInvalid)
+	}
+
+	return nil
+}
+
+// LatestStableVersion は、指定した時点でリリース済みのプレリリースでないバージョンの中で、最も新しいものを返す。
+// 該当するものが存在しない場合は、nilを返す。
+func LatestStableVersion(versions []*model.LanguageVersion, now time.Time) *model.LanguageVersion {
+	var latest *model.LanguageVersion
+	var latestSemver *Semver
+	for _, v := range versions {
+		s, ok := ParseSemver(v.Version)
+		if !ok || s.IsPrerelease() || v.ReleaseDate.After(now) {
+			continue
+		}
+		if latestSemver == nil || s.Compare(latestSemver) > 0 {
+			latest, latestSemver = v, s
+		}
+	}
+	return latest
+}
+
+// SupportedVersionsOn は、指定した日にサポートされているLanguageVersionを返す。
+func SupportedVersionsOn(versions []*model.LanguageVersion, date time.Time) []*model.LanguageVersion {
+	supported := make([]*model.LanguageVersion, 0, len(versions))
+	for _, v := range versions {
+		if v.IsSupportedOn(date) {
+			supported = append(supported, v)
+		}
+	}
+	return supported
+}
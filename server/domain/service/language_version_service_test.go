@@ -0,0 +1,154 @@
+package service
+
+import (
+	"testing"
+	"time"
+
+	"github.com/SekiguchiKai/clean-architecture-with-go/server/domain/model"
+)
+
+// testDate は、テスト用の日付を生成し、返す。
+func testDate(year int, month time.Month, day int) time.Time {
+	return time.Date(year, month, day, 0, 0, 0, 0, time.UTC)
+}
+
+func TestValidateLanguageVersion(t *testing.T) {
+	eol := testDate(2017, time.December, 31)
+
+	tests := []struct {
+		name         string
+		arg          *model.LanguageVersion
+		wantProperty string
+	}{
+		{
+			name: "全ての属性が適切な場合、エラーを返さない",
+			arg: &model.LanguageVersion{
+				Version:      "1.11.0",
+				ReleaseDate:  testDate(2018, time.August, 24),
+				ChangelogURL: "https://golang.org/doc/go1.11",
+			},
+		},
+		{
+			name:         "VersionがSemantic Versioningの形式でない場合、エラーを返す",
+			arg:          &model.LanguageVersion{Version: "1.11", ReleaseDate: testDate(2018, time.August, 24)},
+			wantProperty: model.PropertyVersion,
+		},
+		{
+			name:         "ReleaseDateが設定されていない場合、エラーを返す",
+			arg:          &model.LanguageVersion{Version: "1.11.0"},
+			wantProperty: model.PropertyReleaseDate,
+		},
+		{
+			name:         "EOLDateがReleaseDateより前の場合、エラーを返す",
+			arg:          &model.LanguageVersion{Version: "1.11.0", ReleaseDate: testDate(2018, time.August, 24), EOLDate: &eol},
+			wantProperty: model.PropertyEOLDate,
+		},
+		{
+			name:         "ChangelogURLがhttpもしくはhttpsの絶対URLでない場合、エラーを返す",
+			arg:          &model.LanguageVersion{Version: "1.11.0", ReleaseDate: testDate(2018, time.August, 24), ChangelogURL: "go1.11"},
+			wantProperty: model.PropertyChangelogURL,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := ValidateLanguageVersion(tt.arg)
+			if tt.wantProperty == "" {
+				if err != nil {
+					t.Errorf("ValidateLanguageVersion() error = %v, want nil", err)
+				}
+				return
+			}
+
+			e, ok := err.(*model.InvalidPropertyError)
+			if !ok || e.Property != tt.wantProperty {
+				t.Errorf("ValidateLanguageVersion() error = %v, want InvalidPropertyError of %v", err, tt.wantProperty)
+			}
+		})
+	}
+}
+
+func TestLatestStableVersion(t *testing.T) {
+	versions := []*model.LanguageVersion{
+		{Version: "1.10.0", ReleaseDate: testDate(2018, time.February, 16)},
+		{Version: "1.11.0", ReleaseDate: testDate(2018, time.August, 24)},
+		{Version: "1.12.0-beta1", ReleaseDate: testDate(2018, time.December, 18)},
+		{Version: "1.12.0", ReleaseDate: testDate(2019, time.February, 25)},
+	}
+
+	tests := []struct {
+		name string
+		now  time.Time
+		want string
+	}{
+		{
+			name: "リリース済みのバージョンの中で、プレリリースを除いた最も新しいものを返すこと",
+			now:  testDate(2019, time.January, 1),
+			want: "1.11.0",
+		},
+		{
+			name: "全てのバージョンがリリース済みの場合、最も新しいものを返すこと",
+			now:  testDate(2019, time.March, 1),
+			want: "1.12.0",
+		},
+		{
+			name: "リリース済みのバージョンが存在しない場合、nilを返すこと",
+			now:  testDate(2017, time.January, 1),
+			want: "",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := LatestStableVersion(versions, tt.now)
+			if (got == nil) != (tt.want == "") || (got != nil && got.Version != tt.want) {
+				t.Errorf("LatestStableVersion() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestSupportedVersionsOn(t *testing.T) {
+	eol := testDate(2018, time.August, 24)
+	versions := []*model.LanguageVersion{
+		{Version: "1.9.0", ReleaseDate: testDate(2017, time.August, 24), EOLDate: &eol},
+		{Version: "1.10.0", ReleaseDate: testDate(2018, time.February, 16)},
+		{Version: "1.11.0", ReleaseDate: testDate(2018, time.August, 24)},
+	}
+
+	tests := []struct {
+		name string
+		date time.Time
+		want []string
+	}{
+		{
+			name: "EOLの日はサポートされているものとすること",
+			date: testDate(2018, time.August, 24),
+			want: []string{"1.9.0", "1.10.0", "1.11.0"},
+		},
+		{
+			name: "EOLを過ぎたものとリリース前のものは含まないこと",
+			date: testDate(2018, time.March, 1),
+			want: []string{"1.9.0", "1.10.0"},
+		},
+		{
+			name: "EOLを過ぎた場合、含まないこと",
+			date: testDate(2018, time.August, 25),
+			want: []string{"1.10.0", "1.11.0"},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := make([]string, 0)
+			for _, v := range SupportedVersionsOn(versions, tt.date) {
+				got = append(got, v.Version)
+			}
+			if len(got) != len(tt.want) {
+				t.Fatalf("SupportedVersionsOn() = %v, want %v", got, tt.want)
+			}
+			for i := range got {
+				if got[i] != tt.want[i] {
+					t.Errorf("SupportedVersionsOn() = %v, want %v", got, tt.want)
+				}
+			}
+		})
+	}
+}
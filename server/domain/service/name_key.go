@@ -0,0 +1,36 @@
+package service
+
+import (
+	"strings"
+
+	"github.com/SekiguchiKai/clean-architecture-with-go/server/domain/model"
+	"golang.org/x/text/cases"
+	"golang.org/x/text/unicode/norm"
+)
+
+// NameKey は、NameやAliasesを照合するためのキーを返す。
+// NFKCで正規化してからケースフォールディングを行うため、大文字と小文字、全角と半角の違いは同じキーになる。
+func NameKey(name string) string {
+	return cases.Fold().String(norm.NFKC.String(strings.TrimSpace(name)))
+}
+
+// NameKeys は、ProgrammingLangのNameとAliasesのキーの集合を返す。
+func NameKeys(lang *model.ProgrammingLang) map[string]bool {
+	keys := make(map[string]bool, len(lang.Aliases)+1)
+	keys[NameKey(lang.Name)] = true
+	for _, a := range lang.Aliases {
+		keys[NameKey(a)] = true
+	}
+	return keys
+}
+
+// AddedNames は、ProgrammingLangのNameとAliasesのうち、キーがknownに含まれないものを返す。
+func AddedNames(lang *model.ProgrammingLang, known map[string]bool) []string {
+	var added []string
+	for _, name := range append([]string{lang.Name}, lang.Aliases...) {
+		if !known[NameKey(name)] {
+			added = append(added, name)
+		}
+	}
+	return added
+}
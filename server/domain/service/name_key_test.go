@@ -0,0 +1,82 @@
+package service
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/SekiguchiKai/clean-architecture-with-go/server/domain/model"
+)
+
+func TestNameKey(t *testing.T) {
+	tests := []struct {
+		name string
+		arg  string
+		want string
+	}{
+		{
+			name: "大文字を含む場合、小文字のキーを返すこと",
+			arg:  "JavaScript",
+			want: "javascript",
+		},
+		{
+			name: "記号を含む場合、記号を保ったキーを返すこと",
+			arg:  "C++",
+			want: "c++",
+		},
+		{
+			name: "全角の英数字の場合、半角のキーを返すこと",
+			arg:  "ＪＳ",
+			want: "js",
+		},
+		{
+			name: "前後に空白を含む場合、空白を取り除いたキーを返すこと",
+			arg:  " golang ",
+			want: "golang",
+		},
+		{
+			name: "大文字と小文字の対応が1対1でない文字を含む場合、畳み込んだキーを返すこと",
+			arg:  "Straße",
+			want: "strasse",
+		},
+		{
+			name: "結合文字で表された文字の場合、合成済みの文字と同じキーを返すこと",
+			arg:  "Pyré",
+			want: "pyré",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := NameKey(tt.arg); got != tt.want {
+				t.Errorf("NameKey() = %q, want %q", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestAddedNames(t *testing.T) {
+	known := NameKeys(&model.ProgrammingLang{Name: "Go", Aliases: []string{"golang"}})
+
+	tests := []struct {
+		name string
+		arg  *model.ProgrammingLang
+		want []string
+	}{
+		{
+			name: "表記のみが異なる場合、nilを返すこと",
+			arg:  &model.ProgrammingLang{Name: "GO", Aliases: []string{"GoLang"}},
+			want: nil,
+		},
+		{
+			name: "新しいNameとAliasesを含む場合、それらを返すこと",
+			arg:  &model.ProgrammingLang{Name: "Golang2", Aliases: []string{"golang", "go2"}},
+			want: []string{"Golang2", "go2"},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := AddedNames(tt.arg, known); !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("AddedNames() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
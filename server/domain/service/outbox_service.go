@@ -0,0 +1,69 @@
+package service
+
+import (
+	"encoding/json"
+	"fmt"
+	"time"
+
+	"github.com/SekiguchiKai/clean-architecture-with-go/server/domain/model"
+	"github.com/pkg/errors"
+)
+
+// Outboxの配信の設定。配信に成功するまで諦めずに配信し直す。
+const (
+	OutboxBaseBackoff = 5 * time.Second
+	OutboxMaxBackoff  = 10 * time.Minute
+	OutboxLease       = time.Minute
+)
+
+// domainEventNames は、変更の種類ごとの領域イベントの名前。
+var domainEventNames = map[model.EventType]string{
+	model.EventTypeCreated: model.DomainEventLangCreated,
+	model.EventTypeUpdated: model.DomainEventLangUpdated,
+	model.EventTypeDeleted: model.DomainEventLangDeleted,
+}
+
+// NewOutboxEvent は、ProgrammingLangの変更を、Outboxに記録する領域イベントに変換して返す。
+func NewOutboxEvent(event *model.ProgrammingLangEvent) (*model.OutboxEvent, error) {
+	name, ok := domainEventNames[event.Type]
+	if !ok {
+		return nil, errors.WithStack(fmt.Errorf("unknown event type: %s", event.Type))
+	}
+
+	payload, err := json.Marshal(event)
+	if err != nil {
+		return nil, errors.WithStack(err)
+	}
+
+	var aggregateID int
+	if event.Lang != nil {
+		aggregateID = event.Lang.ID
+	}
+
+	return &model.OutboxEvent{
+		Name:          name,
+		AggregateID:   aggregateID,
+		Payload:       payload,
+		NextAttemptAt: event.OccurredAt,
+		CreatedAt:     event.OccurredAt,
+	}, nil
+}
+
+// DecodeOutboxEvent は、Outboxに記録した領域イベントを、ProgrammingLangの変更に戻して返す。
+// 受信側で重複を判別できるように、IDにはOutboxのIDを設定する。
+func DecodeOutboxEvent(o *model.OutboxEvent) (*model.ProgrammingLangEvent, error) {
+	event := &model.ProgrammingLangEvent{}
+	if err := json.Unmarshal(o.Payload, event); err != nil {
+		return nil, errors.WithStack(err)
+	}
+
+	event.ID = o.ID
+	return event, nil
+}
+
+// RecordOutboxFailure は、配信に失敗した結果を領域イベントに反映し、次の配信日時を設定する。
+func RecordOutboxFailure(o *model.OutboxEvent, err error, now time.Time) {
+	o.Attempts++
+	o.LastError = DeliveryErrorMessage(0, err)
+	o.NextAttemptAt = now.Add(exponentialBackoff(OutboxBaseBackoff, OutboxMaxBackoff, o.Attempts))
+}
@@ -0,0 +1,86 @@
+package service
+
+import (
+	"errors"
+	"reflect"
+	"testing"
+	"time"
+
+	"github.com/SekiguchiKai/clean-architecture-with-go/server/domain/model"
+)
+
+func TestNewOutboxEvent(t *testing.T) {
+	now := time.Date(2018, time.September, 1, 0, 0, 0, 0, time.UTC)
+
+	tests := []struct {
+		name     string
+		arg      *model.ProgrammingLangEvent
+		wantName string
+		wantErr  bool
+	}{
+		{
+			name:     "生成の場合、LangCreatedとして記録すること",
+			arg:      &model.ProgrammingLangEvent{Type: model.EventTypeCreated, Lang: &model.ProgrammingLang{ID: 1, Name: "Go"}, OccurredAt: now},
+			wantName: model.DomainEventLangCreated,
+		},
+		{
+			name:     "削除の場合、LangDeletedとして記録すること",
+			arg:      &model.ProgrammingLangEvent{Type: model.EventTypeDeleted, Lang: &model.ProgrammingLang{ID: 1, Name: "Go"}, OccurredAt: now},
+			wantName: model.DomainEventLangDeleted,
+		},
+		{
+			name:    "未定義の変更の種類の場合、エラーを返すこと",
+			arg:     &model.ProgrammingLangEvent{Type: "renamed", OccurredAt: now},
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := NewOutboxEvent(tt.arg)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("NewOutboxEvent() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if tt.wantErr {
+				return
+			}
+			if got.Name != tt.wantName || got.AggregateID != 1 || !got.NextAttemptAt.Equal(now) {
+				t.Errorf("NewOutboxEvent() = %+v, want name %v", got, tt.wantName)
+			}
+
+			// 記録したイベントは、OutboxのIDを付けて元の変更に戻せること
+			got.ID = 10
+			decoded, err := DecodeOutboxEvent(got)
+			if err != nil {
+				t.Fatal(err)
+			}
+			want := *tt.arg
+			want.ID = 10
+			if !reflect.DeepEqual(decoded, &want) {
+				t.Errorf("DecodeOutboxEvent() = %+v, want %+v", decoded, &want)
+			}
+		})
+	}
+}
+
+func TestRecordOutboxFailure(t *testing.T) {
+	now := time.Date(2018, time.September, 1, 0, 0, 0, 0, time.UTC)
+
+	tests := []struct {
+		name     string
+		attempts int
+		want     time.Duration
+	}{
+		{name: "1回目の失敗の場合、基準の間隔の後に配信し直すこと", attempts: 0, want: OutboxBaseBackoff},
+		{name: "失敗を繰り返した場合、上限の間隔の後に配信し直すこと", attempts: 20, want: OutboxMaxBackoff},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			o := &model.OutboxEvent{Attempts: tt.attempts}
+			RecordOutboxFailure(o, errors.New("connection refused"), now)
+
+			if o.Attempts != tt.attempts+1 || o.LastError != "connection refused" || !o.NextAttemptAt.Equal(now.Add(tt.want)) {
+				t.Errorf("RecordOutboxFailure() = %+v, want next attempt after %v", o, tt.want)
+			}
+		})
+	}
+}
@@ -1,11 +1,38 @@
 package service
 
 import (
+	"net/url"
+	"regexp"
+	"time"
+	"unicode/utf8"
+
 	"github.com/SekiguchiKai/clean-architecture-with-go/server/domain/model"
 	"github.com/pkg/errors"
 	"github.com/SekiguchiKai/clean-architecture-with-go/server/util"
 )
 
+// ProgrammingLangの属性の制限。
+const (
+	MinFirstAppeared  = 1940
+	MaxDesignerLength = 100
+	MaxLicenseLength  = 64
+	MaxWebsiteLength  = 255
+	MaxVersionLength  = 32
+	MaxAliasLength    = 32
+)
+
+// extensionPattern は、拡張子の形式。
+var extensionPattern = regexp.MustCompile(`^\.[a-z0-9][a-z0-9+_-]{0,15}$`)
+
+// filenamePattern は、拡張子によらずに言語が決まるファイル名の形式。.bashrcのように.から始まるものも含む。
+var filenamePattern = regexp.MustCompile(`^\.?[A-Za-z0-9_+-][A-Za-z0-9._+-]{0,63}$`)
+
+// interpreterPattern は、shebangに記述されるインタプリタの名前の形式。
+var interpreterPattern = regexp.MustCompile(`^[a-z][a-z0-9._+-]{0,31}$`)
+
+// colorPattern は、色の形式。
+var colorPattern = regexp.MustCompile(`^#[0-9A-Fa-f]{6}$`)
+
 // NewProgrammingLang は、ProgrammingLangを生成し、返す。
 func NewProgrammingLang(name string) (*model.ProgrammingLang, error) {
 	if err := ValidateProgrammingLang(name); err != nil {
@@ -27,3 +54,122 @@ func ValidateProgrammingLang(name string) error {
 	}
 	return nil
 }
+
+// ValidateProgrammingLangDetail は、ProgrammingLangの詳細な属性をチェックする。値が設定されていない属性はチェックしない。
+func ValidateProgrammingLangDetail(lang *model.ProgrammingLang) error {
+	if lang.FirstAppeared != 0 && (lang.FirstAppeared < MinFirstAppeared || lang.FirstAppeared > time.Now().Year()+1) {
+		return invalidProperty(model.PropertyFirstAppeared, model.FirstAppearedIsOutOfRange)
+	}
+
+	for _, d := range lang.Designers {
+		if util.IsEmpty(d) || utf8.RuneCountInString(d) > MaxDesignerLength {
+			return invalidProperty(model.PropertyDesigners, model.DesignerIsInvalid)
+		}
+	}
+
+	switch lang.TypeChecking {
+	case "", model.TypeCheckingStatic, model.TypeCheckingDynamic, model.TypeCheckingGradual:
+	default:
+		return invalidProperty(model.PropertyTypeChecking, model.TypeCheckingIsUnknown)
+	}
+
+	switch lang.TypeStrength {
+	case "", model.TypeStrengthStrong, model.TypeStrengthWeak:
+	default:
+		return invalidProperty(model.PropertyTypeStrength, model.TypeStrengthIsUnknown)
+	}
+
+	seen := make(map[model.Paradigm]bool, len(lang.Paradigms))
+	for _, p := range lang.Paradigms {
+		if seen[p] || !isKnownParadigm(p) {
+			return invalidProperty(model.PropertyParadigms, model.ParadigmIsUnknown)
+		}
+		seen[p] = true
+	}
+
+	if utf8.RuneCountInString(lang.License) > MaxLicenseLength {
+		return invalidProperty(model.PropertyLicense, model.LicenseIsTooLong)
+	}
+
+	if lang.Website != "" && !isWebsite(lang.Website) {
+		return invalidProperty(model.PropertyWebsite, model.WebsiteIsInvalid)
+	}
+
+	seenExt := make(map[string]bool, len(lang.Extensions))
+	for _, ext := range lang.Extensions {
+		if seenExt[ext] || !extensionPattern.MatchString(ext) {
+			return invalidProperty(model.PropertyExtensions, model.ExtensionIsInvalid)
+		}
+		seenExt[ext] = true
+	}
+
+	if !isUniqueMatch(lang.Filenames, filenamePattern) {
+		return invalidProperty(model.PropertyFilenames, model.FilenameIsInvalid)
+	}
+
+	if !isUniqueMatch(lang.Interpreters, interpreterPattern) {
+		return invalidProperty(model.PropertyInterpreters, model.InterpreterIsInvalid)
+	}
+
+	seenAlias := map[string]bool{NameKey(lang.Name): true}
+	for _, a := range lang.Aliases {
+		key := NameKey(a)
+		if seenAlias[key] || util.IsEmpty(key) || utf8.RuneCountInString(a) > MaxAliasLength {
+			return invalidProperty(model.PropertyAliases, model.AliasIsInvalid)
+		}
+		seenAlias[key] = true
+	}
+
+	if lang.Color != "" && !colorPattern.MatchString(lang.Color) {
+		return invalidProperty(model.PropertyColor, model.ColorIsInvalid)
+	}
+
+	if utf8.RuneCountInString(lang.StableVersion) > MaxVersionLength {
+		return invalidProperty(model.PropertyStableVersion, model.StableVersionIsTooLong)
+	}
+
+	return nil
+}
+
+// invalidProperty は、InvalidPropertyErrorを生成し、返す。
+func invalidProperty(property, message string) error {
+	return &model.InvalidPropertyError{
+		Property: property,
+		Message:  message,
+	}
+}
+
+// isKnownParadigm は、定義されているプログラミングパラダイムかどうかを確認する。
+func isKnownParadigm(p model.Paradigm) bool {
+	for _, known := range model.Paradigms {
+		if p == known {
+			return true
+		}
+	}
+	return false
+}
+
+// isWebsite は、httpもしくはhttpsの絶対URLかどうかを確認する。
+func isWebsite(s string) bool {
+	if len(s) > MaxWebsiteLength {
+		return false
+	}
+
+	u, err := url.Parse(s)
+	if err != nil {
+		return false
+	}
+	return (u.Scheme == "http" || u.Scheme == "https") && u.Host != ""
+}
+
+// isUniqueMatch は、全ての値が重複せずにpatternに一致するかどうかを確認する。
+func isUniqueMatch(values []string, pattern *regexp.Regexp) bool {
+	seen := make(map[string]bool, len(values))
+	for _, v := range values {
+		if seen[v] || !pattern.MatchString(v) {
+			return false
+		}
+		seen[v] = true
+	}
+	return true
+}
@@ -1,6 +1,7 @@
 package service
 
 import (
+	"strings"
 	"testing"
 
 	"github.com/SekiguchiKai/clean-architecture-with-go/server/domain/model"
@@ -143,3 +144,133 @@ func TestValidateProgrammingLang(t *testing.T) {
 		})
 	}
 }
+
+func TestValidateProgrammingLangDetail(t *testing.T) {
+	valid := func() *model.ProgrammingLang {
+		return &model.ProgrammingLang{
+			Name:          "Go",
+			FirstAppeared: 2009,
+			Designers:     []string{"Robert Griesemer", "Rob Pike", "Ken Thompson"},
+			TypeChecking:  model.TypeCheckingStatic,
+			TypeStrength:  model.TypeStrengthStrong,
+			Paradigms:     []model.Paradigm{model.ParadigmConcurrent, model.ParadigmImperative},
+			License:       "BSD-3-Clause",
+			Website:       "https://golang.org",
+			Extensions:    []string{".go"},
+			Filenames:     []string{"go.mod"},
+			Interpreters:  []string{"gorun"},
+			Aliases:       []string{"golang"},
+			Color:         "#00ADD8",
+			StableVersion: "1.11.1",
+		}
+	}
+
+	tests := []struct {
+		name         string
+		modify       func(lang *model.ProgrammingLang)
+		wantProperty string
+	}{
+		{
+			name:   "全ての属性が適切な場合、エラーを返さない",
+			modify: func(lang *model.ProgrammingLang) {},
+		},
+		{
+			name:   "詳細な属性が設定されていない場合、エラーを返さない",
+			modify: func(lang *model.ProgrammingLang) { *lang = model.ProgrammingLang{Name: "Go"} },
+		},
+		{
+			name:         "FirstAppearedが1940より前の場合、エラーを返す",
+			modify:       func(lang *model.ProgrammingLang) { lang.FirstAppeared = 1939 },
+			wantProperty: model.PropertyFirstAppeared,
+		},
+		{
+			name:         "Designersに空文字が含まれる場合、エラーを返す",
+			modify:       func(lang *model.ProgrammingLang) { lang.Designers = append(lang.Designers, "") },
+			wantProperty: model.PropertyDesigners,
+		},
+		{
+			name:         "TypeCheckingが定義されていない値の場合、エラーを返す",
+			modify:       func(lang *model.ProgrammingLang) { lang.TypeChecking = "duck" },
+			wantProperty: model.PropertyTypeChecking,
+		},
+		{
+			name:         "TypeStrengthが定義されていない値の場合、エラーを返す",
+			modify:       func(lang *model.ProgrammingLang) { lang.TypeStrength = "medium" },
+			wantProperty: model.PropertyTypeStrength,
+		},
+		{
+			name:         "Paradigmsが重複する場合、エラーを返す",
+			modify:       func(lang *model.ProgrammingLang) { lang.Paradigms = append(lang.Paradigms, model.ParadigmConcurrent) },
+			wantProperty: model.PropertyParadigms,
+		},
+		{
+			name:         "Paradigmsに定義されていない値が含まれる場合、エラーを返す",
+			modify:       func(lang *model.ProgrammingLang) { lang.Paradigms = []model.Paradigm{"magic"} },
+			wantProperty: model.PropertyParadigms,
+		},
+		{
+			name:         "Websiteがhttpもしくはhttpsの絶対URLでない場合、エラーを返す",
+			modify:       func(lang *model.ProgrammingLang) { lang.Website = "ftp://golang.org" },
+			wantProperty: model.PropertyWebsite,
+		},
+		{
+			name:         "Extensionsが.から始まらない場合、エラーを返す",
+			modify:       func(lang *model.ProgrammingLang) { lang.Extensions = []string{"go"} },
+			wantProperty: model.PropertyExtensions,
+		},
+		{
+			name:         "Filenamesにパスが含まれる場合、エラーを返す",
+			modify:       func(lang *model.ProgrammingLang) { lang.Filenames = []string{"cmd/go.mod"} },
+			wantProperty: model.PropertyFilenames,
+		},
+		{
+			name:         "Interpretersが重複する場合、エラーを返す",
+			modify:       func(lang *model.ProgrammingLang) { lang.Interpreters = []string{"gorun", "gorun"} },
+			wantProperty: model.PropertyInterpreters,
+		},
+		{
+			name:         "Aliasesが大文字と小文字の違いを除いて重複する場合、エラーを返す",
+			modify:       func(lang *model.ProgrammingLang) { lang.Aliases = []string{"golang", "GoLang"} },
+			wantProperty: model.PropertyAliases,
+		},
+		{
+			name:         "AliasesがNameと大文字と小文字の違いを除いて同じ場合、エラーを返す",
+			modify:       func(lang *model.ProgrammingLang) { lang.Aliases = []string{"GO"} },
+			wantProperty: model.PropertyAliases,
+		},
+		{
+			name:         "Aliasesが33文字以上の場合、エラーを返す",
+			modify:       func(lang *model.ProgrammingLang) { lang.Aliases = []string{strings.Repeat("a", 33)} },
+			wantProperty: model.PropertyAliases,
+		},
+		{
+			name:         "Colorが#から始まる6桁の16進数でない場合、エラーを返す",
+			modify:       func(lang *model.ProgrammingLang) { lang.Color = "00ADD8" },
+			wantProperty: model.PropertyColor,
+		},
+		{
+			name:         "StableVersionが33文字以上の場合、エラーを返す",
+			modify:       func(lang *model.ProgrammingLang) { lang.StableVersion = strings.Repeat("1", 33) },
+			wantProperty: model.PropertyStableVersion,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			lang := valid()
+			tt.modify(lang)
+
+			err := ValidateProgrammingLangDetail(lang)
+			if tt.wantProperty == "" {
+				if err != nil {
+					t.Errorf("ValidateProgrammingLangDetail() error = %v, want nil", err)
+				}
+				return
+			}
+
+			e, ok := err.(*model.InvalidPropertyError)
+			if !ok || e.Property != tt.wantProperty {
+				t.Errorf("ValidateProgrammingLangDetail() error = %v, want InvalidPropertyError of %v", err, tt.wantProperty)
+			}
+		})
+	}
+}
@@ -0,0 +1,131 @@
+package service
+
+import (
+	"regexp"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/SekiguchiKai/clean-architecture-with-go/server/domain/model"
+)
+
+// semverPattern は、Semantic Versioning 2.0.0の形式。
+var semverPattern = regexp.MustCompile(`^(0|[1-9]\d*)\.(0|[1-9]\d*)\.(0|[1-9]\d*)` +
+	`(?:-((?:0|[1-9]\d*|\d*[a-zA-Z-][0-9a-zA-Z-]*)(?:\.(?:0|[1-9]\d*|\d*[a-zA-Z-][0-9a-zA-Z-]*))*))?` +
+	`(?:\+([0-9a-zA-Z-]+(?:\.[0-9a-zA-Z-]+)*))?$`)
+
+// Semver は、Semantic Versioningのバージョンを表す。
+type Semver struct {
+	Major      int
+	Minor      int
+	Patch      int
+	Prerelease []string
+	Build      string
+}
+
+// ParseSemver は、文字列をSemverに変換する。Semantic Versioningの形式でない場合は、falseを返す。
+func ParseSemver(s string) (*Semver, bool) {
+	m := semverPattern.FindStringSubmatch(s)
+	if m == nil {
+		return nil, false
+	}
+
+	v := &Semver{Build: m[5]}
+	for i, dst := range []*int{&v.Major, &v.Minor, &v.Patch} {
+		n, err := strconv.Atoi(m[i+1])
+		if err != nil {
+			return nil, false
+		}
+		*dst = n
+	}
+	if m[4] != "" {
+		v.Prerelease = strings.Split(m[4], ".")
+	}
+	return v, true
+}
+
+// IsPrerelease は、プレリリースのバージョンかどうかを返す。
+func (v *Semver) IsPrerelease() bool {
+	return len(v.Prerelease) > 0
+}
+
+// Compare は、vがoより古い場合は負の値、新しい場合は正の値、同じ場合は0を返す。Buildは比較に使用しない。
+func (v *Semver) Compare(o *Semver) int {
+	if c := compareInt(v.Major, o.Major); c != 0 {
+		return c
+	}
+	if c := compareInt(v.Minor, o.Minor); c != 0 {
+		return c
+	}
+	if c := compareInt(v.Patch, o.Patch); c != 0 {
+		return c
+	}
+
+	// プレリリースのバージョンは、プレリリースでないバージョンより古い。
+	switch {
+	case !v.IsPrerelease() && !o.IsPrerelease():
+		return 0
+	case !v.IsPrerelease():
+		return 1
+	case !o.IsPrerelease():
+		return -1
+	}
+
+	for i := 0; i < len(v.Prerelease) && i < len(o.Prerelease); i++ {
+		if c := comparePrerelease(v.Prerelease[i], o.Prerelease[i]); c != 0 {
+			return c
+		}
+	}
+	return compareInt(len(v.Prerelease), len(o.Prerelease))
+}
+
+// CompareVersions は、2つのバージョンの文字列を比較する。Semantic Versioningの形式でないものは、どのバージョンよりも古いものとする。
+func CompareVersions(a, b string) int {
+	va, okA := ParseSemver(a)
+	vb, okB := ParseSemver(b)
+	switch {
+	case okA && okB:
+		return va.Compare(vb)
+	case okA:
+		return 1
+	case okB:
+		return -1
+	default:
+		return strings.Compare(a, b)
+	}
+}
+
+// SortLanguageVersions は、LanguageVersionをバージョンの新しい順に並べ替える。
+func SortLanguageVersions(versions []*model.LanguageVersion) {
+	sort.SliceStable(versions, func(i, j int) bool {
+		return CompareVersions(versions[i].Version, versions[j].Version) > 0
+	})
+}
+
+// comparePrerelease は、プレリリースの識別子を比較する。数字のみの識別子は数値として比較し、英数字の識別子より古いものとする。
+func comparePrerelease(a, b string) int {
+	na, errA := strconv.Atoi(a)
+	nb, errB := strconv.Atoi(b)
+	switch {
+	case errA == nil && errB == nil:
+		return compareInt(na, nb)
+	case errA == nil:
+		return -1
+	case errB == nil:
+		return 1
+	default:
+		return strings.Compare(a, b)
+	}
+}
+
+// compareInt は、2つの整数を比較する。
+func compareInt(a, b int) int {
+	switch {
+	case a < b:
+		return -1
+	case a > b:
+		return 1
+	default:
+		return 0
+	}
+}
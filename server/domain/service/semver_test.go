@@ -0,0 +1,135 @@
+package service
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/SekiguchiKai/clean-architecture-with-go/server/domain/model"
+)
+
+func TestParseSemver(t *testing.T) {
+	tests := []struct {
+		name   string
+		arg    string
+		want   *Semver
+		wantOK bool
+	}{
+		{
+			name:   "MAJOR.MINOR.PATCHの場合、Semverを返すこと",
+			arg:    "1.11.2",
+			want:   &Semver{Major: 1, Minor: 11, Patch: 2},
+			wantOK: true,
+		},
+		{
+			name:   "プレリリースとビルドを含む場合、それぞれを分けて返すこと",
+			arg:    "2.0.0-rc.1+build.5",
+			want:   &Semver{Major: 2, Prerelease: []string{"rc", "1"}, Build: "build.5"},
+			wantOK: true,
+		},
+		{
+			name:   "PATCHが省略されている場合、falseを返すこと",
+			arg:    "1.11",
+			wantOK: false,
+		},
+		{
+			name:   "先頭に0が付いた数字を含む場合、falseを返すこと",
+			arg:    "1.02.0",
+			wantOK: false,
+		},
+		{
+			name:   "先頭にvが付いている場合、falseを返すこと",
+			arg:    "v1.0.0",
+			wantOK: false,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, ok := ParseSemver(tt.arg)
+			if ok != tt.wantOK {
+				t.Errorf("ParseSemver() ok = %v, want %v", ok, tt.wantOK)
+			}
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("ParseSemver() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestCompareVersions(t *testing.T) {
+	tests := []struct {
+		name string
+		a    string
+		b    string
+		want int
+	}{
+		{
+			name: "数値として比較すること",
+			a:    "1.10.0",
+			b:    "1.9.0",
+			want: 1,
+		},
+		{
+			name: "プレリリースは、プレリリースでないバージョンより古いこと",
+			a:    "1.0.0-rc.1",
+			b:    "1.0.0",
+			want: -1,
+		},
+		{
+			name: "プレリリースの数字の識別子は、数値として比較すること",
+			a:    "1.0.0-rc.2",
+			b:    "1.0.0-rc.10",
+			want: -1,
+		},
+		{
+			name: "プレリリースの数字の識別子は、英数字の識別子より古いこと",
+			a:    "1.0.0-1",
+			b:    "1.0.0-alpha",
+			want: -1,
+		},
+		{
+			name: "プレリリースの識別子が一致する場合、識別子が多い方が新しいこと",
+			a:    "1.0.0-alpha.1",
+			b:    "1.0.0-alpha",
+			want: 1,
+		},
+		{
+			name: "ビルドのみが異なる場合、同じバージョンとすること",
+			a:    "1.0.0+a",
+			b:    "1.0.0+b",
+			want: 0,
+		},
+		{
+			name: "Semantic Versioningの形式でない場合、形式が正しいバージョンより古いこと",
+			a:    "latest",
+			b:    "0.0.1",
+			want: -1,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := CompareVersions(tt.a, tt.b); got != tt.want {
+				t.Errorf("CompareVersions() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestSortLanguageVersions(t *testing.T) {
+	versions := []*model.LanguageVersion{
+		{Version: "1.9.0"},
+		{Version: "1.10.0-beta.1"},
+		{Version: "1.10.0"},
+		{Version: "1.2.3"},
+	}
+
+	SortLanguageVersions(versions)
+
+	got := make([]string, len(versions))
+	for i, v := range versions {
+		got[i] = v.Version
+	}
+	want := []string{"1.10.0", "1.10.0-beta.1", "1.9.0", "1.2.3"}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("SortLanguageVersions() = %v, want %v", got, want)
+	}
+}
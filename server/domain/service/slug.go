@@ -0,0 +1,117 @@
+package service
+
+import (
+	"bytes"
+	"fmt"
+	"strings"
+	"unicode"
+)
+
+// slugの定義。
+const (
+	MaxSlugLength   = 64
+	DefaultSlug     = "lang"
+	MaxSlugAttempts = 100
+)
+
+// slugSymbols は、言語名で意味を持つ記号の変換。
+var slugSymbols = map[rune]string{
+	'+': "p",
+	'#': "sharp",
+	'&': "and",
+	'@': "at",
+}
+
+// transliterations は、ASCIIに変換する文字の対応。
+var transliterations = map[rune]string{
+	// ラテン文字
+	'à': "a", 'á': "a", 'â': "a", 'ã': "a", 'ä': "a", 'å': "a", 'ā': "a", 'ă': "a", 'ą': "a",
+	'æ': "ae", 'ç': "c", 'ć': "c", 'č': "c", 'ĉ': "c", 'ċ': "c", 'ď': "d", 'đ': "d", 'ð': "d",
+	'è': "e", 'é': "e", 'ê': "e", 'ë': "e", 'ē': "e", 'ė': "e", 'ę': "e", 'ě': "e",
+	'ĝ': "g", 'ğ': "g", 'ġ': "g", 'ģ': "g", 'ĥ': "h", 'ħ': "h",
+	'ì': "i", 'í': "i", 'î': "i", 'ï': "i", 'ī': "i", 'į': "i", 'ı': "i", 'ĵ': "j", 'ķ': "k",
+	'ĺ': "l", 'ļ': "l", 'ľ': "l", 'ł': "l", 'ñ': "n", 'ń': "n", 'ņ': "n", 'ň': "n",
+	'ò': "o", 'ó': "o", 'ô': "o", 'õ': "o", 'ö': "o", 'ø': "o", 'ō': "o", 'ő': "o", 'œ': "oe",
+	'ŕ': "r", 'ř': "r", 'ś': "s", 'ŝ': "s", 'ş': "s", 'š': "s", 'ß': "ss", 'ţ': "t", 'ť': "t", 'þ': "th",
+	'ù': "u", 'ú': "u", 'û': "u", 'ü': "u", 'ū': "u", 'ŭ': "u", 'ů': "u", 'ű': "u", 'ų': "u",
+	'ŵ': "w", 'ý': "y", 'ÿ': "y", 'ŷ': "y", 'ź': "z", 'ż': "z", 'ž': "z",
+	// ギリシャ文字
+	'α': "a", 'β': "b", 'γ': "g", 'δ': "d", 'ε': "e", 'ζ': "z", 'η': "e", 'θ': "th",
+	'ι': "i", 'κ': "k", 'λ': "l", 'μ': "m", 'ν': "n", 'ξ': "x", 'ο': "o", 'π': "p",
+	'ρ': "r", 'σ': "s", 'ς': "s", 'τ': "t", 'υ': "y", 'φ': "f", 'χ': "ch", 'ψ': "ps", 'ω': "o",
+	// キリル文字
+	'а': "a", 'б': "b", 'в': "v", 'г': "g", 'д': "d", 'е': "e", 'ё': "e", 'ж': "zh",
+	'з': "z", 'и': "i", 'й': "i", 'к': "k", 'л': "l", 'м': "m", 'н': "n", 'о': "o",
+	'п': "p", 'р': "r", 'с': "s", 'т': "t", 'у': "u", 'ф': "f", 'х': "kh", 'ц': "ts",
+	'ч': "ch", 'ш': "sh", 'щ': "shch", 'ъ': "", 'ы': "y", 'ь': "", 'э': "e", 'ю': "iu", 'я': "ia",
+}
+
+// GenerateSlug は、NameからURLで使用できるslugを生成し、返す。
+// 英数字以外の文字はASCIIに変換し、変換できない文字は区切りとして扱う。
+func GenerateSlug(name string) string {
+	var b bytes.Buffer
+	separate := false
+
+	write := func(s string) {
+		if s == "" {
+			return
+		}
+		if separate && b.Len() > 0 {
+			b.WriteByte('-')
+		}
+		separate = false
+		b.WriteString(s)
+	}
+
+	for _, r := range strings.ToLower(name) {
+		switch {
+		case r < unicode.MaxASCII && (unicode.IsLetter(r) || unicode.IsDigit(r)):
+			write(string(r))
+		case slugSymbols[r] != "":
+			write(slugSymbols[r])
+		case transliterations[r] != "":
+			write(transliterations[r])
+		default:
+			if _, ok := transliterations[r]; !ok {
+				separate = true
+			}
+		}
+	}
+
+	slug := b.String()
+	if len(slug) > MaxSlugLength {
+		slug = strings.TrimRight(slug[:MaxSlugLength], "-")
+	}
+	if slug == "" {
+		return DefaultSlug
+	}
+	return slug
+}
+
+// NumberedSlug は、重複を避けるために番号を付与したslugを返す。1番目は、slugをそのまま返す。
+func NumberedSlug(slug string, n int) string {
+	if n <= 1 {
+		return slug
+	}
+
+	suffix := fmt.Sprintf("-%d", n)
+	if len(slug)+len(suffix) > MaxSlugLength {
+		slug = strings.TrimRight(slug[:MaxSlugLength-len(suffix)], "-")
+	}
+	return slug + suffix
+}
+
+// IsSlugOf は、slugがNameから生成したslugもしくはそれに番号を付与したものかどうかを確認する。
+func IsSlugOf(slug, name string) bool {
+	base := GenerateSlug(name)
+	if slug == base {
+		return true
+	}
+
+	for n := 2; n <= MaxSlugAttempts; n++ {
+		if slug == NumberedSlug(base, n) {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,132 @@
+package service
+
+import (
+	"strings"
+	"testing"
+)
+
+func TestGenerateSlug(t *testing.T) {
+	tests := []struct {
+		name string
+		arg  string
+		want string
+	}{
+		{
+			name: "英数字の場合、小文字にしたNameを返すこと",
+			arg:  "Go",
+			want: "go",
+		},
+		{
+			name: "空白や記号を含む場合、ハイフンで区切ること",
+			arg:  "Objective-C  (2.0)",
+			want: "objective-c-2-0",
+		},
+		{
+			name: "+や#を含む場合、意味が分かる文字に変換すること",
+			arg:  "C++",
+			want: "cpp",
+		},
+		{
+			name: "#を含む場合、sharpに変換すること",
+			arg:  "F#",
+			want: "fsharp",
+		},
+		{
+			name: "アクセント記号付きの文字を含む場合、ASCIIに変換すること",
+			arg:  "Pokémon Straße",
+			want: "pokemon-strasse",
+		},
+		{
+			name: "キリル文字の場合、ASCIIに変換すること",
+			arg:  "Рефал",
+			want: "refal",
+		},
+		{
+			name: "変換できない文字のみの場合、デフォルトのslugを返すこと",
+			arg:  "なでしこ",
+			want: DefaultSlug,
+		},
+		{
+			name: "最大長を超える場合、最大長で切り詰めること",
+			arg:  strings.Repeat("a", MaxSlugLength+10),
+			want: strings.Repeat("a", MaxSlugLength),
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := GenerateSlug(tt.arg); got != tt.want {
+				t.Errorf("GenerateSlug() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestNumberedSlug(t *testing.T) {
+	tests := []struct {
+		name string
+		slug string
+		n    int
+		want string
+	}{
+		{
+			name: "1番目の場合、slugをそのまま返すこと",
+			slug: "go",
+			n:    1,
+			want: "go",
+		},
+		{
+			name: "2番目以降の場合、番号を付与すること",
+			slug: "go",
+			n:    3,
+			want: "go-3",
+		},
+		{
+			name: "番号を付与して最大長を超える場合、slugを切り詰めること",
+			slug: strings.Repeat("a", MaxSlugLength),
+			n:    2,
+			want: strings.Repeat("a", MaxSlugLength-2) + "-2",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := NumberedSlug(tt.slug, tt.n); got != tt.want {
+				t.Errorf("NumberedSlug() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestIsSlugOf(t *testing.T) {
+	tests := []struct {
+		name string
+		slug string
+		arg  string
+		want bool
+	}{
+		{
+			name: "Nameから生成したslugの場合、trueを返すこと",
+			slug: "cpp",
+			arg:  "C++",
+			want: true,
+		},
+		{
+			name: "番号を付与したslugの場合、trueを返すこと",
+			slug: "cpp-2",
+			arg:  "C++",
+			want: true,
+		},
+		{
+			name: "別のNameから生成したslugの場合、falseを返すこと",
+			slug: "lang-1",
+			arg:  "C++",
+			want: false,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := IsSlugOf(tt.slug, tt.arg); got != tt.want {
+				t.Errorf("IsSlugOf() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,240 @@
+package service
+
+// Stem は、英単語をPorterのアルゴリズムで語幹に変換して返す。
+// 英小文字以外を含む単語と、2文字以下の単語はそのまま返す。
+func Stem(word string) string {
+	if len(word) <= 2 {
+		return word
+	}
+	for i := 0; i < len(word); i++ {
+		if word[i] < 'a' || 'z' < word[i] {
+			return word
+		}
+	}
+
+	s := &stemmer{b: []byte(word)}
+	s.step1a()
+	s.step1b()
+	s.step1c()
+	s.step2()
+	s.step3()
+	s.step4()
+	s.step5()
+	return string(s.b)
+}
+
+// stemmer は、語幹に変換中の単語を保持する。
+type stemmer struct {
+	b []byte
+}
+
+// step2の接尾辞の置き換え。長いものから順に照合する。
+var step2Suffixes = [][2]string{
+	{"ational", "ate"}, {"iveness", "ive"}, {"fulness", "ful"}, {"ousness", "ous"},
+	{"ization", "ize"}, {"tional", "tion"}, {"biliti", "ble"}, {"entli", "ent"},
+	{"ousli", "ous"}, {"ation", "ate"}, {"alism", "al"}, {"aliti", "al"},
+	{"iviti", "ive"}, {"enci", "ence"}, {"anci", "ance"}, {"izer", "ize"},
+	{"alli", "al"}, {"ator", "ate"}, {"logi", "log"}, {"bli", "ble"}, {"eli", "e"},
+}
+
+// step3の接尾辞の置き換え。長いものから順に照合する。
+var step3Suffixes = [][2]string{
+	{"icate", "ic"}, {"ative", ""}, {"alize", "al"}, {"iciti", "ic"},
+	{"ical", "ic"}, {"ness", ""}, {"ful", ""},
+}
+
+// step4で取り除く接尾辞。長いものから順に照合する。
+var step4Suffixes = []string{
+	"ement", "ance", "ence", "able", "ible", "ment",
+	"ant", "ent", "ism", "ate", "iti", "ous", "ive", "ize", "ion",
+	"al", "er", "ic", "ou",
+}
+
+// step1a は、複数形の語尾を取り除く。
+func (s *stemmer) step1a() {
+	switch {
+	case s.hasSuffix("sses"):
+		s.trim(2)
+	case s.hasSuffix("ies"):
+		s.trim(2)
+	case s.hasSuffix("ss"):
+	case s.hasSuffix("s"):
+		s.trim(1)
+	}
+}
+
+// step1b は、edとingの語尾を取り除く。
+func (s *stemmer) step1b() {
+	if s.hasSuffix("eed") {
+		if measure(s.stem(3)) > 0 {
+			s.trim(1)
+		}
+		return
+	}
+
+	removed := false
+	for _, suffix := range []string{"ed", "ing"} {
+		if s.hasSuffix(suffix) && hasVowel(s.stem(len(suffix))) {
+			s.trim(len(suffix))
+			removed = true
+			break
+		}
+	}
+	if !removed {
+		return
+	}
+
+	switch {
+	case s.hasSuffix("at"), s.hasSuffix("bl"), s.hasSuffix("iz"):
+		s.b = append(s.b, 'e')
+	case endsWithDoubleConsonant(s.b):
+		if last := s.b[len(s.b)-1]; last != 'l' && last != 's' && last != 'z' {
+			s.trim(1)
+		}
+	case measure(s.b) == 1 && endsWithCVC(s.b):
+		s.b = append(s.b, 'e')
+	}
+}
+
+// step1c は、母音を含む語幹に続くyをiに置き換える。
+func (s *stemmer) step1c() {
+	if s.hasSuffix("y") && hasVowel(s.stem(1)) {
+		s.b[len(s.b)-1] = 'i'
+	}
+}
+
+// step2 は、語幹の音節が1つ以上ある場合に、2つの接尾辞の組み合わせを1つにまとめる。
+func (s *stemmer) step2() {
+	s.replaceSuffix(step2Suffixes, 0)
+}
+
+// step3 は、語幹の音節が1つ以上ある場合に、-ful、-nessなどの接尾辞を取り除く。
+func (s *stemmer) step3() {
+	s.replaceSuffix(step3Suffixes, 0)
+}
+
+// step4 は、語幹の音節が2つ以上ある場合に、-ant、-enceなどの接尾辞を取り除く。
+// -ionは、語幹がsもしくはtで終わる場合のみ取り除く。
+func (s *stemmer) step4() {
+	for _, suffix := range step4Suffixes {
+		if !s.hasSuffix(suffix) {
+			continue
+		}
+
+		stem := s.stem(len(suffix))
+		if measure(stem) <= 1 {
+			return
+		}
+		if suffix == "ion" {
+			if last := stem[len(stem)-1]; last != 's' && last != 't' {
+				return
+			}
+		}
+		s.trim(len(suffix))
+		return
+	}
+}
+
+// step5 は、末尾のeと、-llの重複したlを取り除く。
+func (s *stemmer) step5() {
+	if s.hasSuffix("e") {
+		stem := s.stem(1)
+		if m := measure(stem); m > 1 || (m == 1 && !endsWithCVC(stem)) {
+			s.trim(1)
+		}
+	}
+
+	if s.hasSuffix("ll") && measure(s.b) > 1 {
+		s.trim(1)
+	}
+}
+
+// replaceSuffix は、最初に一致した接尾辞を、語幹の音節がminMeasureより多い場合に置き換える。
+func (s *stemmer) replaceSuffix(suffixes [][2]string, minMeasure int) {
+	for _, r := range suffixes {
+		if !s.hasSuffix(r[0]) {
+			continue
+		}
+
+		stem := s.stem(len(r[0]))
+		if measure(stem) > minMeasure {
+			s.b = append(stem, r[1]...)
+		}
+		return
+	}
+}
+
+// hasSuffix は、単語がsuffixで終わるかどうかを返す。
+func (s *stemmer) hasSuffix(suffix string) bool {
+	return len(s.b) > len(suffix) && string(s.b[len(s.b)-len(suffix):]) == suffix
+}
+
+// stem は、末尾のn文字を除いた語幹を返す。
+func (s *stemmer) stem(n int) []byte {
+	return s.b[:len(s.b)-n]
+}
+
+// trim は、末尾のn文字を取り除く。
+func (s *stemmer) trim(n int) {
+	s.b = s.b[:len(s.b)-n]
+}
+
+// isConsonant は、i文字目が子音かどうかを返す。yは、先頭もしくは母音の後の場合に子音として扱う。
+func isConsonant(b []byte, i int) bool {
+	switch b[i] {
+	case 'a', 'e', 'i', 'o', 'u':
+		return false
+	case 'y':
+		return i == 0 || !isConsonant(b, i-1)
+	default:
+		return true
+	}
+}
+
+// measure は、母音の連続と子音の連続の組の数を返す。
+func measure(b []byte) int {
+	n := 0
+	i := 0
+	for i < len(b) && isConsonant(b, i) {
+		i++
+	}
+	for i < len(b) {
+		for i < len(b) && !isConsonant(b, i) {
+			i++
+		}
+		if i == len(b) {
+			break
+		}
+		for i < len(b) && isConsonant(b, i) {
+			i++
+		}
+		n++
+	}
+	return n
+}
+
+// hasVowel は、母音を含むかどうかを返す。
+func hasVowel(b []byte) bool {
+	for i := range b {
+		if !isConsonant(b, i) {
+			return true
+		}
+	}
+	return false
+}
+
+// endsWithDoubleConsonant は、同じ子音が2つ続いて終わるかどうかを返す。
+func endsWithDoubleConsonant(b []byte) bool {
+	l := len(b)
+	return l >= 2 && b[l-1] == b[l-2] && isConsonant(b, l-1)
+}
+
+// endsWithCVC は、子音、母音、子音の順で終わり、最後の子音がw、x、yでないかどうかを返す。
+func endsWithCVC(b []byte) bool {
+	l := len(b)
+	if l < 3 || !isConsonant(b, l-1) || isConsonant(b, l-2) || !isConsonant(b, l-3) {
+		return false
+	}
+	last := b[l-1]
+	return last != 'w' && last != 'x' && last != 'y'
+}
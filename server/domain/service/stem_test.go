@@ -0,0 +1,30 @@
+package service
+
+import "testing"
+
+func TestStem(t *testing.T) {
+	tests := []struct {
+		name string
+		word string
+		want string
+	}{
+		{name: "複数形の場合、単数形にすること", word: "caresses", want: "caress"},
+		{name: "iesで終わる場合、iにすること", word: "ponies", want: "poni"},
+		{name: "ingで終わる場合、重複した子音とともに取り除くこと", word: "running", want: "run"},
+		{name: "edで終わる場合、取り除くこと", word: "compiled", want: "compil"},
+		{name: "同じ語幹の名詞と動詞の場合、同じ語幹にすること", word: "compiler", want: "compil"},
+		{name: "原形の場合も、同じ語幹にすること", word: "compile", want: "compil"},
+		{name: "接尾辞が重なる場合、まとめて取り除くこと", word: "generalizations", want: "gener"},
+		{name: "yで終わる場合、iにすること", word: "happy", want: "happi"},
+		{name: "-ionで終わる場合、取り除くこと", word: "adoption", want: "adopt"},
+		{name: "2文字以下の場合、そのまま返すこと", word: "go", want: "go"},
+		{name: "英小文字以外を含む場合、そのまま返すこと", word: "c++", want: "c++"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := Stem(tt.word); got != tt.want {
+				t.Errorf("Stem() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,44 @@
+package service
+
+import (
+	"regexp"
+	"strings"
+
+	"github.com/SekiguchiKai/clean-architecture-with-go/server/domain/model"
+)
+
+// tagNamePattern は、タグの名前の形式。
+var tagNamePattern = regexp.MustCompile(`^[a-z0-9][a-z0-9+#.-]{0,31}$`)
+
+// NormalizeTagName は、タグの名前を比較できるように前後の空白を取り除いて小文字にする。
+func NormalizeTagName(name string) string {
+	return strings.ToLower(strings.TrimSpace(name))
+}
+
+// NewTag は、名前を正規化したTagを生成し、返す。
+func NewTag(name string) (*model.Tag, error) {
+	name = NormalizeTagName(name)
+	if err := ValidateTagName(name); err != nil {
+		return nil, err
+	}
+
+	return &model.Tag{
+		Name: name,
+	}, nil
+}
+
+// ValidateTagName は、正規化したタグの名前をチェックする。
+func ValidateTagName(name string) error {
+	if !tagNamePattern.MatchString(name) {
+		return invalidProperty(model.PropertyTagName, model.TagNameIsInvalid)
+	}
+	return nil
+}
+
+// ValidateTagMerge は、fromIDのTagをtoIDのTagに統合できるかをチェックする。
+func ValidateTagMerge(fromID, toID int) error {
+	if fromID == toID {
+		return invalidProperty(model.PropertyTagID, model.TagCannotBeMergedIntoItself)
+	}
+	return nil
+}
@@ -0,0 +1,55 @@
+package service
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/SekiguchiKai/clean-architecture-with-go/server/domain/model"
+)
+
+func TestNewTag(t *testing.T) {
+	tests := []struct {
+		name    string
+		arg     string
+		want    *model.Tag
+		wantErr bool
+	}{
+		{
+			name: "大文字や前後の空白を含む場合、正規化した名前のTagを返すこと",
+			arg:  "  Functional ",
+			want: &model.Tag{Name: "functional"},
+		},
+		{
+			name: "記号を含む場合、そのままの名前のTagを返すこと",
+			arg:  "c++",
+			want: &model.Tag{Name: "c++"},
+		},
+		{
+			name:    "空白のみの場合、エラーを返すこと",
+			arg:     "  ",
+			wantErr: true,
+		},
+		{
+			name:    "途中に空白を含む場合、エラーを返すこと",
+			arg:     "garbage collected",
+			wantErr: true,
+		},
+		{
+			name:    "33文字以上の場合、エラーを返すこと",
+			arg:     "abcdefghijklmnopqrstuvwxyz0123456",
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := NewTag(tt.arg)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("NewTag() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("NewTag() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,145 @@
+package service
+
+import (
+	"bytes"
+	"html"
+	"strings"
+	"unicode"
+)
+
+// 抜粋の設定。
+const (
+	SnippetTokens     = 24
+	SnippetLeadTokens = 6
+	SnippetEllipsis   = "…"
+	HighlightOpen     = "<mark>"
+	HighlightClose    = "</mark>"
+)
+
+// MaxSearchQueryLength は、全文検索の検索語の最大の長さ。
+const MaxSearchQueryLength = 256
+
+// stopWords は、検索語として扱わない英単語。
+var stopWords = map[string]bool{
+	"a": true, "an": true, "and": true, "are": true, "as": true, "at": true, "be": true, "by": true,
+	"for": true, "from": true, "has": true, "in": true, "is": true, "it": true, "its": true, "of": true,
+	"on": true, "or": true, "that": true, "the": true, "this": true, "to": true, "was": true, "with": true,
+}
+
+// Token は、文章を分割した語を表す。Termは、照合用に正規化した語。
+// Start、Endは、元の文章における語のバイト単位の位置。
+type Token struct {
+	Term  string
+	Start int
+	End   int
+}
+
+// Tokenize は、文章を文字と数字の連続ごとに分割し、小文字にして語幹に変換した語を返す。英語の頻出語は除く。
+func Tokenize(text string) []Token {
+	tokens := make([]Token, 0)
+	start := -1
+	for i, r := range text {
+		if unicode.IsLetter(r) || unicode.IsDigit(r) {
+			if start < 0 {
+				start = i
+			}
+			continue
+		}
+		if start >= 0 {
+			tokens = appendToken(tokens, text, start, i)
+			start = -1
+		}
+	}
+	if start >= 0 {
+		tokens = appendToken(tokens, text, start, len(text))
+	}
+	return tokens
+}
+
+// appendToken は、text[start:end]の語が頻出語でなければ、正規化してtokensに追加する。
+func appendToken(tokens []Token, text string, start, end int) []Token {
+	word := strings.ToLower(text[start:end])
+	if stopWords[word] {
+		return tokens
+	}
+	return append(tokens, Token{
+		Term:  Stem(word),
+		Start: start,
+		End:   end,
+	})
+}
+
+// AnalyzeQuery は、検索語を正規化した語に分割し、重複を除いて返す。
+func AnalyzeQuery(query string) []string {
+	seen := make(map[string]bool)
+	terms := make([]string, 0)
+	for _, token := range Tokenize(query) {
+		if seen[token.Term] {
+			continue
+		}
+		seen[token.Term] = true
+		terms = append(terms, token.Term)
+	}
+	return terms
+}
+
+// Snippet は、文章のうち最初にtermsに一致した語の周辺を抜粋し、一致した語をmarkで囲んで返す。
+// 一致した語がない場合は、文章の先頭を抜粋する。HTMLとして表示できるように、文章はエスケープする。
+func Snippet(text string, terms []string) string {
+	tokens := Tokenize(text)
+	if len(tokens) == 0 {
+		return ""
+	}
+
+	matched := make(map[string]bool, len(terms))
+	for _, term := range terms {
+		matched[term] = true
+	}
+
+	first := 0
+	for i, token := range tokens {
+		if matched[token.Term] {
+			first = i
+			break
+		}
+	}
+
+	from := first - SnippetLeadTokens
+	if from < 0 {
+		from = 0
+	}
+	to := from + SnippetTokens
+	if to > len(tokens) {
+		to = len(tokens)
+	}
+
+	start, end := tokens[from].Start, tokens[to-1].End
+	if from == 0 {
+		start = 0
+	}
+	if to == len(tokens) {
+		end = len(text)
+	}
+
+	var buf bytes.Buffer
+	if start > 0 {
+		buf.WriteString(SnippetEllipsis)
+	}
+	cursor := start
+	for _, token := range tokens[from:to] {
+		if !matched[token.Term] {
+			continue
+		}
+		buf.WriteString(html.EscapeString(text[cursor:token.Start]))
+		buf.WriteString(HighlightOpen)
+		buf.WriteString(html.EscapeString(text[token.Start:token.End]))
+		buf.WriteString(HighlightClose)
+		cursor = token.End
+	}
+	buf.WriteString(html.EscapeString(text[cursor:end]))
+	if end < len(text) {
+		buf.WriteString(SnippetEllipsis)
+	}
+
+	return strings.TrimSpace(buf.String())
+}
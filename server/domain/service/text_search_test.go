@@ -0,0 +1,108 @@
+package service
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestTokenize(t *testing.T) {
+	tests := []struct {
+		name string
+		text string
+		want []Token
+	}{
+		{
+			name: "文章の場合、頻出語を除いて語幹に変換した語と位置を返すこと",
+			text: "Compiles to native code.",
+			want: []Token{
+				{Term: "compil", Start: 0, End: 8},
+				{Term: "nativ", Start: 12, End: 18},
+				{Term: "code", Start: 19, End: 23},
+			},
+		},
+		{
+			name: "マルチバイト文字の場合、バイト単位の位置を返すこと",
+			text: "型 safe",
+			want: []Token{
+				{Term: "型", Start: 0, End: 3},
+				{Term: "safe", Start: 4, End: 8},
+			},
+		},
+		{
+			name: "記号のみの場合、空のスライスを返すこと",
+			text: "++ --",
+			want: []Token{},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := Tokenize(tt.text); !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("Tokenize() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestAnalyzeQuery(t *testing.T) {
+	tests := []struct {
+		name  string
+		query string
+		want  []string
+	}{
+		{name: "同じ語幹の語を含む場合、重複を除くこと", query: "Concurrency and concurrent", want: []string{"concurr"}},
+		{name: "頻出語のみの場合、空のスライスを返すこと", query: "the of", want: []string{}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := AnalyzeQuery(tt.query); !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("AnalyzeQuery() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestSnippet(t *testing.T) {
+	long := "one two three four five six seven eight nine ten eleven twelve thirteen fourteen fifteen sixteen " +
+		"seventeen eighteen nineteen twenty garbage collection twentythree twentyfour twentyfive twentysix twentyseven " +
+		"twentyeight twentynine thirty thirtyone"
+
+	tests := []struct {
+		name  string
+		text  string
+		terms []string
+		want  string
+	}{
+		{
+			name:  "一致した語がある場合、markで囲むこと",
+			text:  "Fast compilation and garbage collected.",
+			terms: []string{"garbag", "collect"},
+			want:  "Fast compilation and <mark>garbage</mark> <mark>collected</mark>.",
+		},
+		{
+			name:  "HTMLの特殊文字を含む場合、エスケープすること",
+			text:  "Supports <generics> & templates",
+			terms: []string{"gener"},
+			want:  "Supports &lt;<mark>generics</mark>&gt; &amp; templates",
+		},
+		{
+			name:  "長い文章の場合、最初に一致した語の周辺を抜粋すること",
+			text:  long,
+			terms: []string{"garbag"},
+			want: "…fifteen sixteen seventeen eighteen nineteen twenty <mark>garbage</mark> collection twentythree twentyfour " +
+				"twentyfive twentysix twentyseven twentyeight twentynine thirty thirtyone",
+		},
+		{
+			name:  "文章が空の場合、空文字列を返すこと",
+			text:  "",
+			terms: []string{"go"},
+			want:  "",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := Snippet(tt.text, tt.terms); got != tt.want {
+				t.Errorf("Snippet() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,191 @@
+package service
+
+import (
+	"crypto/hmac"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"strconv"
+	"time"
+	"unicode/utf8"
+
+	"github.com/SekiguchiKai/clean-architecture-with-go/server/domain/model"
+	"github.com/pkg/errors"
+)
+
+// Webhookの属性の制限。
+const (
+	MinWebhookSecretLength = 16
+	MaxWebhookSecretLength = 128
+	WebhookSecretBytes     = 32
+	MaxDeliveryErrorLength = 255
+)
+
+// Webhookの通知の再送の設定。
+const (
+	MaxWebhookAttempts = 8
+	WebhookBaseBackoff = 30 * time.Second
+	WebhookMaxBackoff  = 6 * time.Hour
+	WebhookLease       = time.Minute
+)
+
+// Webhookの通知のヘッダ。
+const (
+	WebhookEventHeader     = "X-Webhook-Event"
+	WebhookDeliveryHeader  = "X-Webhook-Delivery"
+	WebhookTimestampHeader = "X-Webhook-Timestamp"
+	WebhookSignatureHeader = "X-Webhook-Signature"
+	WebhookSignaturePrefix = "sha256="
+)
+
+// ValidateWebhook は、Webhookの属性をチェックする。
+func ValidateWebhook(w *model.Webhook) error {
+	if !isWebsite(w.URL) {
+		return invalidProperty(model.PropertyURL, model.WebhookURLIsInvalid)
+	}
+
+	if l := utf8.RuneCountInString(w.Secret); l < MinWebhookSecretLength || MaxWebhookSecretLength < l {
+		return invalidProperty(model.PropertySecret, model.WebhookSecretIsInvalid)
+	}
+
+	seen := make(map[model.EventType]bool, len(w.EventTypes))
+	for _, t := range w.EventTypes {
+		if seen[t] || !isKnownEventType(t) {
+			return invalidProperty(model.PropertyEventTypes, model.EventTypeIsUnknown)
+		}
+		seen[t] = true
+	}
+
+	return nil
+}
+
+// ValidateDeliveryStatus は、通知の状態をチェックする。空の場合は、全ての状態を表すものとして扱う。
+func ValidateDeliveryStatus(status model.DeliveryStatus) error {
+	if status == "" {
+		return nil
+	}
+	for _, known := range model.DeliveryStatuses {
+		if status == known {
+			return nil
+		}
+	}
+	return invalidProperty(model.PropertyStatus, model.DeliveryStatusIsUnknown)
+}
+
+// NewWebhookSecret は、署名用のランダムな秘密鍵を生成し、返す。
+func NewWebhookSecret() (string, error) {
+	b := make([]byte, WebhookSecretBytes)
+	if _, err := rand.Read(b); err != nil {
+		return "", errors.WithStack(err)
+	}
+	return hex.EncodeToString(b), nil
+}
+
+// IsWebhookSubscribed は、Webhookが変更の種類を通知する対象としているかどうかを返す。
+func IsWebhookSubscribed(w *model.Webhook, eventType model.EventType) bool {
+	if w.Disabled {
+		return false
+	}
+	if len(w.EventTypes) == 0 {
+		return true
+	}
+	for _, t := range w.EventTypes {
+		if t == eventType {
+			return true
+		}
+	}
+	return false
+}
+
+// SignWebhook は、送信日時とボディを「timestamp.body」の形式でつなげたもののHMAC-SHA256の署名を返す。
+func SignWebhook(secret string, timestamp int64, body []byte) string {
+	mac := hmac.New(sha256.New, []byte(secret))
+	mac.Write([]byte(strconv.FormatInt(timestamp, 10)))
+	mac.Write([]byte("."))
+	mac.Write(body)
+	return WebhookSignaturePrefix + hex.EncodeToString(mac.Sum(nil))
+}
+
+// NewWebhookRequest は、通知を送信するリクエストを、送信日時と署名をヘッダに付けて生成し、返す。
+func NewWebhookRequest(w *model.Webhook, d *model.WebhookDelivery, now time.Time) *model.WebhookRequest {
+	timestamp := now.Unix()
+	return &model.WebhookRequest{
+		URL: w.URL,
+		Headers: map[string]string{
+			"Content-Type":         "application/json",
+			WebhookEventHeader:     string(d.EventType),
+			WebhookDeliveryHeader:  strconv.Itoa(d.ID),
+			WebhookTimestampHeader: strconv.FormatInt(timestamp, 10),
+			WebhookSignatureHeader: SignWebhook(w.Secret, timestamp, d.Payload),
+		},
+		Body: d.Payload,
+	}
+}
+
+// WebhookBackoff は、attempts回送信に失敗した後、次に送信するまでの間隔を返す。
+// 間隔は失敗するごとに2倍になり、WebhookMaxBackoffを上限とする。
+func WebhookBackoff(attempts int) time.Duration {
+	return exponentialBackoff(WebhookBaseBackoff, WebhookMaxBackoff, attempts)
+}
+
+// IsDeliverySucceeded は、応答のステータスコードが通知の成功を表すかどうかを返す。
+func IsDeliverySucceeded(statusCode int) bool {
+	return 200 <= statusCode && statusCode < 300
+}
+
+// RecordDeliveryAttempt は、送信の結果を通知に反映する。
+// 成功した場合はsucceeded、再送の上限に達した場合はdeadにし、それ以外は次の送信日時を設定する。
+func RecordDeliveryAttempt(d *model.WebhookDelivery, statusCode int, sendErr error, now time.Time) {
+	d.Attempts++
+	d.LastStatusCode = statusCode
+	d.LastError = DeliveryErrorMessage(statusCode, sendErr)
+	d.UpdatedAt = now
+
+	switch {
+	case sendErr == nil && IsDeliverySucceeded(statusCode):
+		d.Status = model.DeliveryStatusSucceeded
+	case d.Attempts >= MaxWebhookAttempts:
+		d.Status = model.DeliveryStatusDead
+	default:
+		d.NextAttemptAt = now.Add(WebhookBackoff(d.Attempts))
+	}
+}
+
+// DeliveryErrorMessage は、送信に失敗した理由をMaxDeliveryErrorLength文字以内で返す。成功した場合は、空文字列を返す。
+func DeliveryErrorMessage(statusCode int, sendErr error) string {
+	var msg string
+	switch {
+	case sendErr != nil:
+		msg = sendErr.Error()
+	case !IsDeliverySucceeded(statusCode):
+		msg = fmt.Sprintf("unexpected status code: %d", statusCode)
+	}
+
+	if utf8.RuneCountInString(msg) > MaxDeliveryErrorLength {
+		msg = string([]rune(msg)[:MaxDeliveryErrorLength])
+	}
+	return msg
+}
+
+// exponentialBackoff は、attempts回失敗した後の間隔を、baseから失敗するごとに2倍にしてmaxを上限として返す。
+func exponentialBackoff(base, max time.Duration, attempts int) time.Duration {
+	backoff := base
+	for i := 1; i < attempts; i++ {
+		backoff *= 2
+		if backoff >= max {
+			return max
+		}
+	}
+	return backoff
+}
+
+// isKnownEventType は、定義されている変更の種類かどうかを確認する。
+func isKnownEventType(t model.EventType) bool {
+	for _, known := range model.EventTypes {
+		if t == known {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,170 @@
+package service
+
+import (
+	"errors"
+	"reflect"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/SekiguchiKai/clean-architecture-with-go/server/domain/model"
+)
+
+func TestValidateWebhook(t *testing.T) {
+	const secret = "0123456789abcdef"
+
+	tests := []struct {
+		name    string
+		arg     *model.Webhook
+		wantErr error
+	}{
+		{
+			name: "適切な属性の場合、エラーを返さないこと",
+			arg:  &model.Webhook{URL: "https://example.com/hooks", Secret: secret, EventTypes: []model.EventType{model.EventTypeCreated}},
+		},
+		{
+			name:    "URLが相対URLの場合、エラーを返すこと",
+			arg:     &model.Webhook{URL: "/hooks", Secret: secret},
+			wantErr: &model.InvalidPropertyError{Property: model.PropertyURL, Message: model.WebhookURLIsInvalid},
+		},
+		{
+			name:    "Secretが短すぎる場合、エラーを返すこと",
+			arg:     &model.Webhook{URL: "https://example.com/hooks", Secret: "short"},
+			wantErr: &model.InvalidPropertyError{Property: model.PropertySecret, Message: model.WebhookSecretIsInvalid},
+		},
+		{
+			name:    "未定義の変更の種類を含む場合、エラーを返すこと",
+			arg:     &model.Webhook{URL: "https://example.com/hooks", Secret: secret, EventTypes: []model.EventType{"renamed"}},
+			wantErr: &model.InvalidPropertyError{Property: model.PropertyEventTypes, Message: model.EventTypeIsUnknown},
+		},
+		{
+			name:    "変更の種類が重複する場合、エラーを返すこと",
+			arg:     &model.Webhook{URL: "https://example.com/hooks", Secret: secret, EventTypes: []model.EventType{model.EventTypeDeleted, model.EventTypeDeleted}},
+			wantErr: &model.InvalidPropertyError{Property: model.PropertyEventTypes, Message: model.EventTypeIsUnknown},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if err := ValidateWebhook(tt.arg); !reflect.DeepEqual(err, tt.wantErr) {
+				t.Errorf("ValidateWebhook() error = %v, wantErr %v", err, tt.wantErr)
+			}
+		})
+	}
+}
+
+func TestIsWebhookSubscribed(t *testing.T) {
+	tests := []struct {
+		name    string
+		webhook *model.Webhook
+		want    bool
+	}{
+		{name: "変更の種類を指定していない場合、trueを返すこと", webhook: &model.Webhook{}, want: true},
+		{name: "変更の種類を含む場合、trueを返すこと", webhook: &model.Webhook{EventTypes: []model.EventType{model.EventTypeUpdated}}, want: true},
+		{name: "変更の種類を含まない場合、falseを返すこと", webhook: &model.Webhook{EventTypes: []model.EventType{model.EventTypeDeleted}}, want: false},
+		{name: "無効にしている場合、falseを返すこと", webhook: &model.Webhook{Disabled: true}, want: false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := IsWebhookSubscribed(tt.webhook, model.EventTypeUpdated); got != tt.want {
+				t.Errorf("IsWebhookSubscribed() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestNewWebhookRequest(t *testing.T) {
+	w := &model.Webhook{URL: "https://example.com/hooks", Secret: "It's a Secret to Everybody"}
+	d := &model.WebhookDelivery{ID: 7, EventType: model.EventTypeCreated, Payload: []byte(`{"type":"created"}`)}
+	now := time.Unix(1500000000, 0)
+
+	got := NewWebhookRequest(w, d, now)
+
+	want := &model.WebhookRequest{
+		URL: w.URL,
+		Headers: map[string]string{
+			"Content-Type":         "application/json",
+			WebhookEventHeader:     "created",
+			WebhookDeliveryHeader:  "7",
+			WebhookTimestampHeader: "1500000000",
+			WebhookSignatureHeader: "sha256=8bfd4a700233f930ed66e466ad7e5ee670030973b17d3701a2197cdbe4a52a50",
+		},
+		Body: d.Payload,
+	}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("NewWebhookRequest() = %v, want %v", got, want)
+	}
+}
+
+func TestSignWebhook(t *testing.T) {
+	// 期待値は、echo -n '1500000000.{"type":"created"}' | openssl dgst -sha256 -hmac secretで求めた。
+	got := SignWebhook("secret", 1500000000, []byte(`{"type":"created"}`))
+	want := "sha256=ff282fba78e9c6c63e0cdeafbba3b8ef80e52e7b1199da3d7fdbd90863bf8d71"
+	if got != want {
+		t.Errorf("SignWebhook() = %v, want %v", got, want)
+	}
+}
+
+func TestWebhookBackoff(t *testing.T) {
+	tests := []struct {
+		name     string
+		attempts int
+		want     time.Duration
+	}{
+		{name: "1回目の失敗の場合、基準の間隔を返すこと", attempts: 1, want: 30 * time.Second},
+		{name: "失敗するごとに、間隔を2倍にすること", attempts: 3, want: 2 * time.Minute},
+		{name: "上限を超える場合、上限の間隔を返すこと", attempts: 20, want: WebhookMaxBackoff},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := WebhookBackoff(tt.attempts); got != tt.want {
+				t.Errorf("WebhookBackoff() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestRecordDeliveryAttempt(t *testing.T) {
+	now := time.Date(2018, time.September, 1, 0, 0, 0, 0, time.UTC)
+
+	tests := []struct {
+		name       string
+		attempts   int
+		statusCode int
+		sendErr    error
+		want       *model.WebhookDelivery
+	}{
+		{
+			name:       "2xxの応答の場合、succeededにすること",
+			statusCode: 204,
+			want: &model.WebhookDelivery{
+				Status: model.DeliveryStatusSucceeded, Attempts: 1, LastStatusCode: 204, UpdatedAt: now,
+			},
+		},
+		{
+			name:       "2xx以外の応答の場合、次の送信日時を設定すること",
+			attempts:   1,
+			statusCode: 500,
+			want: &model.WebhookDelivery{
+				Status: model.DeliveryStatusPending, Attempts: 2, LastStatusCode: 500, LastError: "unexpected status code: 500",
+				NextAttemptAt: now.Add(time.Minute), UpdatedAt: now,
+			},
+		},
+		{
+			name:     "再送の上限に達した場合、deadにすること",
+			attempts: MaxWebhookAttempts - 1,
+			sendErr:  errors.New(strings.Repeat("x", 300)),
+			want: &model.WebhookDelivery{
+				Status: model.DeliveryStatusDead, Attempts: MaxWebhookAttempts, LastError: strings.Repeat("x", MaxDeliveryErrorLength), UpdatedAt: now,
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := &model.WebhookDelivery{Status: model.DeliveryStatusPending, Attempts: tt.attempts}
+			RecordDeliveryAttempt(got, tt.statusCode, tt.sendErr, now)
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("RecordDeliveryAttempt() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
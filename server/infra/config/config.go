@@ -0,0 +1,193 @@
+package config
+
+import (
+	"net"
+	"strings"
+	"time"
+
+	"github.com/SekiguchiKai/clean-architecture-with-go/server/adapter/api"
+	"github.com/go-sql-driver/mysql"
+	"github.com/pkg/errors"
+)
+
+// 環境変数の名前。
+const (
+	HTTPAddrEnv        = "HTTP_ADDR"
+	GRPCAddrEnv        = "GRPC_ADDR"
+	DatabaseDSNEnv     = "DATABASE_DSN"
+	AdminAPIKeyEnv     = "ADMIN_API_KEY"
+	MigrationsDirEnv   = "MIGRATIONS_DIR"
+	SeedFileEnv        = "SEED_FILE"
+	ShutdownTimeoutEnv = "SHUTDOWN_TIMEOUT"
+	ClientAPIKeysEnv   = "CLIENT_API_KEYS"
+	TrustedProxiesEnv  = "TRUSTED_PROXIES"
+	RateLimitReadEnv   = "RATE_LIMIT_READ"
+	RateLimitWriteEnv  = "RATE_LIMIT_WRITE"
+	RateLimitRoutesEnv = "RATE_LIMIT_ROUTES"
+	V1DeprecatedAtEnv  = "V1_DEPRECATED_AT"
+	V1SunsetEnv        = "V1_SUNSET"
+)
+
+// 環境変数が指定されていない場合の値。パスは、serverディレクトリからの相対パス。
+const (
+	DefaultHTTPAddr        = ":8080"
+	DefaultGRPCAddr        = ":9090"
+	DefaultDatabaseDSN     = "root:@tcp(db:3306)/sample?charset=utf8mb4&parseTime=True"
+	DefaultMigrationsDir   = "../mysql/migrations"
+	DefaultSeedFile        = "../mysql/seed/languages.yml"
+	DefaultShutdownTimeout = 10 * time.Second
+	DefaultRateLimitRead   = "120/1m"
+	DefaultRateLimitWrite  = "30/1m"
+	DefaultV1DeprecatedAt  = "2026-10-01"
+	DefaultV1Sunset        = "2027-10-01"
+)
+
+// DateLayout は、日付を指定する環境変数の形式。check-configも同じ形式で表示する。
+const DateLayout = "2006-01-02"
+
+// maskedValue は、check-configで秘密の値の代わりに表示する値。
+const maskedValue = "********"
+
+// Config は、すべてのサブコマンドで共有する設定。
+type Config struct {
+	HTTPAddr        string
+	GRPCAddr        string
+	DatabaseDSN     string
+	AdminAPIKey     string
+	MigrationsDir   string
+	SeedFile        string
+	ShutdownTimeout time.Duration
+	// ClientAPIKeys は、Rate Limitでクライアントを識別するAPIキー。AdminAPIKeyも識別に使用する。
+	ClientAPIKeys []string
+	// TrustedProxies は、X-Forwarded-Forを信頼するプロキシのIPアドレスの範囲。
+	TrustedProxies  []*net.IPNet
+	RateLimitRead   api.RateLimitQuota
+	RateLimitWrite  api.RateLimitQuota
+	RateLimitRoutes []api.RouteQuota
+	// V1DeprecatedAt は、/v1/langsを非推奨にした日時。
+	V1DeprecatedAt time.Time
+	// V1Sunset は、/v1/langsの提供を終了する予定の日時。
+	V1Sunset time.Time
+}
+
+// Load は、getenvで取得した環境変数から設定を読み込み、検証して返す。
+// 指定されていない環境変数は、既定の値で補う。
+func Load(getenv func(string) string) (*Config, error) {
+	cfg := &Config{
+		HTTPAddr:        firstNonEmpty(getenv(HTTPAddrEnv), DefaultHTTPAddr),
+		GRPCAddr:        firstNonEmpty(getenv(GRPCAddrEnv), DefaultGRPCAddr),
+		DatabaseDSN:     firstNonEmpty(getenv(DatabaseDSNEnv), DefaultDatabaseDSN),
+		AdminAPIKey:     getenv(AdminAPIKeyEnv),
+		MigrationsDir:   firstNonEmpty(getenv(MigrationsDirEnv), DefaultMigrationsDir),
+		SeedFile:        firstNonEmpty(getenv(SeedFileEnv), DefaultSeedFile),
+		ShutdownTimeout: DefaultShutdownTimeout,
+	}
+
+	if v := getenv(ShutdownTimeoutEnv); v != "" {
+		d, err := time.ParseDuration(v)
+		if err != nil {
+			return nil, errors.Errorf("%s: %s", ShutdownTimeoutEnv, err.Error())
+		}
+		cfg.ShutdownTimeout = d
+	}
+
+	cfg.ClientAPIKeys = splitList(getenv(ClientAPIKeysEnv))
+
+	proxies, err := api.ParseTrustedProxies(getenv(TrustedProxiesEnv))
+	if err != nil {
+		return nil, errors.Errorf("%s: %s", TrustedProxiesEnv, err.Error())
+	}
+	cfg.TrustedProxies = proxies
+
+	if cfg.RateLimitRead, err = api.ParseRateLimitQuota(firstNonEmpty(getenv(RateLimitReadEnv), DefaultRateLimitRead)); err != nil {
+		return nil, errors.Errorf("%s: %s", RateLimitReadEnv, err.Error())
+	}
+	if cfg.RateLimitWrite, err = api.ParseRateLimitQuota(firstNonEmpty(getenv(RateLimitWriteEnv), DefaultRateLimitWrite)); err != nil {
+		return nil, errors.Errorf("%s: %s", RateLimitWriteEnv, err.Error())
+	}
+	if cfg.RateLimitRoutes, err = api.ParseRouteQuotas(getenv(RateLimitRoutesEnv)); err != nil {
+		return nil, errors.Errorf("%s: %s", RateLimitRoutesEnv, err.Error())
+	}
+
+	if cfg.V1DeprecatedAt, err = time.Parse(DateLayout, firstNonEmpty(getenv(V1DeprecatedAtEnv), DefaultV1DeprecatedAt)); err != nil {
+		return nil, errors.Errorf("%s: %s", V1DeprecatedAtEnv, err.Error())
+	}
+	if cfg.V1Sunset, err = time.Parse(DateLayout, firstNonEmpty(getenv(V1SunsetEnv), DefaultV1Sunset)); err != nil {
+		return nil, errors.Errorf("%s: %s", V1SunsetEnv, err.Error())
+	}
+
+	if err := cfg.Validate(); err != nil {
+		return nil, err
+	}
+	return cfg, nil
+}
+
+// Validate は、設定の値を検証する。
+func (cfg *Config) Validate() error {
+	if cfg.HTTPAddr == cfg.GRPCAddr {
+		return errors.Errorf("%s and %s should be different: %s", HTTPAddrEnv, GRPCAddrEnv, cfg.HTTPAddr)
+	}
+	if _, err := mysql.ParseDSN(cfg.DatabaseDSN); err != nil {
+		return errors.Errorf("%s: %s", DatabaseDSNEnv, err.Error())
+	}
+	if cfg.ShutdownTimeout <= 0 {
+		return errors.Errorf("%s should be positive: %s", ShutdownTimeoutEnv, cfg.ShutdownTimeout)
+	}
+	if err := cfg.RateLimitRead.Validate(); err != nil {
+		return errors.Errorf("%s: %s", RateLimitReadEnv, err.Error())
+	}
+	if err := cfg.RateLimitWrite.Validate(); err != nil {
+		return errors.Errorf("%s: %s", RateLimitWriteEnv, err.Error())
+	}
+	for _, rq := range cfg.RateLimitRoutes {
+		if err := rq.Quota.Validate(); err != nil {
+			return errors.Errorf("%s: %s %s: %s", RateLimitRoutesEnv, rq.Method, rq.Pattern, err.Error())
+		}
+	}
+	if !cfg.V1Sunset.After(cfg.V1DeprecatedAt) {
+		return errors.Errorf("%s should be after %s: %s", V1SunsetEnv, V1DeprecatedAtEnv, cfg.V1Sunset.Format(DateLayout))
+	}
+	return nil
+}
+
+// Masked は、DBのパスワードとAPIキーを伏せた設定の複製を返す。
+// 設定されていない秘密の値は、空のまま返す。
+func (cfg *Config) Masked() *Config {
+	masked := *cfg
+	if masked.AdminAPIKey != "" {
+		masked.AdminAPIKey = maskedValue
+	}
+	if len(masked.ClientAPIKeys) > 0 {
+		keys := make([]string, len(masked.ClientAPIKeys))
+		for i := range keys {
+			keys[i] = maskedValue
+		}
+		masked.ClientAPIKeys = keys
+	}
+	if dsn, err := mysql.ParseDSN(masked.DatabaseDSN); err == nil && dsn.Passwd != "" {
+		dsn.Passwd = maskedValue
+		masked.DatabaseDSN = dsn.FormatDSN()
+	}
+	return &masked
+}
+
+// firstNonEmpty は、空ではない最初の値を返す。
+func firstNonEmpty(values ...string) string {
+	for _, v := range values {
+		if v != "" {
+			return v
+		}
+	}
+	return ""
+}
+
+// splitList は、カンマ区切りの値を空の値を除いて返す。
+func splitList(s string) []string {
+	var values []string
+	for _, v := range strings.Split(s, ",") {
+		if v = strings.TrimSpace(v); v != "" {
+			values = append(values, v)
+		}
+	}
+	return values
+}
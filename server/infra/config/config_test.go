@@ -0,0 +1,178 @@
+package config
+
+import (
+	"net"
+	"reflect"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/SekiguchiKai/clean-architecture-with-go/server/adapter/api"
+)
+
+func TestLoad(t *testing.T) {
+	tests := []struct {
+		name    string
+		env     map[string]string
+		want    *Config
+		wantErr bool
+	}{
+		{
+			name: "環境変数が指定されていない場合、既定の値を返すこと",
+			env:  map[string]string{},
+			want: &Config{
+				HTTPAddr:        DefaultHTTPAddr,
+				GRPCAddr:        DefaultGRPCAddr,
+				DatabaseDSN:     DefaultDatabaseDSN,
+				MigrationsDir:   DefaultMigrationsDir,
+				SeedFile:        DefaultSeedFile,
+				ShutdownTimeout: DefaultShutdownTimeout,
+				RateLimitRead:   api.RateLimitQuota{Limit: 120, Per: time.Minute},
+				RateLimitWrite:  api.RateLimitQuota{Limit: 30, Per: time.Minute},
+				V1DeprecatedAt:  time.Date(2026, time.October, 1, 0, 0, 0, 0, time.UTC),
+				V1Sunset:        time.Date(2027, time.October, 1, 0, 0, 0, 0, time.UTC),
+			},
+		},
+		{
+			name: "環境変数が指定された場合、その値を返すこと",
+			env: map[string]string{
+				HTTPAddrEnv:        ":80",
+				GRPCAddrEnv:        ":90",
+				DatabaseDSNEnv:     "app:secret@tcp(localhost:3306)/langs",
+				AdminAPIKeyEnv:     "admin",
+				MigrationsDirEnv:   "/migrations",
+				SeedFileEnv:        "/seed.yml",
+				ShutdownTimeoutEnv: "30s",
+				ClientAPIKeysEnv:   "key1, ,key2",
+				TrustedProxiesEnv:  "10.0.0.0/8",
+				RateLimitReadEnv:   "600/1h",
+				RateLimitWriteEnv:  "10/1m",
+				RateLimitRoutesEnv: "POST /v1/admin/import=1/1m;POST /v1/:resource=5/1m",
+				V1DeprecatedAtEnv:  "2027-01-15",
+				V1SunsetEnv:        "2028-01-15",
+			},
+			want: &Config{
+				HTTPAddr:        ":80",
+				GRPCAddr:        ":90",
+				DatabaseDSN:     "app:secret@tcp(localhost:3306)/langs",
+				AdminAPIKey:     "admin",
+				MigrationsDir:   "/migrations",
+				SeedFile:        "/seed.yml",
+				ShutdownTimeout: 30 * time.Second,
+				ClientAPIKeys:   []string{"key1", "key2"},
+				TrustedProxies:  []*net.IPNet{{IP: net.IP{10, 0, 0, 0}, Mask: net.CIDRMask(8, 32)}},
+				RateLimitRead:   api.RateLimitQuota{Limit: 600, Per: time.Hour},
+				RateLimitWrite:  api.RateLimitQuota{Limit: 10, Per: time.Minute},
+				RateLimitRoutes: []api.RouteQuota{
+					{Method: api.Post, Pattern: "/v1/admin/import", Quota: api.RateLimitQuota{Limit: 1, Per: time.Minute}},
+					{Method: api.Post, Pattern: "/v1/:resource", Quota: api.RateLimitQuota{Limit: 5, Per: time.Minute}},
+				},
+				V1DeprecatedAt: time.Date(2027, time.January, 15, 0, 0, 0, 0, time.UTC),
+				V1Sunset:       time.Date(2028, time.January, 15, 0, 0, 0, 0, time.UTC),
+			},
+		},
+		{
+			name:    "SHUTDOWN_TIMEOUTが時間として読み込めない場合、エラーを返すこと",
+			env:     map[string]string{ShutdownTimeoutEnv: "soon"},
+			wantErr: true,
+		},
+		{
+			name:    "SHUTDOWN_TIMEOUTが0以下の場合、エラーを返すこと",
+			env:     map[string]string{ShutdownTimeoutEnv: "0s"},
+			wantErr: true,
+		},
+		{
+			name:    "TRUSTED_PROXIESがIPアドレスとして読み込めない場合、エラーを返すこと",
+			env:     map[string]string{TrustedProxiesEnv: "proxy.example.com"},
+			wantErr: true,
+		},
+		{
+			name:    "RATE_LIMIT_READの回数が0以下の場合、エラーを返すこと",
+			env:     map[string]string{RateLimitReadEnv: "0/1m"},
+			wantErr: true,
+		},
+		{
+			name:    "RATE_LIMIT_WRITEが読み込めない場合、エラーを返すこと",
+			env:     map[string]string{RateLimitWriteEnv: "30"},
+			wantErr: true,
+		},
+		{
+			name:    "RATE_LIMIT_ROUTESが読み込めない場合、エラーを返すこと",
+			env:     map[string]string{RateLimitRoutesEnv: "/v1/langs=10/1m"},
+			wantErr: true,
+		},
+		{
+			name:    "V1_SUNSETが日付として読み込めない場合、エラーを返すこと",
+			env:     map[string]string{V1SunsetEnv: "next year"},
+			wantErr: true,
+		},
+		{
+			name:    "V1_SUNSETがV1_DEPRECATED_AT以前の場合、エラーを返すこと",
+			env:     map[string]string{V1DeprecatedAtEnv: "2027-10-01", V1SunsetEnv: "2027-10-01"},
+			wantErr: true,
+		},
+		{
+			name:    "DATABASE_DSNがDSNとして読み込めない場合、エラーを返すこと",
+			env:     map[string]string{DatabaseDSNEnv: "localhost:3306"},
+			wantErr: true,
+		},
+		{
+			name:    "HTTP_ADDRとGRPC_ADDRが同じ場合、エラーを返すこと",
+			env:     map[string]string{HTTPAddrEnv: ":8080", GRPCAddrEnv: ":8080"},
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			getenv := func(key string) string { return tt.env[key] }
+			got, err := Load(getenv)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("Load() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("Load() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestConfig_Masked(t *testing.T) {
+	tests := []struct {
+		name    string
+		config  *Config
+		wantKey string
+	}{
+		{
+			name:    "秘密の値が設定されている場合、伏せた値を返すこと",
+			config:  &Config{DatabaseDSN: "app:secret@tcp(localhost:3306)/langs", AdminAPIKey: "admin-key", ClientAPIKeys: []string{"client-key"}},
+			wantKey: maskedValue,
+		},
+		{
+			name:    "秘密の値が設定されていない場合、空のまま返すこと",
+			config:  &Config{DatabaseDSN: DefaultDatabaseDSN},
+			wantKey: "",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			original := *tt.config
+			got := tt.config.Masked()
+
+			if got.AdminAPIKey != tt.wantKey {
+				t.Errorf("AdminAPIKey = %v, want %v", got.AdminAPIKey, tt.wantKey)
+			}
+			for _, k := range got.ClientAPIKeys {
+				if k != maskedValue {
+					t.Errorf("ClientAPIKeys = %v, want masked", got.ClientAPIKeys)
+				}
+			}
+			if strings.Contains(got.DatabaseDSN, "secret") {
+				t.Errorf("DatabaseDSN = %v, want the password masked", got.DatabaseDSN)
+			}
+			if !reflect.DeepEqual(*tt.config, original) {
+				t.Errorf("Masked() changed the config: %v", tt.config)
+			}
+		})
+	}
+}
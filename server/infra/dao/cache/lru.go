@@ -0,0 +1,96 @@
+package cache
+
+import (
+	"container/list"
+	"time"
+)
+
+// entry は、lruが保持する要素。
+type entry struct {
+	key       string
+	value     interface{}
+	expiresAt time.Time
+}
+
+// lru は、TTLと要素数の上限を持つLRUキャッシュ。goroutine safeではないため、呼び出し側で排他制御を行う。
+type lru struct {
+	size  int
+	ttl   time.Duration
+	ll    *list.List
+	items map[string]*list.Element
+}
+
+// newLRU は、lruを生成し、返す。
+func newLRU(size int, ttl time.Duration) *lru {
+	return &lru{
+		size:  size,
+		ttl:   ttl,
+		ll:    list.New(),
+		items: make(map[string]*list.Element),
+	}
+}
+
+// get は、keyに対応する値を返す。期限切れの場合は削除する。
+func (c *lru) get(key string, now time.Time) (interface{}, bool) {
+	e, ok := c.items[key]
+	if !ok {
+		return nil, false
+	}
+
+	ent := e.Value.(*entry)
+	if !now.Before(ent.expiresAt) {
+		c.removeElement(e)
+		return nil, false
+	}
+
+	c.ll.MoveToFront(e)
+	return ent.value, true
+}
+
+// set は、keyに値を設定する。上限を超えた場合は最も古い要素を削除し、削除した数を返す。
+func (c *lru) set(key string, value interface{}, now time.Time) int {
+	if e, ok := c.items[key]; ok {
+		ent := e.Value.(*entry)
+		ent.value = value
+		ent.expiresAt = now.Add(c.ttl)
+		c.ll.MoveToFront(e)
+		return 0
+	}
+
+	e := c.ll.PushFront(&entry{key: key, value: value, expiresAt: now.Add(c.ttl)})
+	c.items[key] = e
+
+	evicted := 0
+	for c.ll.Len() > c.size {
+		c.removeElement(c.ll.Back())
+		evicted++
+	}
+	return evicted
+}
+
+// remove は、keyに対応する要素を削除する。
+func (c *lru) remove(key string) {
+	if e, ok := c.items[key]; ok {
+		c.removeElement(e)
+	}
+}
+
+// removeIf は、keyが条件に一致する要素を全て削除する。
+func (c *lru) removeIf(match func(key string) bool) {
+	for key, e := range c.items {
+		if match(key) {
+			c.removeElement(e)
+		}
+	}
+}
+
+// len は、保持している要素数を返す。
+func (c *lru) len() int {
+	return c.ll.Len()
+}
+
+// removeElement は、要素を削除する。
+func (c *lru) removeElement(e *list.Element) {
+	c.ll.Remove(e)
+	delete(c.items, e.Value.(*entry).key)
+}
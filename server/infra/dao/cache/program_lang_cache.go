@@ -0,0 +1,328 @@
+package cache
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	"github.com/SekiguchiKai/clean-architecture-with-go/server/domain/model"
+	"github.com/SekiguchiKai/clean-architecture-with-go/server/domain/repository"
+	"github.com/SekiguchiKai/clean-architecture-with-go/server/infra/dao/rdb"
+)
+
+// キャッシュの初期値。
+const (
+	DefaultSize = 1000
+	DefaultTTL  = 5 * time.Minute
+)
+
+// キャッシュのキーのprefix。
+const (
+	keyPrefixRead         = "read:"
+	keyPrefixName         = "name:"
+	keyPrefixList         = "list:"
+	keyPrefixSlug         = "slug:"
+	keyPrefixPreviousSlug = "previousSlug:"
+	keyLastModified       = "lastModified"
+)
+
+// ProgrammingLangCache は、ProgrammingLangRepositoryの読み込み結果をキャッシュするRepository。
+type ProgrammingLangCache struct {
+	Repo repository.ProgrammingLangRepository
+	Now  func() time.Time
+
+	mu         sync.Mutex
+	cache      *lru
+	generation uint64
+	group      singleFlight
+
+	hits      uint64
+	misses    uint64
+	shared    uint64
+	evictions uint64
+}
+
+// NewProgrammingLangCache は、ProgrammingLangCacheを生成し、返す。
+// 統計情報を参照できるよう、Repositoryのinterfaceではなく具象型を返す。
+func NewProgrammingLangCache(repo repository.ProgrammingLangRepository, size int, ttl time.Duration) *ProgrammingLangCache {
+	return &ProgrammingLangCache{
+		Repo:  repo,
+		Now:   time.Now,
+		cache: newLRU(size, ttl),
+	}
+}
+
+// Stats は、キャッシュの統計情報を返す。
+func (c *ProgrammingLangCache) Stats() model.CacheStats {
+	c.mu.Lock()
+	size := c.cache.len()
+	c.mu.Unlock()
+
+	return model.CacheStats{
+		Hits:      atomic.LoadUint64(&c.hits),
+		Misses:    atomic.LoadUint64(&c.misses),
+		Shared:    atomic.LoadUint64(&c.shared),
+		Evictions: atomic.LoadUint64(&c.evictions),
+		Size:      size,
+	}
+}
+
+// List は、ProgrammingLangの一覧を返す。
+func (c *ProgrammingLangCache) List(ctx context.Context, limit int) ([]*model.ProgrammingLang, error) {
+	v, err := c.load(ctx, fmt.Sprintf("%s%d", keyPrefixList, limit), func(ctx context.Context) (interface{}, error) {
+		return c.Repo.List(ctx, limit)
+	})
+	if err != nil {
+		return nil, err
+	}
+	return copyLangs(v.([]*model.ProgrammingLang)), nil
+}
+
+// ListByFilter は、条件に一致するProgrammingLangの一覧を返す。
+// タグの付け外しはこのキャッシュを経由しないため、Tagsを指定した場合はキャッシュしない。
+func (c *ProgrammingLangCache) ListByFilter(ctx context.Context, filter *model.ProgrammingLangFilter) ([]*model.ProgrammingLang, error) {
+	if len(filter.Tags) > 0 {
+		return c.Repo.ListByFilter(ctx, filter)
+	}
+
+	key := fmt.Sprintf("%sfilter:%d:%q:%q", keyPrefixList, filter.Limit, filter.NameContains, filter.After)
+	v, err := c.load(ctx, key, func(ctx context.Context) (interface{}, error) {
+		return c.Repo.ListByFilter(ctx, filter)
+	})
+	if err != nil {
+		return nil, err
+	}
+	return copyLangs(v.([]*model.ProgrammingLang)), nil
+}
+
+// ListByIDs は、IDで指定したProgrammingLangをまとめて返す。キャッシュに存在しないものだけをまとめて取得する。
+// トランザクションの中では、キャッシュを使用しない。
+func (c *ProgrammingLangCache) ListByIDs(ctx context.Context, ids []int) ([]*model.ProgrammingLang, error) {
+	if rdb.InTransaction(ctx) {
+		return c.Repo.ListByIDs(ctx, ids)
+	}
+
+	langSlice := make([]*model.ProgrammingLang, 0, len(ids))
+	missing := make([]int, 0, len(ids))
+
+	c.mu.Lock()
+	now := c.Now()
+	generation := c.generation
+	for _, id := range ids {
+		if v, ok := c.cache.get(readKey(id), now); ok {
+			langSlice = append(langSlice, copyLang(v.(*model.ProgrammingLang)))
+		} else {
+			missing = append(missing, id)
+		}
+	}
+	c.mu.Unlock()
+
+	atomic.AddUint64(&c.hits, uint64(len(langSlice)))
+	atomic.AddUint64(&c.misses, uint64(len(missing)))
+
+	if len(missing) == 0 {
+		return langSlice, nil
+	}
+
+	loaded, err := c.Repo.ListByIDs(ctx, missing)
+	if err != nil {
+		return nil, err
+	}
+
+	c.mu.Lock()
+	// 取得中に更新系の操作が行われた場合は、古い値の可能性があるためキャッシュしない。
+	if generation == c.generation {
+		for _, lang := range loaded {
+			evicted := c.cache.set(readKey(lang.ID), lang, c.Now())
+			atomic.AddUint64(&c.evictions, uint64(evicted))
+		}
+	}
+	c.mu.Unlock()
+
+	return append(langSlice, copyLangs(loaded)...), nil
+}
+
+// Read は、ProgrammingLangを1件返す。
+func (c *ProgrammingLangCache) Read(ctx context.Context, id int) (*model.ProgrammingLang, error) {
+	v, err := c.load(ctx, readKey(id), func(ctx context.Context) (interface{}, error) {
+		return c.Repo.Read(ctx, id)
+	})
+	if err != nil {
+		return nil, err
+	}
+	return copyLang(v.(*model.ProgrammingLang)), nil
+}
+
+// ReadByName は、指定したNameを保持するProgrammingLangを1件返す。
+func (c *ProgrammingLangCache) ReadByName(ctx context.Context, name string) (*model.ProgrammingLang, error) {
+	v, err := c.load(ctx, keyPrefixName+name, func(ctx context.Context) (interface{}, error) {
+		return c.Repo.ReadByName(ctx, name)
+	})
+	if err != nil {
+		return nil, err
+	}
+	return copyLang(v.(*model.ProgrammingLang)), nil
+}
+
+// ReadBySlug は、指定したslugを保持するProgrammingLangを1件返す。
+func (c *ProgrammingLangCache) ReadBySlug(ctx context.Context, slug string) (*model.ProgrammingLang, error) {
+	v, err := c.load(ctx, keyPrefixSlug+slug, func(ctx context.Context) (interface{}, error) {
+		return c.Repo.ReadBySlug(ctx, slug)
+	})
+	if err != nil {
+		return nil, err
+	}
+	return copyLang(v.(*model.ProgrammingLang)), nil
+}
+
+// ReadByPreviousSlug は、変更前のslugとして指定したslugを保持していたProgrammingLangを1件返す。
+func (c *ProgrammingLangCache) ReadByPreviousSlug(ctx context.Context, slug string) (*model.ProgrammingLang, error) {
+	v, err := c.load(ctx, keyPrefixPreviousSlug+slug, func(ctx context.Context) (interface{}, error) {
+		return c.Repo.ReadByPreviousSlug(ctx, slug)
+	})
+	if err != nil {
+		return nil, err
+	}
+	return copyLang(v.(*model.ProgrammingLang)), nil
+}
+
+// LastModified は、ProgrammingLangの中で最も新しい更新日時を返す。
+func (c *ProgrammingLangCache) LastModified(ctx context.Context) (time.Time, error) {
+	v, err := c.load(ctx, keyLastModified, func(ctx context.Context) (interface{}, error) {
+		return c.Repo.LastModified(ctx)
+	})
+	if err != nil {
+		return time.Time{}, err
+	}
+	return v.(time.Time), nil
+}
+
+// Create は、ProgrammingLangを生成し、キャッシュを無効化する。
+func (c *ProgrammingLangCache) Create(ctx context.Context, lang *model.ProgrammingLang) (*model.ProgrammingLang, error) {
+	defer c.invalidateAfterCommit(ctx, 0)
+	return c.Repo.Create(ctx, lang)
+}
+
+// Update は、ProgrammingLangを更新し、キャッシュを無効化する。
+func (c *ProgrammingLangCache) Update(ctx context.Context, lang *model.ProgrammingLang) (*model.ProgrammingLang, error) {
+	defer c.invalidateAfterCommit(ctx, lang.ID)
+	return c.Repo.Update(ctx, lang)
+}
+
+// Delete は、ProgrammingLangを削除し、キャッシュを無効化する。
+func (c *ProgrammingLangCache) Delete(ctx context.Context, id int) error {
+	defer c.invalidateAfterCommit(ctx, id)
+	return c.Repo.Delete(ctx, id)
+}
+
+// CreateSlugHistory は、変更前のslugを記録し、キャッシュを無効化する。
+func (c *ProgrammingLangCache) CreateSlugHistory(ctx context.Context, id int, slug string) error {
+	defer c.invalidateAfterCommit(ctx, id)
+	return c.Repo.CreateSlugHistory(ctx, id, slug)
+}
+
+// load は、キャッシュに値が存在すればそれを返し、存在しなければfnで取得してキャッシュする。
+// 同一のkeyに対する同時の取得は、1回の呼び出しにまとめる。
+// 最初の呼び出し元がキャンセルしても他の呼び出し元が失敗しないよう、fnにはキャンセルを切り離したContextを渡す。
+// トランザクションの中の読み込みは、コミットしていない書き込みを含み、ロールバックされる可能性があるため、
+// キャッシュを参照も保存もせず、他の呼び出し元とも共有せずにfnで取得する。
+func (c *ProgrammingLangCache) load(ctx context.Context, key string, fn func(ctx context.Context) (interface{}, error)) (interface{}, error) {
+	if rdb.InTransaction(ctx) {
+		return fn(ctx)
+	}
+
+	c.mu.Lock()
+	v, ok := c.cache.get(key, c.Now())
+	generation := c.generation
+	c.mu.Unlock()
+
+	if ok {
+		atomic.AddUint64(&c.hits, 1)
+		return v, nil
+	}
+	atomic.AddUint64(&c.misses, 1)
+
+	v, shared, err := c.group.do(ctx, key, func(ctx context.Context) (interface{}, error) {
+		v, err := fn(ctx)
+		if err != nil {
+			return nil, err
+		}
+
+		c.mu.Lock()
+		// 取得中に更新系の操作が行われた場合は、古い値の可能性があるためキャッシュしない。
+		if generation == c.generation {
+			evicted := c.cache.set(key, v, c.Now())
+			atomic.AddUint64(&c.evictions, uint64(evicted))
+		}
+		c.mu.Unlock()
+
+		return v, nil
+	})
+	if shared {
+		atomic.AddUint64(&c.shared, 1)
+	}
+
+	return v, err
+}
+
+// invalidate は、更新系の操作によって古くなった可能性のあるキャッシュを削除する。
+// Nameとslugは変更される可能性があるため、Nameとslugと一覧と最終更新日時のキャッシュは全て削除する。
+func (c *ProgrammingLangCache) invalidate(id int) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	c.generation++
+	c.cache.remove(readKey(id))
+	c.cache.remove(keyLastModified)
+	c.cache.removeIf(func(key string) bool {
+		return strings.HasPrefix(key, keyPrefixName) ||
+			strings.HasPrefix(key, keyPrefixSlug) ||
+			strings.HasPrefix(key, keyPrefixPreviousSlug) ||
+			strings.HasPrefix(key, keyPrefixList)
+	})
+}
+
+// invalidateAfterCommit は、キャッシュを直ちに無効化し、ctxのトランザクションをコミットした後にも無効化する。
+// コミットするまでの間に、他の読み込みが変更前の値をキャッシュする可能性があるため。
+func (c *ProgrammingLangCache) invalidateAfterCommit(ctx context.Context, id int) {
+	c.invalidate(id)
+	rdb.AfterCommit(ctx, func() {
+		c.invalidate(id)
+	})
+}
+
+// readKey は、IDで取得した結果のキャッシュのキーを返す。
+func readKey(id int) string {
+	return fmt.Sprintf("%s%d", keyPrefixRead, id)
+}
+
+// copyLang は、ProgrammingLangの複製を返す。
+// キャッシュした値が呼び出し側で変更されないようにするために使用する。
+func copyLang(lang *model.ProgrammingLang) *model.ProgrammingLang {
+	if lang == nil {
+		return nil
+	}
+	cp := *lang
+	cp.Designers = append([]string(nil), lang.Designers...)
+	cp.Paradigms = append([]model.Paradigm(nil), lang.Paradigms...)
+	cp.Extensions = append([]string(nil), lang.Extensions...)
+	cp.Filenames = append([]string(nil), lang.Filenames...)
+	cp.Interpreters = append([]string(nil), lang.Interpreters...)
+	cp.Aliases = append([]string(nil), lang.Aliases...)
+	return &cp
+}
+
+// copyLangs は、ProgrammingLangのスライスの複製を返す。
+func copyLangs(langSlice []*model.ProgrammingLang) []*model.ProgrammingLang {
+	if langSlice == nil {
+		return nil
+	}
+	cp := make([]*model.ProgrammingLang, len(langSlice))
+	for i, lang := range langSlice {
+		cp[i] = copyLang(lang)
+	}
+	return cp
+}
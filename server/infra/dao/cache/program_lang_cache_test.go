@@ -0,0 +1,341 @@
+package cache_test
+
+import (
+	"context"
+	"reflect"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/SekiguchiKai/clean-architecture-with-go/server/domain/model"
+	"github.com/SekiguchiKai/clean-architecture-with-go/server/infra/dao/cache"
+	"github.com/SekiguchiKai/clean-architecture-with-go/server/infra/dao/mock"
+	"github.com/SekiguchiKai/clean-architecture-with-go/server/infra/dao/rdb"
+	"github.com/golang/mock/gomock"
+	"gopkg.in/DATA-DOG/go-sqlmock.v1"
+)
+
+// newTestCache は、テスト用のProgrammingLangCacheを生成し、返す。
+func newTestCache(repo *mock_repository.MockProgrammingLangRepository, size int, now *time.Time) *cache.ProgrammingLangCache {
+	c := cache.NewProgrammingLangCache(repo, size, time.Minute)
+	c.Now = func() time.Time { return *now }
+	return c
+}
+
+func TestProgrammingLangCache_Read(t *testing.T) {
+	ctx := context.Background()
+	lang := model.CreateProgrammingLangs(1)[0]
+	noDataErr := &model.NoSuchDataError{
+		ID:        2,
+		ModelName: model.ModelNameProgrammingLang,
+	}
+
+	tests := []struct {
+		name      string
+		run       func(t *testing.T, repo *mock_repository.MockProgrammingLangRepository, c *cache.ProgrammingLangCache, now *time.Time)
+		wantStats model.CacheStats
+	}{
+		{
+			name: "同一のIDで2回取得した場合、Repositoryを1回だけ呼び出すこと",
+			run: func(t *testing.T, repo *mock_repository.MockProgrammingLangRepository, c *cache.ProgrammingLangCache, now *time.Time) {
+				repo.EXPECT().Read(gomock.Any(), 1).Return(lang, nil).Times(1)
+				for i := 0; i < 2; i++ {
+					got, err := c.Read(ctx, 1)
+					if err != nil {
+						t.Fatal(err)
+					}
+					if !reflect.DeepEqual(got, lang) {
+						t.Errorf("ProgrammingLangCache.Read() = %v, want %v", got, lang)
+					}
+				}
+			},
+			wantStats: model.CacheStats{Hits: 1, Misses: 1, Size: 1},
+		},
+		{
+			name: "TTLを経過した場合、Repositoryを再度呼び出すこと",
+			run: func(t *testing.T, repo *mock_repository.MockProgrammingLangRepository, c *cache.ProgrammingLangCache, now *time.Time) {
+				repo.EXPECT().Read(gomock.Any(), 1).Return(lang, nil).Times(2)
+				if _, err := c.Read(ctx, 1); err != nil {
+					t.Fatal(err)
+				}
+				*now = now.Add(time.Minute)
+				if _, err := c.Read(ctx, 1); err != nil {
+					t.Fatal(err)
+				}
+			},
+			wantStats: model.CacheStats{Misses: 2, Size: 1},
+		},
+		{
+			name: "エラーの場合、キャッシュしないこと",
+			run: func(t *testing.T, repo *mock_repository.MockProgrammingLangRepository, c *cache.ProgrammingLangCache, now *time.Time) {
+				repo.EXPECT().Read(gomock.Any(), 2).Return(nil, noDataErr).Times(2)
+				for i := 0; i < 2; i++ {
+					if _, err := c.Read(ctx, 2); err != noDataErr {
+						t.Errorf("ProgrammingLangCache.Read() error = %v, want %v", err, noDataErr)
+					}
+				}
+			},
+			wantStats: model.CacheStats{Misses: 2},
+		},
+		{
+			name: "取得した値を変更しても、キャッシュした値は変更されないこと",
+			run: func(t *testing.T, repo *mock_repository.MockProgrammingLangRepository, c *cache.ProgrammingLangCache, now *time.Time) {
+				repo.EXPECT().Read(gomock.Any(), 1).Return(model.CreateProgrammingLangs(1)[0], nil).Times(1)
+				got, err := c.Read(ctx, 1)
+				if err != nil {
+					t.Fatal(err)
+				}
+				got.Name = "changed"
+
+				got, err = c.Read(ctx, 1)
+				if err != nil {
+					t.Fatal(err)
+				}
+				if got.Name != lang.Name {
+					t.Errorf("ProgrammingLangCache.Read() Name = %v, want %v", got.Name, lang.Name)
+				}
+			},
+			wantStats: model.CacheStats{Hits: 1, Misses: 1, Size: 1},
+		},
+		{
+			name: "要素数の上限を超えた場合、最も古い要素を削除すること",
+			run: func(t *testing.T, repo *mock_repository.MockProgrammingLangRepository, c *cache.ProgrammingLangCache, now *time.Time) {
+				langSlice := model.CreateProgrammingLangs(3)
+				repo.EXPECT().Read(gomock.Any(), 1).Return(langSlice[0], nil).Times(2)
+				repo.EXPECT().Read(gomock.Any(), 2).Return(langSlice[1], nil).Times(1)
+				repo.EXPECT().Read(gomock.Any(), 3).Return(langSlice[2], nil).Times(1)
+				for _, id := range []int{1, 2, 3, 1} {
+					if _, err := c.Read(ctx, id); err != nil {
+						t.Fatal(err)
+					}
+				}
+			},
+			wantStats: model.CacheStats{Misses: 4, Evictions: 2, Size: 2},
+		},
+		{
+			name: "同時に取得した場合、Repositoryを1回だけ呼び出すこと",
+			run: func(t *testing.T, repo *mock_repository.MockProgrammingLangRepository, c *cache.ProgrammingLangCache, now *time.Time) {
+				release := make(chan struct{})
+				repo.EXPECT().Read(gomock.Any(), 1).DoAndReturn(func(ctx context.Context, id int) (*model.ProgrammingLang, error) {
+					<-release
+					return lang, nil
+				}).Times(1)
+
+				var wg sync.WaitGroup
+				for i := 0; i < 5; i++ {
+					wg.Add(1)
+					go func() {
+						defer wg.Done()
+						if _, err := c.Read(ctx, 1); err != nil {
+							t.Error(err)
+						}
+					}()
+				}
+				// 全てのgoroutineがキャッシュを参照してから取得を完了させる。
+				for c.Stats().Misses < 5 {
+					time.Sleep(time.Millisecond)
+				}
+				close(release)
+				wg.Wait()
+			},
+			wantStats: model.CacheStats{Misses: 5, Shared: 4, Size: 1},
+		},
+		{
+			name: "最初の呼び出し元がキャンセルした場合、取得を中断せず他の呼び出し元に値を返すこと",
+			run: func(t *testing.T, repo *mock_repository.MockProgrammingLangRepository, c *cache.ProgrammingLangCache, now *time.Time) {
+				started := make(chan struct{})
+				release := make(chan struct{})
+				repo.EXPECT().Read(gomock.Any(), 1).DoAndReturn(func(ctx context.Context, id int) (*model.ProgrammingLang, error) {
+					close(started)
+					<-release
+					if err := ctx.Err(); err != nil {
+						return nil, err
+					}
+					return lang, nil
+				}).Times(1)
+
+				firstCtx, cancel := context.WithCancel(ctx)
+				firstErr := make(chan error, 1)
+				go func() {
+					_, err := c.Read(firstCtx, 1)
+					firstErr <- err
+				}()
+				<-started
+
+				second := make(chan *model.ProgrammingLang, 1)
+				go func() {
+					got, err := c.Read(ctx, 1)
+					if err != nil {
+						t.Error(err)
+					}
+					second <- got
+				}()
+				for c.Stats().Misses < 2 {
+					time.Sleep(time.Millisecond)
+				}
+
+				cancel()
+				if err := <-firstErr; err != context.Canceled {
+					t.Errorf("ProgrammingLangCache.Read() error = %v, want %v", err, context.Canceled)
+				}
+				close(release)
+				if got := <-second; !reflect.DeepEqual(got, lang) {
+					t.Errorf("ProgrammingLangCache.Read() = %v, want %v", got, lang)
+				}
+			},
+			wantStats: model.CacheStats{Misses: 2, Shared: 1, Size: 1},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			repo := mock_repository.NewMockProgrammingLangRepository(ctrl)
+			now := model.GetTestTime(time.October, 1)
+			c := newTestCache(repo, 2, &now)
+
+			tt.run(t, repo, c, &now)
+
+			if got := c.Stats(); !reflect.DeepEqual(got, tt.wantStats) {
+				t.Errorf("ProgrammingLangCache.Stats() = %+v, want %+v", got, tt.wantStats)
+			}
+		})
+	}
+}
+
+func TestProgrammingLangCache_Invalidate(t *testing.T) {
+	ctx := context.Background()
+	lang := model.CreateProgrammingLangs(1)[0]
+	langSlice := model.CreateProgrammingLangs(5)
+
+	tests := []struct {
+		name      string
+		write     func(repo *mock_repository.MockProgrammingLangRepository, c *cache.ProgrammingLangCache) error
+		readTimes int
+	}{
+		{
+			name: "Createした場合、Nameと一覧のキャッシュを無効化すること",
+			write: func(repo *mock_repository.MockProgrammingLangRepository, c *cache.ProgrammingLangCache) error {
+				repo.EXPECT().Create(ctx, lang).Return(lang, nil)
+				_, err := c.Create(ctx, lang)
+				return err
+			},
+			readTimes: 1,
+		},
+		{
+			name: "Updateした場合、キャッシュを無効化すること",
+			write: func(repo *mock_repository.MockProgrammingLangRepository, c *cache.ProgrammingLangCache) error {
+				repo.EXPECT().Update(ctx, lang).Return(lang, nil)
+				_, err := c.Update(ctx, lang)
+				return err
+			},
+			readTimes: 2,
+		},
+		{
+			name: "Deleteした場合、キャッシュを無効化すること",
+			write: func(repo *mock_repository.MockProgrammingLangRepository, c *cache.ProgrammingLangCache) error {
+				repo.EXPECT().Delete(ctx, lang.ID).Return(nil)
+				return c.Delete(ctx, lang.ID)
+			},
+			readTimes: 2,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			repo := mock_repository.NewMockProgrammingLangRepository(ctrl)
+			now := model.GetTestTime(time.October, 1)
+			c := newTestCache(repo, 10, &now)
+
+			repo.EXPECT().Read(gomock.Any(), lang.ID).Return(lang, nil).Times(tt.readTimes)
+			repo.EXPECT().ReadByName(gomock.Any(), lang.Name).Return(lang, nil).Times(2)
+			repo.EXPECT().List(gomock.Any(), 5).Return(langSlice, nil).Times(2)
+
+			read := func() {
+				if _, err := c.Read(ctx, lang.ID); err != nil {
+					t.Fatal(err)
+				}
+				if _, err := c.ReadByName(ctx, lang.Name); err != nil {
+					t.Fatal(err)
+				}
+				if _, err := c.List(ctx, 5); err != nil {
+					t.Fatal(err)
+				}
+			}
+
+			read()
+			if err := tt.write(repo, c); err != nil {
+				t.Fatal(err)
+			}
+			read()
+
+			wantMisses := uint64(4 + tt.readTimes)
+			if got := c.Stats(); got.Misses != wantMisses || got.Hits != 6-wantMisses {
+				t.Errorf("ProgrammingLangCache.Stats() = %+v, want %d misses", got, wantMisses)
+			}
+		})
+	}
+}
+
+func TestProgrammingLangCache_Transaction(t *testing.T) {
+	ctx := context.Background()
+	lang := model.CreateProgrammingLangs(1)[0]
+	uncommitted := model.CreateProgrammingLangs(1)[0]
+	uncommitted.Name = "uncommitted"
+
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	db, mock, err := sqlmock.New()
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer db.Close()
+
+	repo := mock_repository.NewMockProgrammingLangRepository(ctrl)
+	now := model.GetTestTime(time.October, 1)
+	c := newTestCache(repo, 10, &now)
+
+	// トランザクションの中では、コミットしていない値を読み込んだ後にロールバックする。
+	mock.ExpectBegin()
+	mock.ExpectRollback()
+	gomock.InOrder(
+		repo.EXPECT().Read(gomock.Any(), lang.ID).Return(uncommitted, nil),
+		repo.EXPECT().Read(gomock.Any(), lang.ID).Return(lang, nil),
+	)
+
+	sqlM := &rdb.SQLManager{Conn: db}
+	rollback := &model.DBError{}
+	err = sqlM.Transaction(ctx, func(ctx context.Context) error {
+		got, err := c.Read(ctx, lang.ID)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if !reflect.DeepEqual(got, uncommitted) {
+			t.Errorf("ProgrammingLangCache.Read() = %v, want %v", got, uncommitted)
+		}
+		return rollback
+	})
+	if err != rollback {
+		t.Fatalf("SQLManager.Transaction() error = %v, want %v", err, rollback)
+	}
+
+	// ロールバックした値はキャッシュせず、トランザクションの外ではRepositoryから取得し直す。
+	got, err := c.Read(ctx, lang.ID)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !reflect.DeepEqual(got, lang) {
+		t.Errorf("ProgrammingLangCache.Read() = %v, want %v", got, lang)
+	}
+
+	if got, want := c.Stats(), (model.CacheStats{Misses: 1, Size: 1}); !reflect.DeepEqual(got, want) {
+		t.Errorf("ProgrammingLangCache.Stats() = %+v, want %+v", got, want)
+	}
+	if err := mock.ExpectationsWereMet(); err != nil {
+		t.Error(err)
+	}
+}
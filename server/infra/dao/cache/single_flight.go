@@ -0,0 +1,86 @@
+package cache
+
+import (
+	"context"
+	"sync"
+	"time"
+)
+
+// call は、実行中もしくは実行済みの呼び出し。
+type call struct {
+	done chan struct{}
+	val  interface{}
+	err  error
+}
+
+// singleFlight は、同一のkeyに対する呼び出しを1つにまとめる。
+type singleFlight struct {
+	mu    sync.Mutex
+	calls map[string]*call
+}
+
+// do は、keyに対する呼び出しが実行中であればその結果を待ち、そうでなければfnを実行する。
+// fnは特定の呼び出し元のキャンセルの影響を受けないよう、ctxのキャンセルを切り離したContextで実行する。
+// 呼び出し元のctxがキャンセルされた場合は、fnの完了を待たずにctxのエラーを返す。
+// 戻り値のsharedは、他の呼び出しの結果を共有したかどうかを表す。
+func (g *singleFlight) do(ctx context.Context, key string, fn func(ctx context.Context) (interface{}, error)) (val interface{}, shared bool, err error) {
+	g.mu.Lock()
+	if g.calls == nil {
+		g.calls = make(map[string]*call)
+	}
+	c, shared := g.calls[key]
+	if !shared {
+		c = &call{done: make(chan struct{})}
+		g.calls[key] = c
+		go g.run(detach(ctx), key, c, fn)
+	}
+	g.mu.Unlock()
+
+	select {
+	case <-c.done:
+		return c.val, shared, c.err
+	case <-ctx.Done():
+		return nil, shared, ctx.Err()
+	}
+}
+
+// run は、fnを実行して結果を記録し、待っている呼び出しに完了を通知する。
+func (g *singleFlight) run(ctx context.Context, key string, c *call, fn func(ctx context.Context) (interface{}, error)) {
+	c.val, c.err = fn(ctx)
+
+	g.mu.Lock()
+	delete(g.calls, key)
+	g.mu.Unlock()
+
+	close(c.done)
+}
+
+// detachedContext は、値は元のContextから引き継ぎ、期限とキャンセルは引き継がないContext。
+type detachedContext struct {
+	parent context.Context
+}
+
+// detach は、ctxの値のみを引き継いだContextを返す。
+func detach(ctx context.Context) context.Context {
+	return detachedContext{parent: ctx}
+}
+
+// Deadline は、期限を持たないことを返す。
+func (detachedContext) Deadline() (time.Time, bool) {
+	return time.Time{}, false
+}
+
+// Done は、キャンセルされないことを表すnilを返す。
+func (detachedContext) Done() <-chan struct{} {
+	return nil
+}
+
+// Err は、キャンセルされないためnilを返す。
+func (detachedContext) Err() error {
+	return nil
+}
+
+// Value は、元のContextの値を返す。
+func (d detachedContext) Value(key interface{}) interface{} {
+	return d.parent.Value(key)
+}
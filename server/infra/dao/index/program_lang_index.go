@@ -0,0 +1,443 @@
+package index
+
+import (
+	"context"
+	"sort"
+	"sync"
+	"time"
+	"unicode/utf8"
+
+	"github.com/SekiguchiKai/clean-architecture-with-go/server/domain/model"
+	"github.com/SekiguchiKai/clean-architecture-with-go/server/domain/repository"
+	"github.com/SekiguchiKai/clean-architecture-with-go/server/domain/service"
+	"github.com/SekiguchiKai/clean-architecture-with-go/server/infra/dao/rdb"
+	"github.com/pkg/errors"
+)
+
+// 索引の再構築の設定。
+const (
+	rebuildPageSize    = 100
+	maxRebuildAttempts = 3
+)
+
+// entry は、索引に登録したNameもしくは別名を表す。
+type entry struct {
+	id      int
+	name    string
+	slug    string
+	matched string
+	key     string
+	isName  bool
+}
+
+// node は、照合用のキーのprefixの木の節。
+type node struct {
+	children map[rune]*node
+	entries  []*entry
+}
+
+// ProgrammingLangIndex は、ProgrammingLangのNameと別名の索引を保持するRepository。
+// 書き込みは下位のRepositoryに委譲した上で索引に反映し、読み込みはそのまま委譲する。
+type ProgrammingLangIndex struct {
+	Repo repository.ProgrammingLangRepository
+
+	mu         sync.RWMutex
+	built      bool
+	generation uint64
+	root       *node
+	langs      map[int][]*entry
+	trigrams   map[string]map[*entry]bool
+}
+
+// NewProgrammingLangIndex は、空のProgrammingLangIndexを生成し、返す。索引はRebuildで構築する。
+func NewProgrammingLangIndex(repo repository.ProgrammingLangRepository) *ProgrammingLangIndex {
+	idx := &ProgrammingLangIndex{
+		Repo: repo,
+	}
+	idx.reset()
+	return idx
+}
+
+// Rebuild は、全てのProgrammingLangを読み込んで索引を構築し直す。
+// 読み込み中に書き込みが行われた場合は、その変更が失われないように読み込み直す。
+func (idx *ProgrammingLangIndex) Rebuild(ctx context.Context) error {
+	for attempt := 1; ; attempt++ {
+		idx.mu.RLock()
+		generation := idx.generation
+		idx.mu.RUnlock()
+
+		langSlice, err := listAll(ctx, idx.Repo)
+		if err != nil {
+			return err
+		}
+
+		idx.mu.Lock()
+		if generation != idx.generation && attempt < maxRebuildAttempts {
+			idx.mu.Unlock()
+			continue
+		}
+		idx.reset()
+		for _, lang := range langSlice {
+			idx.add(lang)
+		}
+		idx.built = true
+		idx.mu.Unlock()
+
+		return nil
+	}
+}
+
+// listAll は、全てのProgrammingLangをNameの昇順にページごとに取得して返す。
+func listAll(ctx context.Context, repo repository.ProgrammingLangRepository) ([]*model.ProgrammingLang, error) {
+	langSlice := make([]*model.ProgrammingLang, 0)
+	filter := &model.ProgrammingLangFilter{
+		Limit: rebuildPageSize,
+	}
+	for {
+		page, err := repo.ListByFilter(ctx, filter)
+		if err != nil {
+			return nil, errors.WithStack(err)
+		}
+
+		langSlice = append(langSlice, page...)
+		if len(page) < rebuildPageSize {
+			return langSlice, nil
+		}
+		filter = &model.ProgrammingLangFilter{
+			After: page[len(page)-1].Name,
+			Limit: rebuildPageSize,
+		}
+	}
+}
+
+// Suggest は、prefixから始まるNameもしくは別名を持つProgrammingLangを、一致した割合の高い順に最大limit件返す。
+// 候補がlimit件に満たない場合は、prefixに類似するNameもしくは別名を持つものを続けて返す。
+func (idx *ProgrammingLangIndex) Suggest(ctx context.Context, prefix string, limit int) ([]*model.LangSuggestion, error) {
+	key := service.NameKey(prefix)
+	if key == "" || limit <= 0 {
+		return []*model.LangSuggestion{}, nil
+	}
+
+	if err := idx.ensureBuilt(ctx); err != nil {
+		return nil, err
+	}
+
+	idx.mu.RLock()
+	defer idx.mu.RUnlock()
+
+	keyLen := float64(utf8.RuneCountInString(key))
+	scored := make(map[*entry]float64)
+	if n := idx.find(key); n != nil {
+		n.walk(func(e *entry) {
+			scored[e] = keyLen / float64(utf8.RuneCountInString(e.key))
+		})
+	}
+	suggestions := rank(scored, limit, nil)
+
+	if len(suggestions) < limit {
+		excluded := make(map[int]bool, len(suggestions))
+		for _, s := range suggestions {
+			excluded[s.ID] = true
+		}
+		suggestions = append(suggestions, rank(idx.similar(key), limit-len(suggestions), excluded)...)
+	}
+
+	return suggestions, nil
+}
+
+// Similar は、nameに類似するNameもしくは別名を持つProgrammingLangを、類似度の高い順に最大limit件返す。
+func (idx *ProgrammingLangIndex) Similar(ctx context.Context, name string, limit int) ([]*model.LangSuggestion, error) {
+	key := service.NameKey(name)
+	if key == "" || limit <= 0 {
+		return []*model.LangSuggestion{}, nil
+	}
+
+	if err := idx.ensureBuilt(ctx); err != nil {
+		return nil, err
+	}
+
+	idx.mu.RLock()
+	defer idx.mu.RUnlock()
+
+	return rank(idx.similar(key), limit, nil), nil
+}
+
+// List は、ProgrammingLangの一覧を返す。
+func (idx *ProgrammingLangIndex) List(ctx context.Context, limit int) ([]*model.ProgrammingLang, error) {
+	return idx.Repo.List(ctx, limit)
+}
+
+// ListByFilter は、条件に一致するProgrammingLangの一覧を返す。
+func (idx *ProgrammingLangIndex) ListByFilter(ctx context.Context, filter *model.ProgrammingLangFilter) ([]*model.ProgrammingLang, error) {
+	return idx.Repo.ListByFilter(ctx, filter)
+}
+
+// ListByIDs は、IDで指定したProgrammingLangの一覧を返す。
+func (idx *ProgrammingLangIndex) ListByIDs(ctx context.Context, ids []int) ([]*model.ProgrammingLang, error) {
+	return idx.Repo.ListByIDs(ctx, ids)
+}
+
+// Read は、ProgrammingLangを1件返す。
+func (idx *ProgrammingLangIndex) Read(ctx context.Context, id int) (*model.ProgrammingLang, error) {
+	return idx.Repo.Read(ctx, id)
+}
+
+// ReadByName は、指定したNameもしくは別名を保持するProgrammingLangを1件返す。
+func (idx *ProgrammingLangIndex) ReadByName(ctx context.Context, name string) (*model.ProgrammingLang, error) {
+	return idx.Repo.ReadByName(ctx, name)
+}
+
+// ReadBySlug は、指定したslugを保持するProgrammingLangを1件返す。
+func (idx *ProgrammingLangIndex) ReadBySlug(ctx context.Context, slug string) (*model.ProgrammingLang, error) {
+	return idx.Repo.ReadBySlug(ctx, slug)
+}
+
+// ReadByPreviousSlug は、変更前のslugとして指定したslugを保持していたProgrammingLangを1件返す。
+func (idx *ProgrammingLangIndex) ReadByPreviousSlug(ctx context.Context, slug string) (*model.ProgrammingLang, error) {
+	return idx.Repo.ReadByPreviousSlug(ctx, slug)
+}
+
+// LastModified は、ProgrammingLangの中で最も新しい更新日時を返す。
+func (idx *ProgrammingLangIndex) LastModified(ctx context.Context) (time.Time, error) {
+	return idx.Repo.LastModified(ctx)
+}
+
+// CreateSlugHistory は、変更前のslugを記録する。
+func (idx *ProgrammingLangIndex) CreateSlugHistory(ctx context.Context, id int, slug string) error {
+	return idx.Repo.CreateSlugHistory(ctx, id, slug)
+}
+
+// Create は、ProgrammingLangを生成し、コミットした後に索引に登録する。
+func (idx *ProgrammingLangIndex) Create(ctx context.Context, lang *model.ProgrammingLang) (*model.ProgrammingLang, error) {
+	created, err := idx.Repo.Create(ctx, lang)
+	if err != nil {
+		return nil, err
+	}
+
+	rdb.AfterCommit(ctx, func() {
+		idx.mu.Lock()
+		idx.generation++
+		idx.add(created)
+		idx.mu.Unlock()
+	})
+
+	return created, nil
+}
+
+// Update は、ProgrammingLangを更新し、コミットした後に索引を登録し直す。
+func (idx *ProgrammingLangIndex) Update(ctx context.Context, lang *model.ProgrammingLang) (*model.ProgrammingLang, error) {
+	updated, err := idx.Repo.Update(ctx, lang)
+	if err != nil {
+		return nil, err
+	}
+
+	rdb.AfterCommit(ctx, func() {
+		idx.mu.Lock()
+		idx.generation++
+		idx.add(updated)
+		idx.mu.Unlock()
+	})
+
+	return updated, nil
+}
+
+// Delete は、ProgrammingLangを削除し、コミットした後に索引から取り除く。
+func (idx *ProgrammingLangIndex) Delete(ctx context.Context, id int) error {
+	if err := idx.Repo.Delete(ctx, id); err != nil {
+		return err
+	}
+
+	rdb.AfterCommit(ctx, func() {
+		idx.mu.Lock()
+		idx.generation++
+		idx.remove(id)
+		idx.mu.Unlock()
+	})
+
+	return nil
+}
+
+// ensureBuilt は、起動時の構築に失敗していた場合に、索引を構築する。
+func (idx *ProgrammingLangIndex) ensureBuilt(ctx context.Context) error {
+	idx.mu.RLock()
+	built := idx.built
+	idx.mu.RUnlock()
+
+	if built {
+		return nil
+	}
+	return idx.Rebuild(ctx)
+}
+
+// reset は、索引を空にする。呼び出し側でロックを取得する。
+func (idx *ProgrammingLangIndex) reset() {
+	idx.root = &node{}
+	idx.langs = make(map[int][]*entry)
+	idx.trigrams = make(map[string]map[*entry]bool)
+}
+
+// add は、ProgrammingLangのNameと別名を索引に登録する。登録済みの場合は登録し直す。呼び出し側でロックを取得する。
+func (idx *ProgrammingLangIndex) add(lang *model.ProgrammingLang) {
+	idx.remove(lang.ID)
+
+	seen := make(map[string]bool, len(lang.Aliases)+1)
+	for i, matched := range append([]string{lang.Name}, lang.Aliases...) {
+		key := service.NameKey(matched)
+		if key == "" || seen[key] {
+			continue
+		}
+		seen[key] = true
+
+		e := &entry{
+			id:      lang.ID,
+			name:    lang.Name,
+			slug:    lang.Slug,
+			matched: matched,
+			key:     key,
+			isName:  i == 0,
+		}
+		idx.langs[lang.ID] = append(idx.langs[lang.ID], e)
+
+		n := idx.root
+		for _, r := range key {
+			if n.children == nil {
+				n.children = make(map[rune]*node)
+			}
+			child, ok := n.children[r]
+			if !ok {
+				child = &node{}
+				n.children[r] = child
+			}
+			n = child
+		}
+		n.entries = append(n.entries, e)
+
+		for _, t := range service.Trigrams(key) {
+			if idx.trigrams[t] == nil {
+				idx.trigrams[t] = make(map[*entry]bool)
+			}
+			idx.trigrams[t][e] = true
+		}
+	}
+}
+
+// remove は、ProgrammingLangのNameと別名を索引から取り除く。呼び出し側でロックを取得する。
+func (idx *ProgrammingLangIndex) remove(id int) {
+	for _, e := range idx.langs[id] {
+		idx.root.removeEntry([]rune(e.key), e)
+
+		for _, t := range service.Trigrams(e.key) {
+			delete(idx.trigrams[t], e)
+			if len(idx.trigrams[t]) == 0 {
+				delete(idx.trigrams, t)
+			}
+		}
+	}
+	delete(idx.langs, id)
+}
+
+// find は、keyに対応する節を返す。存在しない場合は、nilを返す。呼び出し側でロックを取得する。
+func (idx *ProgrammingLangIndex) find(key string) *node {
+	n := idx.root
+	for _, r := range key {
+		n = n.children[r]
+		if n == nil {
+			return nil
+		}
+	}
+	return n
+}
+
+// similar は、keyと3文字ずつの組を共有するNameと別名のうち、類似度が下限以上のものを類似度とともに返す。
+// 呼び出し側でロックを取得する。
+func (idx *ProgrammingLangIndex) similar(key string) map[*entry]float64 {
+	scored := make(map[*entry]float64)
+	for _, t := range service.Trigrams(key) {
+		for e := range idx.trigrams[t] {
+			if _, ok := scored[e]; ok {
+				continue
+			}
+			scored[e] = service.Similarity(key, e.key)
+		}
+	}
+
+	for e, score := range scored {
+		if score < service.MinSimilarity {
+			delete(scored, e)
+		}
+	}
+	return scored
+}
+
+// removeEntry は、keyの節からeを取り除き、戻りながら子も登録もなくなった節を親から切り離す。
+// 節が空になった場合は、trueを返す。削除や変更を繰り返しても、使われなくなった節が残らないようにする。
+func (n *node) removeEntry(key []rune, e *entry) bool {
+	if len(key) == 0 {
+		kept := n.entries[:0]
+		for _, other := range n.entries {
+			if other != e {
+				kept = append(kept, other)
+			}
+		}
+		n.entries = kept
+	} else if child, ok := n.children[key[0]]; ok && child.removeEntry(key[1:], e) {
+		delete(n.children, key[0])
+	}
+	return len(n.entries) == 0 && len(n.children) == 0
+}
+
+// walk は、節とその子孫の節に登録されたNameと別名を全てfnに渡す。
+func (n *node) walk(fn func(e *entry)) {
+	for _, e := range n.entries {
+		fn(e)
+	}
+	for _, child := range n.children {
+		child.walk(fn)
+	}
+}
+
+// rank は、スコアの高い順に、ProgrammingLangごとに最もスコアの高いNameもしくは別名を最大limit件返す。
+// スコアが同じ場合は、別名よりNameを、次にNameの昇順を優先する。excludedに含まれるIDは除く。
+func rank(scored map[*entry]float64, limit int, excluded map[int]bool) []*model.LangSuggestion {
+	entries := make([]*entry, 0, len(scored))
+	for e := range scored {
+		if !excluded[e.id] {
+			entries = append(entries, e)
+		}
+	}
+
+	sort.Slice(entries, func(i, j int) bool {
+		a, b := entries[i], entries[j]
+		if scored[a] != scored[b] {
+			return scored[a] > scored[b]
+		}
+		if a.isName != b.isName {
+			return a.isName
+		}
+		if a.name != b.name {
+			return a.name < b.name
+		}
+		return a.key < b.key
+	})
+
+	suggestions := make([]*model.LangSuggestion, 0, limit)
+	seen := make(map[int]bool, limit)
+	for _, e := range entries {
+		if len(suggestions) == limit {
+			break
+		}
+		if seen[e.id] {
+			continue
+		}
+		seen[e.id] = true
+
+		suggestions = append(suggestions, &model.LangSuggestion{
+			ID:      e.id,
+			Name:    e.name,
+			Slug:    e.slug,
+			Matched: e.matched,
+			Score:   scored[e],
+		})
+	}
+	return suggestions
+}
@@ -0,0 +1,240 @@
+package index_test
+
+import (
+	"context"
+	"reflect"
+	"testing"
+
+	"github.com/SekiguchiKai/clean-architecture-with-go/server/domain/model"
+	"github.com/SekiguchiKai/clean-architecture-with-go/server/infra/dao/index"
+	"github.com/SekiguchiKai/clean-architecture-with-go/server/infra/dao/mock"
+	"github.com/golang/mock/gomock"
+)
+
+// testLangs は、索引に登録するテスト用のProgrammingLang。
+var testLangs = []*model.ProgrammingLang{
+	{ID: 1, Name: "Go", Slug: "go", Aliases: []string{"golang"}},
+	{ID: 2, Name: "Java", Slug: "java"},
+	{ID: 3, Name: "JavaScript", Slug: "javascript", Aliases: []string{"js", "node"}},
+	{ID: 4, Name: "Python", Slug: "python", Aliases: []string{"python3"}},
+}
+
+// newTestIndex は、testLangsで構築したテスト用のProgrammingLangIndexを生成し、返す。
+func newTestIndex(t *testing.T, repo *mock_repository.MockProgrammingLangRepository) *index.ProgrammingLangIndex {
+	ctx := context.Background()
+	repo.EXPECT().ListByFilter(ctx, &model.ProgrammingLangFilter{Limit: 100}).Return(testLangs, nil)
+
+	idx := index.NewProgrammingLangIndex(repo)
+	if err := idx.Rebuild(ctx); err != nil {
+		t.Fatal(err)
+	}
+	return idx
+}
+
+// suggestedNames は、候補のNameとMatchedを返す。
+func suggestedNames(suggestions []*model.LangSuggestion) [][2]string {
+	names := make([][2]string, 0, len(suggestions))
+	for _, s := range suggestions {
+		names = append(names, [2]string{s.Name, s.Matched})
+	}
+	return names
+}
+
+func TestProgrammingLangIndex_Suggest(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	repo := mock_repository.NewMockProgrammingLangRepository(ctrl)
+	idx := newTestIndex(t, repo)
+
+	tests := []struct {
+		name   string
+		prefix string
+		limit  int
+		want   [][2]string
+	}{
+		{
+			name:   "prefixから始まるNameがある場合、一致した割合の高い順に返すこと",
+			prefix: "ja",
+			limit:  1,
+			want:   [][2]string{{"Java", "Java"}},
+		},
+		{
+			name:   "大文字と小文字が異なる場合も、一致するNameと別名を返すこと",
+			prefix: "J",
+			limit:  5,
+			want:   [][2]string{{"JavaScript", "js"}, {"Java", "Java"}},
+		},
+		{
+			name:   "別名だけが一致する場合、別名を返すこと",
+			prefix: "gol",
+			limit:  5,
+			want:   [][2]string{{"Go", "golang"}},
+		},
+		{
+			name:   "prefixに一致するものがない場合、類似するNameを返すこと",
+			prefix: "pyton",
+			limit:  5,
+			want:   [][2]string{{"Python", "Python"}},
+		},
+		{
+			name:   "空白のみの場合、空のスライスを返すこと",
+			prefix: " ",
+			limit:  5,
+			want:   [][2]string{},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := idx.Suggest(context.Background(), tt.prefix, tt.limit)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if names := suggestedNames(got); !reflect.DeepEqual(names, tt.want) {
+				t.Errorf("ProgrammingLangIndex.Suggest() = %v, want %v", names, tt.want)
+			}
+		})
+	}
+}
+
+func TestProgrammingLangIndex_Similar(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	repo := mock_repository.NewMockProgrammingLangRepository(ctrl)
+	idx := newTestIndex(t, repo)
+
+	tests := []struct {
+		name string
+		arg  string
+		want [][2]string
+	}{
+		{
+			name: "誤字を含む場合、類似するNameを返すこと",
+			arg:  "Javscript",
+			want: [][2]string{{"JavaScript", "JavaScript"}},
+		},
+		{
+			name: "別名に類似する場合、別名を返すこと",
+			arg:  "golnag",
+			want: [][2]string{{"Go", "golang"}},
+		},
+		{
+			name: "類似するものがない場合、空のスライスを返すこと",
+			arg:  "Haskell",
+			want: [][2]string{},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := idx.Similar(context.Background(), tt.arg, 3)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if names := suggestedNames(got); !reflect.DeepEqual(names, tt.want) {
+				t.Errorf("ProgrammingLangIndex.Similar() = %v, want %v", names, tt.want)
+			}
+		})
+	}
+}
+
+func TestProgrammingLangIndex_Sync(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	ctx := context.Background()
+
+	tests := []struct {
+		name   string
+		write  func(repo *mock_repository.MockProgrammingLangRepository, idx *index.ProgrammingLangIndex) error
+		prefix string
+		want   [][2]string
+	}{
+		{
+			name: "生成した場合、生成したProgrammingLangを返すこと",
+			write: func(repo *mock_repository.MockProgrammingLangRepository, idx *index.ProgrammingLangIndex) error {
+				rust := &model.ProgrammingLang{ID: 5, Name: "Rust", Slug: "rust"}
+				repo.EXPECT().Create(ctx, rust).Return(rust, nil)
+				_, err := idx.Create(ctx, rust)
+				return err
+			},
+			prefix: "ru",
+			want:   [][2]string{{"Rust", "Rust"}},
+		},
+		{
+			name: "Nameを変更した場合、変更前のNameを返さないこと",
+			write: func(repo *mock_repository.MockProgrammingLangRepository, idx *index.ProgrammingLangIndex) error {
+				golang := &model.ProgrammingLang{ID: 1, Name: "Golang", Slug: "golang"}
+				repo.EXPECT().Update(ctx, golang).Return(golang, nil)
+				_, err := idx.Update(ctx, golang)
+				return err
+			},
+			prefix: "go",
+			want:   [][2]string{{"Golang", "Golang"}},
+		},
+		{
+			name: "削除した場合、削除したProgrammingLangを返さないこと",
+			write: func(repo *mock_repository.MockProgrammingLangRepository, idx *index.ProgrammingLangIndex) error {
+				repo.EXPECT().Delete(ctx, 2).Return(nil)
+				return idx.Delete(ctx, 2)
+			},
+			prefix: "java",
+			want:   [][2]string{{"JavaScript", "JavaScript"}},
+		},
+		{
+			name: "書き込みに失敗した場合、索引を変更しないこと",
+			write: func(repo *mock_repository.MockProgrammingLangRepository, idx *index.ProgrammingLangIndex) error {
+				repo.EXPECT().Delete(ctx, 2).Return(&model.DBError{})
+				if err := idx.Delete(ctx, 2); err == nil {
+					t.Error("ProgrammingLangIndex.Delete() error = nil, want error")
+				}
+				return nil
+			},
+			prefix: "java",
+			want:   [][2]string{{"Java", "Java"}, {"JavaScript", "JavaScript"}},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			repo := mock_repository.NewMockProgrammingLangRepository(ctrl)
+			idx := newTestIndex(t, repo)
+
+			if err := tt.write(repo, idx); err != nil {
+				t.Fatal(err)
+			}
+
+			got, err := idx.Suggest(ctx, tt.prefix, 5)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if names := suggestedNames(got); !reflect.DeepEqual(names, tt.want) {
+				t.Errorf("ProgrammingLangIndex.Suggest() = %v, want %v", names, tt.want)
+			}
+		})
+	}
+}
+
+func TestProgrammingLangIndex_Rebuild(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	ctx := context.Background()
+	repo := mock_repository.NewMockProgrammingLangRepository(ctrl)
+	idx := index.NewProgrammingLangIndex(repo)
+
+	// 起動時の構築に失敗した場合は、最初の検索で構築し直す。
+	repo.EXPECT().ListByFilter(ctx, &model.ProgrammingLangFilter{Limit: 100}).Return(nil, &model.DBError{})
+	if err := idx.Rebuild(ctx); err == nil {
+		t.Fatal("ProgrammingLangIndex.Rebuild() error = nil, want error")
+	}
+
+	repo.EXPECT().ListByFilter(ctx, &model.ProgrammingLangFilter{Limit: 100}).Return(testLangs, nil)
+	got, err := idx.Suggest(ctx, "py", 5)
+	if err != nil {
+		t.Fatal(err)
+	}
+	want := [][2]string{{"Python", "Python"}}
+	if names := suggestedNames(got); !reflect.DeepEqual(names, want) {
+		t.Errorf("ProgrammingLangIndex.Suggest() = %v, want %v", names, want)
+	}
+}
@@ -0,0 +1,64 @@
+package index
+
+import (
+	"testing"
+
+	"github.com/SekiguchiKai/clean-architecture-with-go/server/domain/model"
+)
+
+// countNodes は、節とその子孫の節の数を返す。
+func countNodes(n *node) int {
+	count := 1
+	for _, child := range n.children {
+		count += countNodes(child)
+	}
+	return count
+}
+
+func TestProgrammingLangIndex_remove(t *testing.T) {
+	tests := []struct {
+		name      string
+		langs     []*model.ProgrammingLang
+		removed   int
+		wantNodes int
+	}{
+		{
+			name:      "唯一のProgrammingLangを取り除いた場合、根の節だけを残すこと",
+			langs:     []*model.ProgrammingLang{{ID: 1, Name: "Go", Aliases: []string{"golang"}}},
+			removed:   1,
+			wantNodes: 1,
+		},
+		{
+			name: "prefixを共有するProgrammingLangを取り除いた場合、残ったProgrammingLangの節だけを残すこと",
+			langs: []*model.ProgrammingLang{
+				{ID: 1, Name: "Java"},
+				{ID: 2, Name: "JavaScript"},
+			},
+			removed:   2,
+			wantNodes: 1 + len("java"),
+		},
+		{
+			name: "他のProgrammingLangのprefixであるProgrammingLangを取り除いた場合、子孫の節を残すこと",
+			langs: []*model.ProgrammingLang{
+				{ID: 1, Name: "Java"},
+				{ID: 2, Name: "JavaScript"},
+			},
+			removed:   1,
+			wantNodes: 1 + len("javascript"),
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			idx := NewProgrammingLangIndex(nil)
+			for _, lang := range tt.langs {
+				idx.add(lang)
+			}
+
+			idx.remove(tt.removed)
+
+			if got := countNodes(idx.root); got != tt.wantNodes {
+				t.Errorf("nodes = %v, want %v", got, tt.wantNodes)
+			}
+		})
+	}
+}
@@ -0,0 +1,334 @@
+package index
+
+import (
+	"context"
+	"math"
+	"sort"
+	"sync"
+	"time"
+
+	"github.com/SekiguchiKai/clean-architecture-with-go/server/domain/model"
+	"github.com/SekiguchiKai/clean-architecture-with-go/server/domain/repository"
+	"github.com/SekiguchiKai/clean-architecture-with-go/server/domain/service"
+	"github.com/SekiguchiKai/clean-architecture-with-go/server/infra/dao/rdb"
+)
+
+// 全文検索の対象の項目。
+const (
+	fieldName = iota
+	fieldFeature
+	fieldCount
+)
+
+// fieldWeights は、項目ごとのスコアの重み。Nameに一致したものを優先する。
+var fieldWeights = [fieldCount]float64{2, 1}
+
+// BM25のパラメータ。
+const (
+	bm25K1 = 1.2
+	bm25B  = 0.75
+)
+
+// document は、全文検索の索引に登録したProgrammingLangを表す。
+type document struct {
+	id      int
+	name    string
+	slug    string
+	feature string
+	lengths [fieldCount]int
+	freqs   map[string]*[fieldCount]int
+}
+
+// ProgrammingLangSearchIndex は、ProgrammingLangのNameとFeatureの転置索引を保持するRepository。
+// 書き込みは下位のRepositoryに委譲した上で索引に反映し、読み込みはそのまま委譲する。
+type ProgrammingLangSearchIndex struct {
+	Repo repository.ProgrammingLangRepository
+
+	mu           sync.RWMutex
+	built        bool
+	generation   uint64
+	docs         map[int]*document
+	postings     map[string]map[int]*document
+	totalLengths [fieldCount]int
+}
+
+// NewProgrammingLangSearchIndex は、空のProgrammingLangSearchIndexを生成し、返す。索引はReindexで構築する。
+func NewProgrammingLangSearchIndex(repo repository.ProgrammingLangRepository) *ProgrammingLangSearchIndex {
+	idx := &ProgrammingLangSearchIndex{
+		Repo: repo,
+	}
+	idx.reset()
+	return idx
+}
+
+// Reindex は、全てのProgrammingLangを読み込んで索引を構築し直し、登録した件数を返す。
+// 読み込み中に書き込みが行われた場合は、その変更が失われないように読み込み直す。
+func (idx *ProgrammingLangSearchIndex) Reindex(ctx context.Context) (*model.ReindexReport, error) {
+	for attempt := 1; ; attempt++ {
+		idx.mu.RLock()
+		generation := idx.generation
+		idx.mu.RUnlock()
+
+		langSlice, err := listAll(ctx, idx.Repo)
+		if err != nil {
+			return nil, err
+		}
+
+		idx.mu.Lock()
+		if generation != idx.generation && attempt < maxRebuildAttempts {
+			idx.mu.Unlock()
+			continue
+		}
+		idx.reset()
+		for _, lang := range langSlice {
+			idx.add(lang)
+		}
+		idx.built = true
+		documents := len(idx.docs)
+		idx.mu.Unlock()
+
+		return &model.ReindexReport{
+			Documents: documents,
+		}, nil
+	}
+}
+
+// Search は、queryの語をNameもしくはFeatureに含むProgrammingLangを、BM25のスコアの高い順に最大limit件返す。
+func (idx *ProgrammingLangSearchIndex) Search(ctx context.Context, query string, limit int) ([]*model.SearchResult, error) {
+	terms := service.AnalyzeQuery(query)
+	if len(terms) == 0 || limit <= 0 {
+		return []*model.SearchResult{}, nil
+	}
+
+	if err := idx.ensureBuilt(ctx); err != nil {
+		return nil, err
+	}
+
+	idx.mu.RLock()
+	defer idx.mu.RUnlock()
+
+	n := float64(len(idx.docs))
+	var avgLengths [fieldCount]float64
+	for f := range avgLengths {
+		if n > 0 {
+			avgLengths[f] = float64(idx.totalLengths[f]) / n
+		}
+	}
+
+	scores := make(map[*document]float64)
+	for _, term := range terms {
+		posting := idx.postings[term]
+		if len(posting) == 0 {
+			continue
+		}
+
+		df := float64(len(posting))
+		idf := math.Log(1 + (n-df+0.5)/(df+0.5))
+		for _, doc := range posting {
+			freqs := doc.freqs[term]
+			for f := 0; f < fieldCount; f++ {
+				tf := float64(freqs[f])
+				if tf == 0 {
+					continue
+				}
+				norm := 1 - bm25B + bm25B*float64(doc.lengths[f])/avgLengths[f]
+				scores[doc] += fieldWeights[f] * idf * tf * (bm25K1 + 1) / (tf + bm25K1*norm)
+			}
+		}
+	}
+
+	docs := make([]*document, 0, len(scores))
+	for doc := range scores {
+		docs = append(docs, doc)
+	}
+	sort.Slice(docs, func(i, j int) bool {
+		if scores[docs[i]] != scores[docs[j]] {
+			return scores[docs[i]] > scores[docs[j]]
+		}
+		return docs[i].name < docs[j].name
+	})
+	if len(docs) > limit {
+		docs = docs[:limit]
+	}
+
+	results := make([]*model.SearchResult, len(docs))
+	for i, doc := range docs {
+		results[i] = &model.SearchResult{
+			ID:      doc.id,
+			Name:    doc.name,
+			Slug:    doc.slug,
+			Score:   scores[doc],
+			Snippet: service.Snippet(doc.feature, terms),
+		}
+	}
+	return results, nil
+}
+
+// List は、ProgrammingLangの一覧を返す。
+func (idx *ProgrammingLangSearchIndex) List(ctx context.Context, limit int) ([]*model.ProgrammingLang, error) {
+	return idx.Repo.List(ctx, limit)
+}
+
+// ListByFilter は、条件に一致するProgrammingLangの一覧を返す。
+func (idx *ProgrammingLangSearchIndex) ListByFilter(ctx context.Context, filter *model.ProgrammingLangFilter) ([]*model.ProgrammingLang, error) {
+	return idx.Repo.ListByFilter(ctx, filter)
+}
+
+// ListByIDs は、IDで指定したProgrammingLangの一覧を返す。
+func (idx *ProgrammingLangSearchIndex) ListByIDs(ctx context.Context, ids []int) ([]*model.ProgrammingLang, error) {
+	return idx.Repo.ListByIDs(ctx, ids)
+}
+
+// Read は、ProgrammingLangを1件返す。
+func (idx *ProgrammingLangSearchIndex) Read(ctx context.Context, id int) (*model.ProgrammingLang, error) {
+	return idx.Repo.Read(ctx, id)
+}
+
+// ReadByName は、指定したNameもしくは別名を保持するProgrammingLangを1件返す。
+func (idx *ProgrammingLangSearchIndex) ReadByName(ctx context.Context, name string) (*model.ProgrammingLang, error) {
+	return idx.Repo.ReadByName(ctx, name)
+}
+
+// ReadBySlug は、指定したslugを保持するProgrammingLangを1件返す。
+func (idx *ProgrammingLangSearchIndex) ReadBySlug(ctx context.Context, slug string) (*model.ProgrammingLang, error) {
+	return idx.Repo.ReadBySlug(ctx, slug)
+}
+
+// ReadByPreviousSlug は、変更前のslugとして指定したslugを保持していたProgrammingLangを1件返す。
+func (idx *ProgrammingLangSearchIndex) ReadByPreviousSlug(ctx context.Context, slug string) (*model.ProgrammingLang, error) {
+	return idx.Repo.ReadByPreviousSlug(ctx, slug)
+}
+
+// LastModified は、ProgrammingLangの中で最も新しい更新日時を返す。
+func (idx *ProgrammingLangSearchIndex) LastModified(ctx context.Context) (time.Time, error) {
+	return idx.Repo.LastModified(ctx)
+}
+
+// CreateSlugHistory は、変更前のslugを記録する。
+func (idx *ProgrammingLangSearchIndex) CreateSlugHistory(ctx context.Context, id int, slug string) error {
+	return idx.Repo.CreateSlugHistory(ctx, id, slug)
+}
+
+// Create は、ProgrammingLangを生成し、コミットした後に索引に登録する。
+func (idx *ProgrammingLangSearchIndex) Create(ctx context.Context, lang *model.ProgrammingLang) (*model.ProgrammingLang, error) {
+	created, err := idx.Repo.Create(ctx, lang)
+	if err != nil {
+		return nil, err
+	}
+
+	rdb.AfterCommit(ctx, func() {
+		idx.mu.Lock()
+		idx.generation++
+		idx.add(created)
+		idx.mu.Unlock()
+	})
+
+	return created, nil
+}
+
+// Update は、ProgrammingLangを更新し、コミットした後に索引を登録し直す。
+func (idx *ProgrammingLangSearchIndex) Update(ctx context.Context, lang *model.ProgrammingLang) (*model.ProgrammingLang, error) {
+	updated, err := idx.Repo.Update(ctx, lang)
+	if err != nil {
+		return nil, err
+	}
+
+	rdb.AfterCommit(ctx, func() {
+		idx.mu.Lock()
+		idx.generation++
+		idx.add(updated)
+		idx.mu.Unlock()
+	})
+
+	return updated, nil
+}
+
+// Delete は、ProgrammingLangを削除し、コミットした後に索引から取り除く。
+func (idx *ProgrammingLangSearchIndex) Delete(ctx context.Context, id int) error {
+	if err := idx.Repo.Delete(ctx, id); err != nil {
+		return err
+	}
+
+	rdb.AfterCommit(ctx, func() {
+		idx.mu.Lock()
+		idx.generation++
+		idx.remove(id)
+		idx.mu.Unlock()
+	})
+
+	return nil
+}
+
+// ensureBuilt は、起動時の構築に失敗していた場合に、索引を構築する。
+func (idx *ProgrammingLangSearchIndex) ensureBuilt(ctx context.Context) error {
+	idx.mu.RLock()
+	built := idx.built
+	idx.mu.RUnlock()
+
+	if built {
+		return nil
+	}
+	_, err := idx.Reindex(ctx)
+	return err
+}
+
+// reset は、索引を空にする。呼び出し側でロックを取得する。
+func (idx *ProgrammingLangSearchIndex) reset() {
+	idx.docs = make(map[int]*document)
+	idx.postings = make(map[string]map[int]*document)
+	idx.totalLengths = [fieldCount]int{}
+}
+
+// add は、ProgrammingLangのNameとFeatureを索引に登録する。登録済みの場合は登録し直す。呼び出し側でロックを取得する。
+func (idx *ProgrammingLangSearchIndex) add(lang *model.ProgrammingLang) {
+	idx.remove(lang.ID)
+
+	doc := &document{
+		id:      lang.ID,
+		name:    lang.Name,
+		slug:    lang.Slug,
+		feature: lang.Feature,
+		freqs:   make(map[string]*[fieldCount]int),
+	}
+	for f, text := range [fieldCount]string{lang.Name, lang.Feature} {
+		tokens := service.Tokenize(text)
+		doc.lengths[f] = len(tokens)
+		idx.totalLengths[f] += len(tokens)
+
+		for _, token := range tokens {
+			freqs, ok := doc.freqs[token.Term]
+			if !ok {
+				freqs = &[fieldCount]int{}
+				doc.freqs[token.Term] = freqs
+			}
+			freqs[f]++
+		}
+	}
+
+	idx.docs[doc.id] = doc
+	for term := range doc.freqs {
+		if idx.postings[term] == nil {
+			idx.postings[term] = make(map[int]*document)
+		}
+		idx.postings[term][doc.id] = doc
+	}
+}
+
+// remove は、ProgrammingLangを索引から取り除く。呼び出し側でロックを取得する。
+func (idx *ProgrammingLangSearchIndex) remove(id int) {
+	doc, ok := idx.docs[id]
+	if !ok {
+		return
+	}
+
+	for term := range doc.freqs {
+		delete(idx.postings[term], id)
+		if len(idx.postings[term]) == 0 {
+			delete(idx.postings, term)
+		}
+	}
+	for f := range idx.totalLengths {
+		idx.totalLengths[f] -= doc.lengths[f]
+	}
+	delete(idx.docs, id)
+}
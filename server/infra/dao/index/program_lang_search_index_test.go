@@ -0,0 +1,211 @@
+package index_test
+
+import (
+	"context"
+	"reflect"
+	"testing"
+
+	"github.com/SekiguchiKai/clean-architecture-with-go/server/domain/model"
+	"github.com/SekiguchiKai/clean-architecture-with-go/server/infra/dao/index"
+	"github.com/SekiguchiKai/clean-architecture-with-go/server/infra/dao/mock"
+	"github.com/golang/mock/gomock"
+)
+
+// testDocuments は、全文検索の索引に登録するテスト用のProgrammingLang。
+var testDocuments = []*model.ProgrammingLang{
+	{ID: 1, Name: "Go", Slug: "go", Feature: "Compiled language with garbage collection and goroutines for concurrency."},
+	{ID: 2, Name: "Java", Slug: "java", Feature: "Class-based language running on the JVM with garbage collection."},
+	{ID: 3, Name: "Rust", Slug: "rust", Feature: "Memory safety without garbage collection, guaranteed by the borrow checker."},
+	{ID: 4, Name: "Erlang", Slug: "erlang", Feature: "Concurrent, fault-tolerant language built for telecom systems."},
+}
+
+// newTestSearchIndex は、testDocumentsで構築したテスト用のProgrammingLangSearchIndexを生成し、返す。
+func newTestSearchIndex(t *testing.T, repo *mock_repository.MockProgrammingLangRepository) *index.ProgrammingLangSearchIndex {
+	ctx := context.Background()
+	repo.EXPECT().ListByFilter(ctx, &model.ProgrammingLangFilter{Limit: 100}).Return(testDocuments, nil)
+
+	idx := index.NewProgrammingLangSearchIndex(repo)
+	report, err := idx.Reindex(ctx)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if report.Documents != len(testDocuments) {
+		t.Fatalf("ProgrammingLangSearchIndex.Reindex() documents = %v, want %v", report.Documents, len(testDocuments))
+	}
+	return idx
+}
+
+// resultNames は、検索結果のNameを返す。
+func resultNames(results []*model.SearchResult) []string {
+	names := make([]string, 0, len(results))
+	for _, r := range results {
+		names = append(names, r.Name)
+	}
+	return names
+}
+
+func TestProgrammingLangSearchIndex_Search(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	repo := mock_repository.NewMockProgrammingLangRepository(ctrl)
+	idx := newTestSearchIndex(t, repo)
+
+	tests := []struct {
+		name  string
+		query string
+		limit int
+		want  []string
+	}{
+		{
+			name:  "語形が異なる場合も、語幹が一致するものを返すこと",
+			query: "concurrent",
+			limit: 10,
+			want:  []string{"Go", "Erlang"},
+		},
+		{
+			name:  "複数の語を指定した場合、一致した語の多いものを優先すること",
+			query: "garbage collection memory safety",
+			limit: 10,
+			want:  []string{"Rust", "Go", "Java"},
+		},
+		{
+			name:  "Nameに一致する場合、Featureのみに一致するものより優先すること",
+			query: "java garbage",
+			limit: 10,
+			want:  []string{"Java", "Go", "Rust"},
+		},
+		{
+			name:  "limitを指定した場合、limit件までを返すこと",
+			query: "garbage",
+			limit: 1,
+			want:  []string{"Go"},
+		},
+		{
+			name:  "一致するものがない場合、空のスライスを返すこと",
+			query: "haskell",
+			limit: 10,
+			want:  []string{},
+		},
+		{
+			name:  "頻出語のみの場合、空のスライスを返すこと",
+			query: "the and",
+			limit: 10,
+			want:  []string{},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := idx.Search(context.Background(), tt.query, tt.limit)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if names := resultNames(got); !reflect.DeepEqual(names, tt.want) {
+				t.Errorf("ProgrammingLangSearchIndex.Search() = %v, want %v", names, tt.want)
+			}
+		})
+	}
+}
+
+func TestProgrammingLangSearchIndex_Search_Snippet(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	repo := mock_repository.NewMockProgrammingLangRepository(ctrl)
+	idx := newTestSearchIndex(t, repo)
+
+	got, err := idx.Search(context.Background(), "borrow", 1)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	want := &model.SearchResult{
+		ID:      3,
+		Name:    "Rust",
+		Slug:    "rust",
+		Snippet: "Memory safety without garbage collection, guaranteed by the <mark>borrow</mark> checker.",
+	}
+	if len(got) != 1 || got[0].Score <= 0 {
+		t.Fatalf("ProgrammingLangSearchIndex.Search() = %v, want 1 result with a positive score", got)
+	}
+	got[0].Score = 0
+	if !reflect.DeepEqual(got[0], want) {
+		t.Errorf("ProgrammingLangSearchIndex.Search() = %v, want %v", got[0], want)
+	}
+}
+
+func TestProgrammingLangSearchIndex_Sync(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	ctx := context.Background()
+
+	tests := []struct {
+		name  string
+		write func(repo *mock_repository.MockProgrammingLangRepository, idx *index.ProgrammingLangSearchIndex) error
+		query string
+		want  []string
+	}{
+		{
+			name: "生成した場合、生成したProgrammingLangを返すこと",
+			write: func(repo *mock_repository.MockProgrammingLangRepository, idx *index.ProgrammingLangSearchIndex) error {
+				elixir := &model.ProgrammingLang{ID: 5, Name: "Elixir", Slug: "elixir", Feature: "Runs on the BEAM with lightweight processes."}
+				repo.EXPECT().Create(ctx, elixir).Return(elixir, nil)
+				_, err := idx.Create(ctx, elixir)
+				return err
+			},
+			query: "processes",
+			want:  []string{"Elixir"},
+		},
+		{
+			name: "Featureを変更した場合、変更前のFeatureに一致しないこと",
+			write: func(repo *mock_repository.MockProgrammingLangRepository, idx *index.ProgrammingLangSearchIndex) error {
+				golang := &model.ProgrammingLang{ID: 1, Name: "Go", Slug: "go", Feature: "Simple syntax and fast builds."}
+				repo.EXPECT().Update(ctx, golang).Return(golang, nil)
+				_, err := idx.Update(ctx, golang)
+				return err
+			},
+			query: "goroutines",
+			want:  []string{},
+		},
+		{
+			name: "削除した場合、削除したProgrammingLangを返さないこと",
+			write: func(repo *mock_repository.MockProgrammingLangRepository, idx *index.ProgrammingLangSearchIndex) error {
+				repo.EXPECT().Delete(ctx, 4).Return(nil)
+				return idx.Delete(ctx, 4)
+			},
+			query: "concurrency",
+			want:  []string{"Go"},
+		},
+		{
+			name: "書き込みに失敗した場合、索引を変更しないこと",
+			write: func(repo *mock_repository.MockProgrammingLangRepository, idx *index.ProgrammingLangSearchIndex) error {
+				repo.EXPECT().Delete(ctx, 4).Return(&model.DBError{})
+				if err := idx.Delete(ctx, 4); err == nil {
+					t.Error("ProgrammingLangSearchIndex.Delete() error = nil, want error")
+				}
+				return nil
+			},
+			query: "concurrency",
+			want:  []string{"Go", "Erlang"},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			repo := mock_repository.NewMockProgrammingLangRepository(ctrl)
+			idx := newTestSearchIndex(t, repo)
+
+			if err := tt.write(repo, idx); err != nil {
+				t.Fatal(err)
+			}
+
+			got, err := idx.Search(ctx, tt.query, 10)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if names := resultNames(got); !reflect.DeepEqual(names, tt.want) {
+				t.Errorf("ProgrammingLangSearchIndex.Search() = %v, want %v", names, tt.want)
+			}
+		})
+	}
+}
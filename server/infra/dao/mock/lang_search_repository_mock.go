@@ -0,0 +1,61 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: domain/repository/lang_search_repository.go
+
+// Package mock_repository is a generated GoMock package.
+package mock_repository
+
+import (
+	context "context"
+	model "github.com/SekiguchiKai/clean-architecture-with-go/server/domain/model"
+	gomock "github.com/golang/mock/gomock"
+	reflect "reflect"
+)
+
+// MockLangSearchRepository is a mock of LangSearchRepository interface
+type MockLangSearchRepository struct {
+	ctrl     *gomock.Controller
+	recorder *MockLangSearchRepositoryMockRecorder
+}
+
+// MockLangSearchRepositoryMockRecorder is the mock recorder for MockLangSearchRepository
+type MockLangSearchRepositoryMockRecorder struct {
+	mock *MockLangSearchRepository
+}
+
+// NewMockLangSearchRepository creates a new mock instance
+func NewMockLangSearchRepository(ctrl *gomock.Controller) *MockLangSearchRepository {
+	mock := &MockLangSearchRepository{ctrl: ctrl}
+	mock.recorder = &MockLangSearchRepositoryMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use
+func (m *MockLangSearchRepository) EXPECT() *MockLangSearchRepositoryMockRecorder {
+	return m.recorder
+}
+
+// Search mocks base method
+func (m *MockLangSearchRepository) Search(ctx context.Context, query string, limit int) ([]*model.SearchResult, error) {
+	ret := m.ctrl.Call(m, "Search", ctx, query, limit)
+	ret0, _ := ret[0].([]*model.SearchResult)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Search indicates an expected call of Search
+func (mr *MockLangSearchRepositoryMockRecorder) Search(ctx, query, limit interface{}) *gomock.Call {
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Search", reflect.TypeOf((*MockLangSearchRepository)(nil).Search), ctx, query, limit)
+}
+
+// Reindex mocks base method
+func (m *MockLangSearchRepository) Reindex(ctx context.Context) (*model.ReindexReport, error) {
+	ret := m.ctrl.Call(m, "Reindex", ctx)
+	ret0, _ := ret[0].(*model.ReindexReport)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Reindex indicates an expected call of Reindex
+func (mr *MockLangSearchRepositoryMockRecorder) Reindex(ctx interface{}) *gomock.Call {
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Reindex", reflect.TypeOf((*MockLangSearchRepository)(nil).Reindex), ctx)
+}
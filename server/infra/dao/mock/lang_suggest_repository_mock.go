@@ -0,0 +1,61 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: domain/repository/lang_suggest_repository.go
+
+// Package mock_repository is a generated GoMock package.
+package mock_repository
+
+import (
+	context "context"
+	model "github.com/SekiguchiKai/clean-architecture-with-go/server/domain/model"
+	gomock "github.com/golang/mock/gomock"
+	reflect "reflect"
+)
+
+// MockLangSuggestRepository is a mock of LangSuggestRepository interface
+type MockLangSuggestRepository struct {
+	ctrl     *gomock.Controller
+	recorder *MockLangSuggestRepositoryMockRecorder
+}
+
+// MockLangSuggestRepositoryMockRecorder is the mock recorder for MockLangSuggestRepository
+type MockLangSuggestRepositoryMockRecorder struct {
+	mock *MockLangSuggestRepository
+}
+
+// NewMockLangSuggestRepository creates a new mock instance
+func NewMockLangSuggestRepository(ctrl *gomock.Controller) *MockLangSuggestRepository {
+	mock := &MockLangSuggestRepository{ctrl: ctrl}
+	mock.recorder = &MockLangSuggestRepositoryMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use
+func (m *MockLangSuggestRepository) EXPECT() *MockLangSuggestRepositoryMockRecorder {
+	return m.recorder
+}
+
+// Suggest mocks base method
+func (m *MockLangSuggestRepository) Suggest(ctx context.Context, prefix string, limit int) ([]*model.LangSuggestion, error) {
+	ret := m.ctrl.Call(m, "Suggest", ctx, prefix, limit)
+	ret0, _ := ret[0].([]*model.LangSuggestion)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Suggest indicates an expected call of Suggest
+func (mr *MockLangSuggestRepositoryMockRecorder) Suggest(ctx, prefix, limit interface{}) *gomock.Call {
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Suggest", reflect.TypeOf((*MockLangSuggestRepository)(nil).Suggest), ctx, prefix, limit)
+}
+
+// Similar mocks base method
+func (m *MockLangSuggestRepository) Similar(ctx context.Context, name string, limit int) ([]*model.LangSuggestion, error) {
+	ret := m.ctrl.Call(m, "Similar", ctx, name, limit)
+	ret0, _ := ret[0].([]*model.LangSuggestion)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Similar indicates an expected call of Similar
+func (mr *MockLangSuggestRepositoryMockRecorder) Similar(ctx, name, limit interface{}) *gomock.Call {
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Similar", reflect.TypeOf((*MockLangSuggestRepository)(nil).Similar), ctx, name, limit)
+}
@@ -9,6 +9,7 @@ import (
 	model "github.com/SekiguchiKai/clean-architecture-with-go/server/domain/model"
 	gomock "github.com/golang/mock/gomock"
 	reflect "reflect"
+	time "time"
 )
 
 // MockProgrammingLangRepository is a mock of ProgrammingLangRepository interface
@@ -47,6 +48,32 @@ func (mr *MockProgrammingLangRepositoryMockRecorder) List(ctx, limit interface{}
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "List", reflect.TypeOf((*MockProgrammingLangRepository)(nil).List), ctx, limit)
 }
 
+// ListByFilter mocks base method
+func (m *MockProgrammingLangRepository) ListByFilter(ctx context.Context, filter *model.ProgrammingLangFilter) ([]*model.ProgrammingLang, error) {
+	ret := m.ctrl.Call(m, "ListByFilter", ctx, filter)
+	ret0, _ := ret[0].([]*model.ProgrammingLang)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListByFilter indicates an expected call of ListByFilter
+func (mr *MockProgrammingLangRepositoryMockRecorder) ListByFilter(ctx, filter interface{}) *gomock.Call {
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListByFilter", reflect.TypeOf((*MockProgrammingLangRepository)(nil).ListByFilter), ctx, filter)
+}
+
+// ListByIDs mocks base method
+func (m *MockProgrammingLangRepository) ListByIDs(ctx context.Context, ids []int) ([]*model.ProgrammingLang, error) {
+	ret := m.ctrl.Call(m, "ListByIDs", ctx, ids)
+	ret0, _ := ret[0].([]*model.ProgrammingLang)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListByIDs indicates an expected call of ListByIDs
+func (mr *MockProgrammingLangRepositoryMockRecorder) ListByIDs(ctx, ids interface{}) *gomock.Call {
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListByIDs", reflect.TypeOf((*MockProgrammingLangRepository)(nil).ListByIDs), ctx, ids)
+}
+
 // Create mocks base method
 func (m *MockProgrammingLangRepository) Create(ctx context.Context, lang *model.ProgrammingLang) (*model.ProgrammingLang, error) {
 	ret := m.ctrl.Call(m, "Create", ctx, lang)
@@ -86,6 +113,44 @@ func (mr *MockProgrammingLangRepositoryMockRecorder) ReadByName(ctx, name interf
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ReadByName", reflect.TypeOf((*MockProgrammingLangRepository)(nil).ReadByName), ctx, name)
 }
 
+// ReadBySlug mocks base method
+func (m *MockProgrammingLangRepository) ReadBySlug(ctx context.Context, slug string) (*model.ProgrammingLang, error) {
+	ret := m.ctrl.Call(m, "ReadBySlug", ctx, slug)
+	ret0, _ := ret[0].(*model.ProgrammingLang)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ReadBySlug indicates an expected call of ReadBySlug
+func (mr *MockProgrammingLangRepositoryMockRecorder) ReadBySlug(ctx, slug interface{}) *gomock.Call {
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ReadBySlug", reflect.TypeOf((*MockProgrammingLangRepository)(nil).ReadBySlug), ctx, slug)
+}
+
+// ReadByPreviousSlug mocks base method
+func (m *MockProgrammingLangRepository) ReadByPreviousSlug(ctx context.Context, slug string) (*model.ProgrammingLang, error) {
+	ret := m.ctrl.Call(m, "ReadByPreviousSlug", ctx, slug)
+	ret0, _ := ret[0].(*model.ProgrammingLang)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ReadByPreviousSlug indicates an expected call of ReadByPreviousSlug
+func (mr *MockProgrammingLangRepositoryMockRecorder) ReadByPreviousSlug(ctx, slug interface{}) *gomock.Call {
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ReadByPreviousSlug", reflect.TypeOf((*MockProgrammingLangRepository)(nil).ReadByPreviousSlug), ctx, slug)
+}
+
+// CreateSlugHistory mocks base method
+func (m *MockProgrammingLangRepository) CreateSlugHistory(ctx context.Context, id int, slug string) error {
+	ret := m.ctrl.Call(m, "CreateSlugHistory", ctx, id, slug)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// CreateSlugHistory indicates an expected call of CreateSlugHistory
+func (mr *MockProgrammingLangRepositoryMockRecorder) CreateSlugHistory(ctx, id, slug interface{}) *gomock.Call {
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateSlugHistory", reflect.TypeOf((*MockProgrammingLangRepository)(nil).CreateSlugHistory), ctx, id, slug)
+}
+
 // Update mocks base method
 func (m *MockProgrammingLangRepository) Update(ctx context.Context, lang *model.ProgrammingLang) (*model.ProgrammingLang, error) {
 	ret := m.ctrl.Call(m, "Update", ctx, lang)
@@ -110,3 +175,16 @@ func (m *MockProgrammingLangRepository) Delete(ctx context.Context, id int) erro
 func (mr *MockProgrammingLangRepositoryMockRecorder) Delete(ctx, id interface{}) *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Delete", reflect.TypeOf((*MockProgrammingLangRepository)(nil).Delete), ctx, id)
 }
+
+// LastModified mocks base method
+func (m *MockProgrammingLangRepository) LastModified(ctx context.Context) (time.Time, error) {
+	ret := m.ctrl.Call(m, "LastModified", ctx)
+	ret0, _ := ret[0].(time.Time)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// LastModified indicates an expected call of LastModified
+func (mr *MockProgrammingLangRepositoryMockRecorder) LastModified(ctx interface{}) *gomock.Call {
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "LastModified", reflect.TypeOf((*MockProgrammingLangRepository)(nil).LastModified), ctx)
+}
@@ -0,0 +1,289 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: domain/repository/webhook_repository.go
+
+// Package mock_repository is a generated GoMock package.
+package mock_repository
+
+import (
+	context "context"
+	model "github.com/SekiguchiKai/clean-architecture-with-go/server/domain/model"
+	gomock "github.com/golang/mock/gomock"
+	reflect "reflect"
+	time "time"
+)
+
+// MockWebhookRepository is a mock of WebhookRepository interface
+type MockWebhookRepository struct {
+	ctrl     *gomock.Controller
+	recorder *MockWebhookRepositoryMockRecorder
+}
+
+// MockWebhookRepositoryMockRecorder is the mock recorder for MockWebhookRepository
+type MockWebhookRepositoryMockRecorder struct {
+	mock *MockWebhookRepository
+}
+
+// NewMockWebhookRepository creates a new mock instance
+func NewMockWebhookRepository(ctrl *gomock.Controller) *MockWebhookRepository {
+	mock := &MockWebhookRepository{ctrl: ctrl}
+	mock.recorder = &MockWebhookRepositoryMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use
+func (m *MockWebhookRepository) EXPECT() *MockWebhookRepositoryMockRecorder {
+	return m.recorder
+}
+
+// List mocks base method
+func (m *MockWebhookRepository) List(ctx context.Context) ([]*model.Webhook, error) {
+	ret := m.ctrl.Call(m, "List", ctx)
+	ret0, _ := ret[0].([]*model.Webhook)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// List indicates an expected call of List
+func (mr *MockWebhookRepositoryMockRecorder) List(ctx interface{}) *gomock.Call {
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "List", reflect.TypeOf((*MockWebhookRepository)(nil).List), ctx)
+}
+
+// Read mocks base method
+func (m *MockWebhookRepository) Read(ctx context.Context, id int) (*model.Webhook, error) {
+	ret := m.ctrl.Call(m, "Read", ctx, id)
+	ret0, _ := ret[0].(*model.Webhook)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Read indicates an expected call of Read
+func (mr *MockWebhookRepositoryMockRecorder) Read(ctx, id interface{}) *gomock.Call {
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Read", reflect.TypeOf((*MockWebhookRepository)(nil).Read), ctx, id)
+}
+
+// Create mocks base method
+func (m *MockWebhookRepository) Create(ctx context.Context, webhook *model.Webhook) (*model.Webhook, error) {
+	ret := m.ctrl.Call(m, "Create", ctx, webhook)
+	ret0, _ := ret[0].(*model.Webhook)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Create indicates an expected call of Create
+func (mr *MockWebhookRepositoryMockRecorder) Create(ctx, webhook interface{}) *gomock.Call {
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Create", reflect.TypeOf((*MockWebhookRepository)(nil).Create), ctx, webhook)
+}
+
+// Update mocks base method
+func (m *MockWebhookRepository) Update(ctx context.Context, webhook *model.Webhook) (*model.Webhook, error) {
+	ret := m.ctrl.Call(m, "Update", ctx, webhook)
+	ret0, _ := ret[0].(*model.Webhook)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Update indicates an expected call of Update
+func (mr *MockWebhookRepositoryMockRecorder) Update(ctx, webhook interface{}) *gomock.Call {
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Update", reflect.TypeOf((*MockWebhookRepository)(nil).Update), ctx, webhook)
+}
+
+// Delete mocks base method
+func (m *MockWebhookRepository) Delete(ctx context.Context, id int) error {
+	ret := m.ctrl.Call(m, "Delete", ctx, id)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Delete indicates an expected call of Delete
+func (mr *MockWebhookRepositoryMockRecorder) Delete(ctx, id interface{}) *gomock.Call {
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Delete", reflect.TypeOf((*MockWebhookRepository)(nil).Delete), ctx, id)
+}
+
+// MockWebhookDeliveryRepository is a mock of WebhookDeliveryRepository interface
+type MockWebhookDeliveryRepository struct {
+	ctrl     *gomock.Controller
+	recorder *MockWebhookDeliveryRepositoryMockRecorder
+}
+
+// MockWebhookDeliveryRepositoryMockRecorder is the mock recorder for MockWebhookDeliveryRepository
+type MockWebhookDeliveryRepositoryMockRecorder struct {
+	mock *MockWebhookDeliveryRepository
+}
+
+// NewMockWebhookDeliveryRepository creates a new mock instance
+func NewMockWebhookDeliveryRepository(ctrl *gomock.Controller) *MockWebhookDeliveryRepository {
+	mock := &MockWebhookDeliveryRepository{ctrl: ctrl}
+	mock.recorder = &MockWebhookDeliveryRepositoryMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use
+func (m *MockWebhookDeliveryRepository) EXPECT() *MockWebhookDeliveryRepositoryMockRecorder {
+	return m.recorder
+}
+
+// ListByWebhook mocks base method
+func (m *MockWebhookDeliveryRepository) ListByWebhook(ctx context.Context, webhookID int, status model.DeliveryStatus, limit int) ([]*model.WebhookDelivery, error) {
+	ret := m.ctrl.Call(m, "ListByWebhook", ctx, webhookID, status, limit)
+	ret0, _ := ret[0].([]*model.WebhookDelivery)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListByWebhook indicates an expected call of ListByWebhook
+func (mr *MockWebhookDeliveryRepositoryMockRecorder) ListByWebhook(ctx, webhookID, status, limit interface{}) *gomock.Call {
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListByWebhook", reflect.TypeOf((*MockWebhookDeliveryRepository)(nil).ListByWebhook), ctx, webhookID, status, limit)
+}
+
+// ListByStatus mocks base method
+func (m *MockWebhookDeliveryRepository) ListByStatus(ctx context.Context, status model.DeliveryStatus, limit int) ([]*model.WebhookDelivery, error) {
+	ret := m.ctrl.Call(m, "ListByStatus", ctx, status, limit)
+	ret0, _ := ret[0].([]*model.WebhookDelivery)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListByStatus indicates an expected call of ListByStatus
+func (mr *MockWebhookDeliveryRepositoryMockRecorder) ListByStatus(ctx, status, limit interface{}) *gomock.Call {
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListByStatus", reflect.TypeOf((*MockWebhookDeliveryRepository)(nil).ListByStatus), ctx, status, limit)
+}
+
+// ListDue mocks base method
+func (m *MockWebhookDeliveryRepository) ListDue(ctx context.Context, now time.Time, limit int) ([]*model.WebhookDelivery, error) {
+	ret := m.ctrl.Call(m, "ListDue", ctx, now, limit)
+	ret0, _ := ret[0].([]*model.WebhookDelivery)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListDue indicates an expected call of ListDue
+func (mr *MockWebhookDeliveryRepositoryMockRecorder) ListDue(ctx, now, limit interface{}) *gomock.Call {
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListDue", reflect.TypeOf((*MockWebhookDeliveryRepository)(nil).ListDue), ctx, now, limit)
+}
+
+// Read mocks base method
+func (m *MockWebhookDeliveryRepository) Read(ctx context.Context, id int) (*model.WebhookDelivery, error) {
+	ret := m.ctrl.Call(m, "Read", ctx, id)
+	ret0, _ := ret[0].(*model.WebhookDelivery)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Read indicates an expected call of Read
+func (mr *MockWebhookDeliveryRepositoryMockRecorder) Read(ctx, id interface{}) *gomock.Call {
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Read", reflect.TypeOf((*MockWebhookDeliveryRepository)(nil).Read), ctx, id)
+}
+
+// Create mocks base method
+func (m *MockWebhookDeliveryRepository) Create(ctx context.Context, delivery *model.WebhookDelivery) (*model.WebhookDelivery, error) {
+	ret := m.ctrl.Call(m, "Create", ctx, delivery)
+	ret0, _ := ret[0].(*model.WebhookDelivery)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Create indicates an expected call of Create
+func (mr *MockWebhookDeliveryRepositoryMockRecorder) Create(ctx, delivery interface{}) *gomock.Call {
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Create", reflect.TypeOf((*MockWebhookDeliveryRepository)(nil).Create), ctx, delivery)
+}
+
+// Update mocks base method
+func (m *MockWebhookDeliveryRepository) Update(ctx context.Context, delivery *model.WebhookDelivery) (*model.WebhookDelivery, error) {
+	ret := m.ctrl.Call(m, "Update", ctx, delivery)
+	ret0, _ := ret[0].(*model.WebhookDelivery)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Update indicates an expected call of Update
+func (mr *MockWebhookDeliveryRepositoryMockRecorder) Update(ctx, delivery interface{}) *gomock.Call {
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Update", reflect.TypeOf((*MockWebhookDeliveryRepository)(nil).Update), ctx, delivery)
+}
+
+// Claim mocks base method
+func (m *MockWebhookDeliveryRepository) Claim(ctx context.Context, delivery *model.WebhookDelivery, until time.Time) (bool, error) {
+	ret := m.ctrl.Call(m, "Claim", ctx, delivery, until)
+	ret0, _ := ret[0].(bool)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Claim indicates an expected call of Claim
+func (mr *MockWebhookDeliveryRepositoryMockRecorder) Claim(ctx, delivery, until interface{}) *gomock.Call {
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Claim", reflect.TypeOf((*MockWebhookDeliveryRepository)(nil).Claim), ctx, delivery, until)
+}
+
+// CreateAttempt mocks base method
+func (m *MockWebhookDeliveryRepository) CreateAttempt(ctx context.Context, attempt *model.DeliveryAttempt) (*model.DeliveryAttempt, error) {
+	ret := m.ctrl.Call(m, "CreateAttempt", ctx, attempt)
+	ret0, _ := ret[0].(*model.DeliveryAttempt)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateAttempt indicates an expected call of CreateAttempt
+func (mr *MockWebhookDeliveryRepositoryMockRecorder) CreateAttempt(ctx, attempt interface{}) *gomock.Call {
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateAttempt", reflect.TypeOf((*MockWebhookDeliveryRepository)(nil).CreateAttempt), ctx, attempt)
+}
+
+// ListAttempts mocks base method
+func (m *MockWebhookDeliveryRepository) ListAttempts(ctx context.Context, deliveryID int) ([]*model.DeliveryAttempt, error) {
+	ret := m.ctrl.Call(m, "ListAttempts", ctx, deliveryID)
+	ret0, _ := ret[0].([]*model.DeliveryAttempt)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListAttempts indicates an expected call of ListAttempts
+func (mr *MockWebhookDeliveryRepositoryMockRecorder) ListAttempts(ctx, deliveryID interface{}) *gomock.Call {
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListAttempts", reflect.TypeOf((*MockWebhookDeliveryRepository)(nil).ListAttempts), ctx, deliveryID)
+}
+
+// DeleteFinishedBefore mocks base method
+func (m *MockWebhookDeliveryRepository) DeleteFinishedBefore(ctx context.Context, before time.Time) (int, error) {
+	ret := m.ctrl.Call(m, "DeleteFinishedBefore", ctx, before)
+	ret0, _ := ret[0].(int)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DeleteFinishedBefore indicates an expected call of DeleteFinishedBefore
+func (mr *MockWebhookDeliveryRepositoryMockRecorder) DeleteFinishedBefore(ctx, before interface{}) *gomock.Call {
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteFinishedBefore", reflect.TypeOf((*MockWebhookDeliveryRepository)(nil).DeleteFinishedBefore), ctx, before)
+}
+
+// MockWebhookSender is a mock of WebhookSender interface
+type MockWebhookSender struct {
+	ctrl     *gomock.Controller
+	recorder *MockWebhookSenderMockRecorder
+}
+
+// MockWebhookSenderMockRecorder is the mock recorder for MockWebhookSender
+type MockWebhookSenderMockRecorder struct {
+	mock *MockWebhookSender
+}
+
+// NewMockWebhookSender creates a new mock instance
+func NewMockWebhookSender(ctrl *gomock.Controller) *MockWebhookSender {
+	mock := &MockWebhookSender{ctrl: ctrl}
+	mock.recorder = &MockWebhookSenderMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use
+func (m *MockWebhookSender) EXPECT() *MockWebhookSenderMockRecorder {
+	return m.recorder
+}
+
+// Send mocks base method
+func (m *MockWebhookSender) Send(ctx context.Context, req *model.WebhookRequest) (int, error) {
+	ret := m.ctrl.Call(m, "Send", ctx, req)
+	ret0, _ := ret[0].(int)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Send indicates an expected call of Send
+func (mr *MockWebhookSenderMockRecorder) Send(ctx, req interface{}) *gomock.Call {
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Send", reflect.TypeOf((*MockWebhookSender)(nil).Send), ctx, req)
+}
@@ -2,5 +2,6 @@ package rdb
 
 // rdb packageの定数。
 const (
-	TotalAffected = "Total Affected"
+	TotalAffected         = "Total Affected"
+	UnsupportedJSONColumn = "Unsupported type of JSON column"
 )
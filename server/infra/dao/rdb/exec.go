@@ -0,0 +1,52 @@
+package rdb
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+
+	"github.com/go-sql-driver/mysql"
+	"github.com/pkg/errors"
+)
+
+// mysqlErrDupEntry は、一意制約に違反した場合のMySQLのエラー番号(ER_DUP_ENTRY)。
+const mysqlErrDupEntry = 1062
+
+// errorMsgFunc は、DAOのメソッドで発生したエラーから、DAOのModelNameのDBErrorを生成する関数。各DAOのErrorMsgを渡す。
+type errorMsgFunc func(method string, err error) error
+
+// execStmt は、クエリを準備して実行し、結果を返す。エラーはerrorMsgでDBErrorに変換する。
+func execStmt(ctx context.Context, sqlM SQLManagerInterface, errorMsg errorMsgFunc, method, query string, args ...interface{}) (sql.Result, error) {
+	stmt, err := sqlM.PrepareContext(ctx, query)
+	if err != nil {
+		return nil, errorMsg(method, err)
+	}
+	defer stmt.Close()
+
+	result, err := stmt.ExecContext(ctx, args...)
+	if err != nil {
+		return nil, errorMsg(method, err)
+	}
+
+	return result, nil
+}
+
+// checkAffected は、影響を受けたレコードの数がwantであることを確認する。エラーはerrorMsgでDBErrorに変換する。
+func checkAffected(errorMsg errorMsgFunc, method string, result sql.Result, want int64) error {
+	affect, err := result.RowsAffected()
+	if err != nil {
+		return errorMsg(method, err)
+	}
+	if affect != want {
+		err = fmt.Errorf("%s: %d ", TotalAffected, affect)
+		return errorMsg(method, err)
+	}
+
+	return nil
+}
+
+// isDuplicateEntry は、エラーがMySQLの一意制約の違反であるかどうかを返す。
+func isDuplicateEntry(err error) bool {
+	mysqlErr, ok := errors.Cause(err).(*mysql.MySQLError)
+	return ok && mysqlErr.Number == mysqlErrDupEntry
+}
@@ -0,0 +1,160 @@
+package rdb
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/SekiguchiKai/clean-architecture-with-go/server/domain/model"
+	"github.com/SekiguchiKai/clean-architecture-with-go/server/domain/repository"
+	"github.com/pkg/errors"
+)
+
+// influenceColumns は、programming_lang_influencesから取得するカラム。listでScanする順序と一致させる。
+const influenceColumns = "programming_lang_id, influenced_by_id, created_at"
+
+// InfluenceDAO は、InfluenceのDAO。
+type InfluenceDAO struct {
+	SQLManager SQLManagerInterface
+}
+
+// NewInfluenceDAO は、InfluenceDAOを生成して返す。
+func NewInfluenceDAO(manager SQLManagerInterface) repository.InfluenceRepository {
+	return &InfluenceDAO{
+		SQLManager: manager,
+	}
+}
+
+// ErrorMsg は、エラー文を生成し、返す。
+func (dao *InfluenceDAO) ErrorMsg(method string, err error) error {
+	return &model.DBError{
+		ModelName: model.ModelNameInfluence,
+		DBMethod:  method,
+		Detail:    err.Error(),
+	}
+}
+
+// List は、全てのレコードを取得して返す。
+func (dao *InfluenceDAO) List(ctx context.Context) ([]*model.Influence, error) {
+	query := "SELECT " + influenceColumns + " FROM programming_lang_influences ORDER BY programming_lang_id, influenced_by_id"
+	influences, err := dao.list(ctx, query)
+	if err != nil {
+		return nil, errors.WithStack(err)
+	}
+
+	return influences, nil
+}
+
+// ListByLang は、指定したProgrammingLangが影響を受けたレコードの一覧を取得して返す。
+func (dao *InfluenceDAO) ListByLang(ctx context.Context, langID int) ([]*model.Influence, error) {
+	query := "SELECT " + influenceColumns + " FROM programming_lang_influences WHERE programming_lang_id=? ORDER BY influenced_by_id"
+	influences, err := dao.list(ctx, query, langID)
+	if err != nil {
+		return nil, errors.WithStack(err)
+	}
+
+	return influences, nil
+}
+
+// Read は、レコードを1件取得して返す。
+func (dao *InfluenceDAO) Read(ctx context.Context, langID, influencedByID int) (*model.Influence, error) {
+	query := "SELECT " + influenceColumns + " FROM programming_lang_influences WHERE programming_lang_id=? AND influenced_by_id=?"
+	influences, err := dao.list(ctx, query, langID, influencedByID)
+	if err != nil {
+		return nil, errors.WithStack(err)
+	}
+
+	if len(influences) == 0 {
+		return nil, &model.NoSuchDataError{
+			ID:        langID,
+			Name:      fmt.Sprintf("%d", influencedByID),
+			ModelName: model.ModelNameInfluence,
+		}
+	}
+
+	return influences[0], nil
+}
+
+// Create は、レコードを1件生成する。
+func (dao *InfluenceDAO) Create(ctx context.Context, influence *model.Influence) (*model.Influence, error) {
+	query := "INSERT INTO programming_lang_influences (programming_lang_id, influenced_by_id, created_at) VALUES (?, ?, ?)"
+	stmt, err := dao.SQLManager.PrepareContext(ctx, query)
+	if err != nil {
+		return nil, dao.ErrorMsg(model.DBMethodCreate, err)
+	}
+	defer stmt.Close()
+
+	result, err := stmt.ExecContext(ctx, influence.LangID, influence.InfluencedByID, influence.CreatedAt)
+	if err != nil {
+		return nil, dao.ErrorMsg(model.DBMethodCreate, err)
+	}
+
+	affect, err := result.RowsAffected()
+	if err != nil {
+		return nil, dao.ErrorMsg(model.DBMethodCreate, err)
+	}
+	if affect != 1 {
+		err = fmt.Errorf("%s: %d ", TotalAffected, affect)
+		return nil, dao.ErrorMsg(model.DBMethodCreate, err)
+	}
+
+	return influence, nil
+}
+
+// Delete は、レコードを1件削除する。
+func (dao *InfluenceDAO) Delete(ctx context.Context, langID, influencedByID int) error {
+	query := "DELETE FROM programming_lang_influences WHERE programming_lang_id=? AND influenced_by_id=?"
+	stmt, err := dao.SQLManager.PrepareContext(ctx, query)
+	if err != nil {
+		return dao.ErrorMsg(model.DBMethodDelete, err)
+	}
+	defer stmt.Close()
+
+	result, err := stmt.ExecContext(ctx, langID, influencedByID)
+	if err != nil {
+		return dao.ErrorMsg(model.DBMethodDelete, err)
+	}
+
+	affect, err := result.RowsAffected()
+	if err != nil {
+		return dao.ErrorMsg(model.DBMethodDelete, err)
+	}
+	if affect != 1 {
+		err = fmt.Errorf("%s: %d ", TotalAffected, affect)
+		return dao.ErrorMsg(model.DBMethodDelete, err)
+	}
+
+	return nil
+}
+
+// list は、レコードの一覧を取得して返す。
+func (dao *InfluenceDAO) list(ctx context.Context, query string, args ...interface{}) ([]*model.Influence, error) {
+	stmt, err := dao.SQLManager.PrepareContext(ctx, query)
+	if err != nil {
+		return nil, dao.ErrorMsg(model.DBMethodList, err)
+	}
+	defer stmt.Close()
+
+	rows, err := stmt.QueryContext(ctx, args...)
+	if err != nil {
+		return nil, dao.ErrorMsg(model.DBMethodList, err)
+	}
+	defer rows.Close()
+
+	influences := make([]*model.Influence, 0)
+	for rows.Next() {
+		influence := &model.Influence{}
+
+		err = rows.Scan(
+			&influence.LangID,
+			&influence.InfluencedByID,
+			&influence.CreatedAt,
+		)
+		if err != nil {
+			return nil, dao.ErrorMsg(model.DBMethodList, err)
+		}
+
+		influences = append(influences, influence)
+	}
+
+	return influences, nil
+}
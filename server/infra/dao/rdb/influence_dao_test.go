@@ -0,0 +1,110 @@
+package rdb_test
+
+import (
+	"context"
+	"fmt"
+	"reflect"
+	"testing"
+	"time"
+
+	"github.com/SekiguchiKai/clean-architecture-with-go/server/domain/model"
+	"github.com/SekiguchiKai/clean-architecture-with-go/server/infra/dao/rdb"
+	"gopkg.in/DATA-DOG/go-sqlmock.v1"
+)
+
+// influenceColumns は、programming_lang_influencesのカラム。
+var influenceColumns = []string{"programming_lang_id", "influenced_by_id", "created_at"}
+
+func TestInfluenceDAO_List(t *testing.T) {
+	// sqlmockの設定を行う
+	db, mock, err := sqlmock.New()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	defer db.Close()
+
+	createdAt := model.GetTestTime(time.September, 1)
+
+	tests := []struct {
+		name    string
+		rows    *sqlmock.Rows
+		want    []*model.Influence
+		wantErr bool
+	}{
+		{
+			name: "レコードが存在する場合、Influenceの一覧を返すこと",
+			rows: sqlmock.NewRows(influenceColumns).
+				AddRow(2, 1, createdAt).
+				AddRow(3, 1, createdAt),
+			want: []*model.Influence{
+				{LangID: 2, InfluencedByID: 1, CreatedAt: createdAt},
+				{LangID: 3, InfluencedByID: 1, CreatedAt: createdAt},
+			},
+		},
+		{
+			name:    "DBのエラーが発生した場合、エラーを返すこと",
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			prep := mock.ExpectPrepare("SELECT programming_lang_id, influenced_by_id, created_at FROM programming_lang_influences ORDER BY programming_lang_id, influenced_by_id")
+
+			if tt.wantErr {
+				prep.ExpectQuery().WillReturnError(fmt.Errorf(model.TestDBSomeErr))
+			} else {
+				prep.ExpectQuery().WillReturnRows(tt.rows)
+			}
+
+			dao := rdb.NewInfluenceDAO(&rdb.SQLManager{Conn: db})
+
+			got, err := dao.List(context.Background())
+			if (err != nil) != tt.wantErr {
+				t.Errorf("InfluenceDAO.List() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("InfluenceDAO.List() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestInfluenceDAO_Delete(t *testing.T) {
+	// sqlmockの設定を行う
+	db, mock, err := sqlmock.New()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	defer db.Close()
+
+	tests := []struct {
+		name    string
+		affect  int64
+		wantErr bool
+	}{
+		{
+			name:   "レコードが存在する場合、nilを返すこと",
+			affect: 1,
+		},
+		{
+			name:    "レコードが存在しない場合、エラーを返すこと",
+			affect:  0,
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mock.ExpectPrepare("DELETE FROM programming_lang_influences WHERE programming_lang_id=\\? AND influenced_by_id=\\?").
+				ExpectExec().WithArgs(3, 1).WillReturnResult(sqlmock.NewResult(0, tt.affect))
+
+			dao := rdb.NewInfluenceDAO(&rdb.SQLManager{Conn: db})
+
+			if err := dao.Delete(context.Background(), 3, 1); (err != nil) != tt.wantErr {
+				t.Errorf("InfluenceDAO.Delete() error = %v, wantErr %v", err, tt.wantErr)
+			}
+		})
+	}
+}
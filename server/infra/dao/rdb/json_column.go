@@ -0,0 +1,47 @@
+package rdb
+
+import (
+	"database/sql/driver"
+	"encoding/json"
+	"fmt"
+)
+
+// jsonColumn は、スライスをJSONとして保存するカラムを表す。
+// NULLや空のスライスは、NULLとして保存し、nilとして読み込む。
+type jsonColumn struct {
+	v interface{}
+}
+
+// Scan は、JSONとして保存された値をvに読み込む。
+func (c jsonColumn) Scan(src interface{}) error {
+	var b []byte
+	switch src := src.(type) {
+	case nil:
+		return nil
+	case []byte:
+		b = src
+	case string:
+		b = []byte(src)
+	default:
+		return fmt.Errorf("%s: %T", UnsupportedJSONColumn, src)
+	}
+
+	if len(b) == 0 {
+		return nil
+	}
+	return json.Unmarshal(b, c.v)
+}
+
+// Value は、vをJSONに変換した値を返す。
+func (c jsonColumn) Value() (driver.Value, error) {
+	b, err := json.Marshal(c.v)
+	if err != nil {
+		return nil, err
+	}
+
+	switch string(b) {
+	case "null", "[]":
+		return nil, nil
+	}
+	return string(b), nil
+}
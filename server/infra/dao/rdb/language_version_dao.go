@@ -0,0 +1,188 @@
+package rdb
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/SekiguchiKai/clean-architecture-with-go/server/domain/model"
+	"github.com/SekiguchiKai/clean-architecture-with-go/server/domain/repository"
+	"github.com/pkg/errors"
+)
+
+// languageVersionColumns は、language_versionsから取得するカラム。listでScanする順序と一致させる。
+const languageVersionColumns = "id, programming_lang_id, version, release_date, eol_date, lts, changelog_url, created_at, updated_at"
+
+// LanguageVersionDAO は、LanguageVersionのDAO。
+type LanguageVersionDAO struct {
+	SQLManager SQLManagerInterface
+}
+
+// NewLanguageVersionDAO は、LanguageVersionDAOを生成して返す。
+func NewLanguageVersionDAO(manager SQLManagerInterface) repository.LanguageVersionRepository {
+	return &LanguageVersionDAO{
+		SQLManager: manager,
+	}
+}
+
+// ErrorMsg は、エラー文を生成し、返す。
+func (dao *LanguageVersionDAO) ErrorMsg(method string, err error) error {
+	return &model.DBError{
+		ModelName: model.ModelNameLanguageVersion,
+		DBMethod:  method,
+		Detail:    err.Error(),
+	}
+}
+
+// ListByLang は、指定したProgrammingLangのレコードの一覧を取得して返す。並び順は保証しない。
+func (dao *LanguageVersionDAO) ListByLang(ctx context.Context, langID int) ([]*model.LanguageVersion, error) {
+	query := "SELECT " + languageVersionColumns + " FROM language_versions WHERE programming_lang_id=?"
+	versions, err := dao.list(ctx, query, langID)
+	if err != nil {
+		return nil, errors.WithStack(err)
+	}
+
+	return versions, nil
+}
+
+// Read は、指定したProgrammingLangとバージョンのレコードを1件取得して返す。
+func (dao *LanguageVersionDAO) Read(ctx context.Context, langID int, version string) (*model.LanguageVersion, error) {
+	query := "SELECT " + languageVersionColumns + " FROM language_versions WHERE programming_lang_id=? AND version=?"
+	versions, err := dao.list(ctx, query, langID, version)
+	if err != nil {
+		return nil, errors.WithStack(err)
+	}
+
+	if len(versions) == 0 {
+		return nil, &model.NoSuchDataError{
+			ID:        langID,
+			Name:      version,
+			ModelName: model.ModelNameLanguageVersion,
+		}
+	}
+
+	return versions[0], nil
+}
+
+// Create は、レコードを1件生成する。
+func (dao *LanguageVersionDAO) Create(ctx context.Context, v *model.LanguageVersion) (*model.LanguageVersion, error) {
+	query := "INSERT INTO language_versions (programming_lang_id, version, release_date, eol_date, lts, changelog_url, created_at, updated_at) VALUES (?, ?, ?, ?, ?, ?, ?, ?)"
+	stmt, err := dao.SQLManager.PrepareContext(ctx, query)
+	if err != nil {
+		return nil, dao.ErrorMsg(model.DBMethodCreate, err)
+	}
+	defer stmt.Close()
+
+	result, err := stmt.ExecContext(ctx, v.LangID, v.Version, v.ReleaseDate, v.EOLDate, v.LTS, v.ChangelogURL, v.CreatedAt, v.UpdatedAt)
+	if err != nil {
+		return nil, dao.ErrorMsg(model.DBMethodCreate, err)
+	}
+
+	affect, err := result.RowsAffected()
+	if err != nil {
+		return nil, dao.ErrorMsg(model.DBMethodCreate, err)
+	}
+	if affect != 1 {
+		err = fmt.Errorf("%s: %d ", TotalAffected, affect)
+		return nil, dao.ErrorMsg(model.DBMethodCreate, err)
+	}
+
+	id, err := result.LastInsertId()
+	if err != nil {
+		return nil, dao.ErrorMsg(model.DBMethodCreate, err)
+	}
+
+	v.ID = int(id)
+
+	return v, nil
+}
+
+// Update は、レコードを1件更新する。
+func (dao *LanguageVersionDAO) Update(ctx context.Context, v *model.LanguageVersion) (*model.LanguageVersion, error) {
+	query := "UPDATE language_versions SET release_date=?, eol_date=?, lts=?, changelog_url=?, updated_at=? WHERE programming_lang_id=? AND version=?"
+	stmt, err := dao.SQLManager.PrepareContext(ctx, query)
+	if err != nil {
+		return nil, dao.ErrorMsg(model.DBMethodUpdate, err)
+	}
+	defer stmt.Close()
+
+	result, err := stmt.ExecContext(ctx, v.ReleaseDate, v.EOLDate, v.LTS, v.ChangelogURL, v.UpdatedAt, v.LangID, v.Version)
+	if err != nil {
+		return nil, dao.ErrorMsg(model.DBMethodUpdate, err)
+	}
+
+	affect, err := result.RowsAffected()
+	if err != nil {
+		return nil, dao.ErrorMsg(model.DBMethodUpdate, err)
+	}
+	if affect != 1 {
+		err = fmt.Errorf("%s: %d ", TotalAffected, affect)
+		return nil, dao.ErrorMsg(model.DBMethodUpdate, err)
+	}
+
+	return v, nil
+}
+
+// Delete は、レコードを1件削除する。
+func (dao *LanguageVersionDAO) Delete(ctx context.Context, langID int, version string) error {
+	query := "DELETE FROM language_versions WHERE programming_lang_id=? AND version=?"
+	stmt, err := dao.SQLManager.PrepareContext(ctx, query)
+	if err != nil {
+		return dao.ErrorMsg(model.DBMethodDelete, err)
+	}
+	defer stmt.Close()
+
+	result, err := stmt.ExecContext(ctx, langID, version)
+	if err != nil {
+		return dao.ErrorMsg(model.DBMethodDelete, err)
+	}
+
+	affect, err := result.RowsAffected()
+	if err != nil {
+		return dao.ErrorMsg(model.DBMethodDelete, err)
+	}
+	if affect != 1 {
+		err = fmt.Errorf("%s: %d ", TotalAffected, affect)
+		return dao.ErrorMsg(model.DBMethodDelete, err)
+	}
+
+	return nil
+}
+
+// list は、レコードの一覧を取得して返す。
+func (dao *LanguageVersionDAO) list(ctx context.Context, query string, args ...interface{}) ([]*model.LanguageVersion, error) {
+	stmt, err := dao.SQLManager.PrepareContext(ctx, query)
+	if err != nil {
+		return nil, dao.ErrorMsg(model.DBMethodList, err)
+	}
+	defer stmt.Close()
+
+	rows, err := stmt.QueryContext(ctx, args...)
+	if err != nil {
+		return nil, dao.ErrorMsg(model.DBMethodList, err)
+	}
+	defer rows.Close()
+
+	versions := make([]*model.LanguageVersion, 0)
+	for rows.Next() {
+		v := &model.LanguageVersion{}
+
+		err = rows.Scan(
+			&v.ID,
+			&v.LangID,
+			&v.Version,
+			&v.ReleaseDate,
+			&v.EOLDate,
+			&v.LTS,
+			&v.ChangelogURL,
+			&v.CreatedAt,
+			&v.UpdatedAt,
+		)
+		if err != nil {
+			return nil, dao.ErrorMsg(model.DBMethodList, err)
+		}
+
+		versions = append(versions, v)
+	}
+
+	return versions, nil
+}
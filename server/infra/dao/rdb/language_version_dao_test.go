@@ -0,0 +1,240 @@
+package rdb_test
+
+import (
+	"context"
+	"fmt"
+	"reflect"
+	"testing"
+	"time"
+
+	"github.com/SekiguchiKai/clean-architecture-with-go/server/domain/model"
+	"github.com/SekiguchiKai/clean-architecture-with-go/server/infra/dao/rdb"
+	"gopkg.in/DATA-DOG/go-sqlmock.v1"
+)
+
+// selectVersions は、language_versionsのカラムを全て取得するSELECT句。
+const selectVersions = "SELECT id, programming_lang_id, version, release_date, eol_date, lts, changelog_url, created_at, updated_at"
+
+// versionColumns は、language_versionsのカラム。
+var versionColumns = []string{"id", "programming_lang_id", "version", "release_date", "eol_date", "lts", "changelog_url", "created_at", "updated_at"}
+
+func TestLanguageVersionDAO_ListByLang(t *testing.T) {
+	// sqlmockの設定を行う
+	db, mock, err := sqlmock.New()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	defer db.Close()
+
+	versions := model.CreateLanguageVersions(1, 2)
+	eol := time.Date(2019, time.January, 1, 0, 0, 0, 0, time.UTC)
+	versions[0].EOLDate = &eol
+	versions[0].LTS = true
+	versions[0].ChangelogURL = "https://example.com/1.0.0"
+
+	tests := []struct {
+		name    string
+		rows    *sqlmock.Rows
+		want    []*model.LanguageVersion
+		wantErr bool
+	}{
+		{
+			name: "レコードが存在する場合、EOLDateがNULLのものも含めて返すこと",
+			rows: sqlmock.NewRows(versionColumns).
+				AddRow(versions[0].ID, versions[0].LangID, versions[0].Version, versions[0].ReleaseDate, eol, true, versions[0].ChangelogURL, versions[0].CreatedAt, versions[0].UpdatedAt).
+				AddRow(versions[1].ID, versions[1].LangID, versions[1].Version, versions[1].ReleaseDate, nil, false, "", versions[1].CreatedAt, versions[1].UpdatedAt),
+			want: versions,
+		},
+		{
+			name: "レコードが存在しない場合、空のスライスを返すこと",
+			rows: sqlmock.NewRows(versionColumns),
+			want: []*model.LanguageVersion{},
+		},
+		{
+			name:    "DBのエラーが発生した場合、エラーを返すこと",
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			prep := mock.ExpectPrepare(selectVersions + " FROM language_versions WHERE programming_lang_id=\\?")
+
+			if tt.wantErr {
+				prep.ExpectQuery().WithArgs(1).WillReturnError(fmt.Errorf(model.TestDBSomeErr))
+			} else {
+				prep.ExpectQuery().WithArgs(1).WillReturnRows(tt.rows)
+			}
+
+			dao := rdb.NewLanguageVersionDAO(&rdb.SQLManager{Conn: db})
+
+			got, err := dao.ListByLang(context.Background(), 1)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("LanguageVersionDAO.ListByLang() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("LanguageVersionDAO.ListByLang() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestLanguageVersionDAO_Read(t *testing.T) {
+	// sqlmockの設定を行う
+	db, mock, err := sqlmock.New()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	defer db.Close()
+
+	v := model.CreateLanguageVersions(1, 1)[0]
+
+	tests := []struct {
+		name       string
+		rows       *sqlmock.Rows
+		want       *model.LanguageVersion
+		wantNoData bool
+	}{
+		{
+			name: "指定したバージョンが存在する場合、LanguageVersionを返すこと",
+			rows: sqlmock.NewRows(versionColumns).
+				AddRow(v.ID, v.LangID, v.Version, v.ReleaseDate, nil, false, "", v.CreatedAt, v.UpdatedAt),
+			want: v,
+		},
+		{
+			name:       "指定したバージョンが存在しない場合、NoSuchDataErrorを返すこと",
+			rows:       sqlmock.NewRows(versionColumns),
+			wantNoData: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			prep := mock.ExpectPrepare(selectVersions + " FROM language_versions WHERE programming_lang_id=\\? AND version=\\?")
+			prep.ExpectQuery().WithArgs(1, model.TestVersion).WillReturnRows(tt.rows)
+
+			dao := rdb.NewLanguageVersionDAO(&rdb.SQLManager{Conn: db})
+
+			got, err := dao.Read(context.Background(), 1, model.TestVersion)
+			if _, ok := err.(*model.NoSuchDataError); ok != tt.wantNoData {
+				t.Errorf("LanguageVersionDAO.Read() error = %v, want NoSuchDataError %v", err, tt.wantNoData)
+			}
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("LanguageVersionDAO.Read() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestLanguageVersionDAO_Create(t *testing.T) {
+	// sqlmockの設定を行う
+	db, mock, err := sqlmock.New()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	defer db.Close()
+
+	tests := []struct {
+		name        string
+		rowAffected int64
+		wantErr     bool
+	}{
+		{
+			name:        "LanguageVersionを与えられた場合、IDを付与したLanguageVersionを返すこと",
+			rowAffected: 1,
+			wantErr:     false,
+		},
+		{
+			name:        "RowAffectedが1以外の場合、エラーを返すこと",
+			rowAffected: 0,
+			wantErr:     true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			v := model.CreateLanguageVersions(1, 1)[0]
+			v.ID = 0
+
+			prep := mock.ExpectPrepare("INSERT INTO language_versions")
+			prep.ExpectExec().
+				WithArgs(v.LangID, v.Version, v.ReleaseDate, nil, false, "", v.CreatedAt, v.UpdatedAt).
+				WillReturnResult(sqlmock.NewResult(3, tt.rowAffected))
+
+			dao := rdb.NewLanguageVersionDAO(&rdb.SQLManager{Conn: db})
+
+			got, err := dao.Create(context.Background(), v)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("LanguageVersionDAO.Create() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+			if !tt.wantErr && got.ID != 3 {
+				t.Errorf("LanguageVersionDAO.Create() ID = %v, want %v", got.ID, 3)
+			}
+		})
+	}
+}
+
+func TestLanguageVersionDAO_UpdateAndDelete(t *testing.T) {
+	// sqlmockの設定を行う
+	db, mock, err := sqlmock.New()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	defer db.Close()
+
+	v := model.CreateLanguageVersions(1, 1)[0]
+	eol := time.Date(2019, time.January, 1, 0, 0, 0, 0, time.UTC)
+	v.EOLDate = &eol
+
+	tests := []struct {
+		name        string
+		query       string
+		exec        func(dao *rdb.LanguageVersionDAO) error
+		rowAffected int64
+		wantErr     bool
+	}{
+		{
+			name:  "Updateの場合、ProgrammingLangとバージョンで指定したレコードを更新すること",
+			query: "UPDATE language_versions SET release_date=\\?, eol_date=\\?, lts=\\?, changelog_url=\\?, updated_at=\\? WHERE programming_lang_id=\\? AND version=\\?",
+			exec: func(dao *rdb.LanguageVersionDAO) error {
+				_, err := dao.Update(context.Background(), v)
+				return err
+			},
+			rowAffected: 1,
+		},
+		{
+			name:  "Deleteの場合、ProgrammingLangとバージョンで指定したレコードを削除すること",
+			query: "DELETE FROM language_versions WHERE programming_lang_id=\\? AND version=\\?",
+			exec: func(dao *rdb.LanguageVersionDAO) error {
+				return dao.Delete(context.Background(), v.LangID, v.Version)
+			},
+			rowAffected: 1,
+		},
+		{
+			name:  "Deleteで対象のレコードが存在しない場合、エラーを返すこと",
+			query: "DELETE FROM language_versions WHERE programming_lang_id=\\? AND version=\\?",
+			exec: func(dao *rdb.LanguageVersionDAO) error {
+				return dao.Delete(context.Background(), v.LangID, v.Version)
+			},
+			rowAffected: 0,
+			wantErr:     true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mock.ExpectPrepare(tt.query).ExpectExec().WillReturnResult(sqlmock.NewResult(0, tt.rowAffected))
+
+			dao := rdb.NewLanguageVersionDAO(&rdb.SQLManager{Conn: db}).(*rdb.LanguageVersionDAO)
+
+			if err := tt.exec(dao); (err != nil) != tt.wantErr {
+				t.Errorf("error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if err := mock.ExpectationsWereMet(); err != nil {
+				t.Error(err)
+			}
+		})
+	}
+}
@@ -0,0 +1,152 @@
+package rdb
+
+import (
+	"context"
+	"time"
+
+	"github.com/SekiguchiKai/clean-architecture-with-go/server/domain/model"
+	"github.com/SekiguchiKai/clean-architecture-with-go/server/domain/repository"
+	"github.com/pkg/errors"
+)
+
+// outboxColumns は、outbox_eventsから取得するカラム。listでScanする順序と一致させる。
+const outboxColumns = "id, event_name, aggregate_id, payload, published, attempts, next_attempt_at, last_error, created_at"
+
+// OutboxDAO は、OutboxEventのDAO。
+type OutboxDAO struct {
+	SQLManager SQLManagerInterface
+}
+
+// NewOutboxDAO は、OutboxDAOを生成して返す。
+func NewOutboxDAO(manager SQLManagerInterface) repository.OutboxRepository {
+	return &OutboxDAO{
+		SQLManager: manager,
+	}
+}
+
+// ErrorMsg は、エラー文を生成し、返す。
+func (dao *OutboxDAO) ErrorMsg(method string, err error) error {
+	return &model.DBError{
+		ModelName: model.ModelNameOutboxEvent,
+		DBMethod:  method,
+		Detail:    err.Error(),
+	}
+}
+
+// Create は、レコードを1件生成する。変更と同じトランザクションで記録するため、ctxには変更と同じものを渡す。
+func (dao *OutboxDAO) Create(ctx context.Context, event *model.OutboxEvent) (*model.OutboxEvent, error) {
+	query := "INSERT INTO outbox_events (event_name, aggregate_id, payload, published, attempts, next_attempt_at, last_error, created_at) VALUES (?, ?, ?, ?, ?, ?, ?, ?)"
+	result, err := execStmt(ctx, dao.SQLManager, dao.ErrorMsg, model.DBMethodCreate, query, event.Name, event.AggregateID, string(event.Payload), jsonColumn{&event.Published}, event.Attempts, event.NextAttemptAt, event.LastError, event.CreatedAt)
+	if err != nil {
+		return nil, errors.WithStack(err)
+	}
+
+	if err := checkAffected(dao.ErrorMsg, model.DBMethodCreate, result, 1); err != nil {
+		return nil, errors.WithStack(err)
+	}
+
+	id, err := result.LastInsertId()
+	if err != nil {
+		return nil, dao.ErrorMsg(model.DBMethodCreate, err)
+	}
+
+	event.ID = int(id)
+
+	return event, nil
+}
+
+// ListDue は、配信予定の日時がnow以前のレコードの一覧を、記録した順に取得して返す。
+// 同じProgrammingLangの領域イベントを記録した順に配信するため、先に記録したレコードが残っているレコードは取得しない。
+func (dao *OutboxDAO) ListDue(ctx context.Context, now time.Time, limit int) ([]*model.OutboxEvent, error) {
+	query := "SELECT " + outboxColumns + " FROM outbox_events WHERE next_attempt_at<=? AND NOT EXISTS (SELECT 1 FROM outbox_events AS earlier WHERE earlier.aggregate_id=outbox_events.aggregate_id AND earlier.id<outbox_events.id) ORDER BY id LIMIT ?"
+	events, err := dao.list(ctx, query, now, limit)
+	if err != nil {
+		return nil, errors.WithStack(err)
+	}
+
+	return events, nil
+}
+
+// Claim は、レコードの配信予定の日時をuntilまで延ばし、配信する権利を得る。
+// 他のプロセスが先に配信予定の日時を変更していた場合や、既に配信を終えて削除されていた場合は、falseを返す。
+func (dao *OutboxDAO) Claim(ctx context.Context, event *model.OutboxEvent, until time.Time) (bool, error) {
+	query := "UPDATE outbox_events SET next_attempt_at=? WHERE id=? AND next_attempt_at=?"
+	result, err := execStmt(ctx, dao.SQLManager, dao.ErrorMsg, model.DBMethodClaim, query, until, event.ID, event.NextAttemptAt)
+	if err != nil {
+		return false, errors.WithStack(err)
+	}
+
+	affect, err := result.RowsAffected()
+	if err != nil {
+		return false, dao.ErrorMsg(model.DBMethodClaim, err)
+	}
+
+	return affect == 1, nil
+}
+
+// Update は、レコードの配信の状態を1件更新する。
+func (dao *OutboxDAO) Update(ctx context.Context, event *model.OutboxEvent) (*model.OutboxEvent, error) {
+	query := "UPDATE outbox_events SET published=?, attempts=?, next_attempt_at=?, last_error=? WHERE id=?"
+	result, err := execStmt(ctx, dao.SQLManager, dao.ErrorMsg, model.DBMethodUpdate, query, jsonColumn{&event.Published}, event.Attempts, event.NextAttemptAt, event.LastError, event.ID)
+	if err != nil {
+		return nil, errors.WithStack(err)
+	}
+
+	if err := checkAffected(dao.ErrorMsg, model.DBMethodUpdate, result, 1); err != nil {
+		return nil, errors.WithStack(err)
+	}
+
+	return event, nil
+}
+
+// Delete は、配信を終えたレコードを1件削除する。
+func (dao *OutboxDAO) Delete(ctx context.Context, id int) error {
+	query := "DELETE FROM outbox_events WHERE id=?"
+	result, err := execStmt(ctx, dao.SQLManager, dao.ErrorMsg, model.DBMethodDelete, query, id)
+	if err != nil {
+		return errors.WithStack(err)
+	}
+
+	return errors.WithStack(checkAffected(dao.ErrorMsg, model.DBMethodDelete, result, 1))
+}
+
+// list は、レコードの一覧を取得して返す。
+func (dao *OutboxDAO) list(ctx context.Context, query string, args ...interface{}) ([]*model.OutboxEvent, error) {
+	stmt, err := dao.SQLManager.PrepareContext(ctx, query)
+	if err != nil {
+		return nil, dao.ErrorMsg(model.DBMethodList, err)
+	}
+	defer stmt.Close()
+
+	rows, err := stmt.QueryContext(ctx, args...)
+	if err != nil {
+		return nil, dao.ErrorMsg(model.DBMethodList, err)
+	}
+	defer rows.Close()
+
+	events := make([]*model.OutboxEvent, 0)
+	for rows.Next() {
+		event := &model.OutboxEvent{}
+		var payload []byte
+
+		err = rows.Scan(
+			&event.ID,
+			&event.Name,
+			&event.AggregateID,
+			&payload,
+			jsonColumn{&event.Published},
+			&event.Attempts,
+			&event.NextAttemptAt,
+			&event.LastError,
+			&event.CreatedAt,
+		)
+		if err != nil {
+			return nil, dao.ErrorMsg(model.DBMethodList, err)
+		}
+
+		event.Payload = payload
+		events = append(events, event)
+	}
+
+	return events, nil
+}
@@ -0,0 +1,85 @@
+package rdb_test
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"reflect"
+	"testing"
+	"time"
+
+	"github.com/SekiguchiKai/clean-architecture-with-go/server/domain/model"
+	"github.com/SekiguchiKai/clean-architecture-with-go/server/infra/dao/rdb"
+	"gopkg.in/DATA-DOG/go-sqlmock.v1"
+)
+
+// outboxColumns は、outbox_eventsのカラム。
+var outboxColumns = []string{"id", "event_name", "aggregate_id", "payload", "published", "attempts", "next_attempt_at", "last_error", "created_at"}
+
+func TestOutboxDAO_ListDue(t *testing.T) {
+	// sqlmockの設定を行う
+	db, mock, err := sqlmock.New()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	defer db.Close()
+
+	now := model.GetTestTime(time.September, 3)
+	event := &model.OutboxEvent{
+		ID:            1,
+		Name:          model.DomainEventLangUpdated,
+		AggregateID:   2,
+		Payload:       json.RawMessage(`{"type":"updated"}`),
+		Published:     []string{"log"},
+		Attempts:      1,
+		NextAttemptAt: model.GetTestTime(time.September, 2),
+		LastError:     "connection refused",
+		CreatedAt:     model.GetTestTime(time.September, 1),
+	}
+
+	tests := []struct {
+		name    string
+		rows    *sqlmock.Rows
+		want    []*model.OutboxEvent
+		wantErr bool
+	}{
+		{
+			name: "配信予定の日時を過ぎたイベントが存在する場合、イベントの一覧を返すこと",
+			rows: sqlmock.NewRows(outboxColumns).AddRow(
+				event.ID, event.Name, event.AggregateID, []byte(event.Payload), `["log"]`, event.Attempts, event.NextAttemptAt, event.LastError, event.CreatedAt),
+			want: []*model.OutboxEvent{event},
+		},
+		{
+			name: "配信予定の日時を過ぎたイベントが存在しない場合、空のスライスを返すこと",
+			rows: sqlmock.NewRows(outboxColumns),
+			want: []*model.OutboxEvent{},
+		},
+		{
+			name:    "DBのエラーが発生した場合、エラーを返すこと",
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			prep := mock.ExpectPrepare("SELECT id, event_name, aggregate_id, payload, published, attempts, next_attempt_at, last_error, created_at FROM outbox_events WHERE next_attempt_at<=\\? AND NOT EXISTS \\(SELECT 1 FROM outbox_events AS earlier WHERE earlier.aggregate_id=outbox_events.aggregate_id AND earlier.id<outbox_events.id\\) ORDER BY id LIMIT \\?")
+
+			if tt.wantErr {
+				prep.ExpectQuery().WithArgs(now, 10).WillReturnError(fmt.Errorf(model.TestDBSomeErr))
+			} else {
+				prep.ExpectQuery().WithArgs(now, 10).WillReturnRows(tt.rows)
+			}
+
+			dao := rdb.NewOutboxDAO(&rdb.SQLManager{Conn: db})
+
+			got, err := dao.ListDue(context.Background(), now, 10)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("OutboxDAO.ListDue() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("OutboxDAO.ListDue() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
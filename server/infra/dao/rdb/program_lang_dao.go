@@ -3,12 +3,19 @@ package rdb
 import (
 	"context"
 	"fmt"
+	"strings"
+	"time"
 
 	"github.com/SekiguchiKai/clean-architecture-with-go/server/domain/model"
 	"github.com/SekiguchiKai/clean-architecture-with-go/server/domain/repository"
+	"github.com/SekiguchiKai/clean-architecture-with-go/server/domain/service"
 	"github.com/pkg/errors"
 )
 
+// programmingLangColumns は、programming_langsから取得するカラム。listでScanする順序と一致させる。
+// 別名はprogramming_lang_aliasesから取得するため、含まない。
+const programmingLangColumns = "id, name, feature, slug, first_appeared, designers, type_checking, type_strength, paradigms, license, website, extensions, filenames, interpreters, color, stable_version, created_at, updated_at"
+
 // ProgrammingLangDAO は、ProgrammingLangのDAO。
 type ProgrammingLangDAO struct {
 	SQLManager SQLManagerInterface
@@ -16,8 +23,6 @@ type ProgrammingLangDAO struct {
 
 // NewProgrammingLangDAO は、ProgrammingLangDAO生成して返す。
 func NewProgrammingLangDAO(manager SQLManagerInterface) repository.ProgrammingLangRepository {
-	fmt.Printf("NewProgrammingLangDAO")
-
 	return &ProgrammingLangDAO{
 		SQLManager: manager,
 	}
@@ -32,17 +37,30 @@ func (dao *ProgrammingLangDAO) ErrorMsg(method string, err error) error {
 	}
 }
 
-// Create は、レコードを1件生成する。
+// alreadyExist は、一意制約に違反したNameもしくは別名のAlreadyExistErrorを返す。
+// 使用中かどうかの確認と保存の間に他のリクエストが同じ名前を保存した場合に、DBErrorではなく既に存在することを返すために使用する。
+func (dao *ProgrammingLangDAO) alreadyExist(name string) error {
+	return &model.AlreadyExistError{
+		Name:      name,
+		ModelName: model.ModelNameProgrammingLang,
+	}
+}
+
+// Create は、レコードを1件生成する。Nameや別名が一意制約に違反した場合は、AlreadyExistErrorを返す。
 func (dao *ProgrammingLangDAO) Create(ctx context.Context, lang *model.ProgrammingLang) (*model.ProgrammingLang, error) {
-	query := "INSERT INTO programming_langs (name, feature, created_at, updated_at) VALUES (?, ?, ?, ?)"
+	query := "INSERT INTO programming_langs (name, name_key, feature, slug, first_appeared, designers, type_checking, type_strength, paradigms, license, website, extensions, filenames, interpreters, color, stable_version, created_at, updated_at) VALUES (?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?)"
 	stmt, err := dao.SQLManager.PrepareContext(ctx, query)
 	if err != nil {
 		return nil, dao.ErrorMsg(model.DBMethodCreate, err)
 	}
 	defer stmt.Close()
 
-	result, err := stmt.ExecContext(ctx, lang.Name, lang.Feature, lang.CreatedAt, lang.UpdatedAt)
+	args := append(programmingLangValues(lang), lang.CreatedAt, lang.UpdatedAt)
+	result, err := stmt.ExecContext(ctx, args...)
 	if err != nil {
+		if isDuplicateEntry(err) {
+			return nil, dao.alreadyExist(lang.Name)
+		}
 		return nil, dao.ErrorMsg(model.DBMethodCreate, err)
 	}
 
@@ -59,12 +77,16 @@ func (dao *ProgrammingLangDAO) Create(ctx context.Context, lang *model.Programmi
 
 	lang.ID = int(id)
 
+	if err := dao.insertAliases(ctx, lang.ID, lang.Aliases); err != nil {
+		return nil, errors.WithStack(err)
+	}
+
 	return lang, nil
 }
 
 // List は、レコードの一覧を取得して返す。
 func (dao *ProgrammingLangDAO) List(ctx context.Context, limit int) ([]*model.ProgrammingLang, error) {
-	query := "SELECT id, name, feature, created_at, updated_at FROM programming_langs ORDER BY name LIMIT ?"
+	query := "SELECT " + programmingLangColumns + " FROM programming_langs ORDER BY name LIMIT ?"
 	langSlice, err :=   dao.list(ctx, query, limit)
 
 	if len(langSlice) == 0 {
@@ -80,9 +102,75 @@ func (dao *ProgrammingLangDAO) List(ctx context.Context, limit int) ([]*model.Pr
 	return langSlice, nil
 }
 
+// ListByFilter は、条件に一致するレコードの一覧を取得して返す。一致するレコードが存在しない場合は、空のスライスを返す。
+func (dao *ProgrammingLangDAO) ListByFilter(ctx context.Context, filter *model.ProgrammingLangFilter) ([]*model.ProgrammingLang, error) {
+	conditions := make([]string, 0, 2)
+	args := make([]interface{}, 0, 3)
+
+	if filter.After != "" {
+		conditions = append(conditions, "name > ?")
+		args = append(args, filter.After)
+	}
+	if filter.NameContains != "" {
+		conditions = append(conditions, "name LIKE ?")
+		args = append(args, "%"+escapeLike(filter.NameContains)+"%")
+	}
+	if tags := uniqueStrings(filter.Tags); len(tags) > 0 {
+		placeholders := make([]string, len(tags))
+		for i, tag := range tags {
+			placeholders[i] = "?"
+			args = append(args, tag)
+		}
+
+		sub := fmt.Sprintf("SELECT lt.programming_lang_id FROM programming_lang_tags lt INNER JOIN tags t ON t.id=lt.tag_id WHERE t.name IN (%s)", strings.Join(placeholders, ", "))
+		if filter.TagMatch != model.TagMatchAny {
+			// 全てのタグを持つものに絞るため、一致したタグの数を数える
+			sub += " GROUP BY lt.programming_lang_id HAVING COUNT(DISTINCT t.id)=?"
+			args = append(args, len(tags))
+		}
+		conditions = append(conditions, "id IN ("+sub+")")
+	}
+
+	query := "SELECT " + programmingLangColumns + " FROM programming_langs"
+	if len(conditions) > 0 {
+		query += " WHERE " + strings.Join(conditions, " AND ")
+	}
+	query += " ORDER BY name LIMIT ?"
+	args = append(args, filter.Limit)
+
+	langSlice, err := dao.list(ctx, query, args...)
+	if err != nil {
+		return nil, errors.WithStack(err)
+	}
+
+	return langSlice, nil
+}
+
+// ListByIDs は、IDで指定したレコードをまとめて取得して返す。存在しないIDは結果に含まれない。
+func (dao *ProgrammingLangDAO) ListByIDs(ctx context.Context, ids []int) ([]*model.ProgrammingLang, error) {
+	if len(ids) == 0 {
+		return make([]*model.ProgrammingLang, 0), nil
+	}
+
+	placeholders := make([]string, len(ids))
+	args := make([]interface{}, len(ids))
+	for i, id := range ids {
+		placeholders[i] = "?"
+		args[i] = id
+	}
+
+	query := fmt.Sprintf("SELECT %s FROM programming_langs WHERE id IN (%s)", programmingLangColumns, strings.Join(placeholders, ", "))
+	langSlice, err := dao.list(ctx, query, args...)
+	if err != nil {
+		return nil, errors.WithStack(err)
+	}
+
+	return langSlice, nil
+}
+
 // Read は、レコードを1件取得して返す。
 func (dao *ProgrammingLangDAO) Read(ctx context.Context, id int) (*model.ProgrammingLang, error) {
-	query := "SELECT id, name, feature, created_at, updated_at FROM programming_langs WHERE ID=?"
+	query := "SELECT " + programmingLangColumns + " FROM programming_langs WHERE ID=?"
 
 	langSlice, err :=  dao.list(ctx, query, id)
 
@@ -100,10 +188,12 @@ func (dao *ProgrammingLangDAO) Read(ctx context.Context, id int) (*model.Program
 	return langSlice[0], nil
 }
 
-// ReadByName は、指定したNameを保持するレコードを1返す。
+// ReadByName は、指定したNameもしくは別名を保持するレコードを1返す。
+// 大文字と小文字、全角と半角の違いは区別せず、Nameと別名の両方に一致する場合はNameに一致するレコードを返す。
 func (dao *ProgrammingLangDAO) ReadByName(ctx context.Context, name string) (*model.ProgrammingLang, error) {
-	query := "SELECT id, name, feature, created_at, updated_at FROM programming_langs WHERE name=? ORDER BY name LIMIT ?"
-	langSlice, err := dao.list(ctx, query, name, 1)
+	query := "SELECT " + programmingLangColumns + " FROM programming_langs WHERE name_key=? OR id IN (SELECT programming_lang_id FROM programming_lang_aliases WHERE alias_key=?) ORDER BY name_key=? DESC, name LIMIT ?"
+	key := service.NameKey(name)
+	langSlice, err := dao.list(ctx, query, key, key, key, 1)
 
 	if len(langSlice) == 0 {
 		return nil, &model.NoSuchDataError{
@@ -119,6 +209,59 @@ func (dao *ProgrammingLangDAO) ReadByName(ctx context.Context, name string) (*mo
 	return langSlice[0], nil
 }
 
+// ReadBySlug は、指定したslugを保持するレコードを1件返す。
+func (dao *ProgrammingLangDAO) ReadBySlug(ctx context.Context, slug string) (*model.ProgrammingLang, error) {
+	query := "SELECT " + programmingLangColumns + " FROM programming_langs WHERE slug=?"
+	langSlice, err := dao.list(ctx, query, slug)
+	if err != nil {
+		return nil, errors.WithStack(err)
+	}
+
+	if len(langSlice) == 0 {
+		return nil, &model.NoSuchDataError{
+			Name:      slug,
+			ModelName: model.ModelNameProgrammingLang,
+		}
+	}
+
+	return langSlice[0], nil
+}
+
+// ReadByPreviousSlug は、変更前のslugとして指定したslugを保持していたレコードを1件返す。
+func (dao *ProgrammingLangDAO) ReadByPreviousSlug(ctx context.Context, slug string) (*model.ProgrammingLang, error) {
+	query := "SELECT " + programmingLangColumns + " FROM programming_langs WHERE id=(SELECT programming_lang_id FROM programming_lang_slug_histories WHERE slug=?)"
+	langSlice, err := dao.list(ctx, query, slug)
+	if err != nil {
+		return nil, errors.WithStack(err)
+	}
+
+	if len(langSlice) == 0 {
+		return nil, &model.NoSuchDataError{
+			Name:      slug,
+			ModelName: model.ModelNameProgrammingLang,
+		}
+	}
+
+	return langSlice[0], nil
+}
+
+// CreateSlugHistory は、変更前のslugを記録する。既に記録されている場合は、指定したIDのレコードのものとして記録し直す。
+func (dao *ProgrammingLangDAO) CreateSlugHistory(ctx context.Context, id int, slug string) error {
+	query := "INSERT INTO programming_lang_slug_histories (slug, programming_lang_id, created_at) VALUES (?, ?, ?) ON DUPLICATE KEY UPDATE programming_lang_id=VALUES(programming_lang_id)"
+
+	stmt, err := dao.SQLManager.PrepareContext(ctx, query)
+	if err != nil {
+		return dao.ErrorMsg(model.DBMethodSlugHistory, err)
+	}
+	defer stmt.Close()
+
+	if _, err := stmt.ExecContext(ctx, slug, id, time.Now().UTC()); err != nil {
+		return dao.ErrorMsg(model.DBMethodSlugHistory, err)
+	}
+
+	return nil
+}
+
 // list は、レコードの一覧を取得して返す。
 func (dao *ProgrammingLangDAO) list(ctx context.Context, query string, args ...interface{}) ([]*model.ProgrammingLang, error) {
 	stmt, err := dao.SQLManager.PrepareContext(ctx, query)
@@ -141,6 +284,19 @@ func (dao *ProgrammingLangDAO) list(ctx context.Context, query string, args ...i
 			&lang.ID,
 			&lang.Name,
 			&lang.Feature,
+			&lang.Slug,
+			&lang.FirstAppeared,
+			jsonColumn{&lang.Designers},
+			&lang.TypeChecking,
+			&lang.TypeStrength,
+			jsonColumn{&lang.Paradigms},
+			&lang.License,
+			&lang.Website,
+			jsonColumn{&lang.Extensions},
+			jsonColumn{&lang.Filenames},
+			jsonColumn{&lang.Interpreters},
+			&lang.Color,
+			&lang.StableVersion,
 			&lang.CreatedAt,
 			&lang.UpdatedAt,
 		)
@@ -151,13 +307,70 @@ func (dao *ProgrammingLangDAO) list(ctx context.Context, query string, args ...i
 
 		langSlice = append(langSlice, lang)
 	}
+	if err := rows.Err(); err != nil {
+		return nil, dao.ErrorMsg(model.DBMethodList, err)
+	}
+	// トランザクションの接続で別名を取得できるよう、先に結果を閉じる。
+	rows.Close()
+
+	if err := dao.readAliases(ctx, langSlice); err != nil {
+		return nil, errors.WithStack(err)
+	}
 
 	return langSlice, nil
 }
 
-// Update は、レコードを1件更新する。
+// readAliases は、ProgrammingLangの別名をprogramming_lang_aliasesから取得し、記録した順にAliasesに設定する。
+func (dao *ProgrammingLangDAO) readAliases(ctx context.Context, langSlice []*model.ProgrammingLang) error {
+	if len(langSlice) == 0 {
+		return nil
+	}
+
+	langs := make(map[int]*model.ProgrammingLang, len(langSlice))
+	placeholders := make([]string, 0, len(langSlice))
+	args := make([]interface{}, 0, len(langSlice))
+	for _, lang := range langSlice {
+		if _, ok := langs[lang.ID]; ok {
+			continue
+		}
+		langs[lang.ID] = lang
+		placeholders = append(placeholders, "?")
+		args = append(args, lang.ID)
+	}
+	query := "SELECT programming_lang_id, alias FROM programming_lang_aliases WHERE programming_lang_id IN (" + strings.Join(placeholders, ", ") + ") ORDER BY programming_lang_id, position, alias_key"
+
+	stmt, err := dao.SQLManager.PrepareContext(ctx, query)
+	if err != nil {
+		return dao.ErrorMsg(model.DBMethodAlias, err)
+	}
+	defer stmt.Close()
+
+	rows, err := stmt.QueryContext(ctx, args...)
+	if err != nil {
+		return dao.ErrorMsg(model.DBMethodAlias, err)
+	}
+	defer rows.Close()
+
+	for rows.Next() {
+		var id int
+		var alias string
+		if err := rows.Scan(&id, &alias); err != nil {
+			return dao.ErrorMsg(model.DBMethodAlias, err)
+		}
+		if lang, ok := langs[id]; ok {
+			lang.Aliases = append(lang.Aliases, alias)
+		}
+	}
+	if err := rows.Err(); err != nil {
+		return dao.ErrorMsg(model.DBMethodAlias, err)
+	}
+
+	return nil
+}
+
+// Update は、レコードを1件更新する。Nameや別名が一意制約に違反した場合は、AlreadyExistErrorを返す。
 func (dao *ProgrammingLangDAO) Update(ctx context.Context, lang *model.ProgrammingLang) (*model.ProgrammingLang, error) {
-	query := "UPDATE programming_langs SET name=?, feature=?, created_at=?, updated_at=? WHERE id=?"
+	query := "UPDATE programming_langs SET name=?, name_key=?, feature=?, slug=?, first_appeared=?, designers=?, type_checking=?, type_strength=?, paradigms=?, license=?, website=?, extensions=?, filenames=?, interpreters=?, color=?, stable_version=?, created_at=?, updated_at=? WHERE id=?"
 
 	stmt, err := dao.SQLManager.PrepareContext(ctx, query)
 	defer stmt.Close()
@@ -166,8 +379,12 @@ func (dao *ProgrammingLangDAO) Update(ctx context.Context, lang *model.Programmi
 		return nil, dao.ErrorMsg(model.DBMethodUpdate, err)
 	}
 
-	result, err := stmt.ExecContext(ctx, lang.Name, lang.Feature, lang.CreatedAt, lang.UpdatedAt, lang.ID)
+	args := append(programmingLangValues(lang), lang.CreatedAt, lang.UpdatedAt, lang.ID)
+	result, err := stmt.ExecContext(ctx, args...)
 	if err != nil {
+		if isDuplicateEntry(err) {
+			return nil, dao.alreadyExist(lang.Name)
+		}
 		return nil, dao.ErrorMsg(model.DBMethodUpdate, err)
 	}
 
@@ -177,6 +394,13 @@ func (dao *ProgrammingLangDAO) Update(ctx context.Context, lang *model.Programmi
 		return nil, dao.ErrorMsg(model.DBMethodUpdate, err)
 	}
 
+	if err := dao.deleteAliases(ctx, lang.ID); err != nil {
+		return nil, errors.WithStack(err)
+	}
+	if err := dao.insertAliases(ctx, lang.ID, lang.Aliases); err != nil {
+		return nil, errors.WithStack(err)
+	}
+
 	return lang, nil
 }
 
@@ -204,5 +428,143 @@ func (dao *ProgrammingLangDAO) Delete(ctx context.Context, id int) error {
 		return dao.ErrorMsg(model.DBMethodDelete, err)
 	}
 
+	return dao.recordDeletion(ctx, time.Now().UTC())
+}
+
+// recordDeletion は、一覧の最終更新日時に削除を反映するため、最後に削除した日時を記録する。
+// 削除したレコードのupdated_atは残らないため、MAX(updated_at)だけでは削除を検知できない。
+func (dao *ProgrammingLangDAO) recordDeletion(ctx context.Context, deletedAt time.Time) error {
+	query := "INSERT INTO programming_lang_collection (id, last_deleted_at) VALUES (1, ?) ON DUPLICATE KEY UPDATE last_deleted_at = GREATEST(last_deleted_at, VALUES(last_deleted_at))"
+
+	stmt, err := dao.SQLManager.PrepareContext(ctx, query)
+	if err != nil {
+		return dao.ErrorMsg(model.DBMethodDelete, err)
+	}
+	defer stmt.Close()
+
+	if _, err := stmt.ExecContext(ctx, deletedAt); err != nil {
+		return dao.ErrorMsg(model.DBMethodDelete, err)
+	}
+
 	return nil
 }
+
+// insertAliases は、ProgrammingLangの別名を、照合するためのキーと順序とともに記録する。
+func (dao *ProgrammingLangDAO) insertAliases(ctx context.Context, id int, aliases []string) error {
+	if len(aliases) == 0 {
+		return nil
+	}
+
+	placeholders := make([]string, 0, len(aliases))
+	args := make([]interface{}, 0, len(aliases)*5)
+	now := time.Now().UTC()
+	for i, a := range aliases {
+		placeholders = append(placeholders, "(?, ?, ?, ?, ?)")
+		args = append(args, service.NameKey(a), id, a, i, now)
+	}
+	query := "INSERT INTO programming_lang_aliases (alias_key, programming_lang_id, alias, position, created_at) VALUES " + strings.Join(placeholders, ", ")
+
+	stmt, err := dao.SQLManager.PrepareContext(ctx, query)
+	if err != nil {
+		return dao.ErrorMsg(model.DBMethodAlias, err)
+	}
+	defer stmt.Close()
+
+	if _, err := stmt.ExecContext(ctx, args...); err != nil {
+		if isDuplicateEntry(err) {
+			return dao.alreadyExist(strings.Join(aliases, ", "))
+		}
+		return dao.ErrorMsg(model.DBMethodAlias, err)
+	}
+
+	return nil
+}
+
+// deleteAliases は、ProgrammingLangの別名の記録を全て削除する。
+func (dao *ProgrammingLangDAO) deleteAliases(ctx context.Context, id int) error {
+	query := "DELETE FROM programming_lang_aliases WHERE programming_lang_id=?"
+
+	stmt, err := dao.SQLManager.PrepareContext(ctx, query)
+	if err != nil {
+		return dao.ErrorMsg(model.DBMethodAlias, err)
+	}
+	defer stmt.Close()
+
+	if _, err := stmt.ExecContext(ctx, id); err != nil {
+		return dao.ErrorMsg(model.DBMethodAlias, err)
+	}
+
+	return nil
+}
+
+// LastModified は、レコードの中で最も新しいupdated_atと最後に削除した日時のうち、新しい方を返す。
+// レコードが存在せず削除もしていない場合は、ゼロ値を返す。
+func (dao *ProgrammingLangDAO) LastModified(ctx context.Context) (time.Time, error) {
+	query := "SELECT MAX(modified_at) FROM (SELECT MAX(updated_at) AS modified_at FROM programming_langs UNION ALL SELECT last_deleted_at FROM programming_lang_collection) AS modified"
+
+	stmt, err := dao.SQLManager.PrepareContext(ctx, query)
+	if err != nil {
+		return time.Time{}, dao.ErrorMsg(model.DBMethodLastModified, err)
+	}
+	defer stmt.Close()
+
+	rows, err := stmt.QueryContext(ctx)
+	if err != nil {
+		return time.Time{}, dao.ErrorMsg(model.DBMethodLastModified, err)
+	}
+	defer rows.Close()
+
+	var lastModified *time.Time
+	if rows.Next() {
+		if err := rows.Scan(&lastModified); err != nil {
+			return time.Time{}, dao.ErrorMsg(model.DBMethodLastModified, err)
+		}
+	}
+
+	if lastModified == nil {
+		return time.Time{}, nil
+	}
+
+	return *lastModified, nil
+}
+
+// programmingLangValues は、INSERTとUPDATEで保存するProgrammingLangの値を、created_atより前のカラムの順序で返す。
+func programmingLangValues(lang *model.ProgrammingLang) []interface{} {
+	return []interface{}{
+		lang.Name,
+		service.NameKey(lang.Name),
+		lang.Feature,
+		lang.Slug,
+		lang.FirstAppeared,
+		jsonColumn{lang.Designers},
+		string(lang.TypeChecking),
+		string(lang.TypeStrength),
+		jsonColumn{lang.Paradigms},
+		lang.License,
+		lang.Website,
+		jsonColumn{lang.Extensions},
+		jsonColumn{lang.Filenames},
+		jsonColumn{lang.Interpreters},
+		lang.Color,
+		lang.StableVersion,
+	}
+}
+
+// escapeLike は、LIKEのパターンで特別な意味を持つ文字をエスケープする。
+func escapeLike(s string) string {
+	return strings.NewReplacer("\\", "\\\\", "%", "\\%", "_", "\\_").Replace(s)
+}
+
+// uniqueStrings は、重複を取り除いたスライスを順序を保って返す。
+func uniqueStrings(values []string) []string {
+	seen := make(map[string]bool, len(values))
+	unique := make([]string, 0, len(values))
+	for _, v := range values {
+		if seen[v] {
+			continue
+		}
+		seen[v] = true
+		unique = append(unique, v)
+	}
+	return unique
+}
@@ -3,6 +3,8 @@ package rdb_test
 import (
 	"context"
 	"database/sql"
+	"database/sql/driver"
+	"encoding/json"
 	"fmt"
 	"reflect"
 	"testing"
@@ -10,10 +12,72 @@ import (
 
 	"github.com/SekiguchiKai/clean-architecture-with-go/server/domain/model"
 	"github.com/SekiguchiKai/clean-architecture-with-go/server/domain/repository"
+	"github.com/SekiguchiKai/clean-architecture-with-go/server/domain/service"
 	"github.com/SekiguchiKai/clean-architecture-with-go/server/infra/dao/rdb"
+	"github.com/go-sql-driver/mysql"
+	"github.com/pkg/errors"
 	"gopkg.in/DATA-DOG/go-sqlmock.v1"
 )
 
+// selectLangs は、programming_langsのカラムを全て取得するSELECT句。
+const selectLangs = "SELECT id, name, feature, slug, first_appeared, designers, type_checking, type_strength, paradigms, license, website, extensions, filenames, interpreters, color, stable_version, created_at, updated_at"
+
+// langColumns は、programming_langsのカラム。
+var langColumns = []string{"id", "name", "feature", "slug", "first_appeared", "designers", "type_checking", "type_strength", "paradigms", "license", "website", "extensions", "filenames", "interpreters", "color", "stable_version", "created_at", "updated_at"}
+
+// langValues は、ProgrammingLangをprogramming_langsのレコードの値に変換する。
+func langValues(lang *model.ProgrammingLang) []driver.Value {
+	return []driver.Value{
+		lang.ID,
+		lang.Name,
+		lang.Feature,
+		lang.Slug,
+		lang.FirstAppeared,
+		jsonValue(lang.Designers),
+		string(lang.TypeChecking),
+		string(lang.TypeStrength),
+		jsonValue(lang.Paradigms),
+		lang.License,
+		lang.Website,
+		jsonValue(lang.Extensions),
+		jsonValue(lang.Filenames),
+		jsonValue(lang.Interpreters),
+		lang.Color,
+		lang.StableVersion,
+		lang.CreatedAt,
+		lang.UpdatedAt,
+	}
+}
+
+// expectAliases は、ProgrammingLangの別名をprogramming_lang_aliasesから取得するクエリを期待し、langSliceのAliasesを返す。
+func expectAliases(mock sqlmock.Sqlmock, langSlice ...*model.ProgrammingLang) {
+	ids := make([]driver.Value, 0, len(langSlice))
+	rows := sqlmock.NewRows([]string{"programming_lang_id", "alias"})
+	for _, lang := range langSlice {
+		ids = append(ids, lang.ID)
+		for _, alias := range lang.Aliases {
+			rows.AddRow(lang.ID, alias)
+		}
+	}
+	mock.ExpectPrepare("SELECT programming_lang_id, alias FROM programming_lang_aliases WHERE programming_lang_id IN \\(.+\\) ORDER BY programming_lang_id, position, alias_key").
+		ExpectQuery().WithArgs(ids...).WillReturnRows(rows)
+}
+
+// langArgs は、INSERTとUPDATEで渡されるProgrammingLangの値を返す。Nameの後に照合用のキーが入る。
+func langArgs(lang *model.ProgrammingLang) []driver.Value {
+	values := langValues(lang)
+	return append([]driver.Value{values[1], service.NameKey(lang.Name)}, values[2:]...)
+}
+
+// jsonValue は、スライスをJSONのカラムの値に変換する。空の場合はNULLとする。
+func jsonValue(v interface{}) driver.Value {
+	if reflect.ValueOf(v).Len() == 0 {
+		return nil
+	}
+	b, _ := json.Marshal(v)
+	return string(b)
+}
+
 func TestNewProgrammingLangDAO(t *testing.T) {
 	type args struct {
 		ctx     context.Context
@@ -77,6 +141,7 @@ func TestProgrammingLangDAO_Create(t *testing.T) {
 				lang: &model.ProgrammingLang{
 					Name:      model.TestName,
 					Feature:   model.TestFeature,
+					Slug:      model.TestSlug,
 					CreatedAt: model.GetTestTime(time.September, 1),
 					UpdatedAt: model.GetTestTime(time.September, 2),
 				},
@@ -85,6 +150,75 @@ func TestProgrammingLangDAO_Create(t *testing.T) {
 				ID:        1,
 				Name:      model.TestName,
 				Feature:   model.TestFeature,
+				Slug:      model.TestSlug,
+				CreatedAt: model.GetTestTime(time.September, 1),
+				UpdatedAt: model.GetTestTime(time.September, 2),
+			},
+			rowAffected: 1,
+			wantErr:     false,
+		},
+		{
+			name: "詳細な属性を保持するProgrammingLangを与えられた場合、IDを付与したProgrammingLangを返すこと",
+			fields: fields{
+				SQLManager: &rdb.SQLManager{Conn: db},
+			},
+			args: args{
+				ctx: context.Background(),
+				lang: &model.ProgrammingLang{
+					Name:          model.TestName,
+					Feature:       model.TestFeature,
+					Slug:          model.TestSlug,
+					FirstAppeared: 2009,
+					Designers:     []string{"Rob Pike", "Ken Thompson"},
+					TypeChecking:  model.TypeCheckingStatic,
+					TypeStrength:  model.TypeStrengthStrong,
+					Paradigms:     []model.Paradigm{model.ParadigmConcurrent},
+					License:       "BSD-3-Clause",
+					Website:       "https://golang.org",
+					Extensions:    []string{".go"},
+					StableVersion: "1.11.1",
+					CreatedAt:     model.GetTestTime(time.September, 1),
+					UpdatedAt:     model.GetTestTime(time.September, 2),
+				},
+			},
+			want: &model.ProgrammingLang{
+				ID:            1,
+				Name:          model.TestName,
+				Feature:       model.TestFeature,
+				Slug:          model.TestSlug,
+				FirstAppeared: 2009,
+				Designers:     []string{"Rob Pike", "Ken Thompson"},
+				TypeChecking:  model.TypeCheckingStatic,
+				TypeStrength:  model.TypeStrengthStrong,
+				Paradigms:     []model.Paradigm{model.ParadigmConcurrent},
+				License:       "BSD-3-Clause",
+				Website:       "https://golang.org",
+				Extensions:    []string{".go"},
+				StableVersion: "1.11.1",
+				CreatedAt:     model.GetTestTime(time.September, 1),
+				UpdatedAt:     model.GetTestTime(time.September, 2),
+			},
+			rowAffected: 1,
+			wantErr:     false,
+		},
+		{
+			name: "Aliasesを保持するProgrammingLangを与えられた場合、別名を記録してIDを付与したProgrammingLangを返すこと",
+			fields: fields{
+				SQLManager: &rdb.SQLManager{Conn: db},
+			},
+			args: args{
+				ctx: context.Background(),
+				lang: &model.ProgrammingLang{
+					Name:      model.TestName,
+					Aliases:   []string{"Golang"},
+					CreatedAt: model.GetTestTime(time.September, 1),
+					UpdatedAt: model.GetTestTime(time.September, 2),
+				},
+			},
+			want: &model.ProgrammingLang{
+				ID:        1,
+				Name:      model.TestName,
+				Aliases:   []string{"Golang"},
 				CreatedAt: model.GetTestTime(time.September, 1),
 				UpdatedAt: model.GetTestTime(time.September, 2),
 			},
@@ -150,9 +284,13 @@ func TestProgrammingLangDAO_Create(t *testing.T) {
 			prep := mock.ExpectPrepare(query)
 
 			if tt.rowAffected == 0 {
-				prep.ExpectExec().WithArgs(tt.args.lang.Name, tt.args.lang.Feature, tt.args.lang.CreatedAt, tt.args.lang.UpdatedAt).WillReturnError(fmt.Errorf(model.TestDBSomeErr))
+				prep.ExpectExec().WithArgs(langArgs(tt.args.lang)...).WillReturnError(fmt.Errorf(model.TestDBSomeErr))
 			} else {
-				prep.ExpectExec().WithArgs(tt.args.lang.Name, tt.args.lang.Feature, tt.args.lang.CreatedAt, tt.args.lang.UpdatedAt).WillReturnResult(sqlmock.NewResult(1, tt.rowAffected))
+				prep.ExpectExec().WithArgs(langArgs(tt.args.lang)...).WillReturnResult(sqlmock.NewResult(1, tt.rowAffected))
+			}
+			if len(tt.args.lang.Aliases) > 0 {
+				mock.ExpectPrepare("INSERT INTO programming_lang_aliases \\(alias_key, programming_lang_id, alias, position, created_at\\) VALUES \\(\\?, \\?, \\?, \\?, \\?\\)").
+					ExpectExec().WithArgs("golang", 1, "Golang", 0, sqlmock.AnyArg()).WillReturnResult(sqlmock.NewResult(0, 1))
 			}
 
 			dao := rdb.NewProgrammingLangDAO(tt.fields.SQLManager)
@@ -169,6 +307,69 @@ func TestProgrammingLangDAO_Create(t *testing.T) {
 	}
 }
 
+// TestProgrammingLangDAO_Create_DuplicateEntry は、使用中かどうかの確認の後に他のリクエストが同じ名前を保存し、
+// 一意制約に違反した場合に、DBErrorではなくAlreadyExistErrorを返すことを確認する。
+func TestProgrammingLangDAO_Create_DuplicateEntry(t *testing.T) {
+	dupErr := &mysql.MySQLError{Number: 1062, Message: "Duplicate entry"}
+
+	tests := []struct {
+		name     string
+		lang     *model.ProgrammingLang
+		langErr  error
+		aliasErr error
+		wantName string
+	}{
+		{
+			name: "Nameのキーが一意制約に違反した場合、NameのAlreadyExistErrorを返すこと",
+			lang: &model.ProgrammingLang{
+				Name:      model.TestName,
+				CreatedAt: model.GetTestTime(time.September, 1),
+				UpdatedAt: model.GetTestTime(time.September, 2),
+			},
+			langErr:  dupErr,
+			wantName: model.TestName,
+		},
+		{
+			name: "別名のキーが一意制約に違反した場合、別名のAlreadyExistErrorを返すこと",
+			lang: &model.ProgrammingLang{
+				Name:      model.TestName,
+				Aliases:   []string{"Golang"},
+				CreatedAt: model.GetTestTime(time.September, 1),
+				UpdatedAt: model.GetTestTime(time.September, 2),
+			},
+			aliasErr: dupErr,
+			wantName: "Golang",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			db, mock, err := sqlmock.New()
+			if err != nil {
+				t.Fatal(err)
+			}
+			defer db.Close()
+
+			exec := mock.ExpectPrepare("INSERT INTO programming_langs").ExpectExec().WithArgs(langArgs(tt.lang)...)
+			if tt.langErr != nil {
+				exec.WillReturnError(tt.langErr)
+			} else {
+				exec.WillReturnResult(sqlmock.NewResult(1, 1))
+				mock.ExpectPrepare("INSERT INTO programming_lang_aliases").ExpectExec().WillReturnError(tt.aliasErr)
+			}
+
+			_, err = rdb.NewProgrammingLangDAO(&rdb.SQLManager{Conn: db}).Create(context.Background(), tt.lang)
+
+			want := &model.AlreadyExistError{Name: tt.wantName, ModelName: model.ModelNameProgrammingLang}
+			if !reflect.DeepEqual(errors.Cause(err), want) {
+				t.Errorf("ProgrammingLangDAO.Create() error = %v, want %v", err, want)
+			}
+			if err := mock.ExpectationsWereMet(); err != nil {
+				t.Error(err)
+			}
+		})
+	}
+}
+
 func TestProgrammingLangDAO_List(t *testing.T) {
 	testName1 := fmt.Sprintf("%s1", model.TestName)
 	testName2 := fmt.Sprintf("%s2", model.TestName)
@@ -250,17 +451,18 @@ func TestProgrammingLangDAO_List(t *testing.T) {
 	}
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
-			query := "SELECT id, name, feature, created_at, updated_at FROM programming_langs ORDER BY name LIMIT \\?"
+			query := selectLangs + " FROM programming_langs ORDER BY name LIMIT \\?"
 			prep := mock.ExpectPrepare(query)
 
 			if tt.wantErr {
 				prep.ExpectQuery().WillReturnError(fmt.Errorf(model.TestDBSomeErr))
 			} else {
-				rows := sqlmock.NewRows([]string{"id", "name", "feature", "created_at", "updated_at"}).
-					AddRow(tt.want[0].ID, tt.want[0].Name, tt.want[0].Feature, tt.want[0].CreatedAt, tt.want[0].UpdatedAt).
-					AddRow(tt.want[1].ID, tt.want[1].Name, tt.want[1].Feature, tt.want[1].CreatedAt, tt.want[1].UpdatedAt).
-					AddRow(tt.want[2].ID, tt.want[2].Name, tt.want[2].Feature, tt.want[2].CreatedAt, tt.want[2].UpdatedAt)
+				rows := sqlmock.NewRows(langColumns).
+					AddRow(langValues(tt.want[0])...).
+					AddRow(langValues(tt.want[1])...).
+					AddRow(langValues(tt.want[2])...)
 				prep.ExpectQuery().WillReturnRows(rows)
+				expectAliases(mock, tt.want...)
 			}
 
 			dao := rdb.NewProgrammingLangDAO(tt.fields.SQLManager)
@@ -280,6 +482,73 @@ func TestProgrammingLangDAO_List(t *testing.T) {
 	}
 }
 
+func TestProgrammingLangDAO_ListByFilter(t *testing.T) {
+	// sqlmockの設定を行う
+	db, mock, err := sqlmock.New()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	defer db.Close()
+
+	lang := &model.ProgrammingLang{
+		ID:        1,
+		Name:      model.TestName,
+		Feature:   model.TestFeature,
+		CreatedAt: model.GetTestTime(time.September, 1),
+		UpdatedAt: model.GetTestTime(time.September, 2),
+	}
+
+	tagQuery := "SELECT lt.programming_lang_id FROM programming_lang_tags lt INNER JOIN tags t ON t.id=lt.tag_id WHERE t.name IN \\(\\?, \\?\\)"
+
+	tests := []struct {
+		name   string
+		filter *model.ProgrammingLangFilter
+		query  string
+		args   []driver.Value
+	}{
+		{
+			name:   "タグを指定しない場合、タグで絞り込まないこと",
+			filter: &model.ProgrammingLangFilter{NameContains: "G_", Limit: 10},
+			query:  selectLangs + " FROM programming_langs WHERE name LIKE \\? ORDER BY name LIMIT \\?",
+			args:   []driver.Value{"%G\\_%", 10},
+		},
+		{
+			name:   "複数のタグを指定した場合、全てのタグを持つものに絞り込むこと",
+			filter: &model.ProgrammingLangFilter{Tags: []string{"functional", "compiled", "functional"}, Limit: 10},
+			query:  selectLangs + " FROM programming_langs WHERE id IN \\(" + tagQuery + " GROUP BY lt.programming_lang_id HAVING COUNT\\(DISTINCT t.id\\)=\\?\\) ORDER BY name LIMIT \\?",
+			args:   []driver.Value{"functional", "compiled", 2, 10},
+		},
+		{
+			name:   "TagMatchがanyの場合、いずれかのタグを持つものに絞り込むこと",
+			filter: &model.ProgrammingLangFilter{Tags: []string{"functional", "compiled"}, TagMatch: model.TagMatchAny, After: "C", Limit: 10},
+			query:  selectLangs + " FROM programming_langs WHERE name > \\? AND id IN \\(" + tagQuery + "\\) ORDER BY name LIMIT \\?",
+			args:   []driver.Value{"C", "functional", "compiled", 10},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			rows := sqlmock.NewRows(langColumns).AddRow(langValues(lang)...)
+			mock.ExpectPrepare(tt.query).ExpectQuery().WithArgs(tt.args...).WillReturnRows(rows)
+			expectAliases(mock, lang)
+
+			dao := rdb.NewProgrammingLangDAO(&rdb.SQLManager{Conn: db})
+
+			got, err := dao.ListByFilter(context.Background(), tt.filter)
+			if err != nil {
+				t.Errorf("ProgrammingLangDAO.ListByFilter() error = %v", err)
+				return
+			}
+			if !reflect.DeepEqual(got, []*model.ProgrammingLang{lang}) {
+				t.Errorf("ProgrammingLangDAO.ListByFilter() = %v, want %v", got, []*model.ProgrammingLang{lang})
+			}
+			if err := mock.ExpectationsWereMet(); err != nil {
+				t.Errorf("there were unfulfilled expectations: %s", err)
+			}
+		})
+	}
+}
+
 func TestProgrammingLangDAO_Read(t *testing.T) {
 	// sqlmockの設定を行う
 	db, mock, err := sqlmock.New()
@@ -316,6 +585,54 @@ func TestProgrammingLangDAO_Read(t *testing.T) {
 				ID:        1,
 				Name:      model.TestName,
 				Feature:   model.TestFeature,
+				Slug:      model.TestSlug,
+				CreatedAt: model.GetTestTime(time.September, 1),
+				UpdatedAt: model.GetTestTime(time.September, 2),
+			},
+			wantErr: false,
+		},
+		{
+			name: "詳細な属性を保持するProgrammingLangが存在する場合、詳細な属性を含むProgrammingLangを返すこと",
+			fields: fields{
+				SQLManager: &rdb.SQLManager{Conn: db},
+			},
+			args: args{
+				ctx: context.Background(),
+				id:  1,
+			},
+			want: &model.ProgrammingLang{
+				ID:            1,
+				Name:          model.TestName,
+				Feature:       model.TestFeature,
+				Slug:          model.TestSlug,
+				FirstAppeared: 2009,
+				Designers:     []string{"Rob Pike", "Ken Thompson"},
+				TypeChecking:  model.TypeCheckingStatic,
+				TypeStrength:  model.TypeStrengthStrong,
+				Paradigms:     []model.Paradigm{model.ParadigmConcurrent},
+				License:       "BSD-3-Clause",
+				Website:       "https://golang.org",
+				Extensions:    []string{".go"},
+				StableVersion: "1.11.1",
+				CreatedAt:     model.GetTestTime(time.September, 1),
+				UpdatedAt:     model.GetTestTime(time.September, 2),
+			},
+			wantErr: false,
+		},
+		{
+			name: "別名を記録したProgrammingLangが存在する場合、programming_lang_aliasesの別名を記録した順に含むProgrammingLangを返すこと",
+			fields: fields{
+				SQLManager: &rdb.SQLManager{Conn: db},
+			},
+			args: args{
+				ctx: context.Background(),
+				id:  1,
+			},
+			want: &model.ProgrammingLang{
+				ID:        1,
+				Name:      model.TestName,
+				Slug:      model.TestSlug,
+				Aliases:   []string{"Golang", "Go言語"},
 				CreatedAt: model.GetTestTime(time.September, 1),
 				UpdatedAt: model.GetTestTime(time.September, 2),
 			},
@@ -336,15 +653,16 @@ func TestProgrammingLangDAO_Read(t *testing.T) {
 	}
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
-			query := "SELECT id, name, feature, created_at, updated_at FROM programming_langs WHERE ID=\\?"
+			query := selectLangs + " FROM programming_langs WHERE ID=\\?"
 			prep := mock.ExpectPrepare(query)
 
 			if tt.wantErr {
 				prep.ExpectQuery().WillReturnError(fmt.Errorf(model.TestDBSomeErr))
 			} else {
-				rows := sqlmock.NewRows([]string{"id", "name", "feature", "created_at", "updated_at"}).
-					AddRow(tt.want.ID, tt.want.Name, tt.want.Feature, tt.want.CreatedAt, tt.want.UpdatedAt)
+				rows := sqlmock.NewRows(langColumns).
+					AddRow(langValues(tt.want)...)
 				prep.ExpectQuery().WillReturnRows(rows)
+				expectAliases(mock, tt.want)
 			}
 
 			dao := rdb.NewProgrammingLangDAO(tt.fields.SQLManager)
@@ -397,6 +715,25 @@ func TestProgrammingLangDAO_ReadByName(t *testing.T) {
 				ID:        1,
 				Name:      model.TestName,
 				Feature:   model.TestFeature,
+				Slug:      model.TestSlug,
+				CreatedAt: model.GetTestTime(time.September, 1),
+				UpdatedAt: model.GetTestTime(time.September, 2),
+			},
+			wantErr: false,
+		},
+		{
+			name: "大文字と小文字が異なるNameや別名を指定した場合、照合用のキーでProgrammingLangを1件返すこと",
+			fields: fields{
+				SQLManager: &rdb.SQLManager{Conn: db},
+			},
+			args: args{
+				ctx:  context.Background(),
+				name: "GOLANG",
+			},
+			want: &model.ProgrammingLang{
+				ID:        1,
+				Name:      model.TestName,
+				Aliases:   []string{"golang"},
 				CreatedAt: model.GetTestTime(time.September, 1),
 				UpdatedAt: model.GetTestTime(time.September, 2),
 			},
@@ -417,15 +754,17 @@ func TestProgrammingLangDAO_ReadByName(t *testing.T) {
 	}
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
-			query := "SELECT id, name, feature, created_at, updated_at FROM programming_langs WHERE name=\\? ORDER BY name LIMIT \\?"
+			query := selectLangs + " FROM programming_langs WHERE name_key=\\? OR id IN \\(SELECT programming_lang_id FROM programming_lang_aliases WHERE alias_key=\\?\\) ORDER BY name_key=\\? DESC, name LIMIT \\?"
 			prep := mock.ExpectPrepare(query)
+			key := service.NameKey(tt.args.name)
 
 			if tt.wantErr {
-				prep.ExpectQuery().WillReturnError(fmt.Errorf(model.TestDBSomeErr))
+				prep.ExpectQuery().WithArgs(key, key, key, 1).WillReturnError(fmt.Errorf(model.TestDBSomeErr))
 			} else {
-				rows := sqlmock.NewRows([]string{"id", "name", "feature", "created_at", "updated_at"}).
-					AddRow(tt.want.ID, tt.want.Name, tt.want.Feature, tt.want.CreatedAt, tt.want.UpdatedAt)
-				prep.ExpectQuery().WillReturnRows(rows)
+				rows := sqlmock.NewRows(langColumns).
+					AddRow(langValues(tt.want)...)
+				prep.ExpectQuery().WithArgs(key, key, key, 1).WillReturnRows(rows)
+				expectAliases(mock, tt.want)
 			}
 
 			dao := rdb.NewProgrammingLangDAO(tt.fields.SQLManager)
@@ -479,6 +818,7 @@ func TestProgrammingLangDAO_Update(t *testing.T) {
 					ID:        1,
 					Name:      model.TestName,
 					Feature:   model.TestFeature,
+					Slug:      model.TestSlug,
 					CreatedAt: model.GetTestTime(time.September, 1),
 					UpdatedAt: model.GetTestTime(time.September, 2),
 				},
@@ -487,6 +827,7 @@ func TestProgrammingLangDAO_Update(t *testing.T) {
 				ID:        1,
 				Name:      model.TestName,
 				Feature:   model.TestFeature,
+				Slug:      model.TestSlug,
 				CreatedAt: model.GetTestTime(time.September, 1),
 				UpdatedAt: model.GetTestTime(time.September, 2),
 			},
@@ -549,13 +890,17 @@ func TestProgrammingLangDAO_Update(t *testing.T) {
 	}
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
-			query := "UPDATE programming_langs SET name=\\?, feature=\\?, created_at=\\?, updated_at=\\? WHERE id=\\?"
+			query := "UPDATE programming_langs SET name=\\?, name_key=\\?, feature=\\?, slug=\\?, first_appeared=\\?, designers=\\?, type_checking=\\?, type_strength=\\?, paradigms=\\?, license=\\?, website=\\?, extensions=\\?, filenames=\\?, interpreters=\\?, color=\\?, stable_version=\\?, created_at=\\?, updated_at=\\? WHERE id=\\?"
 			prep := mock.ExpectPrepare(query)
 
 			if tt.wantErr {
-				prep.ExpectExec().WithArgs(tt.args.lang.Name, tt.args.lang.Feature, tt.args.lang.CreatedAt, tt.args.lang.UpdatedAt, tt.args.lang.ID).WillReturnError(fmt.Errorf(model.TestDBSomeErr))
+				prep.ExpectExec().WithArgs(append(langArgs(tt.args.lang), tt.args.lang.ID)...).WillReturnError(fmt.Errorf(model.TestDBSomeErr))
 			} else {
-				prep.ExpectExec().WithArgs(tt.args.lang.Name, tt.args.lang.Feature, tt.args.lang.CreatedAt, tt.args.lang.UpdatedAt, tt.args.lang.ID).WillReturnResult(sqlmock.NewResult(1, tt.rowAffected))
+				prep.ExpectExec().WithArgs(append(langArgs(tt.args.lang), tt.args.lang.ID)...).WillReturnResult(sqlmock.NewResult(1, tt.rowAffected))
+			}
+			if tt.rowAffected == 1 {
+				mock.ExpectPrepare("DELETE FROM programming_lang_aliases WHERE programming_lang_id=\\?").
+					ExpectExec().WithArgs(tt.args.lang.ID).WillReturnResult(sqlmock.NewResult(0, 0))
 			}
 
 			dao := rdb.NewProgrammingLangDAO(tt.fields.SQLManager)
@@ -629,6 +974,9 @@ func TestProgrammingLangDAO_Delete(t *testing.T) {
 				prep.ExpectExec().WithArgs(tt.args.id).WillReturnError(fmt.Errorf(model.TestDBSomeErr))
 			} else {
 				prep.ExpectExec().WithArgs(tt.args.id).WillReturnResult(sqlmock.NewResult(1, tt.rowAffected))
+				// 一覧の最終更新日時に反映するため、削除した日時を記録する。
+				mock.ExpectPrepare("INSERT INTO programming_lang_collection \\(id, last_deleted_at\\) VALUES \\(1, \\?\\) ON DUPLICATE KEY UPDATE").
+					ExpectExec().WithArgs(sqlmock.AnyArg()).WillReturnResult(sqlmock.NewResult(1, 1))
 			}
 
 			dao := rdb.NewProgrammingLangDAO(tt.fields.SQLManager)
@@ -640,3 +988,217 @@ func TestProgrammingLangDAO_Delete(t *testing.T) {
 		})
 	}
 }
+
+func TestProgrammingLangDAO_LastModified(t *testing.T) {
+	// sqlmockの設定を行う
+	db, mock, err := sqlmock.New()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	defer db.Close()
+
+	type fields struct {
+		SQLManager rdb.SQLManagerInterface
+	}
+	type args struct {
+		ctx context.Context
+	}
+	tests := []struct {
+		name    string
+		fields  fields
+		args    args
+		rows    *sqlmock.Rows
+		want    time.Time
+		wantErr bool
+	}{
+		{
+			name: "レコードが存在する場合、最も新しいupdated_atを返すこと",
+			fields: fields{
+				SQLManager: &rdb.SQLManager{Conn: db},
+			},
+			args: args{
+				ctx: context.Background(),
+			},
+			rows:    sqlmock.NewRows([]string{"MAX(modified_at)"}).AddRow(model.GetTestTime(time.September, 2)),
+			want:    model.GetTestTime(time.September, 2),
+			wantErr: false,
+		},
+		{
+			name: "レコードが存在しない場合、ゼロ値を返すこと",
+			fields: fields{
+				SQLManager: &rdb.SQLManager{Conn: db},
+			},
+			args: args{
+				ctx: context.Background(),
+			},
+			rows:    sqlmock.NewRows([]string{"MAX(modified_at)"}).AddRow(nil),
+			want:    time.Time{},
+			wantErr: false,
+		},
+		{
+			name: "DBのエラーが発生した場合、エラーを返すこと",
+			fields: fields{
+				SQLManager: &rdb.SQLManager{Conn: db},
+			},
+			args: args{
+				ctx: context.Background(),
+			},
+			want:    time.Time{},
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			query := "SELECT MAX\\(modified_at\\) FROM \\(SELECT MAX\\(updated_at\\) AS modified_at FROM programming_langs UNION ALL SELECT last_deleted_at FROM programming_lang_collection\\) AS modified"
+			prep := mock.ExpectPrepare(query)
+
+			if tt.wantErr {
+				prep.ExpectQuery().WillReturnError(fmt.Errorf(model.TestDBSomeErr))
+			} else {
+				prep.ExpectQuery().WillReturnRows(tt.rows)
+			}
+
+			dao := rdb.NewProgrammingLangDAO(tt.fields.SQLManager)
+
+			got, err := dao.LastModified(tt.args.ctx)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("ProgrammingLangDAO.LastModified() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+			if !got.Equal(tt.want) {
+				t.Errorf("ProgrammingLangDAO.LastModified() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestProgrammingLangDAO_ReadBySlug(t *testing.T) {
+	// sqlmockの設定を行う
+	db, mock, err := sqlmock.New()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	defer db.Close()
+
+	lang := model.CreateProgrammingLangs(1)[0]
+	columns := langColumns
+
+	tests := []struct {
+		name       string
+		query      string
+		read       func(dao repository.ProgrammingLangRepository) (*model.ProgrammingLang, error)
+		rows       *sqlmock.Rows
+		want       *model.ProgrammingLang
+		wantErr    bool
+		wantNoData bool
+	}{
+		{
+			name:  "slugを保持するレコードが存在する場合、ProgrammingLangを返すこと",
+			query: selectLangs + " FROM programming_langs WHERE slug=\\?",
+			read: func(dao repository.ProgrammingLangRepository) (*model.ProgrammingLang, error) {
+				return dao.ReadBySlug(context.Background(), lang.Slug)
+			},
+			rows: sqlmock.NewRows(columns).AddRow(langValues(lang)...),
+			want: lang,
+		},
+		{
+			name:  "slugを保持するレコードが存在しない場合、NoSuchDataErrorを返すこと",
+			query: selectLangs + " FROM programming_langs WHERE slug=\\?",
+			read: func(dao repository.ProgrammingLangRepository) (*model.ProgrammingLang, error) {
+				return dao.ReadBySlug(context.Background(), "old")
+			},
+			rows:       sqlmock.NewRows(columns),
+			wantErr:    true,
+			wantNoData: true,
+		},
+		{
+			name:  "変更前のslugとして保持していたレコードが存在する場合、ProgrammingLangを返すこと",
+			query: selectLangs + " FROM programming_langs WHERE id=\\(SELECT programming_lang_id FROM programming_lang_slug_histories WHERE slug=\\?\\)",
+			read: func(dao repository.ProgrammingLangRepository) (*model.ProgrammingLang, error) {
+				return dao.ReadByPreviousSlug(context.Background(), "old")
+			},
+			rows: sqlmock.NewRows(columns).AddRow(langValues(lang)...),
+			want: lang,
+		},
+		{
+			name:  "DBのエラーが発生した場合、エラーを返すこと",
+			query: selectLangs + " FROM programming_langs WHERE slug=\\?",
+			read: func(dao repository.ProgrammingLangRepository) (*model.ProgrammingLang, error) {
+				return dao.ReadBySlug(context.Background(), lang.Slug)
+			},
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			prep := mock.ExpectPrepare(tt.query)
+
+			if tt.rows == nil {
+				prep.ExpectQuery().WillReturnError(fmt.Errorf(model.TestDBSomeErr))
+			} else {
+				prep.ExpectQuery().WillReturnRows(tt.rows)
+			}
+			if tt.want != nil {
+				expectAliases(mock, tt.want)
+			}
+
+			dao := rdb.NewProgrammingLangDAO(&rdb.SQLManager{Conn: db})
+
+			got, err := tt.read(dao)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("ProgrammingLangDAO.ReadBySlug() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+			if _, ok := err.(*model.NoSuchDataError); ok != tt.wantNoData {
+				t.Errorf("ProgrammingLangDAO.ReadBySlug() error = %v, want NoSuchDataError %v", err, tt.wantNoData)
+			}
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("ProgrammingLangDAO.ReadBySlug() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestProgrammingLangDAO_CreateSlugHistory(t *testing.T) {
+	// sqlmockの設定を行う
+	db, mock, err := sqlmock.New()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	defer db.Close()
+
+	tests := []struct {
+		name    string
+		wantErr bool
+	}{
+		{
+			name:    "変更前のslugを記録すること",
+			wantErr: false,
+		},
+		{
+			name:    "DBのエラーが発生した場合、エラーを返すこと",
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			query := "INSERT INTO programming_lang_slug_histories \\(slug, programming_lang_id, created_at\\) VALUES \\(\\?, \\?, \\?\\) ON DUPLICATE KEY UPDATE"
+			prep := mock.ExpectPrepare(query)
+
+			if tt.wantErr {
+				prep.ExpectExec().WithArgs("old", 1, sqlmock.AnyArg()).WillReturnError(fmt.Errorf(model.TestDBSomeErr))
+			} else {
+				prep.ExpectExec().WithArgs("old", 1, sqlmock.AnyArg()).WillReturnResult(sqlmock.NewResult(0, 1))
+			}
+
+			dao := rdb.NewProgrammingLangDAO(&rdb.SQLManager{Conn: db})
+
+			if err := dao.CreateSlugHistory(context.Background(), 1, "old"); (err != nil) != tt.wantErr {
+				t.Errorf("ProgrammingLangDAO.CreateSlugHistory() error = %v, wantErr %v", err, tt.wantErr)
+			}
+		})
+	}
+}
@@ -12,9 +12,9 @@ type SQLManager struct {
 	Conn *sql.DB
 }
 
-// NewSQLManager は、SQLManagerを生成し、返す。
-func NewSQLManager() SQLManagerInterface {
-	conn, err := sql.Open("mysql", "root:@tcp(db:3306)/sample?charset=utf8mb4&parseTime=True")
+// NewSQLManager は、dsnのDBに接続するSQLManagerを生成し、返す。
+func NewSQLManager(dsn string) SQLManagerInterface {
+	conn, err := sql.Open("mysql", dsn)
 	if err != nil {
 		panic(err.Error())
 	}
@@ -29,8 +29,11 @@ func (s *SQLManager) Exec(query string, args ...interface{}) (Result, error) {
 	return s.Conn.Exec(query, args...)
 }
 
-// ExecContext は、SQL実行する。
+// ExecContext は、SQL実行する。ctxがトランザクションの中である場合は、そのトランザクションで実行する。
 func (s *SQLManager) ExecContext(ctx context.Context, query string, args ...interface{}) (Result, error) {
+	if state := txFromContext(ctx); state != nil {
+		return state.tx.ExecContext(ctx, query, args...)
+	}
 	return s.Conn.ExecContext(ctx, query, args...)
 }
 
@@ -46,8 +49,16 @@ func (s *SQLManager) Query(query string, args ...interface{}) (Rows, error) {
 	return row, nil
 }
 
-// QueryContext は、rowを返すようなQueryを実行する。
+// QueryContext は、rowを返すようなQueryを実行する。ctxがトランザクションの中である場合は、そのトランザクションで実行する。
 func (s *SQLManager) QueryContext(ctx context.Context, query string, args ...interface{}) (Rows, error) {
+	if state := txFromContext(ctx); state != nil {
+		rows, err := state.tx.QueryContext(ctx, query, args...)
+		if err != nil {
+			return nil, err
+		}
+		return &SQLRowManager{Rows: rows}, nil
+	}
+
 	rows, err := s.Conn.Query(query, args...)
 	if err != nil {
 		return nil, err
@@ -64,7 +75,11 @@ func (s *SQLManager) Prepare(query string) (*sql.Stmt, error) {
 }
 
 // PrepareContext は、後でQueryやExecを行うために、準備された状態にする。
+// ctxがトランザクションの中である場合は、そのトランザクションで準備する。
 func (s *SQLManager) PrepareContext(ctx context.Context, query string) (*sql.Stmt, error) {
+	if state := txFromContext(ctx); state != nil {
+		return state.tx.PrepareContext(ctx, query)
+	}
 	return s.Conn.PrepareContext(ctx, query)
 }
 
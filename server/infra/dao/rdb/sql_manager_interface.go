@@ -3,6 +3,8 @@ package rdb
 import (
 	"context"
 	"database/sql"
+
+	"github.com/SekiguchiKai/clean-architecture-with-go/server/domain/repository"
 )
 
 // SQLManagerInterface は、SQLManagerのinterface。
@@ -10,6 +12,7 @@ type SQLManagerInterface interface {
 	Executor
 	Preparer
 	Queryer
+	repository.Transactor
 }
 
 // DBに関するInterfaceの定義。
@@ -0,0 +1,198 @@
+package rdb
+
+import (
+	"context"
+
+	"github.com/SekiguchiKai/clean-architecture-with-go/server/domain/model"
+	"github.com/SekiguchiKai/clean-architecture-with-go/server/domain/repository"
+	"github.com/pkg/errors"
+)
+
+// tagColumns は、tagsから取得するカラム。listでScanする順序と一致させる。
+const tagColumns = "id, name, created_at, updated_at"
+
+// TagDAO は、TagのDAO。
+type TagDAO struct {
+	SQLManager SQLManagerInterface
+}
+
+// NewTagDAO は、TagDAOを生成して返す。
+func NewTagDAO(manager SQLManagerInterface) repository.TagRepository {
+	return &TagDAO{
+		SQLManager: manager,
+	}
+}
+
+// ErrorMsg は、エラー文を生成し、返す。
+func (dao *TagDAO) ErrorMsg(method string, err error) error {
+	return &model.DBError{
+		ModelName: model.ModelNameTag,
+		DBMethod:  method,
+		Detail:    err.Error(),
+	}
+}
+
+// List は、レコードの一覧をNameの昇順で取得して返す。
+func (dao *TagDAO) List(ctx context.Context) ([]*model.Tag, error) {
+	query := "SELECT " + tagColumns + " FROM tags ORDER BY name"
+	tags, err := dao.list(ctx, query)
+	if err != nil {
+		return nil, errors.WithStack(err)
+	}
+
+	return tags, nil
+}
+
+// ListByLang は、指定したProgrammingLangに付けられたレコードの一覧をNameの昇順で取得して返す。
+func (dao *TagDAO) ListByLang(ctx context.Context, langID int) ([]*model.Tag, error) {
+	query := "SELECT t.id, t.name, t.created_at, t.updated_at FROM tags t INNER JOIN programming_lang_tags lt ON lt.tag_id=t.id WHERE lt.programming_lang_id=? ORDER BY t.name"
+	tags, err := dao.list(ctx, query, langID)
+	if err != nil {
+		return nil, errors.WithStack(err)
+	}
+
+	return tags, nil
+}
+
+// Read は、レコードを1件取得して返す。
+func (dao *TagDAO) Read(ctx context.Context, id int) (*model.Tag, error) {
+	query := "SELECT " + tagColumns + " FROM tags WHERE id=?"
+	tags, err := dao.list(ctx, query, id)
+	if err != nil {
+		return nil, errors.WithStack(err)
+	}
+
+	if len(tags) == 0 {
+		return nil, &model.NoSuchDataError{
+			ID:        id,
+			ModelName: model.ModelNameTag,
+		}
+	}
+
+	return tags[0], nil
+}
+
+// ReadByName は、指定したNameを保持するレコードを1件取得して返す。
+func (dao *TagDAO) ReadByName(ctx context.Context, name string) (*model.Tag, error) {
+	query := "SELECT " + tagColumns + " FROM tags WHERE name=?"
+	tags, err := dao.list(ctx, query, name)
+	if err != nil {
+		return nil, errors.WithStack(err)
+	}
+
+	if len(tags) == 0 {
+		return nil, &model.NoSuchDataError{
+			Name:      name,
+			ModelName: model.ModelNameTag,
+		}
+	}
+
+	return tags[0], nil
+}
+
+// Create は、レコードを1件生成する。
+func (dao *TagDAO) Create(ctx context.Context, tag *model.Tag) (*model.Tag, error) {
+	query := "INSERT INTO tags (name, created_at, updated_at) VALUES (?, ?, ?)"
+	result, err := execStmt(ctx, dao.SQLManager, dao.ErrorMsg, model.DBMethodCreate, query, tag.Name, tag.CreatedAt, tag.UpdatedAt)
+	if err != nil {
+		return nil, errors.WithStack(err)
+	}
+
+	if err := checkAffected(dao.ErrorMsg, model.DBMethodCreate, result, 1); err != nil {
+		return nil, errors.WithStack(err)
+	}
+
+	id, err := result.LastInsertId()
+	if err != nil {
+		return nil, dao.ErrorMsg(model.DBMethodCreate, err)
+	}
+
+	tag.ID = int(id)
+
+	return tag, nil
+}
+
+// Update は、レコードを1件更新する。
+func (dao *TagDAO) Update(ctx context.Context, tag *model.Tag) (*model.Tag, error) {
+	query := "UPDATE tags SET name=?, updated_at=? WHERE id=?"
+	result, err := execStmt(ctx, dao.SQLManager, dao.ErrorMsg, model.DBMethodUpdate, query, tag.Name, tag.UpdatedAt, tag.ID)
+	if err != nil {
+		return nil, errors.WithStack(err)
+	}
+
+	if err := checkAffected(dao.ErrorMsg, model.DBMethodUpdate, result, 1); err != nil {
+		return nil, errors.WithStack(err)
+	}
+
+	return tag, nil
+}
+
+// Delete は、レコードを1件削除する。ProgrammingLangとの関連は外部キーにより削除される。
+func (dao *TagDAO) Delete(ctx context.Context, id int) error {
+	query := "DELETE FROM tags WHERE id=?"
+	result, err := execStmt(ctx, dao.SQLManager, dao.ErrorMsg, model.DBMethodDelete, query, id)
+	if err != nil {
+		return errors.WithStack(err)
+	}
+
+	return errors.WithStack(checkAffected(dao.ErrorMsg, model.DBMethodDelete, result, 1))
+}
+
+// Merge は、fromIDのTagが付いたProgrammingLangにtoIDのTagを付け、fromIDのTagを削除する。
+// 付け替えは重複を無視するため、途中で失敗した場合も再度実行できる。
+func (dao *TagDAO) Merge(ctx context.Context, fromID, toID int) error {
+	query := "INSERT IGNORE INTO programming_lang_tags (programming_lang_id, tag_id) SELECT programming_lang_id, ? FROM programming_lang_tags WHERE tag_id=?"
+	if _, err := execStmt(ctx, dao.SQLManager, dao.ErrorMsg, model.DBMethodMerge, query, toID, fromID); err != nil {
+		return errors.WithStack(err)
+	}
+
+	return errors.WithStack(dao.Delete(ctx, fromID))
+}
+
+// Attach は、ProgrammingLangにTagを付ける。既に付いている場合は何もしない。
+func (dao *TagDAO) Attach(ctx context.Context, langID, tagID int) error {
+	query := "INSERT IGNORE INTO programming_lang_tags (programming_lang_id, tag_id) VALUES (?, ?)"
+	_, err := execStmt(ctx, dao.SQLManager, dao.ErrorMsg, model.DBMethodAttach, query, langID, tagID)
+	return errors.WithStack(err)
+}
+
+// Detach は、ProgrammingLangからTagを外す。付いていない場合は何もしない。
+func (dao *TagDAO) Detach(ctx context.Context, langID, tagID int) error {
+	query := "DELETE FROM programming_lang_tags WHERE programming_lang_id=? AND tag_id=?"
+	_, err := execStmt(ctx, dao.SQLManager, dao.ErrorMsg, model.DBMethodDetach, query, langID, tagID)
+	return errors.WithStack(err)
+}
+
+// list は、レコードの一覧を取得して返す。
+func (dao *TagDAO) list(ctx context.Context, query string, args ...interface{}) ([]*model.Tag, error) {
+	stmt, err := dao.SQLManager.PrepareContext(ctx, query)
+	if err != nil {
+		return nil, dao.ErrorMsg(model.DBMethodList, err)
+	}
+	defer stmt.Close()
+
+	rows, err := stmt.QueryContext(ctx, args...)
+	if err != nil {
+		return nil, dao.ErrorMsg(model.DBMethodList, err)
+	}
+	defer rows.Close()
+
+	tags := make([]*model.Tag, 0)
+	for rows.Next() {
+		tag := &model.Tag{}
+
+		err = rows.Scan(
+			&tag.ID,
+			&tag.Name,
+			&tag.CreatedAt,
+			&tag.UpdatedAt,
+		)
+		if err != nil {
+			return nil, dao.ErrorMsg(model.DBMethodList, err)
+		}
+
+		tags = append(tags, tag)
+	}
+
+	return tags, nil
+}
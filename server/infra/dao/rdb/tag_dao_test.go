@@ -0,0 +1,241 @@
+package rdb_test
+
+import (
+	"context"
+	"database/sql/driver"
+	"fmt"
+	"reflect"
+	"testing"
+	"time"
+
+	"github.com/SekiguchiKai/clean-architecture-with-go/server/domain/model"
+	"github.com/SekiguchiKai/clean-architecture-with-go/server/infra/dao/rdb"
+	"gopkg.in/DATA-DOG/go-sqlmock.v1"
+)
+
+// tagColumns は、tagsのカラム。
+var tagColumns = []string{"id", "name", "created_at", "updated_at"}
+
+func TestTagDAO_ListByLang(t *testing.T) {
+	// sqlmockの設定を行う
+	db, mock, err := sqlmock.New()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	defer db.Close()
+
+	tag := &model.Tag{
+		ID:        1,
+		Name:      model.TestTagName,
+		CreatedAt: model.GetTestTime(time.September, 1),
+		UpdatedAt: model.GetTestTime(time.September, 2),
+	}
+
+	tests := []struct {
+		name    string
+		rows    *sqlmock.Rows
+		want    []*model.Tag
+		wantErr bool
+	}{
+		{
+			name: "Tagが付いている場合、Tagの一覧を返すこと",
+			rows: sqlmock.NewRows(tagColumns).AddRow(tag.ID, tag.Name, tag.CreatedAt, tag.UpdatedAt),
+			want: []*model.Tag{tag},
+		},
+		{
+			name: "Tagが付いていない場合、空のスライスを返すこと",
+			rows: sqlmock.NewRows(tagColumns),
+			want: []*model.Tag{},
+		},
+		{
+			name:    "DBのエラーが発生した場合、エラーを返すこと",
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			prep := mock.ExpectPrepare("SELECT t.id, t.name, t.created_at, t.updated_at FROM tags t INNER JOIN programming_lang_tags lt ON lt.tag_id=t.id WHERE lt.programming_lang_id=\\? ORDER BY t.name")
+
+			if tt.wantErr {
+				prep.ExpectQuery().WithArgs(1).WillReturnError(fmt.Errorf(model.TestDBSomeErr))
+			} else {
+				prep.ExpectQuery().WithArgs(1).WillReturnRows(tt.rows)
+			}
+
+			dao := rdb.NewTagDAO(&rdb.SQLManager{Conn: db})
+
+			got, err := dao.ListByLang(context.Background(), 1)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("TagDAO.ListByLang() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("TagDAO.ListByLang() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestTagDAO_ReadByName(t *testing.T) {
+	// sqlmockの設定を行う
+	db, mock, err := sqlmock.New()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	defer db.Close()
+
+	tag := &model.Tag{
+		ID:        1,
+		Name:      model.TestTagName,
+		CreatedAt: model.GetTestTime(time.September, 1),
+		UpdatedAt: model.GetTestTime(time.September, 2),
+	}
+
+	tests := []struct {
+		name    string
+		rows    *sqlmock.Rows
+		want    *model.Tag
+		wantErr error
+	}{
+		{
+			name: "Nameに一致するTagが存在する場合、Tagを返すこと",
+			rows: sqlmock.NewRows(tagColumns).AddRow(tag.ID, tag.Name, tag.CreatedAt, tag.UpdatedAt),
+			want: tag,
+		},
+		{
+			name: "Nameに一致するTagが存在しない場合、NoSuchDataErrorを返すこと",
+			rows: sqlmock.NewRows(tagColumns),
+			wantErr: &model.NoSuchDataError{
+				Name:      model.TestTagName,
+				ModelName: model.ModelNameTag,
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mock.ExpectPrepare("SELECT id, name, created_at, updated_at FROM tags WHERE name=\\?").
+				ExpectQuery().WithArgs(model.TestTagName).WillReturnRows(tt.rows)
+
+			dao := rdb.NewTagDAO(&rdb.SQLManager{Conn: db})
+
+			got, err := dao.ReadByName(context.Background(), model.TestTagName)
+			if !reflect.DeepEqual(err, tt.wantErr) {
+				t.Errorf("TagDAO.ReadByName() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("TagDAO.ReadByName() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestTagDAO_Merge(t *testing.T) {
+	// sqlmockの設定を行う
+	db, mock, err := sqlmock.New()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	defer db.Close()
+
+	mergeQuery := "INSERT IGNORE INTO programming_lang_tags \\(programming_lang_id, tag_id\\) SELECT programming_lang_id, \\? FROM programming_lang_tags WHERE tag_id=\\?"
+	deleteQuery := "DELETE FROM tags WHERE id=\\?"
+
+	tests := []struct {
+		name      string
+		mergeErr  error
+		deleteErr error
+		wantErr   bool
+	}{
+		{
+			name: "付け替えと削除に成功した場合、nilを返すこと",
+		},
+		{
+			name:     "付け替えに失敗した場合、Tagを削除せずにエラーを返すこと",
+			mergeErr: fmt.Errorf(model.TestDBSomeErr),
+			wantErr:  true,
+		},
+		{
+			name:      "削除に失敗した場合、エラーを返すこと",
+			deleteErr: fmt.Errorf(model.TestDBSomeErr),
+			wantErr:   true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			merge := mock.ExpectPrepare(mergeQuery).ExpectExec().WithArgs(2, 1)
+			if tt.mergeErr != nil {
+				merge.WillReturnError(tt.mergeErr)
+			} else {
+				merge.WillReturnResult(sqlmock.NewResult(0, 3))
+
+				del := mock.ExpectPrepare(deleteQuery).ExpectExec().WithArgs(1)
+				if tt.deleteErr != nil {
+					del.WillReturnError(tt.deleteErr)
+				} else {
+					del.WillReturnResult(sqlmock.NewResult(0, 1))
+				}
+			}
+
+			dao := rdb.NewTagDAO(&rdb.SQLManager{Conn: db})
+
+			if err := dao.Merge(context.Background(), 1, 2); (err != nil) != tt.wantErr {
+				t.Errorf("TagDAO.Merge() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if err := mock.ExpectationsWereMet(); err != nil {
+				t.Errorf("there were unfulfilled expectations: %s", err)
+			}
+		})
+	}
+}
+
+func TestTagDAO_Attach(t *testing.T) {
+	// sqlmockの設定を行う
+	db, mock, err := sqlmock.New()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	defer db.Close()
+
+	tests := []struct {
+		name    string
+		result  driver.Result
+		err     error
+		wantErr bool
+	}{
+		{
+			name:   "Tagが付いていない場合、nilを返すこと",
+			result: sqlmock.NewResult(0, 1),
+		},
+		{
+			name:   "既にTagが付いている場合も、nilを返すこと",
+			result: sqlmock.NewResult(0, 0),
+		},
+		{
+			name:    "DBのエラーが発生した場合、エラーを返すこと",
+			err:     fmt.Errorf(model.TestDBSomeErr),
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			exec := mock.ExpectPrepare("INSERT IGNORE INTO programming_lang_tags \\(programming_lang_id, tag_id\\) VALUES \\(\\?, \\?\\)").
+				ExpectExec().WithArgs(1, 2)
+			if tt.err != nil {
+				exec.WillReturnError(tt.err)
+			} else {
+				exec.WillReturnResult(tt.result)
+			}
+
+			dao := rdb.NewTagDAO(&rdb.SQLManager{Conn: db})
+
+			if err := dao.Attach(context.Background(), 1, 2); (err != nil) != tt.wantErr {
+				t.Errorf("TagDAO.Attach() error = %v, wantErr %v", err, tt.wantErr)
+			}
+		})
+	}
+}
@@ -0,0 +1,86 @@
+package rdb
+
+import (
+	"context"
+	"database/sql"
+
+	"github.com/SekiguchiKai/clean-architecture-with-go/server/domain/model"
+)
+
+// txKey は、実行中のトランザクションをcontextに保持するためのキー。
+type txKey struct{}
+
+// txState は、実行中のトランザクションと、コミットした後に実行する処理を表す。
+type txState struct {
+	tx          *sql.Tx
+	afterCommit []func()
+}
+
+// Transaction は、fnを1つのトランザクションの中で実行する。fnがエラーを返した場合やpanicした場合は、ロールバックする。
+// fnに渡すctxを引き継いだDAOの操作は、全てこのトランザクションで実行される。
+// ctxが既にトランザクションの中である場合は、新たに開始せずにそのトランザクションの中でfnを実行する。
+func (s *SQLManager) Transaction(ctx context.Context, fn func(ctx context.Context) error) error {
+	if txFromContext(ctx) != nil {
+		return fn(ctx)
+	}
+
+	tx, err := s.Conn.BeginTx(ctx, nil)
+	if err != nil {
+		return txError(model.DBMethodBegin, err)
+	}
+
+	state := &txState{tx: tx}
+	committed := false
+	defer func() {
+		if !committed {
+			tx.Rollback()
+		}
+	}()
+
+	if err := fn(context.WithValue(ctx, txKey{}, state)); err != nil {
+		return err
+	}
+
+	if err := tx.Commit(); err != nil {
+		return txError(model.DBMethodCommit, err)
+	}
+	committed = true
+
+	for _, f := range state.afterCommit {
+		f()
+	}
+
+	return nil
+}
+
+// AfterCommit は、ctxのトランザクションをコミットした後にfnを実行する。ロールバックした場合は実行しない。
+// ctxがトランザクションの中でない場合は、直ちに実行する。
+// DAOをラップしてメモリ上に状態を持つRepositoryが、確定していない書き込みを反映しないために使用する。
+func AfterCommit(ctx context.Context, fn func()) {
+	if state := txFromContext(ctx); state != nil {
+		state.afterCommit = append(state.afterCommit, fn)
+		return
+	}
+	fn()
+}
+
+// InTransaction は、ctxがトランザクションの中であるかどうかを返す。
+// DAOをラップしてメモリ上に状態を持つRepositoryが、コミットしていない書き込みを含む読み込みの結果を保持しないために使用する。
+func InTransaction(ctx context.Context) bool {
+	return txFromContext(ctx) != nil
+}
+
+// txFromContext は、ctxが保持する実行中のトランザクションを返す。保持していない場合は、nilを返す。
+func txFromContext(ctx context.Context) *txState {
+	state, _ := ctx.Value(txKey{}).(*txState)
+	return state
+}
+
+// txError は、トランザクションの開始と終了のエラー文を生成し、返す。
+func txError(method string, err error) error {
+	return &model.DBError{
+		ModelName: model.ModelNameTransaction,
+		DBMethod:  method,
+		Detail:    err.Error(),
+	}
+}
@@ -0,0 +1,90 @@
+package rdb_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/SekiguchiKai/clean-architecture-with-go/server/domain/model"
+	"github.com/SekiguchiKai/clean-architecture-with-go/server/infra/dao/rdb"
+	"gopkg.in/DATA-DOG/go-sqlmock.v1"
+)
+
+func TestSQLManager_Transaction(t *testing.T) {
+	// sqlmockの設定を行う
+	db, mock, err := sqlmock.New()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	defer db.Close()
+
+	insertTag := "INSERT INTO tags \\(name, created_at, updated_at\\) VALUES \\(\\?, \\?, \\?\\)"
+	insertOutbox := "INSERT INTO outbox_events \\(event_name, aggregate_id, payload, published, attempts, next_attempt_at, last_error, created_at\\) VALUES \\(\\?, \\?, \\?, \\?, \\?, \\?, \\?, \\?\\)"
+
+	tests := []struct {
+		name          string
+		outboxErr     error
+		wantErr       bool
+		wantCommitted bool
+	}{
+		{
+			name:          "全ての書き込みに成功した場合、コミットしてコミット後の処理を実行すること",
+			wantCommitted: true,
+		},
+		{
+			name:      "書き込みに失敗した場合、ロールバックしてコミット後の処理を実行しないこと",
+			outboxErr: fmt.Errorf(model.TestDBSomeErr),
+			wantErr:   true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mock.ExpectBegin()
+			mock.ExpectPrepare(insertTag).ExpectExec().WillReturnResult(sqlmock.NewResult(1, 1))
+			outbox := mock.ExpectPrepare(insertOutbox).ExpectExec()
+			if tt.outboxErr != nil {
+				outbox.WillReturnError(tt.outboxErr)
+				mock.ExpectRollback()
+			} else {
+				outbox.WillReturnResult(sqlmock.NewResult(1, 1))
+				mock.ExpectCommit()
+			}
+
+			manager := &rdb.SQLManager{Conn: db}
+			tagDAO := rdb.NewTagDAO(manager)
+			outboxDAO := rdb.NewOutboxDAO(manager)
+			now := model.GetTestTime(time.September, 1)
+
+			committed := false
+			err := manager.Transaction(context.Background(), func(ctx context.Context) error {
+				tag, err := tagDAO.Create(ctx, &model.Tag{Name: model.TestTagName, CreatedAt: now, UpdatedAt: now})
+				if err != nil {
+					return err
+				}
+				rdb.AfterCommit(ctx, func() { committed = true })
+
+				_, err = outboxDAO.Create(ctx, &model.OutboxEvent{Name: model.DomainEventLangCreated, AggregateID: tag.ID, Payload: []byte(`{}`), NextAttemptAt: now, CreatedAt: now})
+				return err
+			})
+			if (err != nil) != tt.wantErr {
+				t.Errorf("SQLManager.Transaction() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if committed != tt.wantCommitted {
+				t.Errorf("SQLManager.Transaction() committed = %v, want %v", committed, tt.wantCommitted)
+			}
+			if err := mock.ExpectationsWereMet(); err != nil {
+				t.Errorf("there were unfulfilled expectations: %s", err)
+			}
+		})
+	}
+}
+
+func TestAfterCommit(t *testing.T) {
+	called := false
+	rdb.AfterCommit(context.Background(), func() { called = true })
+	if !called {
+		t.Error("AfterCommit() outside a transaction did not run fn immediately")
+	}
+}
@@ -0,0 +1,147 @@
+package rdb
+
+import (
+	"context"
+
+	"github.com/SekiguchiKai/clean-architecture-with-go/server/domain/model"
+	"github.com/SekiguchiKai/clean-architecture-with-go/server/domain/repository"
+	"github.com/pkg/errors"
+)
+
+// webhookColumns は、webhooksから取得するカラム。listでScanする順序と一致させる。
+const webhookColumns = "id, url, secret, event_types, disabled, created_at, updated_at"
+
+// WebhookDAO は、WebhookのDAO。
+type WebhookDAO struct {
+	SQLManager SQLManagerInterface
+}
+
+// NewWebhookDAO は、WebhookDAOを生成して返す。
+func NewWebhookDAO(manager SQLManagerInterface) repository.WebhookRepository {
+	return &WebhookDAO{
+		SQLManager: manager,
+	}
+}
+
+// ErrorMsg は、エラー文を生成し、返す。
+func (dao *WebhookDAO) ErrorMsg(method string, err error) error {
+	return &model.DBError{
+		ModelName: model.ModelNameWebhook,
+		DBMethod:  method,
+		Detail:    err.Error(),
+	}
+}
+
+// List は、レコードの一覧をIDの昇順で取得して返す。
+func (dao *WebhookDAO) List(ctx context.Context) ([]*model.Webhook, error) {
+	query := "SELECT " + webhookColumns + " FROM webhooks ORDER BY id"
+	webhooks, err := dao.list(ctx, query)
+	if err != nil {
+		return nil, errors.WithStack(err)
+	}
+
+	return webhooks, nil
+}
+
+// Read は、レコードを1件取得して返す。
+func (dao *WebhookDAO) Read(ctx context.Context, id int) (*model.Webhook, error) {
+	query := "SELECT " + webhookColumns + " FROM webhooks WHERE id=?"
+	webhooks, err := dao.list(ctx, query, id)
+	if err != nil {
+		return nil, errors.WithStack(err)
+	}
+
+	if len(webhooks) == 0 {
+		return nil, &model.NoSuchDataError{
+			ID:        id,
+			ModelName: model.ModelNameWebhook,
+		}
+	}
+
+	return webhooks[0], nil
+}
+
+// Create は、レコードを1件生成する。
+func (dao *WebhookDAO) Create(ctx context.Context, webhook *model.Webhook) (*model.Webhook, error) {
+	query := "INSERT INTO webhooks (url, secret, event_types, disabled, created_at, updated_at) VALUES (?, ?, ?, ?, ?, ?)"
+	result, err := execStmt(ctx, dao.SQLManager, dao.ErrorMsg, model.DBMethodCreate, query, webhook.URL, webhook.Secret, jsonColumn{webhook.EventTypes}, webhook.Disabled, webhook.CreatedAt, webhook.UpdatedAt)
+	if err != nil {
+		return nil, errors.WithStack(err)
+	}
+
+	if err := checkAffected(dao.ErrorMsg, model.DBMethodCreate, result, 1); err != nil {
+		return nil, errors.WithStack(err)
+	}
+
+	id, err := result.LastInsertId()
+	if err != nil {
+		return nil, dao.ErrorMsg(model.DBMethodCreate, err)
+	}
+
+	webhook.ID = int(id)
+
+	return webhook, nil
+}
+
+// Update は、レコードを1件更新する。
+func (dao *WebhookDAO) Update(ctx context.Context, webhook *model.Webhook) (*model.Webhook, error) {
+	query := "UPDATE webhooks SET url=?, secret=?, event_types=?, disabled=?, updated_at=? WHERE id=?"
+	result, err := execStmt(ctx, dao.SQLManager, dao.ErrorMsg, model.DBMethodUpdate, query, webhook.URL, webhook.Secret, jsonColumn{webhook.EventTypes}, webhook.Disabled, webhook.UpdatedAt, webhook.ID)
+	if err != nil {
+		return nil, errors.WithStack(err)
+	}
+
+	if err := checkAffected(dao.ErrorMsg, model.DBMethodUpdate, result, 1); err != nil {
+		return nil, errors.WithStack(err)
+	}
+
+	return webhook, nil
+}
+
+// Delete は、レコードを1件削除する。通知とその送信の記録は外部キーにより削除される。
+func (dao *WebhookDAO) Delete(ctx context.Context, id int) error {
+	query := "DELETE FROM webhooks WHERE id=?"
+	result, err := execStmt(ctx, dao.SQLManager, dao.ErrorMsg, model.DBMethodDelete, query, id)
+	if err != nil {
+		return errors.WithStack(err)
+	}
+
+	return errors.WithStack(checkAffected(dao.ErrorMsg, model.DBMethodDelete, result, 1))
+}
+
+// list は、レコードの一覧を取得して返す。
+func (dao *WebhookDAO) list(ctx context.Context, query string, args ...interface{}) ([]*model.Webhook, error) {
+	stmt, err := dao.SQLManager.PrepareContext(ctx, query)
+	if err != nil {
+		return nil, dao.ErrorMsg(model.DBMethodList, err)
+	}
+	defer stmt.Close()
+
+	rows, err := stmt.QueryContext(ctx, args...)
+	if err != nil {
+		return nil, dao.ErrorMsg(model.DBMethodList, err)
+	}
+	defer rows.Close()
+
+	webhooks := make([]*model.Webhook, 0)
+	for rows.Next() {
+		webhook := &model.Webhook{}
+
+		err = rows.Scan(
+			&webhook.ID,
+			&webhook.URL,
+			&webhook.Secret,
+			jsonColumn{&webhook.EventTypes},
+			&webhook.Disabled,
+			&webhook.CreatedAt,
+			&webhook.UpdatedAt,
+		)
+		if err != nil {
+			return nil, dao.ErrorMsg(model.DBMethodList, err)
+		}
+
+		webhooks = append(webhooks, webhook)
+	}
+
+	return webhooks, nil
+}
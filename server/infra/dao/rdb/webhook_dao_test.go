@@ -0,0 +1,141 @@
+package rdb_test
+
+import (
+	"context"
+	"fmt"
+	"reflect"
+	"testing"
+	"time"
+
+	"github.com/SekiguchiKai/clean-architecture-with-go/server/domain/model"
+	"github.com/SekiguchiKai/clean-architecture-with-go/server/infra/dao/rdb"
+	"gopkg.in/DATA-DOG/go-sqlmock.v1"
+)
+
+// webhookColumns は、webhooksのカラム。
+var webhookColumns = []string{"id", "url", "secret", "event_types", "disabled", "created_at", "updated_at"}
+
+func TestWebhookDAO_Read(t *testing.T) {
+	// sqlmockの設定を行う
+	db, mock, err := sqlmock.New()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	defer db.Close()
+
+	webhook := &model.Webhook{
+		ID:         1,
+		URL:        "https://example.com/hooks",
+		Secret:     "0123456789abcdef",
+		EventTypes: []model.EventType{model.EventTypeCreated, model.EventTypeDeleted},
+		CreatedAt:  model.GetTestTime(time.September, 1),
+		UpdatedAt:  model.GetTestTime(time.September, 2),
+	}
+
+	tests := []struct {
+		name    string
+		rows    *sqlmock.Rows
+		want    *model.Webhook
+		wantErr error
+	}{
+		{
+			name: "IDに一致するWebhookが存在する場合、Webhookを返すこと",
+			rows: sqlmock.NewRows(webhookColumns).
+				AddRow(webhook.ID, webhook.URL, webhook.Secret, `["created","deleted"]`, false, webhook.CreatedAt, webhook.UpdatedAt),
+			want: webhook,
+		},
+		{
+			name: "IDに一致するWebhookが存在しない場合、NoSuchDataErrorを返すこと",
+			rows: sqlmock.NewRows(webhookColumns),
+			wantErr: &model.NoSuchDataError{
+				ID:        1,
+				ModelName: model.ModelNameWebhook,
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mock.ExpectPrepare("SELECT id, url, secret, event_types, disabled, created_at, updated_at FROM webhooks WHERE id=\\?").
+				ExpectQuery().WithArgs(1).WillReturnRows(tt.rows)
+
+			dao := rdb.NewWebhookDAO(&rdb.SQLManager{Conn: db})
+
+			got, err := dao.Read(context.Background(), 1)
+			if !reflect.DeepEqual(err, tt.wantErr) {
+				t.Errorf("WebhookDAO.Read() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("WebhookDAO.Read() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestWebhookDAO_Create(t *testing.T) {
+	// sqlmockの設定を行う
+	db, mock, err := sqlmock.New()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	defer db.Close()
+
+	tests := []struct {
+		name       string
+		eventTypes []model.EventType
+		wantArg    interface{}
+		err        error
+		wantErr    bool
+	}{
+		{
+			name:       "変更の種類を指定した場合、JSONとして保存すること",
+			eventTypes: []model.EventType{model.EventTypeUpdated},
+			wantArg:    `["updated"]`,
+		},
+		{
+			name:    "変更の種類を指定していない場合、NULLとして保存すること",
+			wantArg: nil,
+		},
+		{
+			name:    "DBのエラーが発生した場合、エラーを返すこと",
+			wantArg: nil,
+			err:     fmt.Errorf(model.TestDBSomeErr),
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			webhook := &model.Webhook{
+				URL:        "https://example.com/hooks",
+				Secret:     "0123456789abcdef",
+				EventTypes: tt.eventTypes,
+				CreatedAt:  model.GetTestTime(time.September, 1),
+				UpdatedAt:  model.GetTestTime(time.September, 1),
+			}
+
+			exec := mock.ExpectPrepare("INSERT INTO webhooks \\(url, secret, event_types, disabled, created_at, updated_at\\) VALUES \\(\\?, \\?, \\?, \\?, \\?, \\?\\)").
+				ExpectExec().WithArgs(webhook.URL, webhook.Secret, tt.wantArg, false, webhook.CreatedAt, webhook.UpdatedAt)
+			if tt.err != nil {
+				exec.WillReturnError(tt.err)
+			} else {
+				exec.WillReturnResult(sqlmock.NewResult(3, 1))
+			}
+
+			dao := rdb.NewWebhookDAO(&rdb.SQLManager{Conn: db})
+
+			got, err := dao.Create(context.Background(), webhook)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("WebhookDAO.Create() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+			if !tt.wantErr && got.ID != 3 {
+				t.Errorf("WebhookDAO.Create() ID = %v, want %v", got.ID, 3)
+			}
+			if err := mock.ExpectationsWereMet(); err != nil {
+				t.Errorf("there were unfulfilled expectations: %s", err)
+			}
+		})
+	}
+}
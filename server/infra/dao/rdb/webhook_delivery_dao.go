@@ -0,0 +1,269 @@
+package rdb
+
+import (
+	"context"
+	"time"
+
+	"github.com/SekiguchiKai/clean-architecture-with-go/server/domain/model"
+	"github.com/SekiguchiKai/clean-architecture-with-go/server/domain/repository"
+	"github.com/pkg/errors"
+)
+
+// webhookDeliveryColumns は、webhook_deliveriesから取得するカラム。listでScanする順序と一致させる。
+const webhookDeliveryColumns = "d.id, d.webhook_id, d.event_type, d.payload, d.status, d.attempts, d.next_attempt_at, d.last_status_code, d.last_error, d.created_at, d.updated_at"
+
+// deliveryAttemptColumns は、webhook_delivery_attemptsから取得するカラム。listAttemptsでScanする順序と一致させる。
+const deliveryAttemptColumns = "id, delivery_id, attempt, status_code, error, duration_ms, attempted_at"
+
+// WebhookDeliveryDAO は、WebhookDeliveryのDAO。
+type WebhookDeliveryDAO struct {
+	SQLManager SQLManagerInterface
+}
+
+// NewWebhookDeliveryDAO は、WebhookDeliveryDAOを生成して返す。
+func NewWebhookDeliveryDAO(manager SQLManagerInterface) repository.WebhookDeliveryRepository {
+	return &WebhookDeliveryDAO{
+		SQLManager: manager,
+	}
+}
+
+// ErrorMsg は、エラー文を生成し、返す。
+func (dao *WebhookDeliveryDAO) ErrorMsg(method string, err error) error {
+	return &model.DBError{
+		ModelName: model.ModelNameWebhookDelivery,
+		DBMethod:  method,
+		Detail:    err.Error(),
+	}
+}
+
+// ListByWebhook は、指定したWebhookのレコードの一覧を新しい順に取得して返す。statusが空の場合は、全ての状態のものを返す。
+func (dao *WebhookDeliveryDAO) ListByWebhook(ctx context.Context, webhookID int, status model.DeliveryStatus, limit int) ([]*model.WebhookDelivery, error) {
+	query := "SELECT " + webhookDeliveryColumns + " FROM webhook_deliveries d WHERE d.webhook_id=? ORDER BY d.id DESC LIMIT ?"
+	args := []interface{}{webhookID, limit}
+	if status != "" {
+		query = "SELECT " + webhookDeliveryColumns + " FROM webhook_deliveries d WHERE d.webhook_id=? AND d.status=? ORDER BY d.id DESC LIMIT ?"
+		args = []interface{}{webhookID, string(status), limit}
+	}
+
+	deliveries, err := dao.list(ctx, query, args...)
+	if err != nil {
+		return nil, errors.WithStack(err)
+	}
+
+	return deliveries, nil
+}
+
+// ListByStatus は、全てのWebhookのうち、指定した状態のレコードの一覧を新しい順に取得して返す。
+func (dao *WebhookDeliveryDAO) ListByStatus(ctx context.Context, status model.DeliveryStatus, limit int) ([]*model.WebhookDelivery, error) {
+	query := "SELECT " + webhookDeliveryColumns + " FROM webhook_deliveries d WHERE d.status=? ORDER BY d.id DESC LIMIT ?"
+	deliveries, err := dao.list(ctx, query, string(status), limit)
+	if err != nil {
+		return nil, errors.WithStack(err)
+	}
+
+	return deliveries, nil
+}
+
+// ListDue は、送信予定の日時がnow以前の未送信のレコードの一覧を、送信予定の日時の古い順に取得して返す。
+// 無効にしたWebhookのレコードは、有効に戻すまで取得しない。
+func (dao *WebhookDeliveryDAO) ListDue(ctx context.Context, now time.Time, limit int) ([]*model.WebhookDelivery, error) {
+	query := "SELECT " + webhookDeliveryColumns + " FROM webhook_deliveries d INNER JOIN webhooks w ON w.id=d.webhook_id WHERE d.status=? AND d.next_attempt_at<=? AND w.disabled=0 ORDER BY d.next_attempt_at, d.id LIMIT ?"
+	deliveries, err := dao.list(ctx, query, string(model.DeliveryStatusPending), now, limit)
+	if err != nil {
+		return nil, errors.WithStack(err)
+	}
+
+	return deliveries, nil
+}
+
+// Read は、レコードを1件取得して返す。
+func (dao *WebhookDeliveryDAO) Read(ctx context.Context, id int) (*model.WebhookDelivery, error) {
+	query := "SELECT " + webhookDeliveryColumns + " FROM webhook_deliveries d WHERE d.id=?"
+	deliveries, err := dao.list(ctx, query, id)
+	if err != nil {
+		return nil, errors.WithStack(err)
+	}
+
+	if len(deliveries) == 0 {
+		return nil, &model.NoSuchDataError{
+			ID:        id,
+			ModelName: model.ModelNameWebhookDelivery,
+		}
+	}
+
+	return deliveries[0], nil
+}
+
+// Create は、レコードを1件生成する。
+func (dao *WebhookDeliveryDAO) Create(ctx context.Context, d *model.WebhookDelivery) (*model.WebhookDelivery, error) {
+	query := "INSERT INTO webhook_deliveries (webhook_id, event_type, payload, status, attempts, next_attempt_at, last_status_code, last_error, created_at, updated_at) VALUES (?, ?, ?, ?, ?, ?, ?, ?, ?, ?)"
+	result, err := execStmt(ctx, dao.SQLManager, dao.ErrorMsg, model.DBMethodCreate, query, d.WebhookID, string(d.EventType), string(d.Payload), string(d.Status), d.Attempts, d.NextAttemptAt, d.LastStatusCode, d.LastError, d.CreatedAt, d.UpdatedAt)
+	if err != nil {
+		return nil, errors.WithStack(err)
+	}
+
+	if err := checkAffected(dao.ErrorMsg, model.DBMethodCreate, result, 1); err != nil {
+		return nil, errors.WithStack(err)
+	}
+
+	id, err := result.LastInsertId()
+	if err != nil {
+		return nil, dao.ErrorMsg(model.DBMethodCreate, err)
+	}
+
+	d.ID = int(id)
+
+	return d, nil
+}
+
+// Update は、レコードの状態を1件更新する。
+func (dao *WebhookDeliveryDAO) Update(ctx context.Context, d *model.WebhookDelivery) (*model.WebhookDelivery, error) {
+	query := "UPDATE webhook_deliveries SET status=?, attempts=?, next_attempt_at=?, last_status_code=?, last_error=?, updated_at=? WHERE id=?"
+	result, err := execStmt(ctx, dao.SQLManager, dao.ErrorMsg, model.DBMethodUpdate, query, string(d.Status), d.Attempts, d.NextAttemptAt, d.LastStatusCode, d.LastError, d.UpdatedAt, d.ID)
+	if err != nil {
+		return nil, errors.WithStack(err)
+	}
+
+	if err := checkAffected(dao.ErrorMsg, model.DBMethodUpdate, result, 1); err != nil {
+		return nil, errors.WithStack(err)
+	}
+
+	return d, nil
+}
+
+// Claim は、未送信のレコードの送信予定の日時をuntilまで延ばし、送信する権利を得る。
+// 他のプロセスが先に送信予定の日時を変更していた場合は、falseを返す。
+func (dao *WebhookDeliveryDAO) Claim(ctx context.Context, d *model.WebhookDelivery, until time.Time) (bool, error) {
+	query := "UPDATE webhook_deliveries SET next_attempt_at=? WHERE id=? AND status=? AND next_attempt_at=?"
+	result, err := execStmt(ctx, dao.SQLManager, dao.ErrorMsg, model.DBMethodClaim, query, until, d.ID, string(model.DeliveryStatusPending), d.NextAttemptAt)
+	if err != nil {
+		return false, errors.WithStack(err)
+	}
+
+	affect, err := result.RowsAffected()
+	if err != nil {
+		return false, dao.ErrorMsg(model.DBMethodClaim, err)
+	}
+
+	return affect == 1, nil
+}
+
+// CreateAttempt は、送信の記録を1件生成する。
+func (dao *WebhookDeliveryDAO) CreateAttempt(ctx context.Context, a *model.DeliveryAttempt) (*model.DeliveryAttempt, error) {
+	query := "INSERT INTO webhook_delivery_attempts (delivery_id, attempt, status_code, error, duration_ms, attempted_at) VALUES (?, ?, ?, ?, ?, ?)"
+	result, err := execStmt(ctx, dao.SQLManager, dao.ErrorMsg, model.DBMethodAttempt, query, a.DeliveryID, a.Attempt, a.StatusCode, a.Error, a.DurationMS, a.AttemptedAt)
+	if err != nil {
+		return nil, errors.WithStack(err)
+	}
+
+	if err := checkAffected(dao.ErrorMsg, model.DBMethodAttempt, result, 1); err != nil {
+		return nil, errors.WithStack(err)
+	}
+
+	id, err := result.LastInsertId()
+	if err != nil {
+		return nil, dao.ErrorMsg(model.DBMethodAttempt, err)
+	}
+
+	a.ID = int(id)
+
+	return a, nil
+}
+
+// ListAttempts は、指定した通知の送信の記録の一覧を古い順に取得して返す。
+func (dao *WebhookDeliveryDAO) ListAttempts(ctx context.Context, deliveryID int) ([]*model.DeliveryAttempt, error) {
+	query := "SELECT " + deliveryAttemptColumns + " FROM webhook_delivery_attempts WHERE delivery_id=? ORDER BY id"
+	stmt, err := dao.SQLManager.PrepareContext(ctx, query)
+	if err != nil {
+		return nil, dao.ErrorMsg(model.DBMethodAttempt, err)
+	}
+	defer stmt.Close()
+
+	rows, err := stmt.QueryContext(ctx, deliveryID)
+	if err != nil {
+		return nil, dao.ErrorMsg(model.DBMethodAttempt, err)
+	}
+	defer rows.Close()
+
+	attempts := make([]*model.DeliveryAttempt, 0)
+	for rows.Next() {
+		a := &model.DeliveryAttempt{}
+
+		err = rows.Scan(
+			&a.ID,
+			&a.DeliveryID,
+			&a.Attempt,
+			&a.StatusCode,
+			&a.Error,
+			&a.DurationMS,
+			&a.AttemptedAt,
+		)
+		if err != nil {
+			return nil, dao.ErrorMsg(model.DBMethodAttempt, err)
+		}
+
+		attempts = append(attempts, a)
+	}
+
+	return attempts, nil
+}
+
+// DeleteFinishedBefore は、送信に成功したか、送信を諦めたレコードのうち、最後の更新がbeforeより前のものを削除し、削除した件数を返す。
+// 送信の記録は、外部キーにより削除する。
+func (dao *WebhookDeliveryDAO) DeleteFinishedBefore(ctx context.Context, before time.Time) (int, error) {
+	query := "DELETE FROM webhook_deliveries WHERE status IN (?, ?) AND updated_at<?"
+	result, err := execStmt(ctx, dao.SQLManager, dao.ErrorMsg, model.DBMethodDelete, query, string(model.DeliveryStatusSucceeded), string(model.DeliveryStatusDead), before)
+	if err != nil {
+		return 0, errors.WithStack(err)
+	}
+
+	affect, err := result.RowsAffected()
+	if err != nil {
+		return 0, dao.ErrorMsg(model.DBMethodDelete, err)
+	}
+
+	return int(affect), nil
+}
+
+// list は、レコードの一覧を取得して返す。
+func (dao *WebhookDeliveryDAO) list(ctx context.Context, query string, args ...interface{}) ([]*model.WebhookDelivery, error) {
+	stmt, err := dao.SQLManager.PrepareContext(ctx, query)
+	if err != nil {
+		return nil, dao.ErrorMsg(model.DBMethodList, err)
+	}
+	defer stmt.Close()
+
+	rows, err := stmt.QueryContext(ctx, args...)
+	if err != nil {
+		return nil, dao.ErrorMsg(model.DBMethodList, err)
+	}
+	defer rows.Close()
+
+	deliveries := make([]*model.WebhookDelivery, 0)
+	for rows.Next() {
+		d := &model.WebhookDelivery{}
+		var payload []byte
+
+		err = rows.Scan(
+			&d.ID,
+			&d.WebhookID,
+			&d.EventType,
+			&payload,
+			&d.Status,
+			&d.Attempts,
+			&d.NextAttemptAt,
+			&d.LastStatusCode,
+			&d.LastError,
+			&d.CreatedAt,
+			&d.UpdatedAt,
+		)
+		if err != nil {
+			return nil, dao.ErrorMsg(model.DBMethodList, err)
+		}
+
+		d.Payload = payload
+		deliveries = append(deliveries, d)
+	}
+
+	return deliveries, nil
+}
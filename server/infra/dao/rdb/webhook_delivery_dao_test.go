@@ -0,0 +1,204 @@
+package rdb_test
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"reflect"
+	"testing"
+	"time"
+
+	"github.com/SekiguchiKai/clean-architecture-with-go/server/domain/model"
+	"github.com/SekiguchiKai/clean-architecture-with-go/server/infra/dao/rdb"
+	"gopkg.in/DATA-DOG/go-sqlmock.v1"
+)
+
+// webhookDeliveryColumns は、webhook_deliveriesのカラム。
+var webhookDeliveryColumns = []string{"id", "webhook_id", "event_type", "payload", "status", "attempts", "next_attempt_at", "last_status_code", "last_error", "created_at", "updated_at"}
+
+func TestWebhookDeliveryDAO_ListDue(t *testing.T) {
+	// sqlmockの設定を行う
+	db, mock, err := sqlmock.New()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	defer db.Close()
+
+	now := model.GetTestTime(time.September, 3)
+	delivery := &model.WebhookDelivery{
+		ID:            1,
+		WebhookID:     2,
+		EventType:     model.EventTypeCreated,
+		Payload:       json.RawMessage(`{"type":"created"}`),
+		Status:        model.DeliveryStatusPending,
+		Attempts:      1,
+		NextAttemptAt: model.GetTestTime(time.September, 2),
+		LastError:     "unexpected status code: 500",
+		CreatedAt:     model.GetTestTime(time.September, 1),
+		UpdatedAt:     model.GetTestTime(time.September, 1),
+	}
+
+	tests := []struct {
+		name    string
+		rows    *sqlmock.Rows
+		want    []*model.WebhookDelivery
+		wantErr bool
+	}{
+		{
+			name: "送信予定の日時を過ぎた通知が存在する場合、通知の一覧を返すこと",
+			rows: sqlmock.NewRows(webhookDeliveryColumns).AddRow(
+				delivery.ID, delivery.WebhookID, string(delivery.EventType), []byte(delivery.Payload), string(delivery.Status),
+				delivery.Attempts, delivery.NextAttemptAt, delivery.LastStatusCode, delivery.LastError, delivery.CreatedAt, delivery.UpdatedAt),
+			want: []*model.WebhookDelivery{delivery},
+		},
+		{
+			name: "送信予定の日時を過ぎた通知が存在しない場合、空のスライスを返すこと",
+			rows: sqlmock.NewRows(webhookDeliveryColumns),
+			want: []*model.WebhookDelivery{},
+		},
+		{
+			name:    "DBのエラーが発生した場合、エラーを返すこと",
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			prep := mock.ExpectPrepare("SELECT d.id, d.webhook_id, d.event_type, d.payload, d.status, d.attempts, d.next_attempt_at, d.last_status_code, d.last_error, d.created_at, d.updated_at FROM webhook_deliveries d INNER JOIN webhooks w ON w.id=d.webhook_id WHERE d.status=\\? AND d.next_attempt_at<=\\? AND w.disabled=0 ORDER BY d.next_attempt_at, d.id LIMIT \\?")
+
+			if tt.wantErr {
+				prep.ExpectQuery().WithArgs("pending", now, 10).WillReturnError(fmt.Errorf(model.TestDBSomeErr))
+			} else {
+				prep.ExpectQuery().WithArgs("pending", now, 10).WillReturnRows(tt.rows)
+			}
+
+			dao := rdb.NewWebhookDeliveryDAO(&rdb.SQLManager{Conn: db})
+
+			got, err := dao.ListDue(context.Background(), now, 10)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("WebhookDeliveryDAO.ListDue() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("WebhookDeliveryDAO.ListDue() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestWebhookDeliveryDAO_Claim(t *testing.T) {
+	// sqlmockの設定を行う
+	db, mock, err := sqlmock.New()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	defer db.Close()
+
+	delivery := &model.WebhookDelivery{ID: 1, NextAttemptAt: model.GetTestTime(time.September, 2)}
+	until := model.GetTestTime(time.September, 3)
+
+	tests := []struct {
+		name     string
+		affected int64
+		err      error
+		want     bool
+		wantErr  bool
+	}{
+		{
+			name:     "送信予定の日時が変わっていない場合、trueを返すこと",
+			affected: 1,
+			want:     true,
+		},
+		{
+			name:     "他のプロセスが先に送信する権利を得ていた場合、falseを返すこと",
+			affected: 0,
+			want:     false,
+		},
+		{
+			name:    "DBのエラーが発生した場合、エラーを返すこと",
+			err:     fmt.Errorf(model.TestDBSomeErr),
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			exec := mock.ExpectPrepare("UPDATE webhook_deliveries SET next_attempt_at=\\? WHERE id=\\? AND status=\\? AND next_attempt_at=\\?").
+				ExpectExec().WithArgs(until, delivery.ID, "pending", delivery.NextAttemptAt)
+			if tt.err != nil {
+				exec.WillReturnError(tt.err)
+			} else {
+				exec.WillReturnResult(sqlmock.NewResult(0, tt.affected))
+			}
+
+			dao := rdb.NewWebhookDeliveryDAO(&rdb.SQLManager{Conn: db})
+
+			got, err := dao.Claim(context.Background(), delivery, until)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("WebhookDeliveryDAO.Claim() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+			if got != tt.want {
+				t.Errorf("WebhookDeliveryDAO.Claim() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestWebhookDeliveryDAO_DeleteFinishedBefore(t *testing.T) {
+	// sqlmockの設定を行う
+	db, mock, err := sqlmock.New()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	defer db.Close()
+
+	before := model.GetTestTime(time.September, 3)
+
+	tests := []struct {
+		name     string
+		affected int64
+		err      error
+		want     int
+		wantErr  bool
+	}{
+		{
+			name:     "削除対象のレコードが存在する場合、削除した件数を返すこと",
+			affected: 3,
+			want:     3,
+		},
+		{
+			name:     "削除対象のレコードが存在しない場合、0を返すこと",
+			affected: 0,
+			want:     0,
+		},
+		{
+			name:    "DBのエラーが発生した場合、エラーを返すこと",
+			err:     fmt.Errorf(model.TestDBSomeErr),
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			exec := mock.ExpectPrepare("DELETE FROM webhook_deliveries WHERE status IN \\(\\?, \\?\\) AND updated_at<\\?").
+				ExpectExec().WithArgs("succeeded", "dead", before)
+			if tt.err != nil {
+				exec.WillReturnError(tt.err)
+			} else {
+				exec.WillReturnResult(sqlmock.NewResult(0, tt.affected))
+			}
+
+			dao := rdb.NewWebhookDeliveryDAO(&rdb.SQLManager{Conn: db})
+
+			got, err := dao.DeleteFinishedBefore(context.Background(), before)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("WebhookDeliveryDAO.DeleteFinishedBefore() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+			if got != tt.want {
+				t.Errorf("WebhookDeliveryDAO.DeleteFinishedBefore() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
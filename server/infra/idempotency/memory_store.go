@@ -0,0 +1,131 @@
+package idempotency
+
+import (
+	"container/list"
+	"context"
+	"sync"
+	"time"
+
+	"github.com/SekiguchiKai/clean-architecture-with-go/server/adapter/api"
+)
+
+// DefaultMaxRecords は、MemoryStoreが保持する記録の数の上限の初期値。
+const DefaultMaxRecords = 10000
+
+// entry は、keyとその記録。
+type entry struct {
+	key    string
+	record *api.IdempotencyRecord
+}
+
+// MemoryStore は、プロセス内でIdempotency-Keyごとの記録を保持するIdempotencyStore。
+// 記録は予約した順に保持し、上限に達した場合は期限切れの記録、最も古い記録の順に破棄する。
+type MemoryStore struct {
+	mu         sync.Mutex
+	maxRecords int
+	ll         *list.List
+	records    map[string]*list.Element
+}
+
+// NewMemoryStore は、最大でmaxRecords件の記録を保持するMemoryStoreを生成し、返す。
+// maxRecordsが0以下の場合は、DefaultMaxRecordsを使用する。
+func NewMemoryStore(maxRecords int) api.IdempotencyStore {
+	if maxRecords <= 0 {
+		maxRecords = DefaultMaxRecords
+	}
+	return &MemoryStore{
+		maxRecords: maxRecords,
+		ll:         list.New(),
+		records:    make(map[string]*list.Element),
+	}
+}
+
+// Reserve は、keyの記録がないか期限切れの場合は処理中として記録し、nilを返す。記録がある場合は、その記録の複製を返す。
+func (s *MemoryStore) Reserve(ctx context.Context, key, fingerprint string, ttl time.Duration, now time.Time) (*api.IdempotencyRecord, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if e, ok := s.records[key]; ok {
+		r := e.Value.(*entry).record
+		if now.Before(r.ExpiresAt) {
+			cp := *r
+			return &cp, nil
+		}
+		s.remove(e)
+	}
+
+	s.evict(now)
+	s.records[key] = s.ll.PushFront(&entry{
+		key: key,
+		record: &api.IdempotencyRecord{
+			Fingerprint: fingerprint,
+			ExpiresAt:   now.Add(ttl),
+		},
+	})
+
+	return nil, nil
+}
+
+// Complete は、keyの記録をrecordに置き換える。上限に達して破棄された後の場合は、新しい記録として保持する。
+func (s *MemoryStore) Complete(ctx context.Context, key string, record *api.IdempotencyRecord) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if e, ok := s.records[key]; ok {
+		e.Value.(*entry).record = record
+		return nil
+	}
+
+	s.trim()
+	s.records[key] = s.ll.PushFront(&entry{key: key, record: record})
+	return nil
+}
+
+// Release は、keyの記録を削除する。
+func (s *MemoryStore) Release(ctx context.Context, key string) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if e, ok := s.records[key]; ok {
+		s.remove(e)
+	}
+	return nil
+}
+
+// Len は、保持している記録の数を返す。
+func (s *MemoryStore) Len() int {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return s.ll.Len()
+}
+
+// evict は、記録を1件追加できるように、上限に達している場合は記録を破棄する。
+// 期限切れの記録を全て破棄し、それでも上限に達している場合は最も古い記録から破棄する。
+func (s *MemoryStore) evict(now time.Time) {
+	if s.ll.Len() < s.maxRecords {
+		return
+	}
+
+	for e := s.ll.Back(); e != nil; {
+		prev := e.Prev()
+		if !now.Before(e.Value.(*entry).record.ExpiresAt) {
+			s.remove(e)
+		}
+		e = prev
+	}
+
+	s.trim()
+}
+
+// trim は、記録を1件追加できるように、上限に達している場合は最も古い記録から破棄する。
+func (s *MemoryStore) trim() {
+	for s.ll.Len() >= s.maxRecords && s.ll.Len() > 0 {
+		s.remove(s.ll.Back())
+	}
+}
+
+// remove は、記録を破棄する。
+func (s *MemoryStore) remove(e *list.Element) {
+	s.ll.Remove(e)
+	delete(s.records, e.Value.(*entry).key)
+}
@@ -0,0 +1,145 @@
+package idempotency_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/SekiguchiKai/clean-architecture-with-go/server/adapter/api"
+	"github.com/SekiguchiKai/clean-architecture-with-go/server/domain/model"
+	"github.com/SekiguchiKai/clean-architecture-with-go/server/infra/idempotency"
+)
+
+func TestMemoryStore_Reserve(t *testing.T) {
+	ttl := time.Hour
+	start := model.GetTestTime(time.October, 1)
+
+	tests := []struct {
+		name          string
+		complete      bool
+		release       bool
+		elapsed       time.Duration
+		wantRecord    bool
+		wantCompleted bool
+	}{
+		{
+			name:       "処理中のkeyを予約した場合、処理中の記録を返すこと",
+			elapsed:    time.Minute,
+			wantRecord: true,
+		},
+		{
+			name:          "処理を終えたkeyを予約した場合、保存したレスポンスを返すこと",
+			complete:      true,
+			elapsed:       time.Minute,
+			wantRecord:    true,
+			wantCompleted: true,
+		},
+		{
+			name:    "記録を削除したkeyを予約した場合、予約できること",
+			release: true,
+			elapsed: time.Minute,
+		},
+		{
+			name:     "期限切れのkeyを予約した場合、予約できること",
+			complete: true,
+			elapsed:  2 * ttl,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctx := context.Background()
+			s := idempotency.NewMemoryStore(idempotency.DefaultMaxRecords)
+
+			if r, err := s.Reserve(ctx, "a", "f", ttl, start); err != nil || r != nil {
+				t.Fatalf("MemoryStore.Reserve() = %v, %v, want nil", r, err)
+			}
+			if tt.complete {
+				if err := s.Complete(ctx, "a", &api.IdempotencyRecord{Fingerprint: "f", Completed: true, Status: 200, ExpiresAt: start.Add(ttl)}); err != nil {
+					t.Fatal(err)
+				}
+			}
+			if tt.release {
+				if err := s.Release(ctx, "a"); err != nil {
+					t.Fatal(err)
+				}
+			}
+
+			got, err := s.Reserve(ctx, "a", "f", ttl, start.Add(tt.elapsed))
+			if err != nil {
+				t.Fatal(err)
+			}
+			if (got != nil) != tt.wantRecord {
+				t.Fatalf("MemoryStore.Reserve() = %v, want record %v", got, tt.wantRecord)
+			}
+			if got != nil && got.Completed != tt.wantCompleted {
+				t.Errorf("MemoryStore.Reserve() completed = %v, want %v", got.Completed, tt.wantCompleted)
+			}
+		})
+	}
+}
+
+func TestMemoryStore_Evict(t *testing.T) {
+	ttl := time.Hour
+	start := model.GetTestTime(time.October, 1)
+
+	type reserve struct {
+		key     string
+		elapsed time.Duration
+	}
+
+	tests := []struct {
+		name       string
+		maxRecords int
+		reserves   []reserve
+		wantLen    int
+		kept       []string
+		evicted    []string
+	}{
+		{
+			name:       "上限に達した場合、最も古い記録を破棄すること",
+			maxRecords: 2,
+			reserves:   []reserve{{key: "a"}, {key: "b"}, {key: "c"}},
+			wantLen:    2,
+			kept:       []string{"b", "c"},
+			evicted:    []string{"a"},
+		},
+		{
+			name:       "上限に達した場合、期限切れの記録を先に破棄すること",
+			maxRecords: 3,
+			reserves:   []reserve{{key: "a"}, {key: "b", elapsed: ttl / 2}, {key: "c", elapsed: ttl / 2}, {key: "d", elapsed: ttl}},
+			wantLen:    3,
+			kept:       []string{"b", "c", "d"},
+			evicted:    []string{"a"},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctx := context.Background()
+			s := idempotency.NewMemoryStore(tt.maxRecords).(*idempotency.MemoryStore)
+
+			var now time.Time
+			for _, r := range tt.reserves {
+				now = start.Add(r.elapsed)
+				if _, err := s.Reserve(ctx, r.key, "f", ttl, now); err != nil {
+					t.Fatal(err)
+				}
+			}
+
+			if got := s.Len(); got != tt.wantLen {
+				t.Errorf("MemoryStore.Len() = %v, want %v", got, tt.wantLen)
+			}
+
+			// 保持している記録は処理中の記録を返し、破棄した記録は予約し直せる。
+			for _, key := range tt.kept {
+				if got, err := s.Reserve(ctx, key, "f", ttl, now); err != nil || got == nil {
+					t.Errorf("MemoryStore.Reserve(%s) = %v, %v, want kept record", key, got, err)
+				}
+			}
+			for _, key := range tt.evicted {
+				if got, err := s.Reserve(ctx, key, "f", ttl, now); err != nil || got != nil {
+					t.Errorf("MemoryStore.Reserve(%s) = %v, %v, want nil", key, got, err)
+				}
+			}
+		})
+	}
+}
@@ -0,0 +1,231 @@
+package migration
+
+import (
+	"context"
+	"io/ioutil"
+	"path/filepath"
+	"regexp"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/SekiguchiKai/clean-architecture-with-go/server/infra/dao/rdb"
+	"github.com/pkg/errors"
+)
+
+// fileNamePattern は、マイグレーションのファイル名(001_add_slug.sqlなど)のパターン。
+var fileNamePattern = regexp.MustCompile(`^(\d+)_(\w+)\.sql$`)
+
+// commentPrefix は、マイグレーションのファイルで読み飛ばす行の接頭辞。
+const commentPrefix = "--"
+
+// createTableQuery は、適用済みのバージョンを記録するテーブルを作成するQuery。
+const createTableQuery = `CREATE TABLE IF NOT EXISTS schema_migrations (
+  version bigint(20) unsigned NOT NULL,
+  name VARCHAR(128) NOT NULL,
+  applied_at datetime NOT NULL,
+  PRIMARY KEY (version)
+) DEFAULT CHARACTER SET utf8mb4`
+
+// Migration は、1つのマイグレーションのファイルを表す。
+type Migration struct {
+	Version int
+	Name    string
+	Path    string
+}
+
+// Migrator は、マイグレーションのファイルをバージョンの昇順に適用し、schema_migrationsに記録する。
+// Stepsにバージョンの処理がある場合は、そのファイルを適用した後、記録する前に実行する。
+type Migrator struct {
+	SQLM  rdb.SQLManagerInterface
+	Dir   string
+	Now   func() time.Time
+	Steps map[int]Step
+}
+
+// NewMigrator は、DefaultStepsを実行するMigratorを生成し、返す。
+func NewMigrator(sqlM rdb.SQLManagerInterface, dir string) *Migrator {
+	return &Migrator{
+		SQLM:  sqlM,
+		Dir:   dir,
+		Now:   time.Now,
+		Steps: DefaultSteps(),
+	}
+}
+
+// Load は、Dirにあるマイグレーションのファイルをバージョンの昇順で返す。
+// パターンに一致しないファイルは無視し、同じバージョンのファイルが複数ある場合はエラーを返す。
+func (m *Migrator) Load() ([]*Migration, error) {
+	files, err := ioutil.ReadDir(m.Dir)
+	if err != nil {
+		return nil, errors.WithStack(err)
+	}
+
+	migrations := make([]*Migration, 0, len(files))
+	seen := make(map[int]string)
+	for _, f := range files {
+		matches := fileNamePattern.FindStringSubmatch(f.Name())
+		if f.IsDir() || matches == nil {
+			continue
+		}
+
+		version, err := strconv.Atoi(matches[1])
+		if err != nil {
+			return nil, errors.WithStack(err)
+		}
+		if other, ok := seen[version]; ok {
+			return nil, errors.Errorf("duplicate migration version %d: %s and %s", version, other, f.Name())
+		}
+		seen[version] = f.Name()
+
+		migrations = append(migrations, &Migration{
+			Version: version,
+			Name:    matches[2],
+			Path:    filepath.Join(m.Dir, f.Name()),
+		})
+	}
+
+	sort.Slice(migrations, func(i, j int) bool {
+		return migrations[i].Version < migrations[j].Version
+	})
+	return migrations, nil
+}
+
+// Up は、適用していないマイグレーションを順に適用し、適用したマイグレーションを返す。
+// 途中で失敗した場合は、それまでに適用したマイグレーションとエラーを返す。
+// MySQLのDDLはトランザクションで巻き戻せないため、失敗したファイルは手動で修復してから再実行する。
+func (m *Migrator) Up(ctx context.Context) ([]*Migration, error) {
+	pending, err := m.Pending(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	applied := make([]*Migration, 0, len(pending))
+	for _, mig := range pending {
+		b, err := ioutil.ReadFile(mig.Path)
+		if err != nil {
+			return applied, errors.WithStack(err)
+		}
+
+		for _, stmt := range SplitStatements(string(b)) {
+			if _, err := m.SQLM.ExecContext(ctx, stmt); err != nil {
+				return applied, errors.Wrapf(err, "migration %03d_%s", mig.Version, mig.Name)
+			}
+		}
+
+		if step, ok := m.Steps[mig.Version]; ok {
+			if err := step(ctx, m.SQLM); err != nil {
+				return applied, errors.Wrapf(err, "migration %03d_%s", mig.Version, mig.Name)
+			}
+		}
+
+		if err := m.record(ctx, mig); err != nil {
+			return applied, err
+		}
+		applied = append(applied, mig)
+	}
+	return applied, nil
+}
+
+// Baseline は、version以下の適用していないマイグレーションを、ファイルもStepsも実行せずに適用済みとして記録し、記録したマイグレーションを返す。
+// schema_migrationsを導入する前にsetup.sqlや手動で適用したDBで使用する。
+func (m *Migrator) Baseline(ctx context.Context, version int) ([]*Migration, error) {
+	pending, err := m.Pending(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	recorded := make([]*Migration, 0, len(pending))
+	for _, mig := range pending {
+		if mig.Version > version {
+			break
+		}
+		if err := m.record(ctx, mig); err != nil {
+			return recorded, err
+		}
+		recorded = append(recorded, mig)
+	}
+	return recorded, nil
+}
+
+// Pending は、適用していないマイグレーションをバージョンの昇順で返す。
+func (m *Migrator) Pending(ctx context.Context) ([]*Migration, error) {
+	migrations, err := m.Load()
+	if err != nil {
+		return nil, err
+	}
+
+	applied, err := m.applied(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	pending := make([]*Migration, 0, len(migrations))
+	for _, mig := range migrations {
+		if !applied[mig.Version] {
+			pending = append(pending, mig)
+		}
+	}
+	return pending, nil
+}
+
+// applied は、schema_migrationsを作成し、適用済みのバージョンを返す。
+func (m *Migrator) applied(ctx context.Context) (map[int]bool, error) {
+	if _, err := m.SQLM.ExecContext(ctx, createTableQuery); err != nil {
+		return nil, errors.WithStack(err)
+	}
+
+	rows, err := m.SQLM.QueryContext(ctx, "SELECT version FROM schema_migrations")
+	if err != nil {
+		return nil, errors.WithStack(err)
+	}
+	defer rows.Close()
+
+	versions := make(map[int]bool)
+	for rows.Next() {
+		var version int
+		if err := rows.Scan(&version); err != nil {
+			return nil, errors.WithStack(err)
+		}
+		versions[version] = true
+	}
+	return versions, nil
+}
+
+// record は、migを適用済みとして記録する。
+func (m *Migrator) record(ctx context.Context, mig *Migration) error {
+	_, err := m.SQLM.ExecContext(ctx, "INSERT INTO schema_migrations(version, name, applied_at) VALUES(?, ?, ?)", mig.Version, mig.Name, m.Now())
+	return errors.WithStack(err)
+}
+
+// SplitStatements は、マイグレーションのファイルの内容を文に分割する。
+// 行末の;を文の区切りとし、--で始まる行と空の文は取り除く。
+func SplitStatements(content string) []string {
+	var stmts []string
+	var current []string
+
+	flush := func() {
+		if stmt := strings.TrimSpace(strings.Join(current, "\n")); stmt != "" {
+			stmts = append(stmts, stmt)
+		}
+		current = nil
+	}
+
+	for _, line := range strings.Split(content, "\n") {
+		trimmed := strings.TrimSpace(line)
+		if strings.HasPrefix(trimmed, commentPrefix) {
+			continue
+		}
+
+		if strings.HasSuffix(trimmed, ";") {
+			current = append(current, strings.TrimSuffix(strings.TrimRight(line, " \t\r"), ";"))
+			flush()
+			continue
+		}
+		current = append(current, strings.TrimRight(line, "\r"))
+	}
+	flush()
+
+	return stmts
+}
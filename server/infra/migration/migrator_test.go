@@ -0,0 +1,271 @@
+package migration_test
+
+import (
+	"context"
+	"errors"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"reflect"
+	"testing"
+	"time"
+
+	"github.com/SekiguchiKai/clean-architecture-with-go/server/domain/model"
+	"github.com/SekiguchiKai/clean-architecture-with-go/server/infra/dao/rdb"
+	"github.com/SekiguchiKai/clean-architecture-with-go/server/infra/migration"
+	"gopkg.in/DATA-DOG/go-sqlmock.v1"
+)
+
+// migrationFiles は、テスト用のマイグレーションのファイル。
+var migrationFiles = map[string]string{
+	"001_add_slug.sql":  "-- slugを追加する。\nALTER TABLE programming_langs ADD COLUMN slug VARCHAR(128);\n",
+	"002_add_tags.sql":  "CREATE TABLE tags (\n  id bigint(20)\n);\nALTER TABLE tags CONVERT TO CHARACTER SET utf8mb4;\n",
+	"003_add_color.sql": "ALTER TABLE programming_langs ADD COLUMN color VARCHAR(7);\n",
+	"README.md":         "マイグレーションではないファイル",
+	"setup_old.sql":     "SELECT 1;",
+}
+
+// migrationsDir は、テスト用のマイグレーションのファイルを置いたディレクトリを生成し、返す。
+func migrationsDir(t *testing.T) string {
+	dir, err := ioutil.TempDir("", "migrations")
+	if err != nil {
+		t.Fatal(err)
+	}
+	for name, content := range migrationFiles {
+		if err := ioutil.WriteFile(filepath.Join(dir, name), []byte(content), 0644); err != nil {
+			t.Fatal(err)
+		}
+	}
+	return dir
+}
+
+// expectApplied は、schema_migrationsの作成と、適用済みのバージョンの取得を期待する。
+func expectApplied(mock sqlmock.Sqlmock, versions ...int) {
+	mock.ExpectExec("CREATE TABLE IF NOT EXISTS schema_migrations").WillReturnResult(sqlmock.NewResult(0, 0))
+	rows := sqlmock.NewRows([]string{"version"})
+	for _, v := range versions {
+		rows.AddRow(v)
+	}
+	mock.ExpectQuery("SELECT version FROM schema_migrations").WillReturnRows(rows)
+}
+
+// expectRecord は、マイグレーションを適用済みとして記録することを期待する。
+func expectRecord(mock sqlmock.Sqlmock, version int, name string, now time.Time) {
+	mock.ExpectExec(`INSERT INTO schema_migrations\(version, name, applied_at\) VALUES\(\?, \?, \?\)`).
+		WithArgs(version, name, now).
+		WillReturnResult(sqlmock.NewResult(0, 1))
+}
+
+// versions は、マイグレーションのバージョンの一覧を返す。
+func versions(migrations []*migration.Migration) []int {
+	vs := make([]int, 0, len(migrations))
+	for _, m := range migrations {
+		vs = append(vs, m.Version)
+	}
+	return vs
+}
+
+func TestSplitStatements(t *testing.T) {
+	tests := []struct {
+		name    string
+		content string
+		want    []string
+	}{
+		{
+			name:    "複数の文の場合、行末の;で分割すること",
+			content: "CREATE TABLE tags (\n  id bigint(20)\n);\nALTER TABLE tags CONVERT TO CHARACTER SET utf8mb4;\n",
+			want:    []string{"CREATE TABLE tags (\n  id bigint(20)\n)", "ALTER TABLE tags CONVERT TO CHARACTER SET utf8mb4"},
+		},
+		{
+			name:    "コメントの行がある場合、取り除くこと",
+			content: "-- 説明。\nALTER TABLE programming_langs\n  -- 色を追加する。\n  ADD COLUMN color VARCHAR(7);\n",
+			want:    []string{"ALTER TABLE programming_langs\n  ADD COLUMN color VARCHAR(7)"},
+		},
+		{
+			name:    "最後の文に;がない場合、その文も返すこと",
+			content: "ALTER TABLE a ADD COLUMN b int;\nALTER TABLE a ADD COLUMN c int\n",
+			want:    []string{"ALTER TABLE a ADD COLUMN b int", "ALTER TABLE a ADD COLUMN c int"},
+		},
+		{
+			name:    "コメントのみの場合、空を返すこと",
+			content: "-- 説明。\n\n",
+			want:    nil,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := migration.SplitStatements(tt.content); !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("SplitStatements() = %q, want %q", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestMigrator_Load(t *testing.T) {
+	dir := migrationsDir(t)
+	defer os.RemoveAll(dir)
+
+	got, err := migration.NewMigrator(nil, dir).Load()
+	if err != nil {
+		t.Fatal(err)
+	}
+	want := []*migration.Migration{
+		{Version: 1, Name: "add_slug", Path: filepath.Join(dir, "001_add_slug.sql")},
+		{Version: 2, Name: "add_tags", Path: filepath.Join(dir, "002_add_tags.sql")},
+		{Version: 3, Name: "add_color", Path: filepath.Join(dir, "003_add_color.sql")},
+	}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("Migrator.Load() = %v, want %v", got, want)
+	}
+
+	if err := ioutil.WriteFile(filepath.Join(dir, "003_add_aliases.sql"), []byte(""), 0644); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := migration.NewMigrator(nil, dir).Load(); err == nil {
+		t.Errorf("Migrator.Load() error = nil, want duplicate version error")
+	}
+}
+
+func TestMigrator_Up(t *testing.T) {
+	dir := migrationsDir(t)
+	defer os.RemoveAll(dir)
+
+	now := model.GetTestTime(time.October, 1)
+
+	tests := []struct {
+		name    string
+		steps   map[int]migration.Step
+		setup   func(mock sqlmock.Sqlmock)
+		want    []int
+		wantErr bool
+	}{
+		{
+			name: "適用していないマイグレーションがある場合、バージョンの昇順に適用して記録すること",
+			setup: func(mock sqlmock.Sqlmock) {
+				expectApplied(mock, 1)
+				mock.ExpectExec(`CREATE TABLE tags`).WillReturnResult(sqlmock.NewResult(0, 0))
+				mock.ExpectExec(`ALTER TABLE tags CONVERT TO CHARACTER SET utf8mb4`).WillReturnResult(sqlmock.NewResult(0, 0))
+				expectRecord(mock, 2, "add_tags", now)
+				mock.ExpectExec(`ALTER TABLE programming_langs ADD COLUMN color`).WillReturnResult(sqlmock.NewResult(0, 0))
+				expectRecord(mock, 3, "add_color", now)
+			},
+			want: []int{2, 3},
+		},
+		{
+			name: "すべて適用済みの場合、何も実行しないこと",
+			setup: func(mock sqlmock.Sqlmock) {
+				expectApplied(mock, 1, 2, 3)
+			},
+			want: []int{},
+		},
+		{
+			name: "途中の文が失敗した場合、それまでに適用したマイグレーションとエラーを返すこと",
+			setup: func(mock sqlmock.Sqlmock) {
+				expectApplied(mock, 1)
+				mock.ExpectExec(`CREATE TABLE tags`).WillReturnResult(sqlmock.NewResult(0, 0))
+				mock.ExpectExec(`ALTER TABLE tags CONVERT TO CHARACTER SET utf8mb4`).WillReturnResult(sqlmock.NewResult(0, 0))
+				expectRecord(mock, 2, "add_tags", now)
+				mock.ExpectExec(`ALTER TABLE programming_langs ADD COLUMN color`).WillReturnError(errors.New(model.TestDBSomeErr))
+			},
+			want:    []int{2},
+			wantErr: true,
+		},
+		{
+			name: "バージョンの処理がある場合、ファイルを適用した後、記録する前に実行すること",
+			steps: map[int]migration.Step{
+				3: func(ctx context.Context, sqlM rdb.SQLManagerInterface) error {
+					_, err := sqlM.ExecContext(ctx, "UPDATE programming_langs SET color=''")
+					return err
+				},
+			},
+			setup: func(mock sqlmock.Sqlmock) {
+				expectApplied(mock, 1, 2)
+				mock.ExpectExec(`ALTER TABLE programming_langs ADD COLUMN color`).WillReturnResult(sqlmock.NewResult(0, 0))
+				mock.ExpectExec(`UPDATE programming_langs SET color=''`).WillReturnResult(sqlmock.NewResult(0, 0))
+				expectRecord(mock, 3, "add_color", now)
+			},
+			want: []int{3},
+		},
+		{
+			name: "バージョンの処理が失敗した場合、記録せずにエラーを返すこと",
+			steps: map[int]migration.Step{
+				3: func(ctx context.Context, sqlM rdb.SQLManagerInterface) error {
+					return errors.New(model.TestDBSomeErr)
+				},
+			},
+			setup: func(mock sqlmock.Sqlmock) {
+				expectApplied(mock, 1, 2)
+				mock.ExpectExec(`ALTER TABLE programming_langs ADD COLUMN color`).WillReturnResult(sqlmock.NewResult(0, 0))
+			},
+			want:    []int{},
+			wantErr: true,
+		},
+		{
+			name: "適用済みのバージョンを取得できない場合、エラーを返すこと",
+			setup: func(mock sqlmock.Sqlmock) {
+				mock.ExpectExec("CREATE TABLE IF NOT EXISTS schema_migrations").WillReturnError(errors.New(model.TestDBSomeErr))
+			},
+			want:    nil,
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			db, mock, err := sqlmock.New()
+			if err != nil {
+				t.Fatal(err)
+			}
+			defer db.Close()
+			tt.setup(mock)
+
+			m := migration.NewMigrator(&rdb.SQLManager{Conn: db}, dir)
+			m.Now = func() time.Time { return now }
+			m.Steps = tt.steps
+
+			got, err := m.Up(context.Background())
+			if (err != nil) != tt.wantErr {
+				t.Errorf("Migrator.Up() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if tt.want == nil {
+				if got != nil {
+					t.Errorf("Migrator.Up() = %v, want nil", versions(got))
+				}
+			} else if !reflect.DeepEqual(versions(got), tt.want) {
+				t.Errorf("Migrator.Up() = %v, want %v", versions(got), tt.want)
+			}
+			if err := mock.ExpectationsWereMet(); err != nil {
+				t.Error(err)
+			}
+		})
+	}
+}
+
+func TestMigrator_Baseline(t *testing.T) {
+	dir := migrationsDir(t)
+	defer os.RemoveAll(dir)
+
+	now := model.GetTestTime(time.October, 1)
+
+	db, mock, err := sqlmock.New()
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer db.Close()
+
+	expectApplied(mock, 1)
+	expectRecord(mock, 2, "add_tags", now)
+
+	m := migration.NewMigrator(&rdb.SQLManager{Conn: db}, dir)
+	m.Now = func() time.Time { return now }
+
+	got, err := m.Baseline(context.Background(), 2)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !reflect.DeepEqual(versions(got), []int{2}) {
+		t.Errorf("Migrator.Baseline() = %v, want %v", versions(got), []int{2})
+	}
+	if err := mock.ExpectationsWereMet(); err != nil {
+		t.Error(err)
+	}
+}
@@ -0,0 +1,99 @@
+package migration
+
+import (
+	"context"
+	"database/sql"
+	"encoding/json"
+	"time"
+
+	"github.com/SekiguchiKai/clean-architecture-with-go/server/domain/service"
+	"github.com/SekiguchiKai/clean-architecture-with-go/server/infra/dao/rdb"
+	"github.com/pkg/errors"
+)
+
+// NameKeyBackfillVersion は、NameとAliasesの照合用のキーを埋めるマイグレーションのバージョン。
+const NameKeyBackfillVersion = 12
+
+// Step は、マイグレーションのファイルを適用した後に、SQLでは行えない移行を行う処理。
+type Step func(ctx context.Context, sqlM rdb.SQLManagerInterface) error
+
+// DefaultSteps は、マイグレーションのバージョンと、そのファイルを適用した後に実行する処理の対応を返す。
+func DefaultSteps() map[int]Step {
+	return map[int]Step{
+		NameKeyBackfillVersion: BackfillNameKeys,
+	}
+}
+
+// langNames は、照合用のキーを埋めるProgrammingLangのNameとAliases。
+type langNames struct {
+	id      int
+	name    string
+	aliases []string
+}
+
+// BackfillNameKeys は、全てのProgrammingLangのname_keyをservice.NameKeyで計算し直し、
+// aliasesのカラムの別名をprogramming_lang_aliasesに移す。1つのトランザクションで行い、何度実行しても同じ結果になる。
+// 同じキーの別名を複数のProgrammingLangが持つ場合は、どちらで照合するか決められないため、エラーを返す。
+func BackfillNameKeys(ctx context.Context, sqlM rdb.SQLManagerInterface) error {
+	return sqlM.Transaction(ctx, func(ctx context.Context) error {
+		langs, err := readLangNames(ctx, sqlM)
+		if err != nil {
+			return err
+		}
+
+		for _, l := range langs {
+			if _, err := sqlM.ExecContext(ctx, "UPDATE programming_langs SET name_key=? WHERE id=?", service.NameKey(l.name), l.id); err != nil {
+				return errors.WithStack(err)
+			}
+			if _, err := sqlM.ExecContext(ctx, "DELETE FROM programming_lang_aliases WHERE programming_lang_id=?", l.id); err != nil {
+				return errors.WithStack(err)
+			}
+		}
+
+		owners := make(map[string]*langNames)
+		now := time.Now().UTC()
+		for _, l := range langs {
+			for _, alias := range l.aliases {
+				key := service.NameKey(alias)
+				if owner, ok := owners[key]; ok {
+					if owner.id == l.id {
+						continue
+					}
+					return errors.Errorf("alias %q of %q (id %d) is also an alias of %q (id %d)", alias, l.name, l.id, owner.name, owner.id)
+				}
+				owners[key] = l
+
+				if _, err := sqlM.ExecContext(ctx, "INSERT INTO programming_lang_aliases (alias_key, programming_lang_id, alias, created_at) VALUES (?, ?, ?, ?)", key, l.id, alias, now); err != nil {
+					return errors.WithStack(err)
+				}
+			}
+		}
+		return nil
+	})
+}
+
+// readLangNames は、全てのProgrammingLangのNameとAliasesを読み込む。
+// 同じ接続で書き込めるように、全ての行を読み込んでから返す。
+func readLangNames(ctx context.Context, sqlM rdb.SQLManagerInterface) ([]*langNames, error) {
+	rows, err := sqlM.QueryContext(ctx, "SELECT id, name, aliases FROM programming_langs ORDER BY id")
+	if err != nil {
+		return nil, errors.WithStack(err)
+	}
+	defer rows.Close()
+
+	var langs []*langNames
+	for rows.Next() {
+		l := &langNames{}
+		var aliases sql.NullString
+		if err := rows.Scan(&l.id, &l.name, &aliases); err != nil {
+			return nil, errors.WithStack(err)
+		}
+		if aliases.Valid && aliases.String != "" {
+			if err := json.Unmarshal([]byte(aliases.String), &l.aliases); err != nil {
+				return nil, errors.Wrapf(err, "aliases of %q (id %d)", l.name, l.id)
+			}
+		}
+		langs = append(langs, l)
+	}
+	return langs, nil
+}
@@ -0,0 +1,72 @@
+package migration_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/SekiguchiKai/clean-architecture-with-go/server/infra/dao/rdb"
+	"github.com/SekiguchiKai/clean-architecture-with-go/server/infra/migration"
+	"gopkg.in/DATA-DOG/go-sqlmock.v1"
+)
+
+func TestBackfillNameKeys(t *testing.T) {
+	tests := []struct {
+		name    string
+		setup   func(mock sqlmock.Sqlmock)
+		wantErr bool
+	}{
+		{
+			name: "NameのキーをNFKCの正規化とケースフォールディングで埋め直し、別名をprogramming_lang_aliasesに移すこと",
+			setup: func(mock sqlmock.Sqlmock) {
+				mock.ExpectBegin()
+				mock.ExpectQuery(`SELECT id, name, aliases FROM programming_langs ORDER BY id`).WillReturnRows(
+					sqlmock.NewRows([]string{"id", "name", "aliases"}).
+						AddRow(1, "Go", `["golang","GoLang"]`).
+						AddRow(2, "Ｃ＃", nil),
+				)
+				mock.ExpectExec(`UPDATE programming_langs SET name_key=\? WHERE id=\?`).WithArgs("go", 1).WillReturnResult(sqlmock.NewResult(0, 1))
+				mock.ExpectExec(`DELETE FROM programming_lang_aliases WHERE programming_lang_id=\?`).WithArgs(1).WillReturnResult(sqlmock.NewResult(0, 0))
+				mock.ExpectExec(`UPDATE programming_langs SET name_key=\? WHERE id=\?`).WithArgs("c#", 2).WillReturnResult(sqlmock.NewResult(0, 1))
+				mock.ExpectExec(`DELETE FROM programming_lang_aliases WHERE programming_lang_id=\?`).WithArgs(2).WillReturnResult(sqlmock.NewResult(0, 0))
+				mock.ExpectExec(`INSERT INTO programming_lang_aliases`).WithArgs("golang", 1, "golang", sqlmock.AnyArg()).WillReturnResult(sqlmock.NewResult(0, 1))
+				mock.ExpectCommit()
+			},
+		},
+		{
+			name: "同じキーの別名を複数のProgrammingLangが持つ場合、ロールバックしてエラーを返すこと",
+			setup: func(mock sqlmock.Sqlmock) {
+				mock.ExpectBegin()
+				mock.ExpectQuery(`SELECT id, name, aliases FROM programming_langs ORDER BY id`).WillReturnRows(
+					sqlmock.NewRows([]string{"id", "name", "aliases"}).
+						AddRow(1, "JavaScript", `["js"]`).
+						AddRow(2, "JScript", `["JS"]`),
+				)
+				for id, key := range []string{"javascript", "jscript"} {
+					mock.ExpectExec(`UPDATE programming_langs SET name_key=\? WHERE id=\?`).WithArgs(key, id+1).WillReturnResult(sqlmock.NewResult(0, 1))
+					mock.ExpectExec(`DELETE FROM programming_lang_aliases WHERE programming_lang_id=\?`).WithArgs(id + 1).WillReturnResult(sqlmock.NewResult(0, 0))
+				}
+				mock.ExpectExec(`INSERT INTO programming_lang_aliases`).WithArgs("js", 1, "js", sqlmock.AnyArg()).WillReturnResult(sqlmock.NewResult(0, 1))
+				mock.ExpectRollback()
+			},
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			db, mock, err := sqlmock.New()
+			if err != nil {
+				t.Fatal(err)
+			}
+			defer db.Close()
+			tt.setup(mock)
+
+			err = migration.BackfillNameKeys(context.Background(), &rdb.SQLManager{Conn: db})
+			if (err != nil) != tt.wantErr {
+				t.Errorf("BackfillNameKeys() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if err := mock.ExpectationsWereMet(); err != nil {
+				t.Error(err)
+			}
+		})
+	}
+}
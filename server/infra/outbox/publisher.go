@@ -0,0 +1,102 @@
+package outbox
+
+import (
+	"context"
+	"encoding/json"
+	"io"
+	"sync"
+
+	"github.com/SekiguchiKai/clean-architecture-with-go/server/domain/model"
+	"github.com/SekiguchiKai/clean-architecture-with-go/server/domain/repository"
+	"github.com/SekiguchiKai/clean-architecture-with-go/server/usecase"
+	"github.com/SekiguchiKai/clean-architecture-with-go/server/usecase/input"
+	"github.com/SekiguchiKai/clean-architecture-with-go/server/usecase/output"
+	"github.com/pkg/errors"
+)
+
+// 配信先の名前。Outboxに配信の成否を記録するため、変更しない。
+const (
+	LogPublisherName     = "log"
+	WebhookPublisherName = "webhook"
+	BrokerPublisherName  = "broker"
+)
+
+// LogPublisher は、領域イベントを1行のJSONとしてWriterに書き出す配信先。
+type LogPublisher struct {
+	mu     sync.Mutex
+	Writer io.Writer
+}
+
+// NewLogPublisher は、LogPublisherを生成し、返す。
+func NewLogPublisher(w io.Writer) repository.EventPublisher {
+	return &LogPublisher{
+		Writer: w,
+	}
+}
+
+// Name は、配信先の名前を返す。
+func (p *LogPublisher) Name() string {
+	return LogPublisherName
+}
+
+// Publish は、領域イベントを1行のJSONとして書き出す。
+func (p *LogPublisher) Publish(ctx context.Context, event *model.ProgrammingLangEvent) error {
+	b, err := json.Marshal(output.NewProgrammingLangEventOutput(event))
+	if err != nil {
+		return errors.WithStack(err)
+	}
+
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	if _, err := p.Writer.Write(append(b, '\n')); err != nil {
+		return errors.WithStack(err)
+	}
+
+	return nil
+}
+
+// WebhookPublisher は、領域イベントをWebhookの通知として登録する配信先。
+type WebhookPublisher struct {
+	UseCase input.WebhookInputPort
+}
+
+// NewWebhookPublisher は、WebhookPublisherを生成し、返す。
+func NewWebhookPublisher(useCase input.WebhookInputPort) repository.EventPublisher {
+	return &WebhookPublisher{
+		UseCase: useCase,
+	}
+}
+
+// Name は、配信先の名前を返す。
+func (p *WebhookPublisher) Name() string {
+	return WebhookPublisherName
+}
+
+// Publish は、領域イベントを購読しているWebhookごとに通知を登録する。
+func (p *WebhookPublisher) Publish(ctx context.Context, event *model.ProgrammingLangEvent) error {
+	return p.UseCase.Enqueue(ctx, event)
+}
+
+// BrokerPublisher は、領域イベントをプロセス内の購読者に配信する配信先。
+type BrokerPublisher struct {
+	Broker *usecase.EventBroker
+}
+
+// NewBrokerPublisher は、BrokerPublisherを生成し、返す。
+func NewBrokerPublisher(broker *usecase.EventBroker) repository.EventPublisher {
+	return &BrokerPublisher{
+		Broker: broker,
+	}
+}
+
+// Name は、配信先の名前を返す。
+func (p *BrokerPublisher) Name() string {
+	return BrokerPublisherName
+}
+
+// Publish は、領域イベントを全ての購読者に配信する。
+func (p *BrokerPublisher) Publish(ctx context.Context, event *model.ProgrammingLangEvent) error {
+	p.Broker.Publish(event)
+	return nil
+}
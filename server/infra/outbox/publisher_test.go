@@ -0,0 +1,55 @@
+package outbox_test
+
+import (
+	"bytes"
+	"context"
+	"testing"
+	"time"
+
+	"github.com/SekiguchiKai/clean-architecture-with-go/server/domain/model"
+	"github.com/SekiguchiKai/clean-architecture-with-go/server/infra/outbox"
+	"github.com/SekiguchiKai/clean-architecture-with-go/server/usecase"
+)
+
+func TestLogPublisher_Publish(t *testing.T) {
+	now := time.Date(2018, time.September, 1, 0, 0, 0, 0, time.UTC)
+
+	tests := []struct {
+		name  string
+		event *model.ProgrammingLangEvent
+		want  string
+	}{
+		{
+			name:  "領域イベントを1行のJSONとして書き出すこと",
+			event: &model.ProgrammingLangEvent{ID: 3, Type: model.EventTypeDeleted, OccurredAt: now},
+			want:  `{"id":3,"type":"deleted","lang":null,"occurredAt":"2018-09-01T00:00:00Z"}` + "\n",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			buf := &bytes.Buffer{}
+			if err := outbox.NewLogPublisher(buf).Publish(context.Background(), tt.event); err != nil {
+				t.Fatal(err)
+			}
+			if got := buf.String(); got != tt.want {
+				t.Errorf("LogPublisher.Publish() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestBrokerPublisher_Publish(t *testing.T) {
+	broker := usecase.NewEventBroker()
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	events := broker.Subscribe(ctx, 1)
+	if err := outbox.NewBrokerPublisher(broker).Publish(ctx, &model.ProgrammingLangEvent{ID: 3, Type: model.EventTypeCreated}); err != nil {
+		t.Fatal(err)
+	}
+
+	if event := <-events; event.ID != 3 {
+		t.Errorf("BrokerPublisher.Publish() id = %v, want %v", event.ID, 3)
+	}
+}
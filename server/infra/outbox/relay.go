@@ -0,0 +1,56 @@
+package outbox
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"time"
+
+	"github.com/SekiguchiKai/clean-architecture-with-go/server/usecase/input"
+)
+
+// Relayの設定。
+const (
+	DefaultRelayInterval = time.Second
+	DefaultRelayBatch    = 100
+)
+
+// Relay は、Outboxに記録した領域イベントを定期的に配信先に中継する。
+// 配信する権利はイベントごとに得るため、複数のプロセスで動かしても同じイベントを同時に配信しない。
+type Relay struct {
+	UseCase   input.OutboxInputPort
+	Interval  time.Duration
+	BatchSize int
+}
+
+// NewRelay は、Relayを生成し、返す。
+func NewRelay(useCase input.OutboxInputPort) *Relay {
+	return &Relay{
+		UseCase:   useCase,
+		Interval:  DefaultRelayInterval,
+		BatchSize: DefaultRelayBatch,
+	}
+}
+
+// Run は、ctxが終了するまで領域イベントを中継し続ける。
+// 1回に中継した件数がBatchSizeに達した場合は、間隔を空けずに続けて中継する。
+func (r *Relay) Run(ctx context.Context) {
+	ticker := time.NewTicker(r.Interval)
+	defer ticker.Stop()
+
+	for {
+		n, err := r.UseCase.Relay(ctx, r.BatchSize)
+		if err != nil {
+			fmt.Fprintln(os.Stderr, err.Error())
+		}
+		if err == nil && n >= r.BatchSize {
+			continue
+		}
+
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+	}
+}
@@ -0,0 +1,130 @@
+package ratelimit
+
+import (
+	"container/list"
+	"context"
+	"math"
+	"sync"
+	"time"
+
+	"github.com/SekiguchiKai/clean-architecture-with-go/server/adapter/api"
+)
+
+// DefaultMaxBuckets は、MemoryStoreが保持するバケット数の上限の初期値。
+const DefaultMaxBuckets = 10000
+
+// bucket は、トークンバケット。
+// fullAt は、バケット自身のQuotaで満タンまで補充される日時であり、それ以降は破棄しても結果が変わらない。
+type bucket struct {
+	key    string
+	tokens float64
+	last   time.Time
+	fullAt time.Time
+}
+
+// MemoryStore は、プロセス内でトークンバケットを保持するRateLimitStore。
+// バケットは最近使用した順に保持し、上限に達した場合は満タンになったバケット、最も使われていないバケットの順に破棄する。
+type MemoryStore struct {
+	mu         sync.Mutex
+	maxBuckets int
+	ll         *list.List
+	buckets    map[string]*list.Element
+}
+
+// NewMemoryStore は、最大でmaxBuckets個のバケットを保持するMemoryStoreを生成し、返す。
+// maxBucketsが0以下の場合は、DefaultMaxBucketsを使用する。
+func NewMemoryStore(maxBuckets int) api.RateLimitStore {
+	if maxBuckets <= 0 {
+		maxBuckets = DefaultMaxBuckets
+	}
+	return &MemoryStore{
+		maxBuckets: maxBuckets,
+		ll:         list.New(),
+		buckets:    make(map[string]*list.Element),
+	}
+}
+
+// Take は、keyに対応するバケットからトークンを1つ取得する。
+func (s *MemoryStore) Take(ctx context.Context, key string, quota api.RateLimitQuota, now time.Time) (*api.RateLimitResult, error) {
+	if err := quota.Validate(); err != nil {
+		return nil, err
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	capacity := float64(quota.Limit)
+	rate := capacity / quota.Per.Seconds()
+
+	var b *bucket
+	if e, ok := s.buckets[key]; ok {
+		b = e.Value.(*bucket)
+		b.tokens = refill(b, now, rate, capacity)
+		s.ll.MoveToFront(e)
+	} else {
+		s.evict(now)
+		b = &bucket{key: key, tokens: capacity}
+		s.buckets[key] = s.ll.PushFront(b)
+	}
+	b.last = now
+
+	result := &api.RateLimitResult{
+		Limit: quota.Limit,
+	}
+
+	if b.tokens >= 1 {
+		b.tokens--
+		result.Allowed = true
+	} else {
+		result.RetryAfter = seconds((1 - b.tokens) / rate)
+	}
+
+	result.Remaining = int(math.Floor(b.tokens))
+	result.Reset = seconds((capacity - b.tokens) / rate)
+	b.fullAt = now.Add(result.Reset)
+
+	return result, nil
+}
+
+// Len は、保持しているバケット数を返す。
+func (s *MemoryStore) Len() int {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return s.ll.Len()
+}
+
+// evict は、バケットを1つ追加できるように、上限に達している場合はバケットを破棄する。
+// 使われていない順に満タンになったバケットを破棄し、それでも上限に達している場合は最も使われていないバケットを破棄する。
+func (s *MemoryStore) evict(now time.Time) {
+	if s.ll.Len() < s.maxBuckets {
+		return
+	}
+
+	for e := s.ll.Back(); e != nil && !now.Before(e.Value.(*bucket).fullAt); e = s.ll.Back() {
+		s.remove(e)
+	}
+
+	for s.ll.Len() >= s.maxBuckets && s.ll.Len() > 0 {
+		s.remove(s.ll.Back())
+	}
+}
+
+// remove は、バケットを破棄する。
+func (s *MemoryStore) remove(e *list.Element) {
+	s.ll.Remove(e)
+	delete(s.buckets, e.Value.(*bucket).key)
+}
+
+// refill は、経過時間に応じて補充したトークン数を返す。
+func refill(b *bucket, now time.Time, rate, capacity float64) float64 {
+	elapsed := now.Sub(b.last).Seconds()
+	if elapsed < 0 {
+		elapsed = 0
+	}
+	return math.Min(capacity, b.tokens+elapsed*rate)
+}
+
+// seconds は、秒数をDurationに変換する。
+func seconds(s float64) time.Duration {
+	return time.Duration(s * float64(time.Second))
+}
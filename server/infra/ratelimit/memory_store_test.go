@@ -0,0 +1,162 @@
+package ratelimit_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/SekiguchiKai/clean-architecture-with-go/server/adapter/api"
+	"github.com/SekiguchiKai/clean-architecture-with-go/server/domain/model"
+	"github.com/SekiguchiKai/clean-architecture-with-go/server/infra/ratelimit"
+)
+
+func TestMemoryStore_Take(t *testing.T) {
+	quota := api.RateLimitQuota{Limit: 2, Per: 2 * time.Second}
+	start := model.GetTestTime(time.October, 1)
+
+	type take struct {
+		key     string
+		elapsed time.Duration
+	}
+
+	type want struct {
+		allowed    bool
+		remaining  int
+		retryAfter time.Duration
+	}
+
+	tests := []struct {
+		name  string
+		takes []take
+		want  want
+	}{
+		{
+			name:  "初回のリクエストの場合、許可して残りのトークン数を返すこと",
+			takes: []take{{key: "a"}},
+			want:  want{allowed: true, remaining: 1},
+		},
+		{
+			name:  "トークンを使い切った場合、拒否してRetryAfterを返すこと",
+			takes: []take{{key: "a"}, {key: "a"}, {key: "a"}},
+			want:  want{allowed: false, remaining: 0, retryAfter: time.Second},
+		},
+		{
+			name:  "時間が経過してトークンが補充された場合、許可すること",
+			takes: []take{{key: "a"}, {key: "a"}, {key: "a", elapsed: time.Second}},
+			want:  want{allowed: true, remaining: 0},
+		},
+		{
+			name:  "キーが異なる場合、別のバケットとして扱うこと",
+			takes: []take{{key: "a"}, {key: "a"}, {key: "b"}},
+			want:  want{allowed: true, remaining: 1},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			s := ratelimit.NewMemoryStore(ratelimit.DefaultMaxBuckets)
+
+			var got *api.RateLimitResult
+			for _, tk := range tt.takes {
+				var err error
+				got, err = s.Take(context.Background(), tk.key, quota, start.Add(tk.elapsed))
+				if err != nil {
+					t.Fatal(err)
+				}
+			}
+
+			if got.Allowed != tt.want.allowed {
+				t.Errorf("MemoryStore.Take() Allowed = %v, want %v", got.Allowed, tt.want.allowed)
+			}
+			if got.Remaining != tt.want.remaining {
+				t.Errorf("MemoryStore.Take() Remaining = %v, want %v", got.Remaining, tt.want.remaining)
+			}
+			if got.RetryAfter != tt.want.retryAfter {
+				t.Errorf("MemoryStore.Take() RetryAfter = %v, want %v", got.RetryAfter, tt.want.retryAfter)
+			}
+		})
+	}
+}
+
+func TestMemoryStore_Evict(t *testing.T) {
+	start := model.GetTestTime(time.October, 1)
+	short := api.RateLimitQuota{Limit: 1, Per: time.Second}
+	long := api.RateLimitQuota{Limit: 2, Per: time.Hour}
+
+	type take struct {
+		key     string
+		quota   api.RateLimitQuota
+		elapsed time.Duration
+	}
+
+	type want struct {
+		len     int
+		kept    []string
+		evicted string
+	}
+
+	tests := []struct {
+		name       string
+		maxBuckets int
+		takes      []take
+		want       want
+	}{
+		{
+			name:       "上限に達した場合、最も使われていないバケットを破棄すること",
+			maxBuckets: 2,
+			takes: []take{
+				{key: "a", quota: long},
+				{key: "b", quota: long},
+				{key: "a", quota: long},
+				{key: "c", quota: long},
+			},
+			want: want{len: 2, kept: []string{"a", "c"}, evicted: "b"},
+		},
+		{
+			name:       "上限に達した場合、各バケット自身のQuotaで満タンになったバケットのみを破棄すること",
+			maxBuckets: 3,
+			takes: []take{
+				{key: "a", quota: short},
+				{key: "b", quota: long},
+				{key: "c", quota: long},
+				{key: "d", quota: short, elapsed: 2 * time.Second},
+			},
+			want: want{len: 3, kept: []string{"b", "c"}, evicted: "a"},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			s := ratelimit.NewMemoryStore(tt.maxBuckets).(*ratelimit.MemoryStore)
+
+			var now time.Time
+			for _, tk := range tt.takes {
+				now = start.Add(tk.elapsed)
+				if _, err := s.Take(context.Background(), tk.key, tk.quota, now); err != nil {
+					t.Fatal(err)
+				}
+			}
+
+			if got := s.Len(); got != tt.want.len {
+				t.Errorf("MemoryStore.Len() = %v, want %v", got, tt.want.len)
+			}
+
+			// 保持しているバケットはトークンの消費を引き継ぎ、破棄したバケットは満タンから始まる。
+			for _, key := range tt.want.kept {
+				got, err := s.Take(context.Background(), key, long, now)
+				if err != nil {
+					t.Fatal(err)
+				}
+				if got.Allowed && got.Remaining == long.Limit-1 {
+					t.Errorf("MemoryStore.Take(%s) Remaining = %v, want kept bucket", key, got.Remaining)
+				}
+			}
+
+			got, err := s.Take(context.Background(), tt.want.evicted, long, now)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if !got.Allowed || got.Remaining != long.Limit-1 {
+				t.Errorf("MemoryStore.Take(%s) Remaining = %v, want %v", tt.want.evicted, got.Remaining, long.Limit-1)
+			}
+		})
+	}
+}
@@ -1,31 +1,218 @@
 package router
 
 import (
+	"context"
+	"fmt"
+	"os"
+
 	"github.com/SekiguchiKai/clean-architecture-with-go/server/adapter/api"
+	"github.com/SekiguchiKai/clean-architecture-with-go/server/adapter/gql"
+	"github.com/SekiguchiKai/clean-architecture-with-go/server/adapter/rpc"
+	"github.com/SekiguchiKai/clean-architecture-with-go/server/domain/repository"
+	"github.com/SekiguchiKai/clean-architecture-with-go/server/infra/config"
+	"github.com/SekiguchiKai/clean-architecture-with-go/server/infra/dao/cache"
+	"github.com/SekiguchiKai/clean-architecture-with-go/server/infra/dao/index"
 	"github.com/SekiguchiKai/clean-architecture-with-go/server/infra/dao/rdb"
+	"github.com/SekiguchiKai/clean-architecture-with-go/server/infra/idempotency"
+	"github.com/SekiguchiKai/clean-architecture-with-go/server/infra/outbox"
+	"github.com/SekiguchiKai/clean-architecture-with-go/server/infra/ratelimit"
+	"github.com/SekiguchiKai/clean-architecture-with-go/server/infra/webhook"
 	"github.com/SekiguchiKai/clean-architecture-with-go/server/usecase"
+	"github.com/SekiguchiKai/clean-architecture-with-go/server/usecase/input"
 	"github.com/gin-gonic/gin"
+	"google.golang.org/grpc"
 )
 
 // G は、ginのフレームワークのインスタンス。
 var G *gin.Engine
 
-// init は、アプリケーションの初期設定を行う。
-func init() {
+// GRPC は、gRPCのServerのインスタンス。
+var GRPC *grpc.Server
+
+// Import は、コマンドラインからProgrammingLangを取り込むためのUseCaseのインスタンス。
+var Import input.ImportInputPort
+
+// Webhook は、コマンドラインからWebhookの通知を管理するためのUseCaseのインスタンス。
+var Webhook input.WebhookInputPort
+
+// Dispatcher は、Webhookの通知を送信するDispatcherのインスタンス。
+var Dispatcher *webhook.Dispatcher
+
+// Broker は、ProgrammingLangの変更をプロセス内の購読者に配信するEventBrokerのインスタンス。
+var Broker *usecase.EventBroker
+
+// Relay は、Outboxに記録した領域イベントを中継するRelayのインスタンス。
+var Relay *outbox.Relay
+
+// Init は、cfgに従ってアプリケーションの初期設定を行う。
+// DBに接続しないサブコマンドから呼ばれないよう、パッケージの初期化ではなく明示的に呼び出す。
+func Init(cfg *config.Config, info api.BuildInfo) {
 	g := gin.New()
-	apiV1 := g.Group("/v1")
+	clients := api.NewClientIdentifier(append(cfg.ClientAPIKeys, cfg.AdminAPIKey), cfg.TrustedProxies)
+	rateLimiter := initRateLimiter(cfg, clients)
+	idempotent := api.NewIdempotency(idempotency.NewMemoryStore(idempotency.DefaultMaxRecords), clients, api.DefaultIdempotencyTTL)
+	deprecation := api.NewDeprecation(cfg.V1DeprecatedAt, cfg.V1Sunset, api.V1Path+api.ProgrammingLangAPIPath, api.V2Path+api.ProgrammingLangAPIPath)
+	validator := api.NewRequestValidator(initOpenAPISpec())
+	apiV1 := g.Group(api.V1Path)
+	apiV1.Use(deprecation.Handle, rateLimiter.Handle, validator.Handle, idempotent.Handle)
+	apiV2 := g.Group(api.V2Path)
+	apiV2.Use(rateLimiter.Handle, validator.Handle, idempotent.Handle)
+
+	sqlM := rdb.NewSQLManager(cfg.DatabaseDSN)
+	langDAO := rdb.NewProgrammingLangDAO(sqlM)
+	webhookUseCase := initWebhook(sqlM)
+	broker := usecase.NewEventBroker()
+	langCache := cache.NewProgrammingLangCache(langDAO, cache.DefaultSize, cache.DefaultTTL)
+	langUseCase, searchUseCase := initProgrammingLang(langCache, sqlM, broker)
 
-	sqlM := rdb.NewSQLManager()
-	langAPI := initProgrammingLang(sqlM)
+	langAPI := api.NewProgrammingLangAPI(langUseCase)
 	langAPI.InitAPI(apiV1)
 
+	langV2API := api.NewProgrammingLangV2API(langUseCase)
+	langV2API.InitAPI(apiV2)
+
+	versionAPI := api.NewLanguageVersionAPI(initLanguageVersion(langDAO, sqlM))
+	versionAPI.InitAPI(apiV1)
+
+	tagUseCase := initTag(langDAO, sqlM)
+	tagAPI := api.NewTagAPI(tagUseCase)
+	tagAPI.InitAPI(apiV1)
+
+	influenceAPI := api.NewInfluenceAPI(initInfluence(langDAO, sqlM))
+	influenceAPI.InitAPI(apiV1)
+
+	detectionAPI := api.NewDetectionAPI(initDetection(langDAO))
+	detectionAPI.InitAPI(apiV1)
+
+	admin := apiV1.Group(api.AdminAPIPath, api.NewAdminAuth(cfg.AdminAPIKey).Handle)
+
+	importUseCase := usecase.NewImportUseCase(langUseCase, tagUseCase, sqlM)
+	importAPI := api.NewImportAPI(importUseCase)
+	importAPI.InitAPI(admin)
+
+	searchAPI := api.NewSearchAPI(searchUseCase)
+	searchAPI.InitAPI(apiV1, admin)
+
+	webhookAPI := api.NewWebhookAPI(webhookUseCase)
+	webhookAPI.InitAPI(admin)
+
+	cacheAPI := api.NewCacheAPI(langCache)
+	cacheAPI.InitAPI(admin)
+
+	langGraphQL, err := gql.NewProgrammingLangGraphQL(langUseCase)
+	if err != nil {
+		panic(err.Error())
+	}
+	langGraphQL.InitAPI(g.Group("", rateLimiter.Handle))
+
+	docsAPI := api.NewDocsAPI()
+	docsAPI.InitAPI(g.Group("", rateLimiter.Handle))
+
+	buildAPI := api.NewVersionAPI(info)
+	buildAPI.InitAPI(g.Group("", rateLimiter.Handle))
+
+	s := grpc.NewServer()
+	rpc.NewProgrammingLangServer(langUseCase).Register(s)
+
 	G = g
+	GRPC = s
+	Import = importUseCase
+	Webhook = webhookUseCase
+	Dispatcher = webhook.NewDispatcher(webhookUseCase)
+	Relay = initOutbox(sqlM, webhookUseCase, broker)
+	Broker = broker
+}
+
+// InitAdmin は、cfgに従ってDBを直接更新するサブコマンドの初期設定を行い、ImportとWebhookのみを設定する。
+// 結果を標準出力にJSONで書くため、ルート、gRPC、GraphQL、索引、Outboxの中継は構築しない。
+// 変更はOutboxに記録するが、起動中のサーバーのキャッシュと索引には反映されない。
+func InitAdmin(cfg *config.Config) {
+	sqlM := rdb.NewSQLManager(cfg.DatabaseDSN)
+	langDAO := rdb.NewProgrammingLangDAO(sqlM)
+	langUseCase := usecase.NewProgrammingLangUseCase(langDAO, nil, sqlM, rdb.NewOutboxDAO(sqlM), nil)
+
+	Import = usecase.NewImportUseCase(langUseCase, initTag(langDAO, sqlM), sqlM)
+	Webhook = initWebhook(sqlM)
+}
+
+// initRateLimiter は、RateLimiterに関する初期設定を行う。
+// クライアントは、登録済みのAPIキー、もしくは信頼するプロキシを考慮した接続元のIPアドレスで識別する。
+func initRateLimiter(cfg *config.Config, clients *api.ClientIdentifier) *api.RateLimiter {
+	limiter, err := api.NewRateLimiter(ratelimit.NewMemoryStore(ratelimit.DefaultMaxBuckets), clients, cfg.RateLimitRead, cfg.RateLimitWrite)
+	if err != nil {
+		panic(err.Error())
+	}
+	for _, rq := range cfg.RateLimitRoutes {
+		if err := limiter.SetRouteQuota(rq.Method, rq.Pattern, rq.Quota); err != nil {
+			panic(err.Error())
+		}
+	}
+	return limiter
+}
+
+// initOpenAPISpec は、リクエストの検証に使用するOpenAPIの文書を読み込む。
+func initOpenAPISpec() *api.OpenAPISpec {
+	spec, err := api.LoadOpenAPISpec()
+	if err != nil {
+		panic(err.Error())
+	}
+	return spec
 }
 
 // initProgrammingLang は、ProgrammingLangに関する初期設定を行う。
-func initProgrammingLang(sqlM rdb.SQLManagerInterface) *api.ProgrammingLangAPI {
-	rep := rdb.NewProgrammingLangDAO(sqlM)
-	u := usecase.NewProgrammingLangUseCase(rep)
-	api := api.NewProgrammingLangAPI(u)
-	return api
+// RESTとgRPCで変更の通知を共有するため、UseCaseは1つだけ生成する。
+// Nameと別名の索引と全文検索の索引は起動時に構築し、構築できなかった場合は最初の検索で構築し直す。
+// 書き込みを両方の索引に反映するため、ProgrammingLangのUseCaseには外側の全文検索の索引を渡す。
+// 変更は同じトランザクションでOutboxに記録し、brokerへの配信はRelayに任せる。
+// repには、統計情報を管理用のAPIから参照するため、呼び出し側で生成したキャッシュを渡す。
+func initProgrammingLang(rep repository.ProgrammingLangRepository, sqlM rdb.SQLManagerInterface, broker *usecase.EventBroker) (input.ProgrammingLangInputPort, input.SearchInputPort) {
+	idx := index.NewProgrammingLangIndex(rep)
+	search := index.NewProgrammingLangSearchIndex(idx)
+
+	ctx := context.Background()
+	if err := idx.Rebuild(ctx); err != nil {
+		fmt.Fprintln(os.Stderr, err.Error())
+	}
+	if _, err := search.Reindex(ctx); err != nil {
+		fmt.Fprintln(os.Stderr, err.Error())
+	}
+
+	return usecase.NewProgrammingLangUseCase(search, idx, sqlM, rdb.NewOutboxDAO(sqlM), broker), usecase.NewSearchUseCase(search)
+}
+
+// initWebhook は、Webhookに関する初期設定を行う。
+func initWebhook(sqlM rdb.SQLManagerInterface) input.WebhookInputPort {
+	return usecase.NewWebhookUseCase(rdb.NewWebhookDAO(sqlM), rdb.NewWebhookDeliveryDAO(sqlM), webhook.NewHTTPSender())
+}
+
+// initOutbox は、Outboxに関する初期設定を行う。
+// 領域イベントは、ログ、Webhook、プロセス内の購読者の順に配信する。
+func initOutbox(sqlM rdb.SQLManagerInterface, webhookUseCase input.WebhookInputPort, broker *usecase.EventBroker) *outbox.Relay {
+	relayUseCase := usecase.NewOutboxRelayUseCase(
+		rdb.NewOutboxDAO(sqlM),
+		outbox.NewLogPublisher(os.Stdout),
+		outbox.NewWebhookPublisher(webhookUseCase),
+		outbox.NewBrokerPublisher(broker),
+	)
+	return outbox.NewRelay(relayUseCase)
+}
+
+// initLanguageVersion は、LanguageVersionに関する初期設定を行う。
+func initLanguageVersion(langRepo repository.ProgrammingLangRepository, sqlM rdb.SQLManagerInterface) input.LanguageVersionInputPort {
+	return usecase.NewLanguageVersionUseCase(langRepo, rdb.NewLanguageVersionDAO(sqlM))
+}
+
+// initTag は、Tagに関する初期設定を行う。
+func initTag(langRepo repository.ProgrammingLangRepository, sqlM rdb.SQLManagerInterface) input.TagInputPort {
+	return usecase.NewTagUseCase(langRepo, rdb.NewTagDAO(sqlM))
+}
+
+// initInfluence は、Influenceに関する初期設定を行う。
+func initInfluence(langRepo repository.ProgrammingLangRepository, sqlM rdb.SQLManagerInterface) input.InfluenceInputPort {
+	return usecase.NewInfluenceUseCase(langRepo, rdb.NewInfluenceDAO(sqlM))
+}
+
+// initDetection は、言語の推定に関する初期設定を行う。
+func initDetection(langRepo repository.ProgrammingLangRepository) input.DetectionInputPort {
+	return usecase.NewDetectionUseCase(langRepo)
 }
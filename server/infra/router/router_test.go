@@ -0,0 +1,102 @@
+package router
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/SekiguchiKai/clean-architecture-with-go/server/adapter/api"
+	"github.com/SekiguchiKai/clean-architecture-with-go/server/infra/config"
+	"github.com/gin-gonic/gin"
+)
+
+// unreachableDSN は、接続を直ちに拒否されるDSN。Initの索引の構築は失敗するが、ルートの登録には影響しない。
+const unreachableDSN = "root:@tcp(127.0.0.1:1)/sample?charset=utf8mb4&parseTime=True"
+
+// pathShape は、ginのルート(:name)とOpenAPIのパス({name})を、パラメータの名前を除いた同じ形式に変換する。
+// パラメータの名前はginとOpenAPIで異なるため、パラメータの位置と固定のセグメントのみを比較する。
+func pathShape(path string) string {
+	segments := strings.Split(path, "/")
+	for i, s := range segments {
+		if strings.HasPrefix(s, ":") || strings.HasPrefix(s, "{") {
+			segments[i] = "{}"
+		}
+	}
+	return strings.Join(segments, "/")
+}
+
+// servesTemplate は、ginのルートがOpenAPIのパスへのリクエストを処理するかどうかを確認する。
+// ginでは:idと同じ位置に固定のパスを定義できないため、/webhooks/dead-lettersのような固定のパスは:idのルートが処理する。
+func servesTemplate(route, template string) bool {
+	routeSegments := strings.Split(route, "/")
+	templateSegments := strings.Split(template, "/")
+	if len(routeSegments) != len(templateSegments) {
+		return false
+	}
+
+	for i, s := range routeSegments {
+		t := templateSegments[i]
+		if strings.HasPrefix(s, ":") {
+			continue
+		}
+		if strings.HasPrefix(t, "{") || s != t {
+			return false
+		}
+	}
+	return true
+}
+
+// TestInit_OpenAPIRoutes は、Initで登録した全てのルートとOpenAPIの文書のルートが一致することを確認する。
+// ルートを追加、削除した際に文書を更新し忘れると失敗する。
+func TestInit_OpenAPIRoutes(t *testing.T) {
+	cfg, err := config.Load(func(key string) string {
+		if key == config.DatabaseDSNEnv {
+			return unreachableDSN
+		}
+		return ""
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	Init(cfg, api.BuildInfo{})
+
+	spec, err := api.LoadOpenAPISpec()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	routes := G.Routes()
+	if len(routes) == 0 {
+		t.Fatal("no routes are registered")
+	}
+
+	for _, route := range routes {
+		found := false
+		for template, item := range spec.Paths {
+			if _, ok := item[strings.ToLower(route.Method)]; ok && pathShape(route.Path) == pathShape(template) {
+				found = true
+				break
+			}
+		}
+		if !found {
+			t.Errorf("%s %s is registered but not in the OpenAPI document", route.Method, route.Path)
+		}
+	}
+
+	for template, item := range spec.Paths {
+		for method := range item {
+			if !registered(routes, method, template) {
+				t.Errorf("%s %s is in the OpenAPI document but not registered", strings.ToUpper(method), template)
+			}
+		}
+	}
+}
+
+// registered は、OpenAPIのパスとメソッドへのリクエストを処理するルートが登録されているかどうかを確認する。
+func registered(routes gin.RoutesInfo, method, template string) bool {
+	for _, route := range routes {
+		if strings.ToLower(route.Method) == method && servesTemplate(route.Path, template) {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,56 @@
+package webhook
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"time"
+
+	"github.com/SekiguchiKai/clean-architecture-with-go/server/usecase/input"
+)
+
+// Dispatcherの設定。
+const (
+	DefaultDispatchInterval = 5 * time.Second
+	DefaultDispatchBatch    = 50
+)
+
+// Dispatcher は、送信予定の日時を過ぎたWebhookの通知を定期的に送信する。
+// 送信する権利は通知ごとに得るため、複数のプロセスで動かしても同じ通知を重ねて送信しない。
+type Dispatcher struct {
+	UseCase   input.WebhookInputPort
+	Interval  time.Duration
+	BatchSize int
+}
+
+// NewDispatcher は、Dispatcherを生成し、返す。
+func NewDispatcher(useCase input.WebhookInputPort) *Dispatcher {
+	return &Dispatcher{
+		UseCase:   useCase,
+		Interval:  DefaultDispatchInterval,
+		BatchSize: DefaultDispatchBatch,
+	}
+}
+
+// Run は、ctxが終了するまで通知を送信し続ける。
+// 1回に送信した件数がBatchSizeに達した場合は、間隔を空けずに続けて送信する。
+func (d *Dispatcher) Run(ctx context.Context) {
+	ticker := time.NewTicker(d.Interval)
+	defer ticker.Stop()
+
+	for {
+		n, err := d.UseCase.DeliverDue(ctx, d.BatchSize)
+		if err != nil {
+			fmt.Fprintln(os.Stderr, err.Error())
+		}
+		if err == nil && n >= d.BatchSize {
+			continue
+		}
+
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+	}
+}
@@ -0,0 +1,60 @@
+package webhook
+
+import (
+	"bytes"
+	"context"
+	"io"
+	"io/ioutil"
+	"net/http"
+	"time"
+
+	"github.com/SekiguchiKai/clean-architecture-with-go/server/domain/model"
+	"github.com/SekiguchiKai/clean-architecture-with-go/server/domain/repository"
+	"github.com/pkg/errors"
+)
+
+// 通知の送信の設定。
+const (
+	DefaultSendTimeout = 10 * time.Second
+	MaxResponseBytes   = 64 * 1024
+)
+
+// HTTPSender は、HTTPのPOSTでWebhookの通知を送信するWebhookSender。
+type HTTPSender struct {
+	Client *http.Client
+}
+
+// NewHTTPSender は、HTTPSenderを生成し、返す。
+// 転送先で通知を受け取ったとみなさないように、リダイレクトには従わない。
+func NewHTTPSender() repository.WebhookSender {
+	return &HTTPSender{
+		Client: &http.Client{
+			Timeout: DefaultSendTimeout,
+			CheckRedirect: func(req *http.Request, via []*http.Request) error {
+				return http.ErrUseLastResponse
+			},
+		},
+	}
+}
+
+// Send は、通知を送信し、応答のステータスコードを返す。応答を受け取れなかった場合は、エラーを返す。
+func (s *HTTPSender) Send(ctx context.Context, req *model.WebhookRequest) (int, error) {
+	httpReq, err := http.NewRequest(http.MethodPost, req.URL, bytes.NewReader(req.Body))
+	if err != nil {
+		return 0, errors.WithStack(err)
+	}
+	for k, v := range req.Headers {
+		httpReq.Header.Set(k, v)
+	}
+
+	res, err := s.Client.Do(httpReq.WithContext(ctx))
+	if err != nil {
+		return 0, errors.WithStack(err)
+	}
+	defer res.Body.Close()
+
+	// 接続を再利用できるように、応答のボディを読み捨てる。
+	io.Copy(ioutil.Discard, io.LimitReader(res.Body, MaxResponseBytes))
+
+	return res.StatusCode, nil
+}
@@ -0,0 +1,71 @@
+package webhook_test
+
+import (
+	"context"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/SekiguchiKai/clean-architecture-with-go/server/domain/model"
+	"github.com/SekiguchiKai/clean-architecture-with-go/server/infra/webhook"
+)
+
+func TestHTTPSender_Send(t *testing.T) {
+	tests := []struct {
+		name    string
+		handler http.HandlerFunc
+		want    int
+	}{
+		{
+			name: "通知を受け取った場合、応答のステータスコードを返すこと",
+			handler: func(w http.ResponseWriter, r *http.Request) {
+				body, _ := ioutil.ReadAll(r.Body)
+				if r.Method != http.MethodPost || r.Header.Get("X-Webhook-Event") != "created" || string(body) != `{"type":"created"}` {
+					w.WriteHeader(http.StatusBadRequest)
+					return
+				}
+				w.WriteHeader(http.StatusNoContent)
+			},
+			want: http.StatusNoContent,
+		},
+		{
+			name: "リダイレクトされた場合、転送先に送信せずに3xxのステータスコードを返すこと",
+			handler: func(w http.ResponseWriter, r *http.Request) {
+				http.Redirect(w, r, "/elsewhere", http.StatusFound)
+			},
+			want: http.StatusFound,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			srv := httptest.NewServer(tt.handler)
+			defer srv.Close()
+
+			req := &model.WebhookRequest{
+				URL:     srv.URL,
+				Headers: map[string]string{"X-Webhook-Event": "created"},
+				Body:    []byte(`{"type":"created"}`),
+			}
+
+			got, err := webhook.NewHTTPSender().Send(context.Background(), req)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if got != tt.want {
+				t.Errorf("HTTPSender.Send() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestHTTPSender_Send_Unreachable(t *testing.T) {
+	srv := httptest.NewServer(http.NotFoundHandler())
+	url := srv.URL
+	srv.Close()
+
+	got, err := webhook.NewHTTPSender().Send(context.Background(), &model.WebhookRequest{URL: url})
+	if err == nil || got != 0 {
+		t.Errorf("HTTPSender.Send() = %v, %v, want 0 and error", got, err)
+	}
+}
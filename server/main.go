@@ -1,11 +1,165 @@
 package main
 
 import (
-	"github.com/SekiguchiKai/clean-architecture-with-go/server/infra/router"
+	"encoding/json"
+	"flag"
+	"fmt"
+	"io"
+	"os"
+	"strings"
+
+	"github.com/SekiguchiKai/clean-architecture-with-go/server/adapter/api"
+	"github.com/SekiguchiKai/clean-architecture-with-go/server/infra/config"
+)
+
+// ビルド時に-ldflags "-X main.version=..."で埋め込む値。go runで実行した場合は、既定の値のままとなる。
+var (
+	version   = "dev"
+	commit    = ""
+	buildTime = ""
 )
 
+// サブコマンドの名前。
+const (
+	serveCommand          = "serve"
+	migrateCommand        = "migrate"
+	seedCommand           = "seed"
+	reindexCommand        = "reindex"
+	purgeCommand          = "purge"
+	checkConfigCommand    = "check-config"
+	versionCommand        = "version"
+	importLinguistCommand = "import-linguist"
+	helpCommand           = "help"
+)
+
+// command は、サブコマンドを表す。
+type command struct {
+	name    string
+	summary string
+	run     func(args []string) error
+}
+
+// commands は、サブコマンドの一覧。usageで表示する順序と一致させる。
+var commands []*command
+
+func init() {
+	commands = []*command{
+		{name: serveCommand, summary: "start the HTTP and gRPC servers (default)", run: serve},
+		{name: migrateCommand, summary: "apply pending migrations in MIGRATIONS_DIR", run: migrate},
+		{name: seedCommand, summary: "import the bundled languages in SEED_FILE (restart a running server afterwards)", run: seed},
+		{name: importLinguistCommand, summary: "import a GitHub Linguist languages.yml (restart a running server afterwards)", run: importLinguist},
+		{name: reindexCommand, summary: "rebuild the search index of a running server", run: reindex},
+		{name: purgeCommand, summary: "delete finished webhook deliveries", run: purge},
+		{name: checkConfigCommand, summary: "validate and print the configuration", run: checkConfig},
+		{name: versionCommand, summary: "print the build metadata", run: printVersion},
+		{name: helpCommand, summary: "show this help", run: help},
+	}
+}
+
 func main() {
-	if err := router.G.Run(":8080"); err != nil {
-		panic(err.Error())
+	name, args := splitCommand(os.Args[1:])
+	cmd := findCommand(name)
+	if cmd == nil {
+		fmt.Fprintf(os.Stderr, "unknown command: %s\n", name)
+		usage(os.Stderr)
+		os.Exit(2)
+	}
+
+	if err := cmd.run(args); err != nil {
+		fmt.Fprintln(os.Stderr, err.Error())
+		os.Exit(1)
+	}
+}
+
+// splitCommand は、引数をサブコマンドの名前とその引数に分ける。
+// サブコマンドが指定されていない場合は、これまでと同じくサーバーを起動する。
+func splitCommand(args []string) (string, []string) {
+	if len(args) == 0 || strings.HasPrefix(args[0], "-") {
+		return serveCommand, args
+	}
+	return args[0], args[1:]
+}
+
+// findCommand は、nameのサブコマンドを返す。存在しない場合は、nilを返す。
+func findCommand(name string) *command {
+	for _, cmd := range commands {
+		if cmd.name == name {
+			return cmd
+		}
+	}
+	return nil
+}
+
+// usage は、サブコマンドの一覧をwに書き込む。
+func usage(w io.Writer) {
+	fmt.Fprintf(w, "usage: %s <command> [flags]\n\ncommands:\n", os.Args[0])
+	for _, cmd := range commands {
+		fmt.Fprintf(w, "  %-16s %s\n", cmd.name, cmd.summary)
+	}
+	fmt.Fprintf(w, "\nconfiguration is read from %s, %s, %s, %s, %s, %s, %s,\n%s, %s, %s, %s, %s,\n%s and %s.\n",
+		config.HTTPAddrEnv, config.GRPCAddrEnv, config.DatabaseDSNEnv, config.AdminAPIKeyEnv,
+		config.MigrationsDirEnv, config.SeedFileEnv, config.ShutdownTimeoutEnv,
+		config.ClientAPIKeysEnv, config.TrustedProxiesEnv, config.RateLimitReadEnv, config.RateLimitWriteEnv, config.RateLimitRoutesEnv,
+		config.V1DeprecatedAtEnv, config.V1SunsetEnv)
+}
+
+// help は、サブコマンドの一覧を表示する。
+func help(args []string) error {
+	usage(os.Stdout)
+	return nil
+}
+
+// newFlagSet は、サブコマンドのフラグを定義するFlagSetを生成し、返す。
+func newFlagSet(name, arguments string) *flag.FlagSet {
+	fs := flag.NewFlagSet(name, flag.ExitOnError)
+	fs.Usage = func() {
+		fmt.Fprintf(fs.Output(), "usage: %s %s [flags]%s\n", os.Args[0], name, arguments)
+		fs.PrintDefaults()
+	}
+	return fs
+}
+
+// loadConfig は、環境変数から設定を読み込む。
+func loadConfig() (*config.Config, error) {
+	return config.Load(os.Getenv)
+}
+
+// buildInfo は、ビルド時に埋め込んだ値を返す。
+func buildInfo() api.BuildInfo {
+	return api.BuildInfo{
+		Version:   version,
+		Commit:    commit,
+		BuildTime: buildTime,
 	}
 }
+
+// printVersion は、ビルド時に埋め込んだ値を表示する。DBには接続しない。
+func printVersion(args []string) error {
+	fs := newFlagSet(versionCommand, "")
+	asJSON := fs.Bool("json", false, "print as JSON")
+	if err := fs.Parse(args); err != nil {
+		return err
+	}
+
+	info := api.NewVersionAPI(buildInfo()).Info
+	if *asJSON {
+		return writeJSON(os.Stdout, info)
+	}
+	fmt.Printf("version %s (commit %s, built %s, %s)\n", info.Version, orUnknown(info.Commit), orUnknown(info.BuildTime), info.GoVersion)
+	return nil
+}
+
+// orUnknown は、vが空の場合にunknownを返す。
+func orUnknown(v string) string {
+	if v == "" {
+		return "unknown"
+	}
+	return v
+}
+
+// writeJSON は、vをインデントしたJSONとしてwに書き込む。
+func writeJSON(w io.Writer, v interface{}) error {
+	enc := json.NewEncoder(w)
+	enc.SetIndent("", "  ")
+	return enc.Encode(v)
+}
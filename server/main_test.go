@@ -0,0 +1,82 @@
+package main
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestSplitCommand(t *testing.T) {
+	tests := []struct {
+		name     string
+		args     []string
+		wantName string
+		wantArgs []string
+	}{
+		{
+			name:     "引数がない場合、serveを返すこと",
+			args:     []string{},
+			wantName: serveCommand,
+			wantArgs: []string{},
+		},
+		{
+			name:     "フラグから始まる場合、serveとフラグを返すこと",
+			args:     []string{"-h"},
+			wantName: serveCommand,
+			wantArgs: []string{"-h"},
+		},
+		{
+			name:     "サブコマンドが指定された場合、その名前と残りの引数を返すこと",
+			args:     []string{purgeCommand, "-older-than", "24h"},
+			wantName: purgeCommand,
+			wantArgs: []string{"-older-than", "24h"},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			gotName, gotArgs := splitCommand(tt.args)
+			if gotName != tt.wantName {
+				t.Errorf("splitCommand() name = %v, want %v", gotName, tt.wantName)
+			}
+			if !reflect.DeepEqual(gotArgs, tt.wantArgs) {
+				t.Errorf("splitCommand() args = %v, want %v", gotArgs, tt.wantArgs)
+			}
+		})
+	}
+}
+
+func TestFindCommand(t *testing.T) {
+	for _, name := range []string{serveCommand, migrateCommand, seedCommand, reindexCommand, purgeCommand, checkConfigCommand, versionCommand, importLinguistCommand} {
+		if cmd := findCommand(name); cmd == nil || cmd.name != name {
+			t.Errorf("findCommand(%q) = %v, want the command", name, cmd)
+		}
+	}
+	if cmd := findCommand("unknown"); cmd != nil {
+		t.Errorf("findCommand(%q) = %v, want nil", "unknown", cmd)
+	}
+}
+
+func TestServerURL(t *testing.T) {
+	tests := []struct {
+		name string
+		addr string
+		want string
+	}{
+		{
+			name: "ホストが省略されている場合、localhostのURLを返すこと",
+			addr: ":8080",
+			want: "http://localhost:8080",
+		},
+		{
+			name: "ホストが指定されている場合、そのホストのURLを返すこと",
+			addr: "app:8080",
+			want: "http://app:8080",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := serverURL(tt.addr); got != tt.want {
+				t.Errorf("serverURL() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
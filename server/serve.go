@@ -0,0 +1,70 @@
+package main
+
+import (
+	"context"
+	"fmt"
+	"net"
+	"net/http"
+	"os"
+	"os/signal"
+	"syscall"
+	"time"
+
+	"github.com/SekiguchiKai/clean-architecture-with-go/server/infra/router"
+)
+
+// serve は、HTTPとgRPCのサーバーを起動し、SIGINTかSIGTERMを受け取るまで待つ。
+func serve(args []string) error {
+	fs := newFlagSet(serveCommand, "")
+	if err := fs.Parse(args); err != nil {
+		return err
+	}
+
+	cfg, err := loadConfig()
+	if err != nil {
+		return err
+	}
+	router.Init(cfg, buildInfo())
+
+	lis, err := net.Listen("tcp", cfg.GRPCAddr)
+	if err != nil {
+		return err
+	}
+	go func() {
+		if err := router.GRPC.Serve(lis); err != nil {
+			panic(err.Error())
+		}
+	}()
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	go router.Dispatcher.Run(ctx)
+	go router.Relay.Run(ctx)
+
+	srv := &http.Server{Addr: cfg.HTTPAddr, Handler: router.G}
+	go shutdownOnSignal(srv, cancel, cfg.ShutdownTimeout)
+
+	if err := srv.ListenAndServe(); err != nil && err != http.ErrServerClosed {
+		return err
+	}
+	return nil
+}
+
+// shutdownOnSignal は、SIGINTかSIGTERMを受け取った場合に、処理中のリクエストをtimeoutまで待ってサーバーを停止する。
+// 変更の購読は終わらないため、先に閉じてから停止する。
+func shutdownOnSignal(srv *http.Server, cancel context.CancelFunc, timeout time.Duration) {
+	sig := make(chan os.Signal, 1)
+	signal.Notify(sig, syscall.SIGINT, syscall.SIGTERM)
+	<-sig
+
+	cancel()
+	router.Broker.Close()
+	router.GRPC.GracefulStop()
+
+	ctx, done := context.WithTimeout(context.Background(), timeout)
+	defer done()
+	if err := srv.Shutdown(ctx); err != nil {
+		fmt.Fprintln(os.Stderr, err.Error())
+	}
+}
@@ -0,0 +1,66 @@
+package usecase
+
+import (
+	"context"
+
+	"github.com/SekiguchiKai/clean-architecture-with-go/server/domain/model"
+	"github.com/SekiguchiKai/clean-architecture-with-go/server/domain/repository"
+	"github.com/SekiguchiKai/clean-architecture-with-go/server/domain/service"
+	"github.com/SekiguchiKai/clean-architecture-with-go/server/usecase/input"
+	"github.com/SekiguchiKai/clean-architecture-with-go/server/util"
+	"github.com/pkg/errors"
+)
+
+// detectionPageSize は、言語の推定のためにProgrammingLangを一度に取得する件数。
+const detectionPageSize = 100
+
+// DetectionUseCase は、言語の推定のUseCase。
+type DetectionUseCase struct {
+	Repo repository.ProgrammingLangRepository
+}
+
+// NewDetectionUseCase は、DetectionUseCaseを生成し、返す。
+func NewDetectionUseCase(repo repository.ProgrammingLangRepository) input.DetectionInputPort {
+	return &DetectionUseCase{
+		Repo: repo,
+	}
+}
+
+// Detect は、ファイル名と内容から推定したファイルの言語の候補を、スコアの高い順に返す。
+func (u *DetectionUseCase) Detect(ctx context.Context, filename, content string) ([]*model.DetectionCandidate, error) {
+	if util.IsEmpty(filename) && util.IsEmpty(content) {
+		return nil, &model.RequiredError{
+			Property: model.PropertyFilename,
+		}
+	}
+
+	langSlice, err := u.listAll(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	return service.DetectLanguages(langSlice, filename, content), nil
+}
+
+// listAll は、全てのProgrammingLangをNameの昇順にページごとに取得して返す。
+func (u *DetectionUseCase) listAll(ctx context.Context) ([]*model.ProgrammingLang, error) {
+	langSlice := make([]*model.ProgrammingLang, 0)
+	filter := &model.ProgrammingLangFilter{
+		Limit: detectionPageSize,
+	}
+	for {
+		page, err := u.Repo.ListByFilter(ctx, filter)
+		if err != nil {
+			return nil, errors.WithStack(err)
+		}
+
+		langSlice = append(langSlice, page...)
+		if len(page) < detectionPageSize {
+			return langSlice, nil
+		}
+		filter = &model.ProgrammingLangFilter{
+			After: page[len(page)-1].Name,
+			Limit: detectionPageSize,
+		}
+	}
+}
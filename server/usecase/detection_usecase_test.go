@@ -0,0 +1,70 @@
+package usecase
+
+import (
+	"context"
+	"fmt"
+	"reflect"
+	"testing"
+
+	"github.com/SekiguchiKai/clean-architecture-with-go/server/domain/model"
+	"github.com/SekiguchiKai/clean-architecture-with-go/server/infra/dao/mock"
+	"github.com/golang/mock/gomock"
+	"github.com/pkg/errors"
+)
+
+func TestDetectionUseCase_Detect(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	repo := mock_repository.NewMockProgrammingLangRepository(ctrl)
+
+	firstPage := make([]*model.ProgrammingLang, detectionPageSize)
+	for i := range firstPage {
+		firstPage[i] = &model.ProgrammingLang{ID: i + 1, Name: fmt.Sprintf("lang%03d", i)}
+	}
+	rust := &model.ProgrammingLang{ID: 101, Name: "Rust", Extensions: []string{".rs"}}
+
+	tests := []struct {
+		name        string
+		filename    string
+		content     string
+		mock        func(ctx context.Context)
+		want        []*model.DetectionCandidate
+		wantErrType error
+	}{
+		{
+			name:     "ProgrammingLangが複数ページに渡る場合、全てのページから推定すること",
+			filename: "main.rs",
+			mock: func(ctx context.Context) {
+				repo.EXPECT().ListByFilter(ctx, &model.ProgrammingLangFilter{Limit: detectionPageSize}).Return(firstPage, nil)
+				repo.EXPECT().ListByFilter(ctx, &model.ProgrammingLangFilter{After: "lang099", Limit: detectionPageSize}).Return([]*model.ProgrammingLang{rust}, nil)
+			},
+			want: []*model.DetectionCandidate{
+				{Lang: rust, Score: 50, Reasons: []model.DetectionReason{model.DetectionReasonExtension}},
+			},
+		},
+		{
+			name:        "ファイル名も内容も指定されていない場合、RequiredErrorを返すこと",
+			mock:        func(ctx context.Context) {},
+			wantErrType: &model.RequiredError{},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			u := &DetectionUseCase{
+				Repo: repo,
+			}
+			ctx := context.Background()
+			tt.mock(ctx)
+
+			got, err := u.Detect(ctx, tt.filename, tt.content)
+			if reflect.TypeOf(errors.Cause(err)) != reflect.TypeOf(tt.wantErrType) {
+				t.Errorf("DetectionUseCase.Detect() error = %v, want %T", err, tt.wantErrType)
+				return
+			}
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("DetectionUseCase.Detect() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,130 @@
+package usecase
+
+import (
+	"context"
+	"sync"
+
+	"github.com/SekiguchiKai/clean-architecture-with-go/server/domain/model"
+)
+
+// DefaultSubscriberBuffer は、購読者ごとのチャネルのバッファの初期値。
+const DefaultSubscriberBuffer = 64
+
+// DefaultReplaySize は、再接続した購読者に配信し直すために保持するイベントの件数の初期値。
+const DefaultReplaySize = 256
+
+// EventBroker は、ProgrammingLangEventをプロセス内の購読者に配信する。
+// 直近のイベントを保持し、再接続した購読者には受信し損ねたイベントから配信する。
+type EventBroker struct {
+	mu          sync.Mutex
+	subscribers map[chan *model.ProgrammingLangEvent]struct{}
+	replay      []*model.ProgrammingLangEvent
+	replaySize  int
+	closed      bool
+}
+
+// NewEventBroker は、EventBrokerを生成し、返す。
+func NewEventBroker() *EventBroker {
+	return &EventBroker{
+		subscribers: make(map[chan *model.ProgrammingLangEvent]struct{}),
+		replaySize:  DefaultReplaySize,
+	}
+}
+
+// Subscribe は、イベントを受信するチャネルを返す。
+// チャネルは、ctxが終了した場合、受信が追いつかずバッファが溢れた場合、もしくはBrokerが閉じられた場合に閉じられる。
+func (b *EventBroker) Subscribe(ctx context.Context, buffer int) <-chan *model.ProgrammingLangEvent {
+	ch, _ := b.SubscribeFrom(ctx, buffer, 0)
+	return ch
+}
+
+// SubscribeFrom は、IDがlastIDのイベントより後のイベントから受信するチャネルを返す。lastIDが0の場合は、これから配信するイベントのみを受信する。
+// 保持しているイベントにlastIDのイベントが含まれず、受信し損ねたイベントを全て配信できない場合は、falseを返す。
+func (b *EventBroker) SubscribeFrom(ctx context.Context, buffer int, lastID int) (<-chan *model.ProgrammingLangEvent, bool) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	missed, complete := b.since(lastID)
+	ch := make(chan *model.ProgrammingLangEvent, buffer+len(missed))
+	for _, event := range missed {
+		ch <- event
+	}
+
+	if b.closed {
+		close(ch)
+		return ch, complete
+	}
+	b.subscribers[ch] = struct{}{}
+
+	go func() {
+		<-ctx.Done()
+		b.unsubscribe(ch)
+	}()
+
+	return ch, complete
+}
+
+// Publish は、全ての購読者にイベントを配信する。受信が追いつかない購読者は購読を解除する。
+func (b *EventBroker) Publish(event *model.ProgrammingLangEvent) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	b.replay = append(b.replay, event)
+	if len(b.replay) > b.replaySize {
+		b.replay = b.replay[len(b.replay)-b.replaySize:]
+	}
+
+	for ch := range b.subscribers {
+		select {
+		case ch <- event:
+		default:
+			delete(b.subscribers, ch)
+			close(ch)
+		}
+	}
+}
+
+// Close は、全ての購読を解除してチャネルを閉じる。閉じた後に購読したチャネルは、保持しているイベントを配信した後に閉じられる。
+func (b *EventBroker) Close() {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	b.closed = true
+	for ch := range b.subscribers {
+		delete(b.subscribers, ch)
+		close(ch)
+	}
+}
+
+// since は、保持しているイベントのうち、IDがlastIDのイベントより後のイベントを返す。
+// lastIDのイベントを保持していない場合は、IDがlastIDより大きいイベントとfalseを返す。
+func (b *EventBroker) since(lastID int) ([]*model.ProgrammingLangEvent, bool) {
+	if lastID <= 0 {
+		return nil, true
+	}
+
+	for i, event := range b.replay {
+		if event.ID == lastID {
+			return append([]*model.ProgrammingLangEvent(nil), b.replay[i+1:]...), true
+		}
+	}
+
+	var missed []*model.ProgrammingLangEvent
+	for _, event := range b.replay {
+		if event.ID > lastID {
+			missed = append(missed, event)
+		}
+	}
+	return missed, false
+}
+
+// unsubscribe は、購読を解除してチャネルを閉じる。
+func (b *EventBroker) unsubscribe(ch chan *model.ProgrammingLangEvent) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	if _, ok := b.subscribers[ch]; ok {
+		delete(b.subscribers, ch)
+		close(ch)
+	}
+}
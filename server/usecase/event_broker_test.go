@@ -0,0 +1,166 @@
+package usecase
+
+import (
+	"context"
+	"reflect"
+	"testing"
+
+	"github.com/SekiguchiKai/clean-architecture-with-go/server/domain/model"
+)
+
+func TestEventBroker_Publish(t *testing.T) {
+	lang := model.CreateProgrammingLangs(1)[0]
+	event := &model.ProgrammingLangEvent{
+		Type: model.EventTypeCreated,
+		Lang: lang,
+	}
+
+	tests := []struct {
+		name       string
+		buffer     int
+		publish    int
+		wantEvents int
+		wantClosed bool
+	}{
+		{
+			name:       "バッファに空きがある場合、イベントを配信すること",
+			buffer:     2,
+			publish:    2,
+			wantEvents: 2,
+			wantClosed: false,
+		},
+		{
+			name:       "バッファが溢れた場合、配信済みのイベントを残して購読を解除すること",
+			buffer:     1,
+			publish:    2,
+			wantEvents: 1,
+			wantClosed: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctx, cancel := context.WithCancel(context.Background())
+			defer cancel()
+
+			b := NewEventBroker()
+			ch := b.Subscribe(ctx, tt.buffer)
+			for i := 0; i < tt.publish; i++ {
+				b.Publish(event)
+			}
+
+			got := 0
+			closed := false
+		loop:
+			for {
+				select {
+				case e, ok := <-ch:
+					if !ok {
+						closed = true
+						break loop
+					}
+					if !reflect.DeepEqual(e, event) {
+						t.Errorf("EventBroker.Subscribe() event = %v, want %v", e, event)
+					}
+					got++
+				default:
+					break loop
+				}
+			}
+
+			if got != tt.wantEvents {
+				t.Errorf("EventBroker.Subscribe() events = %v, want %v", got, tt.wantEvents)
+			}
+			if closed != tt.wantClosed {
+				t.Errorf("EventBroker.Subscribe() closed = %v, want %v", closed, tt.wantClosed)
+			}
+		})
+	}
+}
+
+func TestEventBroker_Subscribe(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	b := NewEventBroker()
+	ch := b.Subscribe(ctx, 1)
+
+	cancel()
+
+	if _, ok := <-ch; ok {
+		t.Errorf("EventBroker.Subscribe() channel is not closed after the context is done")
+	}
+}
+
+func TestEventBroker_SubscribeFrom(t *testing.T) {
+	tests := []struct {
+		name         string
+		published    []int
+		lastID       int
+		want         []int
+		wantComplete bool
+	}{
+		{
+			name:         "lastIDを指定しない場合、保持しているイベントを配信しないこと",
+			published:    []int{1, 2, 3},
+			lastID:       0,
+			want:         nil,
+			wantComplete: true,
+		},
+		{
+			name:         "lastIDのイベントを保持している場合、その後のイベントから配信すること",
+			published:    []int{1, 2, 3},
+			lastID:       1,
+			want:         []int{2, 3},
+			wantComplete: true,
+		},
+		{
+			name:         "lastIDのイベントを保持していない場合、IDが大きいイベントを配信し、falseを返すこと",
+			published:    []int{5, 6},
+			lastID:       3,
+			want:         []int{5, 6},
+			wantComplete: false,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctx, cancel := context.WithCancel(context.Background())
+			defer cancel()
+
+			b := NewEventBroker()
+			for _, id := range tt.published {
+				b.Publish(&model.ProgrammingLangEvent{ID: id, Type: model.EventTypeUpdated})
+			}
+
+			ch, complete := b.SubscribeFrom(ctx, 1, tt.lastID)
+			if complete != tt.wantComplete {
+				t.Errorf("EventBroker.SubscribeFrom() complete = %v, want %v", complete, tt.wantComplete)
+			}
+
+			// 受信し損ねたイベントの後に、これから配信するイベントを受信すること
+			b.Publish(&model.ProgrammingLangEvent{ID: 10, Type: model.EventTypeUpdated})
+
+			var got []int
+			for e := range ch {
+				got = append(got, e.ID)
+				if e.ID == 10 {
+					break
+				}
+			}
+			if want := append(tt.want, 10); !reflect.DeepEqual(got, want) {
+				t.Errorf("EventBroker.SubscribeFrom() ids = %v, want %v", got, want)
+			}
+		})
+	}
+}
+
+func TestEventBroker_Close(t *testing.T) {
+	b := NewEventBroker()
+	ch := b.Subscribe(context.Background(), 1)
+
+	b.Close()
+
+	if _, ok := <-ch; ok {
+		t.Errorf("EventBroker.Close() did not close the subscribed channel")
+	}
+	if _, ok := <-b.Subscribe(context.Background(), 1); ok {
+		t.Errorf("EventBroker.Subscribe() after Close() returned an open channel")
+	}
+}
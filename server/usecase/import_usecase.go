@@ -0,0 +1,178 @@
+package usecase
+
+import (
+	"context"
+
+	"github.com/SekiguchiKai/clean-architecture-with-go/server/domain/model"
+	"github.com/SekiguchiKai/clean-architecture-with-go/server/domain/repository"
+	"github.com/SekiguchiKai/clean-architecture-with-go/server/domain/service"
+	"github.com/SekiguchiKai/clean-architecture-with-go/server/usecase/input"
+	"github.com/pkg/errors"
+)
+
+// ImportUseCase は、ProgrammingLangの取り込みのUseCase。
+// 生成や更新の通知が行われるように、ProgrammingLangUseCaseを介して保存する。
+// 取り込みは1つのトランザクションで行い、途中で失敗した場合はそれまでの保存も取り消す。
+type ImportUseCase struct {
+	LangUseCase input.ProgrammingLangInputPort
+	TagUseCase  input.TagInputPort
+	Transactor  repository.Transactor
+}
+
+// NewImportUseCase は、ImportUseCaseを生成し、返す。
+func NewImportUseCase(langUseCase input.ProgrammingLangInputPort, tagUseCase input.TagInputPort, transactor repository.Transactor) input.ImportInputPort {
+	return &ImportUseCase{
+		LangUseCase: langUseCase,
+		TagUseCase:  tagUseCase,
+		Transactor:  transactor,
+	}
+}
+
+// Import は、ProgrammingLangをNameで照合して生成もしくは更新し、その件数を返す。
+// 既存のProgrammingLangと差分がない場合と、保存できない値を含む場合はスキップする。
+// スキップした場合もTagは付ける。スキップできないエラーが発生した場合は、全ての保存を取り消してエラーを返す。
+func (u *ImportUseCase) Import(ctx context.Context, imports []*model.LangImport) (*model.ImportReport, error) {
+	var report *model.ImportReport
+	err := u.transaction(ctx, func(ctx context.Context) error {
+		r, err := u.importAll(ctx, imports)
+		if err != nil {
+			return err
+		}
+		report = r
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return report, nil
+}
+
+// importAll は、importsを順に保存してTagを付け、その件数を返す。
+func (u *ImportUseCase) importAll(ctx context.Context, imports []*model.LangImport) (*model.ImportReport, error) {
+	report := &model.ImportReport{}
+	tagIDs := make(map[string]int)
+
+	for _, imp := range imports {
+		lang, err := u.upsert(ctx, imp.Lang, report)
+		if err != nil {
+			return nil, err
+		}
+		if lang == nil {
+			continue
+		}
+
+		for _, name := range imp.Tags {
+			if err := u.attachTag(ctx, lang, name, tagIDs); err != nil {
+				if !isImportSkippable(err) {
+					return nil, err
+				}
+				report.Errors = append(report.Errors, &model.ImportError{Name: lang.Name, Message: err.Error()})
+			}
+		}
+	}
+
+	return report, nil
+}
+
+// transaction は、fnを1つのトランザクションの中で実行する。Transactorが設定されていない場合は、そのまま実行する。
+func (u *ImportUseCase) transaction(ctx context.Context, fn func(ctx context.Context) error) error {
+	if u.Transactor == nil {
+		return fn(ctx)
+	}
+	return u.Transactor.Transaction(ctx, fn)
+}
+
+// upsert は、ProgrammingLangを生成もしくは更新し、結果をreportに数える。保存できない値を含む場合は、nilを返す。
+func (u *ImportUseCase) upsert(ctx context.Context, lang *model.ProgrammingLang, report *model.ImportReport) (*model.ProgrammingLang, error) {
+	service.SanitizeImportedLang(lang)
+
+	saved, err := u.save(ctx, lang, report)
+	if err != nil {
+		if !isImportSkippable(err) {
+			return nil, err
+		}
+		report.Skipped++
+		report.Errors = append(report.Errors, &model.ImportError{Name: lang.Name, Message: err.Error()})
+		return nil, nil
+	}
+	return saved, nil
+}
+
+// save は、NameでProgrammingLangを照合し、存在しない場合は生成し、差分がある場合は更新する。
+func (u *ImportUseCase) save(ctx context.Context, lang *model.ProgrammingLang, report *model.ImportReport) (*model.ProgrammingLang, error) {
+	if err := service.ValidateProgrammingLang(lang.Name); err != nil {
+		return nil, err
+	}
+
+	existing, err := u.LangUseCase.GetByName(ctx, lang.Name)
+	if err != nil {
+		if _, ok := errors.Cause(err).(*model.NoSuchDataError); !ok {
+			return nil, errors.WithStack(err)
+		}
+
+		created, err := u.LangUseCase.Create(ctx, input.NewProgrammingLangCreate(lang))
+		if err != nil {
+			return nil, err
+		}
+		report.Created++
+		return created, nil
+	}
+
+	if !service.IsImportChanged(existing, lang) {
+		report.Skipped++
+		return existing, nil
+	}
+
+	updated, err := u.LangUseCase.Update(ctx, existing.ID, importUpdate(existing, lang))
+	if err != nil {
+		return nil, err
+	}
+	report.Updated++
+	return updated, nil
+}
+
+// importUpdate は、取り込むlangに設定されている属性だけを変更するProgrammingLangUpdateを生成する。
+// 取り込むデータに含まれないNameの表記とFeatureや、設定されていない属性は、既存の値を維持する。
+func importUpdate(existing, lang *model.ProgrammingLang) *input.ProgrammingLangUpdate {
+	param := &input.ProgrammingLangUpdate{
+		Name:         existing.Name,
+		Feature:      existing.Feature,
+		Extensions:   lang.Extensions,
+		Filenames:    lang.Filenames,
+		Interpreters: lang.Interpreters,
+		Aliases:      lang.Aliases,
+	}
+	if lang.Color != "" {
+		param.Color = &lang.Color
+	}
+	return param
+}
+
+// attachTag は、名前で指定したTagをProgrammingLangに付ける。Tagが存在しない場合は生成する。
+func (u *ImportUseCase) attachTag(ctx context.Context, lang *model.ProgrammingLang, name string, tagIDs map[string]int) error {
+	name = service.NormalizeTagName(name)
+	id, ok := tagIDs[name]
+	if !ok {
+		tag, err := u.TagUseCase.Create(ctx, name)
+		switch e := errors.Cause(err).(type) {
+		case nil:
+			id = tag.ID
+		case *model.AlreadyExistError:
+			id = e.ID
+		default:
+			return err
+		}
+		tagIDs[name] = id
+	}
+
+	return u.TagUseCase.Attach(ctx, lang.ID, id)
+}
+
+// isImportSkippable は、取り込みを中断せずに、その言語をスキップするエラーかどうかを確認する。
+func isImportSkippable(err error) bool {
+	switch errors.Cause(err).(type) {
+	case *model.RequiredError, *model.InvalidPropertyError, *model.InvalidParameterError, *model.AlreadyExistError:
+		return true
+	}
+	return false
+}
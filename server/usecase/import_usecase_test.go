@@ -0,0 +1,151 @@
+package usecase
+
+import (
+	"context"
+	"reflect"
+	"testing"
+
+	"github.com/SekiguchiKai/clean-architecture-with-go/server/domain/model"
+	"github.com/SekiguchiKai/clean-architecture-with-go/server/infra/dao/mock"
+	"github.com/SekiguchiKai/clean-architecture-with-go/server/usecase/input"
+	"github.com/SekiguchiKai/clean-architecture-with-go/server/usecase/mock"
+	"github.com/golang/mock/gomock"
+	"github.com/pkg/errors"
+)
+
+func TestImportUseCase_Import(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	langUseCase := mock_input.NewMockProgrammingLangInputPort(ctrl)
+	tagUseCase := mock_input.NewMockTagInputPort(ctrl)
+	transactor := mock_repository.NewMockTransactor(ctrl)
+
+	tests := []struct {
+		name        string
+		imports     func() []*model.LangImport
+		mock        func(ctx context.Context)
+		want        *model.ImportReport
+		wantErrType error
+	}{
+		{
+			name: "存在しない言語の場合、生成してTagを付けること",
+			imports: func() []*model.LangImport {
+				return []*model.LangImport{
+					{Lang: &model.ProgrammingLang{Name: "Go", Extensions: []string{".GO"}}, Tags: []string{"programming"}},
+					{Lang: &model.ProgrammingLang{Name: "Rust"}, Tags: []string{"programming"}},
+				}
+			},
+			mock: func(ctx context.Context) {
+				langUseCase.EXPECT().GetByName(ctx, "Go").Return(nil, &model.NoSuchDataError{})
+				langUseCase.EXPECT().Create(ctx, &input.ProgrammingLangCreate{Name: "Go", Extensions: []string{".go"}}).Return(&model.ProgrammingLang{ID: 1, Name: "Go"}, nil)
+				tagUseCase.EXPECT().Create(ctx, "programming").Return(&model.Tag{ID: 10, Name: "programming"}, nil)
+				tagUseCase.EXPECT().Attach(ctx, 1, 10).Return(nil)
+				langUseCase.EXPECT().GetByName(ctx, "Rust").Return(nil, &model.NoSuchDataError{})
+				langUseCase.EXPECT().Create(ctx, &input.ProgrammingLangCreate{Name: "Rust"}).Return(&model.ProgrammingLang{ID: 2, Name: "Rust"}, nil)
+				tagUseCase.EXPECT().Attach(ctx, 2, 10).Return(nil)
+			},
+			want: &model.ImportReport{Created: 2},
+		},
+		{
+			name: "既存の言語と差分がある場合、NameとFeatureを維持して更新すること",
+			imports: func() []*model.LangImport {
+				return []*model.LangImport{
+					{Lang: &model.ProgrammingLang{Name: "Go", Aliases: []string{"golang"}}, Tags: []string{"programming"}},
+				}
+			},
+			mock: func(ctx context.Context) {
+				langUseCase.EXPECT().GetByName(ctx, "Go").Return(&model.ProgrammingLang{ID: 1, Name: "Go", Feature: "Goroutine"}, nil)
+				langUseCase.EXPECT().Update(ctx, 1, &input.ProgrammingLangUpdate{Name: "Go", Feature: "Goroutine", Aliases: []string{"golang"}}).Return(&model.ProgrammingLang{ID: 1, Name: "Go"}, nil)
+				tagUseCase.EXPECT().Create(ctx, "programming").Return(nil, &model.AlreadyExistError{ID: 10, Name: "programming"})
+				tagUseCase.EXPECT().Attach(ctx, 1, 10).Return(nil)
+			},
+			want: &model.ImportReport{Updated: 1},
+		},
+		{
+			name: "既存の言語と差分がない場合、スキップしてTagを付けること",
+			imports: func() []*model.LangImport {
+				return []*model.LangImport{
+					{Lang: &model.ProgrammingLang{Name: "Go", Color: "#00ADD8"}, Tags: []string{"programming"}},
+				}
+			},
+			mock: func(ctx context.Context) {
+				langUseCase.EXPECT().GetByName(ctx, "Go").Return(&model.ProgrammingLang{ID: 1, Name: "Go", Color: "#00ADD8"}, nil)
+				tagUseCase.EXPECT().Create(ctx, "programming").Return(&model.Tag{ID: 10, Name: "programming"}, nil)
+				tagUseCase.EXPECT().Attach(ctx, 1, 10).Return(nil)
+			},
+			want: &model.ImportReport{Skipped: 1},
+		},
+		{
+			name: "保存できない名前の場合、スキップしてエラーを記録すること",
+			imports: func() []*model.LangImport {
+				return []*model.LangImport{
+					{Lang: &model.ProgrammingLang{Name: "Augeas Configuration Language"}, Tags: []string{"programming"}},
+				}
+			},
+			mock: func(ctx context.Context) {},
+			want: &model.ImportReport{
+				Skipped: 1,
+				Errors: []*model.ImportError{
+					{Name: "Augeas Configuration Language", Message: (&model.InvalidPropertyError{Property: model.PropertyName, Message: model.NameShouldBeMoreThanOneUnderTheTwenty}).Error()},
+				},
+			},
+		},
+		{
+			name: "DBのエラーの場合、取り込みを中断してエラーを返すこと",
+			imports: func() []*model.LangImport {
+				return []*model.LangImport{
+					{Lang: &model.ProgrammingLang{Name: "Go"}},
+				}
+			},
+			mock: func(ctx context.Context) {
+				langUseCase.EXPECT().GetByName(ctx, "Go").Return(nil, &model.DBError{})
+			},
+			wantErrType: &model.DBError{},
+		},
+		{
+			name: "保存した後にDBのエラーが発生した場合、保存を取り消すためにトランザクションにエラーを返すこと",
+			imports: func() []*model.LangImport {
+				return []*model.LangImport{
+					{Lang: &model.ProgrammingLang{Name: "Go"}},
+					{Lang: &model.ProgrammingLang{Name: "Rust"}},
+				}
+			},
+			mock: func(ctx context.Context) {
+				langUseCase.EXPECT().GetByName(ctx, "Go").Return(nil, &model.NoSuchDataError{})
+				langUseCase.EXPECT().Create(ctx, &input.ProgrammingLangCreate{Name: "Go"}).Return(&model.ProgrammingLang{ID: 1, Name: "Go"}, nil)
+				langUseCase.EXPECT().GetByName(ctx, "Rust").Return(nil, &model.DBError{})
+			},
+			wantErrType: &model.DBError{},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			u := &ImportUseCase{
+				LangUseCase: langUseCase,
+				TagUseCase:  tagUseCase,
+				Transactor:  transactor,
+			}
+			ctx := context.Background()
+			tt.mock(ctx)
+
+			var txErr error
+			transactor.EXPECT().Transaction(ctx, gomock.Any()).DoAndReturn(func(ctx context.Context, fn func(ctx context.Context) error) error {
+				txErr = fn(ctx)
+				return txErr
+			})
+
+			got, err := u.Import(ctx, tt.imports())
+			if reflect.TypeOf(errors.Cause(err)) != reflect.TypeOf(tt.wantErrType) {
+				t.Errorf("ImportUseCase.Import() error = %v, want %T", err, tt.wantErrType)
+				return
+			}
+			if reflect.TypeOf(errors.Cause(txErr)) != reflect.TypeOf(tt.wantErrType) {
+				t.Errorf("Transaction() error = %v, want %T", txErr, tt.wantErrType)
+			}
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("ImportUseCase.Import() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,218 @@
+package usecase
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"time"
+
+	"github.com/SekiguchiKai/clean-architecture-with-go/server/domain/model"
+	"github.com/SekiguchiKai/clean-architecture-with-go/server/domain/repository"
+	"github.com/SekiguchiKai/clean-architecture-with-go/server/domain/service"
+	"github.com/SekiguchiKai/clean-architecture-with-go/server/usecase/input"
+	"github.com/pkg/errors"
+)
+
+// InfluenceUseCase は、InfluenceのUseCase。
+type InfluenceUseCase struct {
+	LangRepo repository.ProgrammingLangRepository
+	Repo     repository.InfluenceRepository
+}
+
+// NewInfluenceUseCase は、InfluenceUseCaseを生成し、返す。
+func NewInfluenceUseCase(langRepo repository.ProgrammingLangRepository, repo repository.InfluenceRepository) input.InfluenceInputPort {
+	return &InfluenceUseCase{
+		LangRepo: langRepo,
+		Repo:     repo,
+	}
+}
+
+// ListInfluencers は、ProgrammingLangに直接影響を与えたProgrammingLangの一覧をIDの昇順で返す。
+func (u *InfluenceUseCase) ListInfluencers(ctx context.Context, langID int) ([]*model.ProgrammingLang, error) {
+	if _, err := u.LangRepo.Read(ctx, langID); err != nil {
+		return nil, errors.WithStack(err)
+	}
+
+	influences, err := u.Repo.ListByLang(ctx, langID)
+	if err != nil {
+		return nil, errors.WithStack(err)
+	}
+
+	ids := make([]int, len(influences))
+	for i, in := range influences {
+		ids[i] = in.InfluencedByID
+	}
+	return u.langsInOrder(ctx, ids)
+}
+
+// Add は、langIDのProgrammingLangがinfluencedByIDのProgrammingLangから影響を受けたことを記録する。
+func (u *InfluenceUseCase) Add(ctx context.Context, langID, influencedByID int) (*model.Influence, error) {
+	influence := &model.Influence{
+		LangID:         langID,
+		InfluencedByID: influencedByID,
+	}
+	if err := service.ValidateInfluence(influence); err != nil {
+		return nil, err
+	}
+
+	for _, id := range []int{langID, influencedByID} {
+		if _, err := u.LangRepo.Read(ctx, id); err != nil {
+			return nil, errors.WithStack(err)
+		}
+	}
+
+	existing, err := u.Repo.Read(ctx, langID, influencedByID)
+	if existing != nil {
+		return nil, &model.AlreadyExistError{
+			ID:        langID,
+			Name:      fmt.Sprintf("%d", influencedByID),
+			ModelName: model.ModelNameInfluence,
+		}
+	}
+
+	if _, ok := errors.Cause(err).(*model.NoSuchDataError); !ok {
+		return nil, errors.WithStack(err)
+	}
+
+	influence.CreatedAt = time.Now().UTC()
+
+	return u.Repo.Create(ctx, influence)
+}
+
+// Remove は、影響関係の記録を削除する。
+func (u *InfluenceUseCase) Remove(ctx context.Context, langID, influencedByID int) error {
+	if _, err := u.Repo.Read(ctx, langID, influencedByID); err != nil {
+		return errors.WithStack(err)
+	}
+
+	return u.Repo.Delete(ctx, langID, influencedByID)
+}
+
+// Ancestors は、ProgrammingLangに影響を与えたProgrammingLangを、depthまでさかのぼって距離の近い順に返す。
+func (u *InfluenceUseCase) Ancestors(ctx context.Context, langID, depth int) ([]*model.InfluenceNode, error) {
+	return u.traverse(ctx, langID, depth, service.InfluenceAncestors)
+}
+
+// Descendants は、ProgrammingLangから影響を受けたProgrammingLangを、depthまでたどって距離の近い順に返す。
+func (u *InfluenceUseCase) Descendants(ctx context.Context, langID, depth int) ([]*model.InfluenceNode, error) {
+	return u.traverse(ctx, langID, depth, service.InfluenceDescendants)
+}
+
+// ShortestPath は、fromからtoまでの影響関係の最短経路を、両端を含めて返す。経路が存在しない場合は、NoSuchDataErrorを返す。
+func (u *InfluenceUseCase) ShortestPath(ctx context.Context, from, to int) ([]*model.ProgrammingLang, error) {
+	for _, id := range []int{from, to} {
+		if _, err := u.LangRepo.Read(ctx, id); err != nil {
+			return nil, errors.WithStack(err)
+		}
+	}
+
+	influences, err := u.Repo.List(ctx)
+	if err != nil {
+		return nil, errors.WithStack(err)
+	}
+
+	path := service.ShortestInfluencePath(influences, from, to)
+	if path == nil {
+		return nil, &model.NoSuchDataError{
+			ID:        from,
+			Name:      fmt.Sprintf("%d", to),
+			ModelName: model.ModelNameInfluencePath,
+		}
+	}
+
+	return u.langsInOrder(ctx, path)
+}
+
+// Graph は、影響関係の全体と、影響関係を持つProgrammingLangをIDの昇順で返す。
+func (u *InfluenceUseCase) Graph(ctx context.Context) (*model.InfluenceGraph, error) {
+	influences, err := u.Repo.List(ctx)
+	if err != nil {
+		return nil, errors.WithStack(err)
+	}
+
+	seen := make(map[int]bool)
+	ids := make([]int, 0)
+	for _, in := range influences {
+		for _, id := range []int{in.InfluencedByID, in.LangID} {
+			if !seen[id] {
+				seen[id] = true
+				ids = append(ids, id)
+			}
+		}
+	}
+	sort.Ints(ids)
+
+	langSlice, err := u.langsInOrder(ctx, ids)
+	if err != nil {
+		return nil, err
+	}
+
+	return &model.InfluenceGraph{
+		Langs:      langSlice,
+		Influences: influences,
+	}, nil
+}
+
+// traverse は、ProgrammingLangから指定した向きに影響関係をたどる。
+func (u *InfluenceUseCase) traverse(ctx context.Context, langID, depth int, direction service.InfluenceDirection) ([]*model.InfluenceNode, error) {
+	if _, err := u.LangRepo.Read(ctx, langID); err != nil {
+		return nil, errors.WithStack(err)
+	}
+
+	influences, err := u.Repo.List(ctx)
+	if err != nil {
+		return nil, errors.WithStack(err)
+	}
+
+	hops := service.TraverseInfluences(influences, langID, depth, direction)
+	ids := make([]int, len(hops))
+	for i, hop := range hops {
+		ids[i] = hop.LangID
+	}
+
+	langs, err := u.langsByID(ctx, ids)
+	if err != nil {
+		return nil, err
+	}
+
+	nodes := make([]*model.InfluenceNode, 0, len(hops))
+	for _, hop := range hops {
+		if lang, ok := langs[hop.LangID]; ok {
+			nodes = append(nodes, &model.InfluenceNode{
+				Lang:  lang,
+				Depth: hop.Depth,
+			})
+		}
+	}
+	return nodes, nil
+}
+
+// langsInOrder は、IDで指定したProgrammingLangをIDの順序で返す。存在しないIDは結果に含まれない。
+func (u *InfluenceUseCase) langsInOrder(ctx context.Context, ids []int) ([]*model.ProgrammingLang, error) {
+	langs, err := u.langsByID(ctx, ids)
+	if err != nil {
+		return nil, err
+	}
+
+	langSlice := make([]*model.ProgrammingLang, 0, len(ids))
+	for _, id := range ids {
+		if lang, ok := langs[id]; ok {
+			langSlice = append(langSlice, lang)
+		}
+	}
+	return langSlice, nil
+}
+
+// langsByID は、IDで指定したProgrammingLangをIDをキーとしたmapで返す。
+func (u *InfluenceUseCase) langsByID(ctx context.Context, ids []int) (map[int]*model.ProgrammingLang, error) {
+	langSlice, err := u.LangRepo.ListByIDs(ctx, ids)
+	if err != nil {
+		return nil, errors.WithStack(err)
+	}
+
+	langs := make(map[int]*model.ProgrammingLang, len(langSlice))
+	for _, lang := range langSlice {
+		langs[lang.ID] = lang
+	}
+	return langs, nil
+}
@@ -0,0 +1,188 @@
+package usecase
+
+import (
+	"context"
+	"reflect"
+	"testing"
+
+	"github.com/SekiguchiKai/clean-architecture-with-go/server/domain/model"
+	"github.com/SekiguchiKai/clean-architecture-with-go/server/infra/dao/mock"
+	"github.com/golang/mock/gomock"
+	"github.com/pkg/errors"
+)
+
+func TestInfluenceUseCase_Add(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	langRepo := mock_repository.NewMockProgrammingLangRepository(ctrl)
+	repo := mock_repository.NewMockInfluenceRepository(ctrl)
+
+	langs := model.CreateProgrammingLangs(2)
+	existing := &model.Influence{LangID: 2, InfluencedByID: 1}
+	noDataErr := &model.NoSuchDataError{ID: 2, Name: "1", ModelName: model.ModelNameInfluence}
+
+	tests := []struct {
+		name           string
+		influencedByID int
+		mock           func(ctx context.Context)
+		wantErrType    error
+	}{
+		{
+			name:           "影響関係が記録されていない場合、Influenceを生成すること",
+			influencedByID: 1,
+			mock: func(ctx context.Context) {
+				langRepo.EXPECT().Read(ctx, 2).Return(langs[1], nil)
+				langRepo.EXPECT().Read(ctx, 1).Return(langs[0], nil)
+				repo.EXPECT().Read(ctx, 2, 1).Return(nil, noDataErr)
+				repo.EXPECT().Create(ctx, gomock.Any()).DoAndReturn(func(ctx context.Context, in *model.Influence) (*model.Influence, error) {
+					if in.LangID != 2 || in.InfluencedByID != 1 || in.CreatedAt.IsZero() {
+						t.Errorf("Influence = %v, want LangID 2 and InfluencedByID 1 with CreatedAt", in)
+					}
+					return in, nil
+				})
+			},
+		},
+		{
+			name:           "影響関係が既に記録されている場合、AlreadyExistErrorを返すこと",
+			influencedByID: 1,
+			mock: func(ctx context.Context) {
+				langRepo.EXPECT().Read(ctx, 2).Return(langs[1], nil)
+				langRepo.EXPECT().Read(ctx, 1).Return(langs[0], nil)
+				repo.EXPECT().Read(ctx, 2, 1).Return(existing, nil)
+			},
+			wantErrType: &model.AlreadyExistError{},
+		},
+		{
+			name:           "影響を与えたProgrammingLangが存在しない場合、NoSuchDataErrorを返すこと",
+			influencedByID: 3,
+			mock: func(ctx context.Context) {
+				langRepo.EXPECT().Read(ctx, 2).Return(langs[1], nil)
+				langRepo.EXPECT().Read(ctx, 3).Return(nil, &model.NoSuchDataError{ID: 3, ModelName: model.ModelNameProgrammingLang})
+			},
+			wantErrType: &model.NoSuchDataError{},
+		},
+		{
+			name:           "自身から影響を受けた場合、InvalidPropertyErrorを返すこと",
+			influencedByID: 2,
+			mock:           func(ctx context.Context) {},
+			wantErrType:    &model.InvalidPropertyError{},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			u := &InfluenceUseCase{
+				LangRepo: langRepo,
+				Repo:     repo,
+			}
+			ctx := context.Background()
+			tt.mock(ctx)
+
+			_, err := u.Add(ctx, 2, tt.influencedByID)
+			if reflect.TypeOf(errors.Cause(err)) != reflect.TypeOf(tt.wantErrType) {
+				t.Errorf("InfluenceUseCase.Add() error = %v, want %T", err, tt.wantErrType)
+			}
+		})
+	}
+}
+
+func TestInfluenceUseCase_Ancestors(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	langRepo := mock_repository.NewMockProgrammingLangRepository(ctrl)
+	repo := mock_repository.NewMockInfluenceRepository(ctrl)
+
+	langs := model.CreateProgrammingLangs(3)
+	influences := []*model.Influence{
+		{LangID: 3, InfluencedByID: 2},
+		{LangID: 2, InfluencedByID: 1},
+		{LangID: 1, InfluencedByID: 3},
+	}
+
+	ctx := context.Background()
+	langRepo.EXPECT().Read(ctx, 3).Return(langs[2], nil)
+	repo.EXPECT().List(ctx).Return(influences, nil)
+	langRepo.EXPECT().ListByIDs(ctx, []int{2, 1}).Return([]*model.ProgrammingLang{langs[0], langs[1]}, nil)
+
+	u := &InfluenceUseCase{
+		LangRepo: langRepo,
+		Repo:     repo,
+	}
+
+	want := []*model.InfluenceNode{
+		{Lang: langs[1], Depth: 1},
+		{Lang: langs[0], Depth: 2},
+	}
+
+	got, err := u.Ancestors(ctx, 3, 10)
+	if err != nil {
+		t.Errorf("InfluenceUseCase.Ancestors() error = %v", err)
+		return
+	}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("InfluenceUseCase.Ancestors() = %v, want %v", got, want)
+	}
+}
+
+func TestInfluenceUseCase_ShortestPath(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	langRepo := mock_repository.NewMockProgrammingLangRepository(ctrl)
+	repo := mock_repository.NewMockInfluenceRepository(ctrl)
+
+	langs := model.CreateProgrammingLangs(3)
+	influences := []*model.Influence{
+		{LangID: 2, InfluencedByID: 1},
+	}
+
+	tests := []struct {
+		name        string
+		to          int
+		mock        func(ctx context.Context)
+		want        []*model.ProgrammingLang
+		wantErrType error
+	}{
+		{
+			name: "経路が存在する場合、両端を含むProgrammingLangを返すこと",
+			to:   2,
+			mock: func(ctx context.Context) {
+				langRepo.EXPECT().Read(ctx, 1).Return(langs[0], nil)
+				langRepo.EXPECT().Read(ctx, 2).Return(langs[1], nil)
+				repo.EXPECT().List(ctx).Return(influences, nil)
+				langRepo.EXPECT().ListByIDs(ctx, []int{1, 2}).Return([]*model.ProgrammingLang{langs[1], langs[0]}, nil)
+			},
+			want: []*model.ProgrammingLang{langs[0], langs[1]},
+		},
+		{
+			name: "経路が存在しない場合、NoSuchDataErrorを返すこと",
+			to:   3,
+			mock: func(ctx context.Context) {
+				langRepo.EXPECT().Read(ctx, 1).Return(langs[0], nil)
+				langRepo.EXPECT().Read(ctx, 3).Return(langs[2], nil)
+				repo.EXPECT().List(ctx).Return(influences, nil)
+			},
+			wantErrType: &model.NoSuchDataError{},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			u := &InfluenceUseCase{
+				LangRepo: langRepo,
+				Repo:     repo,
+			}
+			ctx := context.Background()
+			tt.mock(ctx)
+
+			got, err := u.ShortestPath(ctx, 1, tt.to)
+			if reflect.TypeOf(errors.Cause(err)) != reflect.TypeOf(tt.wantErrType) {
+				t.Errorf("InfluenceUseCase.ShortestPath() error = %v, want %T", err, tt.wantErrType)
+				return
+			}
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("InfluenceUseCase.ShortestPath() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,12 @@
+package input
+
+import (
+	"context"
+
+	"github.com/SekiguchiKai/clean-architecture-with-go/server/domain/model"
+)
+
+// DetectionInputPort は、言語の推定のInputPort。
+type DetectionInputPort interface {
+	Detect(ctx context.Context, filename, content string) ([]*model.DetectionCandidate, error)
+}
@@ -0,0 +1,12 @@
+package input
+
+import (
+	"context"
+
+	"github.com/SekiguchiKai/clean-architecture-with-go/server/domain/model"
+)
+
+// ImportInputPort は、ProgrammingLangの取り込みのInputPort。
+type ImportInputPort interface {
+	Import(ctx context.Context, imports []*model.LangImport) (*model.ImportReport, error)
+}
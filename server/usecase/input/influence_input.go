@@ -0,0 +1,18 @@
+package input
+
+import (
+	"context"
+
+	"github.com/SekiguchiKai/clean-architecture-with-go/server/domain/model"
+)
+
+// InfluenceInputPort は、InfluenceのInputPort。
+type InfluenceInputPort interface {
+	ListInfluencers(ctx context.Context, langID int) ([]*model.ProgrammingLang, error)
+	Add(ctx context.Context, langID, influencedByID int) (*model.Influence, error)
+	Remove(ctx context.Context, langID, influencedByID int) error
+	Ancestors(ctx context.Context, langID, depth int) ([]*model.InfluenceNode, error)
+	Descendants(ctx context.Context, langID, depth int) ([]*model.InfluenceNode, error)
+	ShortestPath(ctx context.Context, from, to int) ([]*model.ProgrammingLang, error)
+	Graph(ctx context.Context) (*model.InfluenceGraph, error)
+}
@@ -0,0 +1,31 @@
+package input
+
+import (
+	"time"
+
+	"github.com/SekiguchiKai/clean-architecture-with-go/server/domain/model"
+)
+
+// LanguageVersionInput は、LanguageVersionを生成、更新する際に受け付ける値。
+// ID、ProgrammingLangのID、生成日時、更新日時はサーバーで決定するため、受け付けない。
+type LanguageVersionInput struct {
+	Version      string     `json:"version"`
+	ReleaseDate  time.Time  `json:"releaseDate"`
+	EOLDate      *time.Time `json:"eolDate"`
+	LTS          bool       `json:"lts"`
+	ChangelogURL string     `json:"changelogUrl"`
+}
+
+// ToModel は、受け付けた値をLanguageVersionに変換して返す。ボディがnullの場合は、値を指定しなかったものとみなす。
+func (in *LanguageVersionInput) ToModel() *model.LanguageVersion {
+	if in == nil {
+		return &model.LanguageVersion{}
+	}
+	return &model.LanguageVersion{
+		Version:      in.Version,
+		ReleaseDate:  in.ReleaseDate,
+		EOLDate:      in.EOLDate,
+		LTS:          in.LTS,
+		ChangelogURL: in.ChangelogURL,
+	}
+}
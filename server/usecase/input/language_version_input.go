@@ -0,0 +1,19 @@
+package input
+
+import (
+	"context"
+	"time"
+
+	"github.com/SekiguchiKai/clean-architecture-with-go/server/domain/model"
+)
+
+// LanguageVersionInputPort は、LanguageVersionのInputPort。
+type LanguageVersionInputPort interface {
+	List(ctx context.Context, langID int) ([]*model.LanguageVersion, error)
+	ListSupportedOn(ctx context.Context, langID int, date time.Time) ([]*model.LanguageVersion, error)
+	GetLatestStable(ctx context.Context, langID int) (*model.LanguageVersion, error)
+	Get(ctx context.Context, langID int, version string) (*model.LanguageVersion, error)
+	Create(ctx context.Context, langID int, param *model.LanguageVersion) (*model.LanguageVersion, error)
+	Update(ctx context.Context, langID int, version string, param *model.LanguageVersion) (*model.LanguageVersion, error)
+	Delete(ctx context.Context, langID int, version string) error
+}
@@ -0,0 +1,8 @@
+package input
+
+import "context"
+
+// OutboxInputPort は、OutboxのInputPort。
+type OutboxInputPort interface {
+	Relay(ctx context.Context, limit int) (int, error)
+}
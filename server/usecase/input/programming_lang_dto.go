@@ -0,0 +1,316 @@
+package input
+
+import "github.com/SekiguchiKai/clean-architecture-with-go/server/domain/model"
+
+// ProgrammingLangInput は、ProgrammingLangを生成、更新する際に受け付ける値。
+// ID、Slug、生成日時、更新日時はサーバーで決定するため、受け付けない。
+// 詳細な属性は、省略またはnullの場合に既存の値を維持し、空文字や空のリストの場合に値を消す。
+type ProgrammingLangInput struct {
+	Name          string              `json:"name"`
+	Feature       string              `json:"feature"`
+	FirstAppeared *int                `json:"firstAppeared"`
+	Designers     []string            `json:"designers"`
+	TypeChecking  *model.TypeChecking `json:"typeChecking"`
+	TypeStrength  *model.TypeStrength `json:"typeStrength"`
+	Paradigms     []model.Paradigm    `json:"paradigms"`
+	License       *string             `json:"license"`
+	Website       *string             `json:"website"`
+	Extensions    []string            `json:"extensions"`
+	Filenames     []string            `json:"filenames"`
+	Interpreters  []string            `json:"interpreters"`
+	Aliases       []string            `json:"aliases"`
+	Color         *string             `json:"color"`
+	StableVersion *string             `json:"stableVersion"`
+}
+
+// NewProgrammingLangInput は、ProgrammingLangから、生成、更新する際に送信する値を生成する。
+func NewProgrammingLangInput(lang *model.ProgrammingLang) *ProgrammingLangInput {
+	u := NewProgrammingLangUpdate(lang)
+	return &ProgrammingLangInput{
+		Name:          u.Name,
+		Feature:       u.Feature,
+		FirstAppeared: u.FirstAppeared,
+		Designers:     u.Designers,
+		TypeChecking:  u.TypeChecking,
+		TypeStrength:  u.TypeStrength,
+		Paradigms:     u.Paradigms,
+		License:       u.License,
+		Website:       u.Website,
+		Extensions:    u.Extensions,
+		Filenames:     u.Filenames,
+		Interpreters:  u.Interpreters,
+		Aliases:       u.Aliases,
+		Color:         u.Color,
+		StableVersion: u.StableVersion,
+	}
+}
+
+// ToModel は、受け付けた値をProgrammingLangに変換して返す。ボディがnullの場合は、値を指定しなかったものとみなす。
+func (in *ProgrammingLangInput) ToModel() *model.ProgrammingLang {
+	return in.ToUpdate().Apply(&model.ProgrammingLang{})
+}
+
+// ToCreate は、受け付けた値をProgrammingLangCreateに変換して返す。ボディがnullの場合は、値を指定しなかったものとみなす。
+func (in *ProgrammingLangInput) ToCreate() *ProgrammingLangCreate {
+	return NewProgrammingLangCreate(in.ToModel())
+}
+
+// ToUpdate は、受け付けた値をProgrammingLangUpdateに変換して返す。ボディがnullの場合は、値を指定しなかったものとみなす。
+func (in *ProgrammingLangInput) ToUpdate() *ProgrammingLangUpdate {
+	if in == nil {
+		in = &ProgrammingLangInput{}
+	}
+	return &ProgrammingLangUpdate{
+		Name:          in.Name,
+		Feature:       in.Feature,
+		FirstAppeared: in.FirstAppeared,
+		Designers:     in.Designers,
+		TypeChecking:  in.TypeChecking,
+		TypeStrength:  in.TypeStrength,
+		Paradigms:     in.Paradigms,
+		License:       in.License,
+		Website:       in.Website,
+		Extensions:    in.Extensions,
+		Filenames:     in.Filenames,
+		Interpreters:  in.Interpreters,
+		Aliases:       in.Aliases,
+		Color:         in.Color,
+		StableVersion: in.StableVersion,
+	}
+}
+
+// ProgrammingLangV2Input は、/v2でProgrammingLangを生成、更新する際に受け付ける値。
+// /v1のfeatureはdescriptionに、firstAppearedはfirstAppearedYearに名前を変えている。
+// 詳細な属性は、省略またはnullの場合に既存の値を維持し、空文字や空のリストの場合に値を消す。
+type ProgrammingLangV2Input struct {
+	Name              string              `json:"name"`
+	Description       string              `json:"description"`
+	FirstAppearedYear *int                `json:"firstAppearedYear"`
+	Designers         []string            `json:"designers"`
+	TypeChecking      *model.TypeChecking `json:"typeChecking"`
+	TypeStrength      *model.TypeStrength `json:"typeStrength"`
+	Paradigms         []model.Paradigm    `json:"paradigms"`
+	License           *string             `json:"license"`
+	Website           *string             `json:"website"`
+	Extensions        []string            `json:"extensions"`
+	Filenames         []string            `json:"filenames"`
+	Interpreters      []string            `json:"interpreters"`
+	Aliases           []string            `json:"aliases"`
+	Color             *string             `json:"color"`
+	StableVersion     *string             `json:"stableVersion"`
+}
+
+// NewProgrammingLangV2Input は、ProgrammingLangから、/v2で生成、更新する際に送信する値を生成する。
+func NewProgrammingLangV2Input(lang *model.ProgrammingLang) *ProgrammingLangV2Input {
+	u := NewProgrammingLangUpdate(lang)
+	return &ProgrammingLangV2Input{
+		Name:              u.Name,
+		Description:       u.Feature,
+		FirstAppearedYear: u.FirstAppeared,
+		Designers:         u.Designers,
+		TypeChecking:      u.TypeChecking,
+		TypeStrength:      u.TypeStrength,
+		Paradigms:         u.Paradigms,
+		License:           u.License,
+		Website:           u.Website,
+		Extensions:        u.Extensions,
+		Filenames:         u.Filenames,
+		Interpreters:      u.Interpreters,
+		Aliases:           u.Aliases,
+		Color:             u.Color,
+		StableVersion:     u.StableVersion,
+	}
+}
+
+// ToModel は、受け付けた値をProgrammingLangに変換して返す。ボディがnullの場合は、値を指定しなかったものとみなす。
+func (in *ProgrammingLangV2Input) ToModel() *model.ProgrammingLang {
+	return in.ToUpdate().Apply(&model.ProgrammingLang{})
+}
+
+// ToCreate は、受け付けた値をProgrammingLangCreateに変換して返す。ボディがnullの場合は、値を指定しなかったものとみなす。
+func (in *ProgrammingLangV2Input) ToCreate() *ProgrammingLangCreate {
+	return NewProgrammingLangCreate(in.ToModel())
+}
+
+// ToUpdate は、受け付けた値をProgrammingLangUpdateに変換して返す。ボディがnullの場合は、値を指定しなかったものとみなす。
+func (in *ProgrammingLangV2Input) ToUpdate() *ProgrammingLangUpdate {
+	if in == nil {
+		in = &ProgrammingLangV2Input{}
+	}
+	return &ProgrammingLangUpdate{
+		Name:          in.Name,
+		Feature:       in.Description,
+		FirstAppeared: in.FirstAppearedYear,
+		Designers:     in.Designers,
+		TypeChecking:  in.TypeChecking,
+		TypeStrength:  in.TypeStrength,
+		Paradigms:     in.Paradigms,
+		License:       in.License,
+		Website:       in.Website,
+		Extensions:    in.Extensions,
+		Filenames:     in.Filenames,
+		Interpreters:  in.Interpreters,
+		Aliases:       in.Aliases,
+		Color:         in.Color,
+		StableVersion: in.StableVersion,
+	}
+}
+
+// ProgrammingLangCreate は、ProgrammingLangを生成する際の値。
+// ID、Slug、生成日時、更新日時はUseCaseで決定するため、含まない。
+type ProgrammingLangCreate struct {
+	Name          string
+	Feature       string
+	FirstAppeared int
+	Designers     []string
+	TypeChecking  model.TypeChecking
+	TypeStrength  model.TypeStrength
+	Paradigms     []model.Paradigm
+	License       string
+	Website       string
+	Extensions    []string
+	Filenames     []string
+	Interpreters  []string
+	Aliases       []string
+	Color         string
+	StableVersion string
+}
+
+// NewProgrammingLangCreate は、langの属性でProgrammingLangを生成するProgrammingLangCreateを生成する。
+func NewProgrammingLangCreate(lang *model.ProgrammingLang) *ProgrammingLangCreate {
+	return &ProgrammingLangCreate{
+		Name:          lang.Name,
+		Feature:       lang.Feature,
+		FirstAppeared: lang.FirstAppeared,
+		Designers:     lang.Designers,
+		TypeChecking:  lang.TypeChecking,
+		TypeStrength:  lang.TypeStrength,
+		Paradigms:     lang.Paradigms,
+		License:       lang.License,
+		Website:       lang.Website,
+		Extensions:    lang.Extensions,
+		Filenames:     lang.Filenames,
+		Interpreters:  lang.Interpreters,
+		Aliases:       lang.Aliases,
+		Color:         lang.Color,
+		StableVersion: lang.StableVersion,
+	}
+}
+
+// ToModel は、生成するProgrammingLangを返す。
+func (c *ProgrammingLangCreate) ToModel() *model.ProgrammingLang {
+	return &model.ProgrammingLang{
+		Name:          c.Name,
+		Feature:       c.Feature,
+		FirstAppeared: c.FirstAppeared,
+		Designers:     c.Designers,
+		TypeChecking:  c.TypeChecking,
+		TypeStrength:  c.TypeStrength,
+		Paradigms:     c.Paradigms,
+		License:       c.License,
+		Website:       c.Website,
+		Extensions:    c.Extensions,
+		Filenames:     c.Filenames,
+		Interpreters:  c.Interpreters,
+		Aliases:       c.Aliases,
+		Color:         c.Color,
+		StableVersion: c.StableVersion,
+	}
+}
+
+// ProgrammingLangUpdate は、ProgrammingLangを更新する際の値。
+// NameとFeatureは常に置き換える。詳細な属性は、nilの場合に既存の値を維持し、
+// 空文字や0や空のスライスの場合に値を消す。
+type ProgrammingLangUpdate struct {
+	Name          string
+	Feature       string
+	FirstAppeared *int
+	Designers     []string
+	TypeChecking  *model.TypeChecking
+	TypeStrength  *model.TypeStrength
+	Paradigms     []model.Paradigm
+	License       *string
+	Website       *string
+	Extensions    []string
+	Filenames     []string
+	Interpreters  []string
+	Aliases       []string
+	Color         *string
+	StableVersion *string
+}
+
+// NewProgrammingLangUpdate は、属性をlangの内容で置き換えるProgrammingLangUpdateを生成する。
+// nilのスライスの属性は既存の値を維持し、空のスライスの属性は値を消す。
+func NewProgrammingLangUpdate(lang *model.ProgrammingLang) *ProgrammingLangUpdate {
+	firstAppeared := lang.FirstAppeared
+	typeChecking := lang.TypeChecking
+	typeStrength := lang.TypeStrength
+	license := lang.License
+	website := lang.Website
+	color := lang.Color
+	stableVersion := lang.StableVersion
+
+	return &ProgrammingLangUpdate{
+		Name:          lang.Name,
+		Feature:       lang.Feature,
+		FirstAppeared: &firstAppeared,
+		Designers:     lang.Designers,
+		TypeChecking:  &typeChecking,
+		TypeStrength:  &typeStrength,
+		Paradigms:     lang.Paradigms,
+		License:       &license,
+		Website:       &website,
+		Extensions:    lang.Extensions,
+		Filenames:     lang.Filenames,
+		Interpreters:  lang.Interpreters,
+		Aliases:       lang.Aliases,
+		Color:         &color,
+		StableVersion: &stableVersion,
+	}
+}
+
+// Apply は、更新する値をlangに反映し、langを返す。
+func (u *ProgrammingLangUpdate) Apply(lang *model.ProgrammingLang) *model.ProgrammingLang {
+	lang.Name = u.Name
+	lang.Feature = u.Feature
+	if u.FirstAppeared != nil {
+		lang.FirstAppeared = *u.FirstAppeared
+	}
+	if u.Designers != nil {
+		lang.Designers = u.Designers
+	}
+	if u.TypeChecking != nil {
+		lang.TypeChecking = *u.TypeChecking
+	}
+	if u.TypeStrength != nil {
+		lang.TypeStrength = *u.TypeStrength
+	}
+	if u.Paradigms != nil {
+		lang.Paradigms = u.Paradigms
+	}
+	if u.License != nil {
+		lang.License = *u.License
+	}
+	if u.Website != nil {
+		lang.Website = *u.Website
+	}
+	if u.Extensions != nil {
+		lang.Extensions = u.Extensions
+	}
+	if u.Filenames != nil {
+		lang.Filenames = u.Filenames
+	}
+	if u.Interpreters != nil {
+		lang.Interpreters = u.Interpreters
+	}
+	if u.Aliases != nil {
+		lang.Aliases = u.Aliases
+	}
+	if u.Color != nil {
+		lang.Color = *u.Color
+	}
+	if u.StableVersion != nil {
+		lang.StableVersion = *u.StableVersion
+	}
+	return lang
+}
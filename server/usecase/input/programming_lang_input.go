@@ -2,6 +2,7 @@ package input
 
 import (
 	"context"
+	"time"
 
 	"github.com/SekiguchiKai/clean-architecture-with-go/server/domain/model"
 )
@@ -9,8 +10,16 @@ import (
 // ProgrammingLangInputPort は、ProgrammingLangのInputPort。
 type ProgrammingLangInputPort interface {
 	List(ctx context.Context, limit int) ([]*model.ProgrammingLang, error)
+	ListByFilter(ctx context.Context, filter *model.ProgrammingLangFilter) ([]*model.ProgrammingLang, error)
 	Get(ctx context.Context, id int) (*model.ProgrammingLang, error)
-	Create(ctx context.Context, param *model.ProgrammingLang) (*model.ProgrammingLang, error)
-	Update(ctx context.Context, id int, param *model.ProgrammingLang) (*model.ProgrammingLang, error)
+	GetByName(ctx context.Context, name string) (*model.ProgrammingLang, error)
+	GetBySlug(ctx context.Context, slug string) (*model.ProgrammingLang, error)
+	Suggest(ctx context.Context, prefix string, limit int) ([]*model.LangSuggestion, error)
+	BatchGet(ctx context.Context, ids []int) ([]*model.ProgrammingLang, error)
+	Create(ctx context.Context, param *ProgrammingLangCreate) (*model.ProgrammingLang, error)
+	Update(ctx context.Context, id int, param *ProgrammingLangUpdate) (*model.ProgrammingLang, error)
 	Delete(ctx context.Context, id int) error
+	LastModified(ctx context.Context) (time.Time, error)
+	Watch(ctx context.Context) (<-chan *model.ProgrammingLangEvent, error)
+	WatchFrom(ctx context.Context, lastEventID int) (<-chan *model.ProgrammingLangEvent, bool, error)
 }
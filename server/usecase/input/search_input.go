@@ -0,0 +1,13 @@
+package input
+
+import (
+	"context"
+
+	"github.com/SekiguchiKai/clean-architecture-with-go/server/domain/model"
+)
+
+// SearchInputPort は、ProgrammingLangの全文検索のInputPort。
+type SearchInputPort interface {
+	Search(ctx context.Context, query string, limit int) ([]*model.SearchResult, error)
+	Reindex(ctx context.Context) (*model.ReindexReport, error)
+}
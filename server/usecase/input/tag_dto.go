@@ -0,0 +1,6 @@
+package input
+
+// TagInput は、Tagを生成する際や名前を変更する際に受け付ける値。
+type TagInput struct {
+	Name string `json:"name"`
+}
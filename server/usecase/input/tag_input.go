@@ -0,0 +1,20 @@
+package input
+
+import (
+	"context"
+
+	"github.com/SekiguchiKai/clean-architecture-with-go/server/domain/model"
+)
+
+// TagInputPort は、TagのInputPort。
+type TagInputPort interface {
+	List(ctx context.Context) ([]*model.Tag, error)
+	Get(ctx context.Context, id int) (*model.Tag, error)
+	Create(ctx context.Context, name string) (*model.Tag, error)
+	Rename(ctx context.Context, id int, name string) (*model.Tag, error)
+	Delete(ctx context.Context, id int) error
+	Merge(ctx context.Context, fromID, intoID int) (*model.Tag, error)
+	ListByLang(ctx context.Context, langID int) ([]*model.Tag, error)
+	Attach(ctx context.Context, langID, tagID int) error
+	Detach(ctx context.Context, langID, tagID int) error
+}
@@ -0,0 +1,25 @@
+package input
+
+import "github.com/SekiguchiKai/clean-architecture-with-go/server/domain/model"
+
+// WebhookInput は、Webhookを生成、更新する際に受け付ける値。
+// ID、生成日時、更新日時はサーバーで決定するため、受け付けない。
+type WebhookInput struct {
+	URL        string            `json:"url"`
+	Secret     string            `json:"secret"`
+	EventTypes []model.EventType `json:"eventTypes"`
+	Disabled   bool              `json:"disabled"`
+}
+
+// ToModel は、受け付けた値をWebhookに変換して返す。ボディがnullの場合は、値を指定しなかったものとみなす。
+func (in *WebhookInput) ToModel() *model.Webhook {
+	if in == nil {
+		return &model.Webhook{}
+	}
+	return &model.Webhook{
+		URL:        in.URL,
+		Secret:     in.Secret,
+		EventTypes: in.EventTypes,
+		Disabled:   in.Disabled,
+	}
+}
@@ -0,0 +1,24 @@
+package input
+
+import (
+	"context"
+	"time"
+
+	"github.com/SekiguchiKai/clean-architecture-with-go/server/domain/model"
+)
+
+// WebhookInputPort は、WebhookのInputPort。
+type WebhookInputPort interface {
+	List(ctx context.Context) ([]*model.Webhook, error)
+	Get(ctx context.Context, id int) (*model.Webhook, error)
+	Create(ctx context.Context, param *model.Webhook) (*model.Webhook, error)
+	Update(ctx context.Context, id int, param *model.Webhook) (*model.Webhook, error)
+	Delete(ctx context.Context, id int) error
+	ListDeliveries(ctx context.Context, webhookID int, status model.DeliveryStatus, limit int) ([]*model.WebhookDelivery, error)
+	ListDeadLetters(ctx context.Context, limit int) ([]*model.WebhookDelivery, error)
+	GetDelivery(ctx context.Context, webhookID, deliveryID int) (*model.WebhookDelivery, error)
+	Redeliver(ctx context.Context, webhookID, deliveryID int) (*model.WebhookDelivery, error)
+	Enqueue(ctx context.Context, event *model.ProgrammingLangEvent) error
+	DeliverDue(ctx context.Context, limit int) (int, error)
+	PurgeDeliveries(ctx context.Context, retention time.Duration) (int, error)
+}
@@ -0,0 +1,134 @@
+package usecase
+
+import (
+	"context"
+	"time"
+
+	"github.com/SekiguchiKai/clean-architecture-with-go/server/domain/model"
+	"github.com/SekiguchiKai/clean-architecture-with-go/server/domain/repository"
+	"github.com/SekiguchiKai/clean-architecture-with-go/server/domain/service"
+	"github.com/SekiguchiKai/clean-architecture-with-go/server/usecase/input"
+	"github.com/pkg/errors"
+)
+
+// LanguageVersionUseCase は、LanguageVersionのUseCase。
+type LanguageVersionUseCase struct {
+	LangRepo repository.ProgrammingLangRepository
+	Repo     repository.LanguageVersionRepository
+	Now      func() time.Time
+}
+
+// NewLanguageVersionUseCase は、LanguageVersionUseCaseを生成し、返す。
+func NewLanguageVersionUseCase(langRepo repository.ProgrammingLangRepository, repo repository.LanguageVersionRepository) input.LanguageVersionInputPort {
+	return &LanguageVersionUseCase{
+		LangRepo: langRepo,
+		Repo:     repo,
+		Now:      time.Now,
+	}
+}
+
+// List は、ProgrammingLangのLanguageVersionの一覧をバージョンの新しい順に返す。
+func (u *LanguageVersionUseCase) List(ctx context.Context, langID int) ([]*model.LanguageVersion, error) {
+	if _, err := u.LangRepo.Read(ctx, langID); err != nil {
+		return nil, errors.WithStack(err)
+	}
+
+	versions, err := u.Repo.ListByLang(ctx, langID)
+	if err != nil {
+		return nil, errors.WithStack(err)
+	}
+
+	service.SortLanguageVersions(versions)
+	return versions, nil
+}
+
+// ListSupportedOn は、指定した日にサポートされているLanguageVersionの一覧をバージョンの新しい順に返す。
+func (u *LanguageVersionUseCase) ListSupportedOn(ctx context.Context, langID int, date time.Time) ([]*model.LanguageVersion, error) {
+	versions, err := u.List(ctx, langID)
+	if err != nil {
+		return nil, err
+	}
+
+	return service.SupportedVersionsOn(versions, date), nil
+}
+
+// GetLatestStable は、リリース済みのプレリリースでないLanguageVersionの中で、最も新しいものを返す。
+func (u *LanguageVersionUseCase) GetLatestStable(ctx context.Context, langID int) (*model.LanguageVersion, error) {
+	versions, err := u.List(ctx, langID)
+	if err != nil {
+		return nil, err
+	}
+
+	latest := service.LatestStableVersion(versions, u.Now())
+	if latest == nil {
+		return nil, &model.NoSuchDataError{
+			ID:        langID,
+			ModelName: model.ModelNameLanguageVersion,
+		}
+	}
+	return latest, nil
+}
+
+// Get は、バージョンで指定したLanguageVersionを1件返す。
+func (u *LanguageVersionUseCase) Get(ctx context.Context, langID int, version string) (*model.LanguageVersion, error) {
+	return u.Repo.Read(ctx, langID, version)
+}
+
+// Create は、LanguageVersionを生成する。
+func (u *LanguageVersionUseCase) Create(ctx context.Context, langID int, param *model.LanguageVersion) (*model.LanguageVersion, error) {
+	if err := service.ValidateLanguageVersion(param); err != nil {
+		return nil, err
+	}
+
+	if _, err := u.LangRepo.Read(ctx, langID); err != nil {
+		return nil, errors.WithStack(err)
+	}
+
+	v, err := u.Repo.Read(ctx, langID, param.Version)
+	if v != nil {
+		return nil, &model.AlreadyExistError{
+			ID:        v.ID,
+			Name:      v.Version,
+			ModelName: model.ModelNameLanguageVersion,
+		}
+	}
+
+	if _, ok := errors.Cause(err).(*model.NoSuchDataError); !ok {
+		return nil, errors.WithStack(err)
+	}
+
+	param.LangID = langID
+	param.CreatedAt = time.Now().UTC()
+	param.UpdatedAt = time.Now().UTC()
+
+	return u.Repo.Create(ctx, param)
+}
+
+// Update は、LanguageVersionを更新する。バージョンは変更できない。
+func (u *LanguageVersionUseCase) Update(ctx context.Context, langID int, version string, param *model.LanguageVersion) (*model.LanguageVersion, error) {
+	v, err := u.Repo.Read(ctx, langID, version)
+	if err != nil {
+		return nil, errors.WithStack(err)
+	}
+
+	v.ReleaseDate = param.ReleaseDate
+	v.EOLDate = param.EOLDate
+	v.LTS = param.LTS
+	v.ChangelogURL = param.ChangelogURL
+	v.UpdatedAt = time.Now().UTC()
+
+	if err := service.ValidateLanguageVersion(v); err != nil {
+		return nil, err
+	}
+
+	return u.Repo.Update(ctx, v)
+}
+
+// Delete は、LanguageVersionを削除する。
+func (u *LanguageVersionUseCase) Delete(ctx context.Context, langID int, version string) error {
+	if _, err := u.Repo.Read(ctx, langID, version); err != nil {
+		return errors.WithStack(err)
+	}
+
+	return u.Repo.Delete(ctx, langID, version)
+}
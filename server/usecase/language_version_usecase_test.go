@@ -0,0 +1,256 @@
+package usecase
+
+import (
+	"context"
+	"reflect"
+	"testing"
+	"time"
+
+	"github.com/SekiguchiKai/clean-architecture-with-go/server/domain/model"
+	"github.com/SekiguchiKai/clean-architecture-with-go/server/infra/dao/mock"
+	"github.com/golang/mock/gomock"
+)
+
+func TestLanguageVersionUseCase_List(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	langRepo := mock_repository.NewMockProgrammingLangRepository(ctrl)
+	repo := mock_repository.NewMockLanguageVersionRepository(ctrl)
+
+	lang := model.CreateProgrammingLangs(1)[0]
+	noDataErr := &model.NoSuchDataError{ID: 100, ModelName: model.ModelNameProgrammingLang}
+
+	tests := []struct {
+		name    string
+		langID  int
+		mock    func(ctx context.Context)
+		date    time.Time
+		want    []string
+		wantErr error
+	}{
+		{
+			name:   "バージョンの新しい順に並べて返すこと",
+			langID: 1,
+			mock: func(ctx context.Context) {
+				langRepo.EXPECT().Read(ctx, 1).Return(lang, nil)
+				repo.EXPECT().ListByLang(ctx, 1).Return([]*model.LanguageVersion{
+					{Version: "1.9.0"},
+					{Version: "1.10.0"},
+					{Version: "1.10.0-rc.1"},
+				}, nil)
+			},
+			want: []string{"1.10.0", "1.10.0-rc.1", "1.9.0"},
+		},
+		{
+			name:   "日付を指定した場合、その日にサポートされているものだけを返すこと",
+			langID: 1,
+			mock: func(ctx context.Context) {
+				eol := time.Date(2018, time.June, 30, 0, 0, 0, 0, time.UTC)
+				langRepo.EXPECT().Read(ctx, 1).Return(lang, nil)
+				repo.EXPECT().ListByLang(ctx, 1).Return([]*model.LanguageVersion{
+					{Version: "1.0.0", ReleaseDate: time.Date(2017, time.January, 1, 0, 0, 0, 0, time.UTC), EOLDate: &eol},
+					{Version: "2.0.0", ReleaseDate: time.Date(2018, time.January, 1, 0, 0, 0, 0, time.UTC)},
+				}, nil)
+			},
+			date: time.Date(2018, time.July, 1, 0, 0, 0, 0, time.UTC),
+			want: []string{"2.0.0"},
+		},
+		{
+			name:   "ProgrammingLangが存在しない場合、NoSuchDataErrorを返すこと",
+			langID: 100,
+			mock: func(ctx context.Context) {
+				langRepo.EXPECT().Read(ctx, 100).Return(nil, noDataErr)
+			},
+			wantErr: noDataErr,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			u := &LanguageVersionUseCase{
+				LangRepo: langRepo,
+				Repo:     repo,
+			}
+			ctx := context.Background()
+			tt.mock(ctx)
+
+			var got []*model.LanguageVersion
+			var err error
+			if tt.date.IsZero() {
+				got, err = u.List(ctx, tt.langID)
+			} else {
+				got, err = u.ListSupportedOn(ctx, tt.langID, tt.date)
+			}
+			if tt.wantErr != nil {
+				if err == nil || err.Error() != tt.wantErr.Error() {
+					t.Errorf("LanguageVersionUseCase.List() error = %v, wantErr %v", err, tt.wantErr)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			versions := make([]string, len(got))
+			for i, v := range got {
+				versions[i] = v.Version
+			}
+			if !reflect.DeepEqual(versions, tt.want) {
+				t.Errorf("LanguageVersionUseCase.List() = %v, want %v", versions, tt.want)
+			}
+		})
+	}
+}
+
+func TestLanguageVersionUseCase_GetLatestStable(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	langRepo := mock_repository.NewMockProgrammingLangRepository(ctrl)
+	repo := mock_repository.NewMockLanguageVersionRepository(ctrl)
+
+	lang := model.CreateProgrammingLangs(1)[0]
+	now := time.Date(2018, time.October, 1, 0, 0, 0, 0, time.UTC)
+
+	tests := []struct {
+		name       string
+		versions   []*model.LanguageVersion
+		want       string
+		wantNoData bool
+	}{
+		{
+			name: "リリース済みのプレリリースでないバージョンの中で、最も新しいものを返すこと",
+			versions: []*model.LanguageVersion{
+				{Version: "1.10.0", ReleaseDate: time.Date(2018, time.February, 16, 0, 0, 0, 0, time.UTC)},
+				{Version: "1.11.0", ReleaseDate: time.Date(2018, time.August, 24, 0, 0, 0, 0, time.UTC)},
+				{Version: "1.12.0-beta.1", ReleaseDate: time.Date(2018, time.September, 1, 0, 0, 0, 0, time.UTC)},
+			},
+			want: "1.11.0",
+		},
+		{
+			name: "プレリリースしか存在しない場合、NoSuchDataErrorを返すこと",
+			versions: []*model.LanguageVersion{
+				{Version: "0.1.0-alpha", ReleaseDate: time.Date(2018, time.February, 16, 0, 0, 0, 0, time.UTC)},
+			},
+			wantNoData: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			u := &LanguageVersionUseCase{
+				LangRepo: langRepo,
+				Repo:     repo,
+				Now:      func() time.Time { return now },
+			}
+			ctx := context.Background()
+
+			langRepo.EXPECT().Read(ctx, 1).Return(lang, nil)
+			repo.EXPECT().ListByLang(ctx, 1).Return(tt.versions, nil)
+
+			got, err := u.GetLatestStable(ctx, 1)
+			if _, ok := err.(*model.NoSuchDataError); ok != tt.wantNoData {
+				t.Errorf("LanguageVersionUseCase.GetLatestStable() error = %v, want NoSuchDataError %v", err, tt.wantNoData)
+			}
+			if got != nil && got.Version != tt.want {
+				t.Errorf("LanguageVersionUseCase.GetLatestStable() = %v, want %v", got.Version, tt.want)
+			}
+		})
+	}
+}
+
+func TestLanguageVersionUseCase_Create(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	langRepo := mock_repository.NewMockProgrammingLangRepository(ctrl)
+	repo := mock_repository.NewMockLanguageVersionRepository(ctrl)
+
+	lang := model.CreateProgrammingLangs(1)[0]
+	existing := model.CreateLanguageVersions(1, 1)[0]
+	noDataErr := &model.NoSuchDataError{ID: 1, Name: model.TestVersion, ModelName: model.ModelNameLanguageVersion}
+
+	tests := []struct {
+		name        string
+		param       *model.LanguageVersion
+		mock        func(ctx context.Context)
+		wantErrType error
+	}{
+		{
+			name:  "同じバージョンが存在しない場合、LanguageVersionを生成すること",
+			param: &model.LanguageVersion{Version: model.TestVersion, ReleaseDate: existing.ReleaseDate},
+			mock: func(ctx context.Context) {
+				langRepo.EXPECT().Read(ctx, 1).Return(lang, nil)
+				repo.EXPECT().Read(ctx, 1, model.TestVersion).Return(nil, noDataErr)
+				repo.EXPECT().Create(ctx, gomock.Any()).DoAndReturn(func(ctx context.Context, v *model.LanguageVersion) (*model.LanguageVersion, error) {
+					if v.LangID != 1 {
+						t.Errorf("LangID = %v, want %v", v.LangID, 1)
+					}
+					return v, nil
+				})
+			},
+		},
+		{
+			name:  "同じバージョンが既に存在する場合、AlreadyExistErrorを返すこと",
+			param: &model.LanguageVersion{Version: model.TestVersion, ReleaseDate: existing.ReleaseDate},
+			mock: func(ctx context.Context) {
+				langRepo.EXPECT().Read(ctx, 1).Return(lang, nil)
+				repo.EXPECT().Read(ctx, 1, model.TestVersion).Return(existing, nil)
+			},
+			wantErrType: &model.AlreadyExistError{},
+		},
+		{
+			name:        "バージョンがSemantic Versioningの形式でない場合、InvalidPropertyErrorを返すこと",
+			param:       &model.LanguageVersion{Version: "1.0", ReleaseDate: existing.ReleaseDate},
+			mock:        func(ctx context.Context) {},
+			wantErrType: &model.InvalidPropertyError{},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			u := &LanguageVersionUseCase{
+				LangRepo: langRepo,
+				Repo:     repo,
+			}
+			ctx := context.Background()
+			tt.mock(ctx)
+
+			_, err := u.Create(ctx, 1, tt.param)
+			if reflect.TypeOf(err) != reflect.TypeOf(tt.wantErrType) {
+				t.Errorf("LanguageVersionUseCase.Create() error = %v, want %T", err, tt.wantErrType)
+			}
+		})
+	}
+}
+
+func TestLanguageVersionUseCase_Update(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	repo := mock_repository.NewMockLanguageVersionRepository(ctrl)
+
+	existing := model.CreateLanguageVersions(1, 1)[0]
+	eol := existing.ReleaseDate.AddDate(2, 0, 0)
+
+	u := &LanguageVersionUseCase{
+		Repo: repo,
+	}
+	ctx := context.Background()
+
+	repo.EXPECT().Read(ctx, 1, existing.Version).Return(existing, nil)
+	repo.EXPECT().Update(ctx, gomock.Any()).DoAndReturn(func(ctx context.Context, v *model.LanguageVersion) (*model.LanguageVersion, error) {
+		return v, nil
+	})
+
+	got, err := u.Update(ctx, 1, existing.Version, &model.LanguageVersion{
+		Version:     "9.9.9",
+		ReleaseDate: existing.ReleaseDate,
+		EOLDate:     &eol,
+		LTS:         true,
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got.Version != existing.Version || !got.LTS || got.EOLDate == nil || !got.EOLDate.Equal(eol) {
+		t.Errorf("LanguageVersionUseCase.Update() = %+v, want LTS with EOLDate %v and unchanged Version", got, eol)
+	}
+}
@@ -0,0 +1,48 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: usecase/input/detection_input.go
+
+// Package mock_input is a generated GoMock package.
+package mock_input
+
+import (
+	context "context"
+	model "github.com/SekiguchiKai/clean-architecture-with-go/server/domain/model"
+	gomock "github.com/golang/mock/gomock"
+	reflect "reflect"
+)
+
+// MockDetectionInputPort is a mock of DetectionInputPort interface
+type MockDetectionInputPort struct {
+	ctrl     *gomock.Controller
+	recorder *MockDetectionInputPortMockRecorder
+}
+
+// MockDetectionInputPortMockRecorder is the mock recorder for MockDetectionInputPort
+type MockDetectionInputPortMockRecorder struct {
+	mock *MockDetectionInputPort
+}
+
+// NewMockDetectionInputPort creates a new mock instance
+func NewMockDetectionInputPort(ctrl *gomock.Controller) *MockDetectionInputPort {
+	mock := &MockDetectionInputPort{ctrl: ctrl}
+	mock.recorder = &MockDetectionInputPortMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use
+func (m *MockDetectionInputPort) EXPECT() *MockDetectionInputPortMockRecorder {
+	return m.recorder
+}
+
+// Detect mocks base method
+func (m *MockDetectionInputPort) Detect(ctx context.Context, filename, content string) ([]*model.DetectionCandidate, error) {
+	ret := m.ctrl.Call(m, "Detect", ctx, filename, content)
+	ret0, _ := ret[0].([]*model.DetectionCandidate)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Detect indicates an expected call of Detect
+func (mr *MockDetectionInputPortMockRecorder) Detect(ctx, filename, content interface{}) *gomock.Call {
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Detect", reflect.TypeOf((*MockDetectionInputPort)(nil).Detect), ctx, filename, content)
+}
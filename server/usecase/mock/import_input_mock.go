@@ -0,0 +1,48 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: usecase/input/import_input.go
+
+// Package mock_input is a generated GoMock package.
+package mock_input
+
+import (
+	context "context"
+	model "github.com/SekiguchiKai/clean-architecture-with-go/server/domain/model"
+	gomock "github.com/golang/mock/gomock"
+	reflect "reflect"
+)
+
+// MockImportInputPort is a mock of ImportInputPort interface
+type MockImportInputPort struct {
+	ctrl     *gomock.Controller
+	recorder *MockImportInputPortMockRecorder
+}
+
+// MockImportInputPortMockRecorder is the mock recorder for MockImportInputPort
+type MockImportInputPortMockRecorder struct {
+	mock *MockImportInputPort
+}
+
+// NewMockImportInputPort creates a new mock instance
+func NewMockImportInputPort(ctrl *gomock.Controller) *MockImportInputPort {
+	mock := &MockImportInputPort{ctrl: ctrl}
+	mock.recorder = &MockImportInputPortMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use
+func (m *MockImportInputPort) EXPECT() *MockImportInputPortMockRecorder {
+	return m.recorder
+}
+
+// Import mocks base method
+func (m *MockImportInputPort) Import(ctx context.Context, imports []*model.LangImport) (*model.ImportReport, error) {
+	ret := m.ctrl.Call(m, "Import", ctx, imports)
+	ret0, _ := ret[0].(*model.ImportReport)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Import indicates an expected call of Import
+func (mr *MockImportInputPortMockRecorder) Import(ctx, imports interface{}) *gomock.Call {
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Import", reflect.TypeOf((*MockImportInputPort)(nil).Import), ctx, imports)
+}
@@ -0,0 +1,47 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: usecase/input/outbox_input.go
+
+// Package mock_input is a generated GoMock package.
+package mock_input
+
+import (
+	context "context"
+	gomock "github.com/golang/mock/gomock"
+	reflect "reflect"
+)
+
+// MockOutboxInputPort is a mock of OutboxInputPort interface
+type MockOutboxInputPort struct {
+	ctrl     *gomock.Controller
+	recorder *MockOutboxInputPortMockRecorder
+}
+
+// MockOutboxInputPortMockRecorder is the mock recorder for MockOutboxInputPort
+type MockOutboxInputPortMockRecorder struct {
+	mock *MockOutboxInputPort
+}
+
+// NewMockOutboxInputPort creates a new mock instance
+func NewMockOutboxInputPort(ctrl *gomock.Controller) *MockOutboxInputPort {
+	mock := &MockOutboxInputPort{ctrl: ctrl}
+	mock.recorder = &MockOutboxInputPortMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use
+func (m *MockOutboxInputPort) EXPECT() *MockOutboxInputPortMockRecorder {
+	return m.recorder
+}
+
+// Relay mocks base method
+func (m *MockOutboxInputPort) Relay(ctx context.Context, limit int) (int, error) {
+	ret := m.ctrl.Call(m, "Relay", ctx, limit)
+	ret0, _ := ret[0].(int)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Relay indicates an expected call of Relay
+func (mr *MockOutboxInputPortMockRecorder) Relay(ctx, limit interface{}) *gomock.Call {
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Relay", reflect.TypeOf((*MockOutboxInputPort)(nil).Relay), ctx, limit)
+}
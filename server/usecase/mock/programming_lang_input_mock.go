@@ -7,8 +7,10 @@ package mock_input
 import (
 	context "context"
 	model "github.com/SekiguchiKai/clean-architecture-with-go/server/domain/model"
+	input "github.com/SekiguchiKai/clean-architecture-with-go/server/usecase/input"
 	gomock "github.com/golang/mock/gomock"
 	reflect "reflect"
+	time "time"
 )
 
 // MockProgrammingLangInputPort is a mock of ProgrammingLangInputPort interface
@@ -47,6 +49,19 @@ func (mr *MockProgrammingLangInputPortMockRecorder) List(ctx, limit interface{})
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "List", reflect.TypeOf((*MockProgrammingLangInputPort)(nil).List), ctx, limit)
 }
 
+// ListByFilter mocks base method
+func (m *MockProgrammingLangInputPort) ListByFilter(ctx context.Context, filter *model.ProgrammingLangFilter) ([]*model.ProgrammingLang, error) {
+	ret := m.ctrl.Call(m, "ListByFilter", ctx, filter)
+	ret0, _ := ret[0].([]*model.ProgrammingLang)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListByFilter indicates an expected call of ListByFilter
+func (mr *MockProgrammingLangInputPortMockRecorder) ListByFilter(ctx, filter interface{}) *gomock.Call {
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListByFilter", reflect.TypeOf((*MockProgrammingLangInputPort)(nil).ListByFilter), ctx, filter)
+}
+
 // Get mocks base method
 func (m *MockProgrammingLangInputPort) Get(ctx context.Context, id int) (*model.ProgrammingLang, error) {
 	ret := m.ctrl.Call(m, "Get", ctx, id)
@@ -60,8 +75,60 @@ func (mr *MockProgrammingLangInputPortMockRecorder) Get(ctx, id interface{}) *go
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Get", reflect.TypeOf((*MockProgrammingLangInputPort)(nil).Get), ctx, id)
 }
 
+// GetByName mocks base method
+func (m *MockProgrammingLangInputPort) GetByName(ctx context.Context, name string) (*model.ProgrammingLang, error) {
+	ret := m.ctrl.Call(m, "GetByName", ctx, name)
+	ret0, _ := ret[0].(*model.ProgrammingLang)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetByName indicates an expected call of GetByName
+func (mr *MockProgrammingLangInputPortMockRecorder) GetByName(ctx, name interface{}) *gomock.Call {
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetByName", reflect.TypeOf((*MockProgrammingLangInputPort)(nil).GetByName), ctx, name)
+}
+
+// GetBySlug mocks base method
+func (m *MockProgrammingLangInputPort) GetBySlug(ctx context.Context, slug string) (*model.ProgrammingLang, error) {
+	ret := m.ctrl.Call(m, "GetBySlug", ctx, slug)
+	ret0, _ := ret[0].(*model.ProgrammingLang)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetBySlug indicates an expected call of GetBySlug
+func (mr *MockProgrammingLangInputPortMockRecorder) GetBySlug(ctx, slug interface{}) *gomock.Call {
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetBySlug", reflect.TypeOf((*MockProgrammingLangInputPort)(nil).GetBySlug), ctx, slug)
+}
+
+// Suggest mocks base method
+func (m *MockProgrammingLangInputPort) Suggest(ctx context.Context, prefix string, limit int) ([]*model.LangSuggestion, error) {
+	ret := m.ctrl.Call(m, "Suggest", ctx, prefix, limit)
+	ret0, _ := ret[0].([]*model.LangSuggestion)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Suggest indicates an expected call of Suggest
+func (mr *MockProgrammingLangInputPortMockRecorder) Suggest(ctx, prefix, limit interface{}) *gomock.Call {
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Suggest", reflect.TypeOf((*MockProgrammingLangInputPort)(nil).Suggest), ctx, prefix, limit)
+}
+
+// BatchGet mocks base method
+func (m *MockProgrammingLangInputPort) BatchGet(ctx context.Context, ids []int) ([]*model.ProgrammingLang, error) {
+	ret := m.ctrl.Call(m, "BatchGet", ctx, ids)
+	ret0, _ := ret[0].([]*model.ProgrammingLang)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// BatchGet indicates an expected call of BatchGet
+func (mr *MockProgrammingLangInputPortMockRecorder) BatchGet(ctx, ids interface{}) *gomock.Call {
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "BatchGet", reflect.TypeOf((*MockProgrammingLangInputPort)(nil).BatchGet), ctx, ids)
+}
+
 // Create mocks base method
-func (m *MockProgrammingLangInputPort) Create(ctx context.Context, param *model.ProgrammingLang) (*model.ProgrammingLang, error) {
+func (m *MockProgrammingLangInputPort) Create(ctx context.Context, param *input.ProgrammingLangCreate) (*model.ProgrammingLang, error) {
 	ret := m.ctrl.Call(m, "Create", ctx, param)
 	ret0, _ := ret[0].(*model.ProgrammingLang)
 	ret1, _ := ret[1].(error)
@@ -74,7 +141,7 @@ func (mr *MockProgrammingLangInputPortMockRecorder) Create(ctx, param interface{
 }
 
 // Update mocks base method
-func (m *MockProgrammingLangInputPort) Update(ctx context.Context, id int, param *model.ProgrammingLang) (*model.ProgrammingLang, error) {
+func (m *MockProgrammingLangInputPort) Update(ctx context.Context, id int, param *input.ProgrammingLangUpdate) (*model.ProgrammingLang, error) {
 	ret := m.ctrl.Call(m, "Update", ctx, id, param)
 	ret0, _ := ret[0].(*model.ProgrammingLang)
 	ret1, _ := ret[1].(error)
@@ -97,3 +164,43 @@ func (m *MockProgrammingLangInputPort) Delete(ctx context.Context, id int) error
 func (mr *MockProgrammingLangInputPortMockRecorder) Delete(ctx, id interface{}) *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Delete", reflect.TypeOf((*MockProgrammingLangInputPort)(nil).Delete), ctx, id)
 }
+
+// LastModified mocks base method
+func (m *MockProgrammingLangInputPort) LastModified(ctx context.Context) (time.Time, error) {
+	ret := m.ctrl.Call(m, "LastModified", ctx)
+	ret0, _ := ret[0].(time.Time)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// LastModified indicates an expected call of LastModified
+func (mr *MockProgrammingLangInputPortMockRecorder) LastModified(ctx interface{}) *gomock.Call {
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "LastModified", reflect.TypeOf((*MockProgrammingLangInputPort)(nil).LastModified), ctx)
+}
+
+// Watch mocks base method
+func (m *MockProgrammingLangInputPort) Watch(ctx context.Context) (<-chan *model.ProgrammingLangEvent, error) {
+	ret := m.ctrl.Call(m, "Watch", ctx)
+	ret0, _ := ret[0].(<-chan *model.ProgrammingLangEvent)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Watch indicates an expected call of Watch
+func (mr *MockProgrammingLangInputPortMockRecorder) Watch(ctx interface{}) *gomock.Call {
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Watch", reflect.TypeOf((*MockProgrammingLangInputPort)(nil).Watch), ctx)
+}
+
+// WatchFrom mocks base method
+func (m *MockProgrammingLangInputPort) WatchFrom(ctx context.Context, lastEventID int) (<-chan *model.ProgrammingLangEvent, bool, error) {
+	ret := m.ctrl.Call(m, "WatchFrom", ctx, lastEventID)
+	ret0, _ := ret[0].(<-chan *model.ProgrammingLangEvent)
+	ret1, _ := ret[1].(bool)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// WatchFrom indicates an expected call of WatchFrom
+func (mr *MockProgrammingLangInputPortMockRecorder) WatchFrom(ctx, lastEventID interface{}) *gomock.Call {
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "WatchFrom", reflect.TypeOf((*MockProgrammingLangInputPort)(nil).WatchFrom), ctx, lastEventID)
+}
@@ -0,0 +1,61 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: usecase/input/search_input.go
+
+// Package mock_input is a generated GoMock package.
+package mock_input
+
+import (
+	context "context"
+	model "github.com/SekiguchiKai/clean-architecture-with-go/server/domain/model"
+	gomock "github.com/golang/mock/gomock"
+	reflect "reflect"
+)
+
+// MockSearchInputPort is a mock of SearchInputPort interface
+type MockSearchInputPort struct {
+	ctrl     *gomock.Controller
+	recorder *MockSearchInputPortMockRecorder
+}
+
+// MockSearchInputPortMockRecorder is the mock recorder for MockSearchInputPort
+type MockSearchInputPortMockRecorder struct {
+	mock *MockSearchInputPort
+}
+
+// NewMockSearchInputPort creates a new mock instance
+func NewMockSearchInputPort(ctrl *gomock.Controller) *MockSearchInputPort {
+	mock := &MockSearchInputPort{ctrl: ctrl}
+	mock.recorder = &MockSearchInputPortMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use
+func (m *MockSearchInputPort) EXPECT() *MockSearchInputPortMockRecorder {
+	return m.recorder
+}
+
+// Search mocks base method
+func (m *MockSearchInputPort) Search(ctx context.Context, query string, limit int) ([]*model.SearchResult, error) {
+	ret := m.ctrl.Call(m, "Search", ctx, query, limit)
+	ret0, _ := ret[0].([]*model.SearchResult)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Search indicates an expected call of Search
+func (mr *MockSearchInputPortMockRecorder) Search(ctx, query, limit interface{}) *gomock.Call {
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Search", reflect.TypeOf((*MockSearchInputPort)(nil).Search), ctx, query, limit)
+}
+
+// Reindex mocks base method
+func (m *MockSearchInputPort) Reindex(ctx context.Context) (*model.ReindexReport, error) {
+	ret := m.ctrl.Call(m, "Reindex", ctx)
+	ret0, _ := ret[0].(*model.ReindexReport)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Reindex indicates an expected call of Reindex
+func (mr *MockSearchInputPortMockRecorder) Reindex(ctx interface{}) *gomock.Call {
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Reindex", reflect.TypeOf((*MockSearchInputPort)(nil).Reindex), ctx)
+}
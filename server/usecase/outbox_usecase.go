@@ -0,0 +1,98 @@
+package usecase
+
+import (
+	"context"
+	"time"
+
+	"github.com/SekiguchiKai/clean-architecture-with-go/server/domain/model"
+	"github.com/SekiguchiKai/clean-architecture-with-go/server/domain/repository"
+	"github.com/SekiguchiKai/clean-architecture-with-go/server/domain/service"
+	"github.com/SekiguchiKai/clean-architecture-with-go/server/usecase/input"
+	"github.com/pkg/errors"
+)
+
+// OutboxRelayUseCase は、Outboxに記録した領域イベントを配信先に中継するUseCase。
+// 配信に成功した配信先をイベントごとに記録し、失敗した配信先にだけ配信し直す。
+// 同じProgrammingLangのイベントは、先に記録したイベントの配信を終えるまで配信しない。
+type OutboxRelayUseCase struct {
+	Repo       repository.OutboxRepository
+	Publishers []repository.EventPublisher
+}
+
+// NewOutboxRelayUseCase は、OutboxRelayUseCaseを生成し、返す。
+func NewOutboxRelayUseCase(repo repository.OutboxRepository, publishers ...repository.EventPublisher) input.OutboxInputPort {
+	return &OutboxRelayUseCase{
+		Repo:       repo,
+		Publishers: publishers,
+	}
+}
+
+// Relay は、配信予定の日時を過ぎた領域イベントを最大limit件配信し、配信を終えた件数を返す。
+// 配信を終えたイベントはOutboxから削除し、失敗したイベントは間隔を空けて配信し直す。
+func (u *OutboxRelayUseCase) Relay(ctx context.Context, limit int) (int, error) {
+	events, err := u.Repo.ListDue(ctx, time.Now().UTC(), limit)
+	if err != nil {
+		return 0, errors.WithStack(err)
+	}
+
+	relayed := 0
+	for _, o := range events {
+		claimed, err := u.Repo.Claim(ctx, o, time.Now().UTC().Add(service.OutboxLease))
+		if err != nil {
+			return relayed, errors.WithStack(err)
+		}
+		if !claimed {
+			continue
+		}
+
+		if pubErr := u.publish(ctx, o); pubErr != nil {
+			service.RecordOutboxFailure(o, pubErr, time.Now().UTC())
+			if _, err := u.Repo.Update(ctx, o); err != nil {
+				return relayed, errors.WithStack(err)
+			}
+			continue
+		}
+
+		if err := u.Repo.Delete(ctx, o.ID); err != nil {
+			return relayed, errors.WithStack(err)
+		}
+		relayed++
+	}
+
+	return relayed, nil
+}
+
+// publish は、領域イベントをまだ配信に成功していない配信先に配信し、成功した配信先をoに記録する。
+// 1つでも失敗した場合は、最初のエラーを返す。
+func (u *OutboxRelayUseCase) publish(ctx context.Context, o *model.OutboxEvent) error {
+	event, err := service.DecodeOutboxEvent(o)
+	if err != nil {
+		return err
+	}
+
+	var first error
+	for _, p := range u.Publishers {
+		if published(o, p.Name()) {
+			continue
+		}
+		if err := p.Publish(ctx, event); err != nil {
+			if first == nil {
+				first = err
+			}
+			continue
+		}
+		o.Published = append(o.Published, p.Name())
+	}
+
+	return first
+}
+
+// published は、nameの配信先への配信に既に成功しているかどうかを返す。
+func published(o *model.OutboxEvent, name string) bool {
+	for _, n := range o.Published {
+		if n == name {
+			return true
+		}
+	}
+	return false
+}
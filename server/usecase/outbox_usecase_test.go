@@ -0,0 +1,104 @@
+package usecase
+
+import (
+	"context"
+	"errors"
+	"reflect"
+	"testing"
+
+	"github.com/SekiguchiKai/clean-architecture-with-go/server/domain/model"
+	"github.com/SekiguchiKai/clean-architecture-with-go/server/domain/service"
+	"github.com/SekiguchiKai/clean-architecture-with-go/server/infra/dao/mock"
+	"github.com/golang/mock/gomock"
+)
+
+func TestOutboxRelayUseCase_Relay(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	lang := model.CreateProgrammingLangs(1)[0]
+
+	tests := []struct {
+		name       string
+		claimed    bool
+		published  []string
+		publishErr error
+		want       int
+	}{
+		{
+			name:    "全ての配信先への配信に成功した場合、Outboxから削除すること",
+			claimed: true,
+			want:    1,
+		},
+		{
+			name:       "配信に失敗した場合、理由と成功した配信先を記録して配信し直すのを待つこと",
+			claimed:    true,
+			publishErr: errors.New("connection refused"),
+			want:       0,
+		},
+		{
+			name:      "既に配信に成功した配信先には、配信し直さないこと",
+			claimed:   true,
+			published: []string{"log"},
+			want:      1,
+		},
+		{
+			name:    "他のプロセスが配信する権利を先に得た場合、配信しないこと",
+			claimed: false,
+			want:    0,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			repo := mock_repository.NewMockOutboxRepository(ctrl)
+			logPublisher := mock_repository.NewMockEventPublisher(ctrl)
+			busPublisher := mock_repository.NewMockEventPublisher(ctrl)
+			ctx := context.Background()
+
+			o, err := service.NewOutboxEvent(&model.ProgrammingLangEvent{Type: model.EventTypeUpdated, Lang: lang, OccurredAt: lang.UpdatedAt})
+			if err != nil {
+				t.Fatal(err)
+			}
+			o.ID = 3
+			o.Published = tt.published
+
+			repo.EXPECT().ListDue(ctx, gomock.Any(), 10).Return([]*model.OutboxEvent{o}, nil)
+			repo.EXPECT().Claim(ctx, o, gomock.Any()).Return(tt.claimed, nil)
+			logPublisher.EXPECT().Name().Return("log").AnyTimes()
+			busPublisher.EXPECT().Name().Return("bus").AnyTimes()
+			if tt.claimed && tt.published == nil {
+				// 配信するイベントには、重複を判別できるようにOutboxのIDを付けること
+				logPublisher.EXPECT().Publish(ctx, gomock.Any()).DoAndReturn(func(ctx context.Context, event *model.ProgrammingLangEvent) error {
+					if event.ID != o.ID || event.Type != model.EventTypeUpdated || event.Lang.ID != lang.ID {
+						t.Errorf("EventPublisher.Publish() = %+v, want event %v of lang %v", event, o.ID, lang.ID)
+					}
+					return tt.publishErr
+				})
+			}
+			if tt.claimed {
+				busPublisher.EXPECT().Publish(ctx, gomock.Any()).Return(nil)
+			}
+			if tt.claimed && tt.publishErr == nil {
+				repo.EXPECT().Delete(ctx, o.ID).Return(nil)
+			}
+			if tt.claimed && tt.publishErr != nil {
+				repo.EXPECT().Update(ctx, o).DoAndReturn(func(ctx context.Context, event *model.OutboxEvent) (*model.OutboxEvent, error) {
+					if event.Attempts != 1 || event.LastError != tt.publishErr.Error() || !reflect.DeepEqual(event.Published, []string{"bus"}) {
+						t.Errorf("OutboxRepository.Update() = %+v", event)
+					}
+					return event, nil
+				})
+			}
+
+			u := NewOutboxRelayUseCase(repo, logPublisher, busPublisher)
+
+			got, err := u.Relay(ctx, 10)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if got != tt.want {
+				t.Errorf("OutboxRelayUseCase.Relay() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
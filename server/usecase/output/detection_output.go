@@ -0,0 +1,27 @@
+package output
+
+import "github.com/SekiguchiKai/clean-architecture-with-go/server/domain/model"
+
+// DetectionCandidateOutput は、ファイルの言語として推定されたProgrammingLangを返す際の形式。
+type DetectionCandidateOutput struct {
+	Lang    *ProgrammingLangOutput  `json:"lang"`
+	Score   int                     `json:"score"`
+	Reasons []model.DetectionReason `json:"reasons"`
+}
+
+// NewDetectionCandidateOutputs は、推定されたProgrammingLangの一覧を返す際の形式に変換する。
+func NewDetectionCandidateOutputs(candidates []*model.DetectionCandidate) []*DetectionCandidateOutput {
+	if candidates == nil {
+		return nil
+	}
+
+	outputs := make([]*DetectionCandidateOutput, 0, len(candidates))
+	for _, c := range candidates {
+		outputs = append(outputs, &DetectionCandidateOutput{
+			Lang:    NewProgrammingLangOutput(c.Lang),
+			Score:   c.Score,
+			Reasons: c.Reasons,
+		})
+	}
+	return outputs
+}
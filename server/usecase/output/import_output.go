@@ -0,0 +1,35 @@
+package output
+
+import "github.com/SekiguchiKai/clean-architecture-with-go/server/domain/model"
+
+// ImportReportOutput は、ProgrammingLangの取り込みの結果を返す際の形式。
+type ImportReportOutput struct {
+	Created int                  `json:"created"`
+	Updated int                  `json:"updated"`
+	Skipped int                  `json:"skipped"`
+	Errors  []*ImportErrorOutput `json:"errors,omitempty"`
+}
+
+// ImportErrorOutput は、取り込めなかったProgrammingLangとその理由を返す際の形式。
+type ImportErrorOutput struct {
+	Name    string `json:"name"`
+	Message string `json:"message"`
+}
+
+// NewImportReportOutput は、ProgrammingLangの取り込みの結果を返す際の形式に変換する。
+func NewImportReportOutput(report *model.ImportReport) *ImportReportOutput {
+	var errs []*ImportErrorOutput
+	for _, e := range report.Errors {
+		errs = append(errs, &ImportErrorOutput{
+			Name:    e.Name,
+			Message: e.Message,
+		})
+	}
+
+	return &ImportReportOutput{
+		Created: report.Created,
+		Updated: report.Updated,
+		Skipped: report.Skipped,
+		Errors:  errs,
+	}
+}
@@ -0,0 +1,70 @@
+package output
+
+import (
+	"time"
+
+	"github.com/SekiguchiKai/clean-architecture-with-go/server/domain/model"
+)
+
+// InfluenceNodeOutput は、影響関係をたどって見つかったProgrammingLangを返す際の形式。
+type InfluenceNodeOutput struct {
+	Lang  *ProgrammingLangOutput `json:"lang"`
+	Depth int                    `json:"depth"`
+}
+
+// InfluenceOutput は、影響関係を返す際の形式。
+type InfluenceOutput struct {
+	LangID         int       `json:"langId"`
+	InfluencedByID int       `json:"influencedById"`
+	CreatedAt      time.Time `json:"createdAt"`
+}
+
+// InfluenceGraphOutput は、影響関係の全体を返す際の形式。
+type InfluenceGraphOutput struct {
+	Langs      []*ProgrammingLangOutput `json:"langs"`
+	Influences []*InfluenceOutput       `json:"influences"`
+}
+
+// NewInfluenceNodeOutputs は、影響関係をたどって見つかったProgrammingLangの一覧を返す際の形式に変換する。
+func NewInfluenceNodeOutputs(nodes []*model.InfluenceNode) []*InfluenceNodeOutput {
+	if nodes == nil {
+		return nil
+	}
+
+	outputs := make([]*InfluenceNodeOutput, 0, len(nodes))
+	for _, n := range nodes {
+		outputs = append(outputs, &InfluenceNodeOutput{
+			Lang:  NewProgrammingLangOutput(n.Lang),
+			Depth: n.Depth,
+		})
+	}
+	return outputs
+}
+
+// NewInfluenceOutput は、影響関係を返す際の形式に変換する。influenceがnilの場合は、nilを返す。
+func NewInfluenceOutput(influence *model.Influence) *InfluenceOutput {
+	if influence == nil {
+		return nil
+	}
+	return &InfluenceOutput{
+		LangID:         influence.LangID,
+		InfluencedByID: influence.InfluencedByID,
+		CreatedAt:      influence.CreatedAt,
+	}
+}
+
+// NewInfluenceGraphOutput は、影響関係の全体を返す際の形式に変換する。
+func NewInfluenceGraphOutput(graph *model.InfluenceGraph) *InfluenceGraphOutput {
+	var influences []*InfluenceOutput
+	if graph.Influences != nil {
+		influences = make([]*InfluenceOutput, 0, len(graph.Influences))
+		for _, influence := range graph.Influences {
+			influences = append(influences, NewInfluenceOutput(influence))
+		}
+	}
+
+	return &InfluenceGraphOutput{
+		Langs:      NewProgrammingLangOutputs(graph.Langs),
+		Influences: influences,
+	}
+}
@@ -0,0 +1,51 @@
+package output
+
+import (
+	"time"
+
+	"github.com/SekiguchiKai/clean-architecture-with-go/server/domain/model"
+)
+
+// LanguageVersionOutput は、LanguageVersionを返す際の形式。
+type LanguageVersionOutput struct {
+	ID           int        `json:"id"`
+	LangID       int        `json:"langId"`
+	Version      string     `json:"version"`
+	ReleaseDate  time.Time  `json:"releaseDate"`
+	EOLDate      *time.Time `json:"eolDate,omitempty"`
+	LTS          bool       `json:"lts"`
+	ChangelogURL string     `json:"changelogUrl,omitempty"`
+	CreatedAt    time.Time  `json:"createdAt"`
+	UpdatedAt    time.Time  `json:"updatedAt"`
+}
+
+// NewLanguageVersionOutput は、LanguageVersionを返す際の形式に変換する。vがnilの場合は、nilを返す。
+func NewLanguageVersionOutput(v *model.LanguageVersion) *LanguageVersionOutput {
+	if v == nil {
+		return nil
+	}
+	return &LanguageVersionOutput{
+		ID:           v.ID,
+		LangID:       v.LangID,
+		Version:      v.Version,
+		ReleaseDate:  v.ReleaseDate,
+		EOLDate:      v.EOLDate,
+		LTS:          v.LTS,
+		ChangelogURL: v.ChangelogURL,
+		CreatedAt:    v.CreatedAt,
+		UpdatedAt:    v.UpdatedAt,
+	}
+}
+
+// NewLanguageVersionOutputs は、LanguageVersionの一覧を返す際の形式に変換する。versionsがnilの場合は、nilを返す。
+func NewLanguageVersionOutputs(versions []*model.LanguageVersion) []*LanguageVersionOutput {
+	if versions == nil {
+		return nil
+	}
+
+	outputs := make([]*LanguageVersionOutput, 0, len(versions))
+	for _, v := range versions {
+		outputs = append(outputs, NewLanguageVersionOutput(v))
+	}
+	return outputs
+}
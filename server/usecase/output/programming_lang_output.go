@@ -0,0 +1,118 @@
+package output
+
+import (
+	"time"
+
+	"github.com/SekiguchiKai/clean-architecture-with-go/server/domain/model"
+)
+
+// ProgrammingLangOutput は、ProgrammingLangを返す際の形式。
+type ProgrammingLangOutput struct {
+	ID            int                `json:"id"`
+	Name          string             `json:"name"`
+	Feature       string             `json:"feature"`
+	Slug          string             `json:"slug"`
+	FirstAppeared int                `json:"firstAppeared,omitempty"`
+	Designers     []string           `json:"designers,omitempty"`
+	TypeChecking  model.TypeChecking `json:"typeChecking,omitempty"`
+	TypeStrength  model.TypeStrength `json:"typeStrength,omitempty"`
+	Paradigms     []model.Paradigm   `json:"paradigms,omitempty"`
+	License       string             `json:"license,omitempty"`
+	Website       string             `json:"website,omitempty"`
+	Extensions    []string           `json:"extensions,omitempty"`
+	Filenames     []string           `json:"filenames,omitempty"`
+	Interpreters  []string           `json:"interpreters,omitempty"`
+	Aliases       []string           `json:"aliases,omitempty"`
+	Color         string             `json:"color,omitempty"`
+	StableVersion string             `json:"stableVersion,omitempty"`
+	CreatedAt     time.Time          `json:"createdAt"`
+	UpdatedAt     time.Time          `json:"updatedAt"`
+}
+
+// ProgrammingLangEventOutput は、ProgrammingLangに対する変更を通知する際の形式。
+type ProgrammingLangEventOutput struct {
+	ID         int                    `json:"id,omitempty"`
+	Type       model.EventType        `json:"type"`
+	Lang       *ProgrammingLangOutput `json:"lang"`
+	OccurredAt time.Time              `json:"occurredAt"`
+}
+
+// NewProgrammingLangOutput は、ProgrammingLangを返す際の形式に変換する。langがnilの場合は、nilを返す。
+func NewProgrammingLangOutput(lang *model.ProgrammingLang) *ProgrammingLangOutput {
+	if lang == nil {
+		return nil
+	}
+	return &ProgrammingLangOutput{
+		ID:            lang.ID,
+		Name:          lang.Name,
+		Feature:       lang.Feature,
+		Slug:          lang.Slug,
+		FirstAppeared: lang.FirstAppeared,
+		Designers:     lang.Designers,
+		TypeChecking:  lang.TypeChecking,
+		TypeStrength:  lang.TypeStrength,
+		Paradigms:     lang.Paradigms,
+		License:       lang.License,
+		Website:       lang.Website,
+		Extensions:    lang.Extensions,
+		Filenames:     lang.Filenames,
+		Interpreters:  lang.Interpreters,
+		Aliases:       lang.Aliases,
+		Color:         lang.Color,
+		StableVersion: lang.StableVersion,
+		CreatedAt:     lang.CreatedAt,
+		UpdatedAt:     lang.UpdatedAt,
+	}
+}
+
+// ToModel は、受け取ったProgrammingLangの形式をProgrammingLangに変換して返す。oがnilの場合は、nilを返す。
+func (o *ProgrammingLangOutput) ToModel() *model.ProgrammingLang {
+	if o == nil {
+		return nil
+	}
+
+	return &model.ProgrammingLang{
+		ID:            o.ID,
+		Name:          o.Name,
+		Feature:       o.Feature,
+		Slug:          o.Slug,
+		FirstAppeared: o.FirstAppeared,
+		Designers:     o.Designers,
+		TypeChecking:  o.TypeChecking,
+		TypeStrength:  o.TypeStrength,
+		Paradigms:     o.Paradigms,
+		License:       o.License,
+		Website:       o.Website,
+		Extensions:    o.Extensions,
+		Filenames:     o.Filenames,
+		Interpreters:  o.Interpreters,
+		Aliases:       o.Aliases,
+		Color:         o.Color,
+		StableVersion: o.StableVersion,
+		CreatedAt:     o.CreatedAt,
+		UpdatedAt:     o.UpdatedAt,
+	}
+}
+
+// NewProgrammingLangOutputs は、ProgrammingLangの一覧を返す際の形式に変換する。langsがnilの場合は、nilを返す。
+func NewProgrammingLangOutputs(langs []*model.ProgrammingLang) []*ProgrammingLangOutput {
+	if langs == nil {
+		return nil
+	}
+
+	outputs := make([]*ProgrammingLangOutput, 0, len(langs))
+	for _, lang := range langs {
+		outputs = append(outputs, NewProgrammingLangOutput(lang))
+	}
+	return outputs
+}
+
+// NewProgrammingLangEventOutput は、ProgrammingLangに対する変更を通知する際の形式に変換する。
+func NewProgrammingLangEventOutput(event *model.ProgrammingLangEvent) *ProgrammingLangEventOutput {
+	return &ProgrammingLangEventOutput{
+		ID:         event.ID,
+		Type:       event.Type,
+		Lang:       NewProgrammingLangOutput(event.Lang),
+		OccurredAt: event.OccurredAt,
+	}
+}
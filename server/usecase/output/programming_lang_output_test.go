@@ -0,0 +1,69 @@
+package output_test
+
+import (
+	"encoding/json"
+	"testing"
+	"time"
+
+	"github.com/SekiguchiKai/clean-architecture-with-go/server/domain/model"
+	"github.com/SekiguchiKai/clean-architecture-with-go/server/usecase/output"
+)
+
+func TestNewProgrammingLangOutput(t *testing.T) {
+	lang := &model.ProgrammingLang{
+		ID:        1,
+		Name:      "Go",
+		Feature:   "Simple",
+		Slug:      "go",
+		CreatedAt: model.GetTestTime(time.September, 1),
+		UpdatedAt: model.GetTestTime(time.September, 2),
+	}
+
+	tests := []struct {
+		name string
+		v    interface{}
+		want string
+	}{
+		{
+			name: "ProgrammingLangを、設定されていない値を省いたJSONの形式に変換すること",
+			v:    output.NewProgrammingLangOutput(lang),
+			want: `{"id":1,"name":"Go","feature":"Simple","slug":"go","createdAt":"2018-09-01T12:00:00Z","updatedAt":"2018-09-02T12:00:00Z"}`,
+		},
+		{
+			name: "ProgrammingLangがnilの場合、nullに変換すること",
+			v:    output.NewProgrammingLangOutput(nil),
+			want: `null`,
+		},
+		{
+			name: "ProgrammingLangの一覧が空の場合、空の配列に変換すること",
+			v:    output.NewProgrammingLangOutputs([]*model.ProgrammingLang{}),
+			want: `[]`,
+		},
+		{
+			name: "ProgrammingLangの候補を、一致した名前とスコアを含むJSONの形式に変換すること",
+			v:    output.NewLangSuggestionOutputs([]*model.LangSuggestion{{ID: 1, Name: "Go", Slug: "go", Matched: "golang", Score: 0.5}}),
+			want: `[{"id":1,"name":"Go","slug":"go","matched":"golang","score":0.5}]`,
+		},
+		{
+			name: "変更を、種類と変更後のProgrammingLangを含むJSONの形式に変換すること",
+			v: output.NewProgrammingLangEventOutput(&model.ProgrammingLangEvent{
+				ID:         3,
+				Type:       model.EventTypeCreated,
+				Lang:       &model.ProgrammingLang{ID: 1, Name: "Go"},
+				OccurredAt: model.GetTestTime(time.September, 1),
+			}),
+			want: `{"id":3,"type":"created","lang":{"id":1,"name":"Go","feature":"","slug":"","createdAt":"0001-01-01T00:00:00Z","updatedAt":"0001-01-01T00:00:00Z"},"occurredAt":"2018-09-01T12:00:00Z"}`,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			b, err := json.Marshal(tt.v)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if string(b) != tt.want {
+				t.Errorf("json = %s, want %s", b, tt.want)
+			}
+		})
+	}
+}
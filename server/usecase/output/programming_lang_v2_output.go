@@ -0,0 +1,111 @@
+package output
+
+import (
+	"time"
+
+	"github.com/SekiguchiKai/clean-architecture-with-go/server/domain/model"
+)
+
+// ProgrammingLangV2Output は、/v2でProgrammingLangを返す際の形式。
+// /v1のfeatureはdescriptionに、firstAppearedはfirstAppearedYearに名前を変えている。
+type ProgrammingLangV2Output struct {
+	ID                int                `json:"id"`
+	Name              string             `json:"name"`
+	Description       string             `json:"description"`
+	Slug              string             `json:"slug"`
+	FirstAppearedYear int                `json:"firstAppearedYear,omitempty"`
+	Designers         []string           `json:"designers,omitempty"`
+	TypeChecking      model.TypeChecking `json:"typeChecking,omitempty"`
+	TypeStrength      model.TypeStrength `json:"typeStrength,omitempty"`
+	Paradigms         []model.Paradigm   `json:"paradigms,omitempty"`
+	License           string             `json:"license,omitempty"`
+	Website           string             `json:"website,omitempty"`
+	Extensions        []string           `json:"extensions,omitempty"`
+	Filenames         []string           `json:"filenames,omitempty"`
+	Interpreters      []string           `json:"interpreters,omitempty"`
+	Aliases           []string           `json:"aliases,omitempty"`
+	Color             string             `json:"color,omitempty"`
+	StableVersion     string             `json:"stableVersion,omitempty"`
+	CreatedAt         time.Time          `json:"createdAt"`
+	UpdatedAt         time.Time          `json:"updatedAt"`
+}
+
+// ProgrammingLangPageV2Output は、/v2でProgrammingLangの一覧を返す際の形式。
+// NextCursorは、次のページが存在する場合のみ設定する。
+type ProgrammingLangPageV2Output struct {
+	Items      []*ProgrammingLangV2Output `json:"items"`
+	NextCursor string                     `json:"nextCursor,omitempty"`
+	HasMore    bool                       `json:"hasMore"`
+}
+
+// NewProgrammingLangV2Output は、/v2でProgrammingLangを返す際の形式に変換する。langがnilの場合は、nilを返す。
+func NewProgrammingLangV2Output(lang *model.ProgrammingLang) *ProgrammingLangV2Output {
+	if lang == nil {
+		return nil
+	}
+	return &ProgrammingLangV2Output{
+		ID:                lang.ID,
+		Name:              lang.Name,
+		Description:       lang.Feature,
+		Slug:              lang.Slug,
+		FirstAppearedYear: lang.FirstAppeared,
+		Designers:         lang.Designers,
+		TypeChecking:      lang.TypeChecking,
+		TypeStrength:      lang.TypeStrength,
+		Paradigms:         lang.Paradigms,
+		License:           lang.License,
+		Website:           lang.Website,
+		Extensions:        lang.Extensions,
+		Filenames:         lang.Filenames,
+		Interpreters:      lang.Interpreters,
+		Aliases:           lang.Aliases,
+		Color:             lang.Color,
+		StableVersion:     lang.StableVersion,
+		CreatedAt:         lang.CreatedAt,
+		UpdatedAt:         lang.UpdatedAt,
+	}
+}
+
+// ToModel は、/v2で受け取ったProgrammingLangの形式をProgrammingLangに変換して返す。oがnilの場合は、nilを返す。
+func (o *ProgrammingLangV2Output) ToModel() *model.ProgrammingLang {
+	if o == nil {
+		return nil
+	}
+
+	return &model.ProgrammingLang{
+		ID:            o.ID,
+		Name:          o.Name,
+		Feature:       o.Description,
+		Slug:          o.Slug,
+		FirstAppeared: o.FirstAppearedYear,
+		Designers:     o.Designers,
+		TypeChecking:  o.TypeChecking,
+		TypeStrength:  o.TypeStrength,
+		Paradigms:     o.Paradigms,
+		License:       o.License,
+		Website:       o.Website,
+		Extensions:    o.Extensions,
+		Filenames:     o.Filenames,
+		Interpreters:  o.Interpreters,
+		Aliases:       o.Aliases,
+		Color:         o.Color,
+		StableVersion: o.StableVersion,
+		CreatedAt:     o.CreatedAt,
+		UpdatedAt:     o.UpdatedAt,
+	}
+}
+
+// NewProgrammingLangPageV2Output は、/v2でProgrammingLangの一覧を返す際の形式に変換する。
+// 一覧が空の場合も、itemsは空の配列にする。
+func NewProgrammingLangPageV2Output(langs []*model.ProgrammingLang, nextCursor string) *ProgrammingLangPageV2Output {
+	items := make([]*ProgrammingLangV2Output, 0, len(langs))
+	for _, lang := range langs {
+		items = append(items, NewProgrammingLangV2Output(lang))
+	}
+
+	return &ProgrammingLangPageV2Output{
+		Items:      items,
+		NextCursor: nextCursor,
+		HasMore:    nextCursor != "",
+	}
+}
@@ -0,0 +1,71 @@
+package output
+
+import "github.com/SekiguchiKai/clean-architecture-with-go/server/domain/model"
+
+// SearchResultOutput は、全文検索に一致したProgrammingLangを返す際の形式。
+type SearchResultOutput struct {
+	ID      int     `json:"id"`
+	Name    string  `json:"name"`
+	Slug    string  `json:"slug"`
+	Score   float64 `json:"score"`
+	Snippet string  `json:"snippet"`
+}
+
+// ReindexReportOutput は、全文検索の索引を構築し直した結果を返す際の形式。
+type ReindexReportOutput struct {
+	Documents int `json:"documents"`
+}
+
+// LangSuggestionOutput は、入力に近いProgrammingLangの候補を返す際の形式。
+type LangSuggestionOutput struct {
+	ID      int     `json:"id"`
+	Name    string  `json:"name"`
+	Slug    string  `json:"slug"`
+	Matched string  `json:"matched"`
+	Score   float64 `json:"score"`
+}
+
+// NewSearchResultOutputs は、全文検索の結果を返す際の形式に変換する。resultsがnilの場合は、nilを返す。
+func NewSearchResultOutputs(results []*model.SearchResult) []*SearchResultOutput {
+	if results == nil {
+		return nil
+	}
+
+	outputs := make([]*SearchResultOutput, 0, len(results))
+	for _, r := range results {
+		outputs = append(outputs, &SearchResultOutput{
+			ID:      r.ID,
+			Name:    r.Name,
+			Slug:    r.Slug,
+			Score:   r.Score,
+			Snippet: r.Snippet,
+		})
+	}
+	return outputs
+}
+
+// NewReindexReportOutput は、全文検索の索引を構築し直した結果を返す際の形式に変換する。
+func NewReindexReportOutput(report *model.ReindexReport) *ReindexReportOutput {
+	return &ReindexReportOutput{
+		Documents: report.Documents,
+	}
+}
+
+// NewLangSuggestionOutputs は、ProgrammingLangの候補の一覧を返す際の形式に変換する。suggestionsがnilの場合は、nilを返す。
+func NewLangSuggestionOutputs(suggestions []*model.LangSuggestion) []*LangSuggestionOutput {
+	if suggestions == nil {
+		return nil
+	}
+
+	outputs := make([]*LangSuggestionOutput, 0, len(suggestions))
+	for _, s := range suggestions {
+		outputs = append(outputs, &LangSuggestionOutput{
+			ID:      s.ID,
+			Name:    s.Name,
+			Slug:    s.Slug,
+			Matched: s.Matched,
+			Score:   s.Score,
+		})
+	}
+	return outputs
+}
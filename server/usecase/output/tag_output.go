@@ -0,0 +1,41 @@
+package output
+
+import (
+	"time"
+
+	"github.com/SekiguchiKai/clean-architecture-with-go/server/domain/model"
+)
+
+// TagOutput は、Tagを返す際の形式。
+type TagOutput struct {
+	ID        int       `json:"id"`
+	Name      string    `json:"name"`
+	CreatedAt time.Time `json:"createdAt"`
+	UpdatedAt time.Time `json:"updatedAt"`
+}
+
+// NewTagOutput は、Tagを返す際の形式に変換する。tagがnilの場合は、nilを返す。
+func NewTagOutput(tag *model.Tag) *TagOutput {
+	if tag == nil {
+		return nil
+	}
+	return &TagOutput{
+		ID:        tag.ID,
+		Name:      tag.Name,
+		CreatedAt: tag.CreatedAt,
+		UpdatedAt: tag.UpdatedAt,
+	}
+}
+
+// NewTagOutputs は、Tagの一覧を返す際の形式に変換する。tagsがnilの場合は、nilを返す。
+func NewTagOutputs(tags []*model.Tag) []*TagOutput {
+	if tags == nil {
+		return nil
+	}
+
+	outputs := make([]*TagOutput, 0, len(tags))
+	for _, tag := range tags {
+		outputs = append(outputs, NewTagOutput(tag))
+	}
+	return outputs
+}
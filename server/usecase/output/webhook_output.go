@@ -0,0 +1,126 @@
+package output
+
+import (
+	"encoding/json"
+	"time"
+
+	"github.com/SekiguchiKai/clean-architecture-with-go/server/domain/model"
+)
+
+// WebhookOutput は、Webhookを返す際の形式。Secretは、UseCaseが返した場合のみ含める。
+type WebhookOutput struct {
+	ID         int               `json:"id"`
+	URL        string            `json:"url"`
+	Secret     string            `json:"secret,omitempty"`
+	EventTypes []model.EventType `json:"eventTypes,omitempty"`
+	Disabled   bool              `json:"disabled"`
+	CreatedAt  time.Time         `json:"createdAt"`
+	UpdatedAt  time.Time         `json:"updatedAt"`
+}
+
+// WebhookDeliveryOutput は、Webhookの通知を返す際の形式。
+type WebhookDeliveryOutput struct {
+	ID             int                      `json:"id"`
+	WebhookID      int                      `json:"webhookId"`
+	EventType      model.EventType          `json:"eventType"`
+	Payload        json.RawMessage          `json:"payload"`
+	Status         model.DeliveryStatus     `json:"status"`
+	Attempts       int                      `json:"attempts"`
+	NextAttemptAt  time.Time                `json:"nextAttemptAt"`
+	LastStatusCode int                      `json:"lastStatusCode,omitempty"`
+	LastError      string                   `json:"lastError,omitempty"`
+	CreatedAt      time.Time                `json:"createdAt"`
+	UpdatedAt      time.Time                `json:"updatedAt"`
+	Log            []*DeliveryAttemptOutput `json:"log,omitempty"`
+}
+
+// DeliveryAttemptOutput は、Webhookの通知を1回送信した記録を返す際の形式。
+type DeliveryAttemptOutput struct {
+	ID          int       `json:"id"`
+	DeliveryID  int       `json:"deliveryId"`
+	Attempt     int       `json:"attempt"`
+	StatusCode  int       `json:"statusCode,omitempty"`
+	Error       string    `json:"error,omitempty"`
+	DurationMS  int       `json:"durationMs"`
+	AttemptedAt time.Time `json:"attemptedAt"`
+}
+
+// NewWebhookOutput は、Webhookを返す際の形式に変換する。webhookがnilの場合は、nilを返す。
+func NewWebhookOutput(webhook *model.Webhook) *WebhookOutput {
+	if webhook == nil {
+		return nil
+	}
+	return &WebhookOutput{
+		ID:         webhook.ID,
+		URL:        webhook.URL,
+		Secret:     webhook.Secret,
+		EventTypes: webhook.EventTypes,
+		Disabled:   webhook.Disabled,
+		CreatedAt:  webhook.CreatedAt,
+		UpdatedAt:  webhook.UpdatedAt,
+	}
+}
+
+// NewWebhookOutputs は、Webhookの一覧を返す際の形式に変換する。webhooksがnilの場合は、nilを返す。
+func NewWebhookOutputs(webhooks []*model.Webhook) []*WebhookOutput {
+	if webhooks == nil {
+		return nil
+	}
+
+	outputs := make([]*WebhookOutput, 0, len(webhooks))
+	for _, webhook := range webhooks {
+		outputs = append(outputs, NewWebhookOutput(webhook))
+	}
+	return outputs
+}
+
+// NewWebhookDeliveryOutput は、Webhookの通知を返す際の形式に変換する。deliveryがnilの場合は、nilを返す。
+func NewWebhookDeliveryOutput(delivery *model.WebhookDelivery) *WebhookDeliveryOutput {
+	if delivery == nil {
+		return nil
+	}
+
+	var log []*DeliveryAttemptOutput
+	if delivery.Log != nil {
+		log = make([]*DeliveryAttemptOutput, 0, len(delivery.Log))
+		for _, a := range delivery.Log {
+			log = append(log, &DeliveryAttemptOutput{
+				ID:          a.ID,
+				DeliveryID:  a.DeliveryID,
+				Attempt:     a.Attempt,
+				StatusCode:  a.StatusCode,
+				Error:       a.Error,
+				DurationMS:  a.DurationMS,
+				AttemptedAt: a.AttemptedAt,
+			})
+		}
+	}
+
+	return &WebhookDeliveryOutput{
+		ID:             delivery.ID,
+		WebhookID:      delivery.WebhookID,
+		EventType:      delivery.EventType,
+		Payload:        delivery.Payload,
+		Status:         delivery.Status,
+		Attempts:       delivery.Attempts,
+		NextAttemptAt:  delivery.NextAttemptAt,
+		LastStatusCode: delivery.LastStatusCode,
+		LastError:      delivery.LastError,
+		CreatedAt:      delivery.CreatedAt,
+		UpdatedAt:      delivery.UpdatedAt,
+		Log:            log,
+	}
+}
+
+// NewWebhookDeliveryOutputs は、Webhookの通知の一覧を返す際の形式に変換する。deliveriesがnilの場合は、nilを返す。
+func NewWebhookDeliveryOutputs(deliveries []*model.WebhookDelivery) []*WebhookDeliveryOutput {
+	if deliveries == nil {
+		return nil
+	}
+
+	outputs := make([]*WebhookDeliveryOutput, 0, len(deliveries))
+	for _, delivery := range deliveries {
+		outputs = append(outputs, NewWebhookDeliveryOutput(delivery))
+	}
+	return outputs
+}
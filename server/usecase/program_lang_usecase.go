@@ -6,19 +6,32 @@ import (
 
 	"github.com/SekiguchiKai/clean-architecture-with-go/server/domain/model"
 	"github.com/SekiguchiKai/clean-architecture-with-go/server/domain/repository"
+	"github.com/SekiguchiKai/clean-architecture-with-go/server/domain/service"
 	"github.com/SekiguchiKai/clean-architecture-with-go/server/usecase/input"
 	"github.com/pkg/errors"
 )
 
+// DidYouMeanLimit は、Nameで指定したProgrammingLangが存在しない場合に提示する候補の最大件数。
+const DidYouMeanLimit = 3
+
 // ProgrammingLangUseCase は、ProgrammingLangのUseCase。
+// 変更は、その変更を表す領域イベントとともに1つのトランザクションでOutboxに記録し、Brokerへの配信はOutboxの中継に任せる。
 type ProgrammingLangUseCase struct {
-	Repo repository.ProgrammingLangRepository
+	Repo       repository.ProgrammingLangRepository
+	Suggester  repository.LangSuggestRepository
+	Transactor repository.Transactor
+	Outbox     repository.OutboxRepository
+	Broker     *EventBroker
 }
 
 // NewProgrammingLangUseCase は、ProgrammingLangUseCaseを生成し、返す。
-func NewProgrammingLangUseCase(repo repository.ProgrammingLangRepository) input.ProgrammingLangInputPort {
+func NewProgrammingLangUseCase(repo repository.ProgrammingLangRepository, suggester repository.LangSuggestRepository, transactor repository.Transactor, outbox repository.OutboxRepository, broker *EventBroker) input.ProgrammingLangInputPort {
 	return &ProgrammingLangUseCase{
-		Repo: repo,
+		Repo:       repo,
+		Suggester:  suggester,
+		Transactor: transactor,
+		Outbox:     outbox,
+		Broker:     broker,
 	}
 }
 
@@ -27,14 +40,92 @@ func (u *ProgrammingLangUseCase) List(ctx context.Context, limit int) ([]*model.
 	return u.Repo.List(ctx, limit)
 }
 
+// ListByFilter は、条件に一致するProgrammingLangの一覧を返す。Tagsは正規化してから絞り込む。
+func (u *ProgrammingLangUseCase) ListByFilter(ctx context.Context, filter *model.ProgrammingLangFilter) ([]*model.ProgrammingLang, error) {
+	tags := make([]string, len(filter.Tags))
+	for i, tag := range filter.Tags {
+		tags[i] = service.NormalizeTagName(tag)
+	}
+	filter.Tags = tags
+
+	return u.Repo.ListByFilter(ctx, filter)
+}
+
+// BatchGet は、IDで指定したProgrammingLangをまとめて返す。存在しないIDは結果に含まれない。
+func (u *ProgrammingLangUseCase) BatchGet(ctx context.Context, ids []int) ([]*model.ProgrammingLang, error) {
+	return u.Repo.ListByIDs(ctx, ids)
+}
+
 // Get は、ProgrammingLang1件返す。
 func (u *ProgrammingLangUseCase) Get(ctx context.Context, id int) (*model.ProgrammingLang, error) {
 	return u.Repo.Read(ctx, id)
 }
 
-// Create は、ProgrammingLangを生成する。
-func (u *ProgrammingLangUseCase) Create(ctx context.Context, param *model.ProgrammingLang) (*model.ProgrammingLang, error) {
-	lang, err := u.Repo.ReadByName(ctx, param.Name)
+// GetByName は、Nameで指定したProgrammingLangを1件返す。
+// 存在しない場合は、類似するNameもしくは別名をNoSuchDataErrorのDidYouMeanに設定する。
+func (u *ProgrammingLangUseCase) GetByName(ctx context.Context, name string) (*model.ProgrammingLang, error) {
+	lang, err := u.Repo.ReadByName(ctx, name)
+	if err == nil {
+		return lang, nil
+	}
+
+	nsdErr, ok := errors.Cause(err).(*model.NoSuchDataError)
+	if !ok || u.Suggester == nil {
+		return nil, err
+	}
+
+	// 候補を取得できなくても、存在しないことは利用者に伝える。
+	suggestions, sErr := u.Suggester.Similar(ctx, name, DidYouMeanLimit)
+	if sErr != nil || len(suggestions) == 0 {
+		return nil, err
+	}
+
+	didYouMean := make([]string, len(suggestions))
+	for i, s := range suggestions {
+		didYouMean[i] = s.Matched
+	}
+	nsdErr.DidYouMean = didYouMean
+
+	return nil, err
+}
+
+// Suggest は、prefixから始まる、もしくはprefixに類似するNameもしくは別名を持つProgrammingLangを最大limit件返す。
+func (u *ProgrammingLangUseCase) Suggest(ctx context.Context, prefix string, limit int) ([]*model.LangSuggestion, error) {
+	if service.NameKey(prefix) == "" {
+		return nil, &model.RequiredError{
+			Property: model.PropertyPrefix,
+		}
+	}
+
+	if u.Suggester == nil {
+		return nil, errors.New("suggester is not configured")
+	}
+
+	return u.Suggester.Suggest(ctx, prefix, limit)
+}
+
+// GetBySlug は、slugで指定したProgrammingLangを1件返す。変更前のslugを指定した場合も、現在のProgrammingLangを返す。
+func (u *ProgrammingLangUseCase) GetBySlug(ctx context.Context, slug string) (*model.ProgrammingLang, error) {
+	lang, err := u.Repo.ReadBySlug(ctx, slug)
+	if err == nil {
+		return lang, nil
+	}
+
+	if _, ok := errors.Cause(err).(*model.NoSuchDataError); !ok {
+		return nil, errors.WithStack(err)
+	}
+
+	return u.Repo.ReadByPreviousSlug(ctx, slug)
+}
+
+// Create は、ProgrammingLangを生成する。受け付けた値は、ProgrammingLangに変換してから検証する。
+func (u *ProgrammingLangUseCase) Create(ctx context.Context, param *input.ProgrammingLangCreate) (*model.ProgrammingLang, error) {
+	newLang := param.ToModel()
+	if err := service.ValidateProgrammingLangDetail(newLang); err != nil {
+		return nil, err
+	}
+
+	lang, err := u.Repo.ReadByName(ctx, newLang.Name)
 	if lang != nil {
 		return nil, &model.AlreadyExistError{
 			ID:        lang.ID,
@@ -47,19 +138,38 @@ func (u *ProgrammingLangUseCase) Create(ctx context.Context, param *model.Progra
 		return nil, errors.WithStack(err)
 	}
 
-	param.CreatedAt = time.Now().UTC()
-	param.UpdatedAt = time.Now().UTC()
+	// 別名が他のProgrammingLangのNameや別名として使用されている場合は、同じ言語を重複して登録しないようにする。
+	if err := u.checkNamesUnused(ctx, 0, newLang.Aliases); err != nil {
+		return nil, err
+	}
 
-	lang, err = u.Repo.Create(ctx, param)
+	slug, err := u.uniqueSlug(ctx, newLang.Name, 0)
 	if err != nil {
 		return nil, errors.WithStack(err)
 	}
 
+	newLang.Slug = slug
+	newLang.CreatedAt = time.Now().UTC()
+	newLang.UpdatedAt = time.Now().UTC()
+
+	err = u.transaction(ctx, func(ctx context.Context) error {
+		created, err := u.Repo.Create(ctx, newLang)
+		if err != nil {
+			return errors.WithStack(err)
+		}
+		lang = created
+
+		return u.raise(ctx, model.EventTypeCreated, lang)
+	})
+	if err != nil {
+		return nil, err
+	}
+
 	return lang, nil
 }
 
 // Update は、ProgrammingLangを更新する。
-func (u *ProgrammingLangUseCase) Update(ctx context.Context, id int, param *model.ProgrammingLang) (*model.ProgrammingLang, error) {
+func (u *ProgrammingLangUseCase) Update(ctx context.Context, id int, param *input.ProgrammingLangUpdate) (*model.ProgrammingLang, error) {
 	lang, err := u.Repo.Read(ctx, id)
 	if lang == nil {
 		return nil, &model.NoSuchDataError{
@@ -71,12 +181,48 @@ func (u *ProgrammingLangUseCase) Update(ctx context.Context, id int, param *mode
 		return nil, errors.WithStack(err)
 	}
 
+	known := service.NameKeys(lang)
 	lang.ID = id
-	lang.Name = param.Name
-	lang.Feature = param.Feature
+	param.Apply(lang)
 	lang.UpdatedAt = time.Now().UTC()
 
-	return u.Repo.Update(ctx, lang)
+	if err := service.ValidateProgrammingLangDetail(lang); err != nil {
+		return nil, err
+	}
+
+	if err := u.checkNamesUnused(ctx, id, service.AddedNames(lang, known)); err != nil {
+		return nil, err
+	}
+
+	err = u.transaction(ctx, func(ctx context.Context) error {
+		// Nameの変更によってslugが変わる場合は、古いURLを転送できるように変更前のslugを記録する。
+		if !service.IsSlugOf(lang.Slug, lang.Name) {
+			slug, err := u.uniqueSlug(ctx, lang.Name, id)
+			if err != nil {
+				return errors.WithStack(err)
+			}
+
+			if lang.Slug != "" {
+				if err := u.Repo.CreateSlugHistory(ctx, id, lang.Slug); err != nil {
+					return errors.WithStack(err)
+				}
+			}
+			lang.Slug = slug
+		}
+
+		updated, err := u.Repo.Update(ctx, lang)
+		if err != nil {
+			return err
+		}
+		lang = updated
+
+		return u.raise(ctx, model.EventTypeUpdated, lang)
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return lang, nil
 }
 
 // Delete は、ProgrammingLangを削除する。
@@ -91,5 +237,136 @@ func (u *ProgrammingLangUseCase) Delete(ctx context.Context, id int) error {
 		return  errors.WithStack(err)
 	}
 
-	return u.Repo.Delete(ctx, id)
+	return u.transaction(ctx, func(ctx context.Context) error {
+		if err := u.Repo.Delete(ctx, id); err != nil {
+			return err
+		}
+
+		return u.raise(ctx, model.EventTypeDeleted, lang)
+	})
+}
+
+// LastModified は、ProgrammingLangの中で最も新しい更新日時を返す。
+func (u *ProgrammingLangUseCase) LastModified(ctx context.Context) (time.Time, error) {
+	return u.Repo.LastModified(ctx)
+}
+
+// Watch は、ProgrammingLangの変更を受信するチャネルを返す。チャネルは、ctxが終了すると閉じられる。
+func (u *ProgrammingLangUseCase) Watch(ctx context.Context) (<-chan *model.ProgrammingLangEvent, error) {
+	if u.Broker == nil {
+		return nil, errors.New("event broker is not configured")
+	}
+	return u.Broker.Subscribe(ctx, DefaultSubscriberBuffer), nil
+}
+
+// WatchFrom は、IDがlastEventIDのイベントより後の変更から受信するチャネルを返す。チャネルは、ctxが終了すると閉じられる。
+// 受信し損ねた変更を全て配信できない場合は、falseを返す。その場合、受信側は一覧を取得し直す必要がある。
+func (u *ProgrammingLangUseCase) WatchFrom(ctx context.Context, lastEventID int) (<-chan *model.ProgrammingLangEvent, bool, error) {
+	if u.Broker == nil {
+		return nil, false, errors.New("event broker is not configured")
+	}
+
+	events, complete := u.Broker.SubscribeFrom(ctx, DefaultSubscriberBuffer, lastEventID)
+	return events, complete, nil
+}
+
+// uniqueSlug は、Nameから生成したslugのうち、他のProgrammingLangが使用していないものを返す。
+// 転送先が変わらないように、他のProgrammingLangの変更前のslugも使用しない。
+func (u *ProgrammingLangUseCase) uniqueSlug(ctx context.Context, name string, id int) (string, error) {
+	base := service.GenerateSlug(name)
+	for n := 1; n <= service.MaxSlugAttempts; n++ {
+		slug := service.NumberedSlug(base, n)
+
+		used, err := u.slugUsed(ctx, slug, id)
+		if err != nil {
+			return "", err
+		}
+		if !used {
+			return slug, nil
+		}
+	}
+
+	return "", &model.AlreadyExistError{
+		Name:      base,
+		ModelName: model.ModelNameProgrammingLang,
+	}
+}
+
+// checkNamesUnused は、namesがNameもしくは別名として、指定したID以外のProgrammingLangで使用されていないことを確認する。
+func (u *ProgrammingLangUseCase) checkNamesUnused(ctx context.Context, id int, names []string) error {
+	for _, name := range names {
+		lang, err := u.Repo.ReadByName(ctx, name)
+		if err != nil {
+			if _, ok := errors.Cause(err).(*model.NoSuchDataError); ok {
+				continue
+			}
+			return errors.WithStack(err)
+		}
+
+		if lang.ID != id {
+			return &model.AlreadyExistError{
+				ID:        lang.ID,
+				Name:      name,
+				ModelName: model.ModelNameProgrammingLang,
+			}
+		}
+	}
+
+	return nil
+}
+
+// slugUsed は、slugが指定したID以外のProgrammingLangで使用されているかどうかを確認する。
+func (u *ProgrammingLangUseCase) slugUsed(ctx context.Context, slug string, id int) (bool, error) {
+	reads := []func(ctx context.Context, slug string) (*model.ProgrammingLang, error){
+		u.Repo.ReadBySlug,
+		u.Repo.ReadByPreviousSlug,
+	}
+
+	for _, read := range reads {
+		lang, err := read(ctx, slug)
+		if err != nil {
+			if _, ok := errors.Cause(err).(*model.NoSuchDataError); ok {
+				continue
+			}
+			return false, errors.WithStack(err)
+		}
+
+		if lang.ID != id {
+			return true, nil
+		}
+	}
+
+	return false, nil
+}
+
+// transaction は、fnを1つのトランザクションの中で実行する。Transactorが設定されていない場合は、そのまま実行する。
+func (u *ProgrammingLangUseCase) transaction(ctx context.Context, fn func(ctx context.Context) error) error {
+	if u.Transactor == nil {
+		return fn(ctx)
+	}
+	return u.Transactor.Transaction(ctx, fn)
+}
+
+// raise は、ProgrammingLangの変更を表す領域イベントをOutboxに記録する。
+// 変更と同じトランザクションで記録するため、記録に失敗した場合は変更も取り消される。
+func (u *ProgrammingLangUseCase) raise(ctx context.Context, eventType model.EventType, lang *model.ProgrammingLang) error {
+	if u.Outbox == nil || lang == nil {
+		return nil
+	}
+
+	cp := *lang
+	event, err := service.NewOutboxEvent(&model.ProgrammingLangEvent{
+		Type:       eventType,
+		Lang:       &cp,
+		OccurredAt: time.Now().UTC(),
+	})
+	if err != nil {
+		return err
+	}
+
+	if _, err := u.Outbox.Create(ctx, event); err != nil {
+		return errors.WithStack(err)
+	}
+
+	return nil
 }
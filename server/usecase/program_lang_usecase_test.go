@@ -4,11 +4,14 @@ import (
 	"context"
 	"reflect"
 	"testing"
+	"time"
 
 	"github.com/SekiguchiKai/clean-architecture-with-go/server/domain/model"
 	"github.com/SekiguchiKai/clean-architecture-with-go/server/domain/repository"
+	"github.com/SekiguchiKai/clean-architecture-with-go/server/usecase/input"
 	"github.com/SekiguchiKai/clean-architecture-with-go/server/infra/dao/mock"
 	"github.com/golang/mock/gomock"
+	"github.com/pkg/errors"
 )
 
 func TestNewProgrammingLangUseCase(t *testing.T) {
@@ -16,9 +19,16 @@ func TestNewProgrammingLangUseCase(t *testing.T) {
 	defer ctrl.Finish()
 
 	mock := mock_repository.NewMockProgrammingLangRepository(ctrl)
+	suggester := mock_repository.NewMockLangSuggestRepository(ctrl)
+	transactor := mock_repository.NewMockTransactor(ctrl)
+	outbox := mock_repository.NewMockOutboxRepository(ctrl)
 
 	type args struct {
-		repo repository.ProgrammingLangRepository
+		repo       repository.ProgrammingLangRepository
+		suggester  repository.LangSuggestRepository
+		transactor repository.Transactor
+		outbox     repository.OutboxRepository
+		broker     *EventBroker
 	}
 	tests := []struct {
 		name string
@@ -27,13 +37,17 @@ func TestNewProgrammingLangUseCase(t *testing.T) {
 		{
 			name: "適切な引数を与えると、ProgrammingLangUseCaseが返されること",
 			args: args{
-				repo: mock,
+				repo:       mock,
+				suggester:  suggester,
+				transactor: transactor,
+				outbox:     outbox,
+				broker:     NewEventBroker(),
 			},
 		},
 	}
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
-			if got := NewProgrammingLangUseCase(tt.args.repo); got == nil {
+			if got := NewProgrammingLangUseCase(tt.args.repo, tt.args.suggester, tt.args.transactor, tt.args.outbox, tt.args.broker); got == nil {
 				t.Errorf("NewProgrammingLangUseCase() = %v, want not nil", got)
 			}
 		})
@@ -304,10 +318,17 @@ func TestProgrammingLangUseCase_Create(t *testing.T) {
 			mock.EXPECT().ReadByName(tt.args.ctx, tt.args.param.Name).Return(tt.readWant.result, tt.readWant.err)
 
 			if !tt.wantErr.isErr {
-				mock.EXPECT().Create(tt.args.ctx, tt.args.param).Return(tt.want, tt.wantErr.err)
+				mock.EXPECT().ReadBySlug(tt.args.ctx, tt.args.param.Slug).Return(nil, &model.NoSuchDataError{Name: tt.args.param.Slug})
+				mock.EXPECT().ReadByPreviousSlug(tt.args.ctx, tt.args.param.Slug).Return(nil, &model.NoSuchDataError{Name: tt.args.param.Slug})
+				mock.EXPECT().Create(tt.args.ctx, gomock.Any()).DoAndReturn(func(ctx context.Context, lang *model.ProgrammingLang) (*model.ProgrammingLang, error) {
+					if lang.Name != tt.args.param.Name || lang.Slug != tt.args.param.Slug {
+						t.Errorf("ProgrammingLangRepository.Create() lang = %v, want Name %v and Slug %v", lang, tt.args.param.Name, tt.args.param.Slug)
+					}
+					return tt.want, tt.wantErr.err
+				})
 			}
 
-			got, err := u.Create(tt.args.ctx, tt.args.param)
+			got, err := u.Create(tt.args.ctx, input.NewProgrammingLangCreate(tt.args.param))
 
 			if (err != nil) != tt.wantErr.isErr {
 				t.Errorf("ProgrammingLangUseCase.Create() error = %v, wantErr %v", err, tt.wantErr)
@@ -341,7 +362,7 @@ func TestProgrammingLangUseCase_Update(t *testing.T) {
 	type args struct {
 		ctx   context.Context
 		id    int
-		param *model.ProgrammingLang
+		param *input.ProgrammingLangUpdate
 	}
 
 	type readWant struct {
@@ -370,7 +391,7 @@ func TestProgrammingLangUseCase_Update(t *testing.T) {
 			args: args{
 				ctx:   context.Background(),
 				id:    1,
-				param: lang,
+				param: input.NewProgrammingLangUpdate(lang),
 			},
 			want: lang,
 			readWant: readWant{
@@ -390,7 +411,7 @@ func TestProgrammingLangUseCase_Update(t *testing.T) {
 			args: args{
 				ctx:   context.Background(),
 				id:    100,
-				param: lang,
+				param: input.NewProgrammingLangUpdate(lang),
 			},
 			want: nil,
 			readWant: readWant{
@@ -420,7 +441,7 @@ func TestProgrammingLangUseCase_Update(t *testing.T) {
 			mock.EXPECT().Read(tt.args.ctx, tt.args.id).Return(tt.readWant.result, tt.readWant.err)
 
 			if !tt.wantErr.isErr {
-				mock.EXPECT().Update(tt.args.ctx, tt.args.param).Return(tt.want, tt.wantErr.err)
+				mock.EXPECT().Update(tt.args.ctx, tt.readWant.result).Return(tt.want, tt.wantErr.err)
 			}
 
 			got, err := u.Update(tt.args.ctx, tt.args.id, tt.args.param)
@@ -548,3 +569,649 @@ func TestProgrammingLangUseCase_Delete(t *testing.T) {
 		})
 	}
 }
+
+func TestProgrammingLangUseCase_GetBySlug(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mock := mock_repository.NewMockProgrammingLangRepository(ctrl)
+
+	lang := model.CreateProgrammingLangs(1)[0]
+	noSlugErr := &model.NoSuchDataError{
+		Name:      "old",
+		ModelName: model.ModelNameProgrammingLang,
+	}
+
+	tests := []struct {
+		name    string
+		slug    string
+		mock    func(ctx context.Context)
+		want    *model.ProgrammingLang
+		wantErr error
+	}{
+		{
+			name: "現在のslugを指定した場合、ProgrammingLangを返すこと",
+			slug: lang.Slug,
+			mock: func(ctx context.Context) {
+				mock.EXPECT().ReadBySlug(ctx, lang.Slug).Return(lang, nil)
+			},
+			want: lang,
+		},
+		{
+			name: "変更前のslugを指定した場合、現在のProgrammingLangを返すこと",
+			slug: "old",
+			mock: func(ctx context.Context) {
+				mock.EXPECT().ReadBySlug(ctx, "old").Return(nil, noSlugErr)
+				mock.EXPECT().ReadByPreviousSlug(ctx, "old").Return(lang, nil)
+			},
+			want: lang,
+		},
+		{
+			name: "どちらにも存在しないslugを指定した場合、エラーを返すこと",
+			slug: "old",
+			mock: func(ctx context.Context) {
+				mock.EXPECT().ReadBySlug(ctx, "old").Return(nil, noSlugErr)
+				mock.EXPECT().ReadByPreviousSlug(ctx, "old").Return(nil, noSlugErr)
+			},
+			wantErr: noSlugErr,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			u := &ProgrammingLangUseCase{
+				Repo: mock,
+			}
+
+			ctx := context.Background()
+			tt.mock(ctx)
+
+			got, err := u.GetBySlug(ctx, tt.slug)
+			if errors.Cause(err) != tt.wantErr {
+				t.Errorf("ProgrammingLangUseCase.GetBySlug() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("ProgrammingLangUseCase.GetBySlug() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestProgrammingLangUseCase_GetByName(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mock := mock_repository.NewMockProgrammingLangRepository(ctrl)
+	suggester := mock_repository.NewMockLangSuggestRepository(ctrl)
+
+	lang := model.CreateProgrammingLangs(1)[0]
+
+	tests := []struct {
+		name           string
+		mock           func(ctx context.Context)
+		want           *model.ProgrammingLang
+		wantErr        bool
+		wantDidYouMean []string
+	}{
+		{
+			name: "存在するNameを指定した場合、ProgrammingLangを返すこと",
+			mock: func(ctx context.Context) {
+				mock.EXPECT().ReadByName(ctx, "Javscript").Return(lang, nil)
+			},
+			want: lang,
+		},
+		{
+			name: "存在しないNameを指定した場合、類似するNameをDidYouMeanに設定したエラーを返すこと",
+			mock: func(ctx context.Context) {
+				mock.EXPECT().ReadByName(ctx, "Javscript").Return(nil, &model.NoSuchDataError{Name: "Javscript"})
+				suggester.EXPECT().Similar(ctx, "Javscript", DidYouMeanLimit).Return([]*model.LangSuggestion{
+					{ID: 1, Name: "JavaScript", Matched: "JavaScript"},
+					{ID: 2, Name: "Java", Matched: "Java"},
+				}, nil)
+			},
+			wantErr:        true,
+			wantDidYouMean: []string{"JavaScript", "Java"},
+		},
+		{
+			name: "候補の取得に失敗した場合、DidYouMeanを設定せずにエラーを返すこと",
+			mock: func(ctx context.Context) {
+				mock.EXPECT().ReadByName(ctx, "Javscript").Return(nil, &model.NoSuchDataError{Name: "Javscript"})
+				suggester.EXPECT().Similar(ctx, "Javscript", DidYouMeanLimit).Return(nil, &model.DBError{})
+			},
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			u := &ProgrammingLangUseCase{
+				Repo:      mock,
+				Suggester: suggester,
+			}
+
+			ctx := context.Background()
+			tt.mock(ctx)
+
+			got, err := u.GetByName(ctx, "Javscript")
+			if (err != nil) != tt.wantErr {
+				t.Errorf("ProgrammingLangUseCase.GetByName() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("ProgrammingLangUseCase.GetByName() = %v, want %v", got, tt.want)
+			}
+			if err == nil {
+				return
+			}
+
+			nsdErr, ok := errors.Cause(err).(*model.NoSuchDataError)
+			if !ok {
+				t.Fatalf("ProgrammingLangUseCase.GetByName() error = %T, want *model.NoSuchDataError", err)
+			}
+			if !reflect.DeepEqual(nsdErr.DidYouMean, tt.wantDidYouMean) {
+				t.Errorf("ProgrammingLangUseCase.GetByName() DidYouMean = %v, want %v", nsdErr.DidYouMean, tt.wantDidYouMean)
+			}
+		})
+	}
+}
+
+func TestProgrammingLangUseCase_Suggest(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	suggester := mock_repository.NewMockLangSuggestRepository(ctrl)
+
+	suggestions := []*model.LangSuggestion{
+		{ID: 1, Name: "Java", Slug: "java", Matched: "Java", Score: 0.5},
+	}
+
+	tests := []struct {
+		name    string
+		prefix  string
+		mock    func(ctx context.Context)
+		want    []*model.LangSuggestion
+		wantErr error
+	}{
+		{
+			name:   "prefixを指定した場合、候補を返すこと",
+			prefix: "ja",
+			mock: func(ctx context.Context) {
+				suggester.EXPECT().Suggest(ctx, "ja", 5).Return(suggestions, nil)
+			},
+			want: suggestions,
+		},
+		{
+			name:    "prefixが空白のみの場合、RequiredErrorを返すこと",
+			prefix:  " ",
+			mock:    func(ctx context.Context) {},
+			wantErr: &model.RequiredError{Property: model.PropertyPrefix},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			u := &ProgrammingLangUseCase{
+				Suggester: suggester,
+			}
+
+			ctx := context.Background()
+			tt.mock(ctx)
+
+			got, err := u.Suggest(ctx, tt.prefix, 5)
+			if !reflect.DeepEqual(errors.Cause(err), tt.wantErr) {
+				t.Errorf("ProgrammingLangUseCase.Suggest() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("ProgrammingLangUseCase.Suggest() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestProgrammingLangUseCase_Slug(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mock := mock_repository.NewMockProgrammingLangRepository(ctrl)
+
+	noDataErr := &model.NoSuchDataError{
+		ModelName: model.ModelNameProgrammingLang,
+	}
+	other := &model.ProgrammingLang{ID: 2, Name: "C#", Slug: "csharp"}
+
+	tests := []struct {
+		name     string
+		mutate   func(ctx context.Context, u *ProgrammingLangUseCase) (*model.ProgrammingLang, error)
+		wantSlug string
+	}{
+		{
+			name: "生成する際にslugが他のProgrammingLangで使用されている場合、番号を付与したslugにすること",
+			mutate: func(ctx context.Context, u *ProgrammingLangUseCase) (*model.ProgrammingLang, error) {
+				mock.EXPECT().ReadByName(ctx, "c#").Return(nil, noDataErr)
+				mock.EXPECT().ReadBySlug(ctx, "csharp").Return(other, nil)
+				mock.EXPECT().ReadBySlug(ctx, "csharp-2").Return(nil, noDataErr)
+				mock.EXPECT().ReadByPreviousSlug(ctx, "csharp-2").Return(other, nil)
+				mock.EXPECT().ReadBySlug(ctx, "csharp-3").Return(nil, noDataErr)
+				mock.EXPECT().ReadByPreviousSlug(ctx, "csharp-3").Return(nil, noDataErr)
+				mock.EXPECT().Create(ctx, gomock.Any()).DoAndReturn(func(ctx context.Context, lang *model.ProgrammingLang) (*model.ProgrammingLang, error) {
+					return lang, nil
+				})
+				return u.Create(ctx, &input.ProgrammingLangCreate{Name: "c#"})
+			},
+			wantSlug: "csharp-3",
+		},
+		{
+			name: "Nameを変更した場合、slugを変更して変更前のslugを記録すること",
+			mutate: func(ctx context.Context, u *ProgrammingLangUseCase) (*model.ProgrammingLang, error) {
+				mock.EXPECT().Read(ctx, 1).Return(&model.ProgrammingLang{ID: 1, Name: "Golang", Slug: "golang"}, nil)
+				mock.EXPECT().ReadByName(ctx, "Go").Return(nil, noDataErr)
+				mock.EXPECT().ReadBySlug(ctx, "go").Return(nil, noDataErr)
+				mock.EXPECT().ReadByPreviousSlug(ctx, "go").Return(nil, noDataErr)
+				mock.EXPECT().CreateSlugHistory(ctx, 1, "golang").Return(nil)
+				mock.EXPECT().Update(ctx, gomock.Any()).DoAndReturn(func(ctx context.Context, lang *model.ProgrammingLang) (*model.ProgrammingLang, error) {
+					return lang, nil
+				})
+				return u.Update(ctx, 1, &input.ProgrammingLangUpdate{Name: "Go"})
+			},
+			wantSlug: "go",
+		},
+		{
+			name: "変更前のslugに戻す場合、同じProgrammingLangの変更前のslugを使用すること",
+			mutate: func(ctx context.Context, u *ProgrammingLangUseCase) (*model.ProgrammingLang, error) {
+				lang := &model.ProgrammingLang{ID: 1, Name: "Go", Slug: "go"}
+				mock.EXPECT().Read(ctx, 1).Return(lang, nil)
+				mock.EXPECT().ReadByName(ctx, "Golang").Return(nil, noDataErr)
+				mock.EXPECT().ReadBySlug(ctx, "golang").Return(nil, noDataErr)
+				mock.EXPECT().ReadByPreviousSlug(ctx, "golang").Return(lang, nil)
+				mock.EXPECT().CreateSlugHistory(ctx, 1, "go").Return(nil)
+				mock.EXPECT().Update(ctx, gomock.Any()).DoAndReturn(func(ctx context.Context, lang *model.ProgrammingLang) (*model.ProgrammingLang, error) {
+					return lang, nil
+				})
+				return u.Update(ctx, 1, &input.ProgrammingLangUpdate{Name: "Golang"})
+			},
+			wantSlug: "golang",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			u := &ProgrammingLangUseCase{
+				Repo: mock,
+			}
+
+			got, err := tt.mutate(context.Background(), u)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if got.Slug != tt.wantSlug {
+				t.Errorf("Slug = %v, want %v", got.Slug, tt.wantSlug)
+			}
+		})
+	}
+}
+
+func TestProgrammingLangUseCase_Detail(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mock := mock_repository.NewMockProgrammingLangRepository(ctrl)
+
+	existing := func() *model.ProgrammingLang {
+		return &model.ProgrammingLang{
+			ID:            1,
+			Name:          "Go",
+			Slug:          "go",
+			FirstAppeared: 2009,
+			Designers:     []string{"Rob Pike"},
+			TypeChecking:  model.TypeCheckingStatic,
+			Paradigms:     []model.Paradigm{model.ParadigmConcurrent},
+			Extensions:    []string{".go"},
+		}
+	}
+
+	stableVersion := "1.11.1"
+	unset := model.TypeChecking("")
+	duck := model.TypeChecking("duck")
+
+	tests := []struct {
+		name         string
+		param        *input.ProgrammingLangUpdate
+		want         *model.ProgrammingLang
+		wantProperty string
+	}{
+		{
+			name:  "詳細な属性を指定せずに更新した場合、既存の詳細な属性を保持すること",
+			param: &input.ProgrammingLangUpdate{Name: "Go", Feature: model.TestFeature},
+			want: func() *model.ProgrammingLang {
+				lang := existing()
+				lang.Feature = model.TestFeature
+				return lang
+			}(),
+		},
+		{
+			name: "詳細な属性を指定して更新した場合、指定した属性だけを変更すること",
+			param: &input.ProgrammingLangUpdate{
+				Name:          "Go",
+				Paradigms:     []model.Paradigm{model.ParadigmConcurrent, model.ParadigmImperative},
+				StableVersion: &stableVersion,
+			},
+			want: func() *model.ProgrammingLang {
+				lang := existing()
+				lang.Paradigms = []model.Paradigm{model.ParadigmConcurrent, model.ParadigmImperative}
+				lang.StableVersion = "1.11.1"
+				return lang
+			}(),
+		},
+		{
+			name: "空の値を指定して更新した場合、その属性を消すこと",
+			param: &input.ProgrammingLangUpdate{
+				Name:         "Go",
+				Designers:    []string{},
+				TypeChecking: &unset,
+			},
+			want: func() *model.ProgrammingLang {
+				lang := existing()
+				lang.Designers = []string{}
+				lang.TypeChecking = ""
+				return lang
+			}(),
+		},
+		{
+			name:  "ProgrammingLangの内容で置き換える場合、設定されていない値の属性を消し、nilのリストの属性を保持すること",
+			param: input.NewProgrammingLangUpdate(&model.ProgrammingLang{Name: "Go", Extensions: []string{}}),
+			want: &model.ProgrammingLang{
+				ID:         1,
+				Name:       "Go",
+				Slug:       "go",
+				Designers:  []string{"Rob Pike"},
+				Paradigms:  []model.Paradigm{model.ParadigmConcurrent},
+				Extensions: []string{},
+			},
+		},
+		{
+			name:         "不適切な詳細な属性を指定した場合、InvalidPropertyErrorを返し、更新しないこと",
+			param:        &input.ProgrammingLangUpdate{Name: "Go", TypeChecking: &duck},
+			wantProperty: model.PropertyTypeChecking,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			u := &ProgrammingLangUseCase{
+				Repo: mock,
+			}
+			ctx := context.Background()
+
+			mock.EXPECT().Read(ctx, 1).Return(existing(), nil)
+			if tt.wantProperty == "" {
+				mock.EXPECT().Update(ctx, gomock.Any()).DoAndReturn(func(ctx context.Context, lang *model.ProgrammingLang) (*model.ProgrammingLang, error) {
+					return lang, nil
+				})
+			}
+
+			got, err := u.Update(ctx, 1, tt.param)
+			if tt.wantProperty != "" {
+				if e, ok := err.(*model.InvalidPropertyError); !ok || e.Property != tt.wantProperty {
+					t.Errorf("ProgrammingLangUseCase.Update() error = %v, want InvalidPropertyError of %v", err, tt.wantProperty)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			got.UpdatedAt = time.Time{}
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("ProgrammingLangUseCase.Update() = %+v, want %+v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestProgrammingLangUseCase_NameConflict(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mock := mock_repository.NewMockProgrammingLangRepository(ctrl)
+
+	noDataErr := &model.NoSuchDataError{
+		ModelName: model.ModelNameProgrammingLang,
+	}
+	js := &model.ProgrammingLang{ID: 2, Name: "JavaScript", Slug: "javascript", Aliases: []string{"js"}}
+	existing := func() *model.ProgrammingLang {
+		return &model.ProgrammingLang{ID: 1, Name: "Go", Slug: "go", Aliases: []string{"golang"}}
+	}
+	updated := func(ctx context.Context) {
+		mock.EXPECT().Update(ctx, gomock.Any()).DoAndReturn(func(ctx context.Context, lang *model.ProgrammingLang) (*model.ProgrammingLang, error) {
+			return lang, nil
+		})
+	}
+
+	tests := []struct {
+		name        string
+		mutate      func(ctx context.Context, u *ProgrammingLangUseCase) (*model.ProgrammingLang, error)
+		wantErrType error
+	}{
+		{
+			name: "生成する際に別名が他のProgrammingLangで使用されている場合、AlreadyExistErrorを返すこと",
+			mutate: func(ctx context.Context, u *ProgrammingLangUseCase) (*model.ProgrammingLang, error) {
+				mock.EXPECT().ReadByName(ctx, "TypeScript").Return(nil, noDataErr)
+				mock.EXPECT().ReadByName(ctx, "JS").Return(js, nil)
+				return u.Create(ctx, &input.ProgrammingLangCreate{Name: "TypeScript", Aliases: []string{"JS"}})
+			},
+			wantErrType: &model.AlreadyExistError{},
+		},
+		{
+			name: "更新する際に他のProgrammingLangの別名にNameを変更した場合、AlreadyExistErrorを返すこと",
+			mutate: func(ctx context.Context, u *ProgrammingLangUseCase) (*model.ProgrammingLang, error) {
+				mock.EXPECT().Read(ctx, 1).Return(existing(), nil)
+				mock.EXPECT().ReadByName(ctx, "js").Return(js, nil)
+				return u.Update(ctx, 1, &input.ProgrammingLangUpdate{Name: "js"})
+			},
+			wantErrType: &model.AlreadyExistError{},
+		},
+		{
+			name: "Nameの大文字と小文字のみを変更した場合、照合せずに更新すること",
+			mutate: func(ctx context.Context, u *ProgrammingLangUseCase) (*model.ProgrammingLang, error) {
+				mock.EXPECT().Read(ctx, 1).Return(existing(), nil)
+				updated(ctx)
+				return u.Update(ctx, 1, &input.ProgrammingLangUpdate{Name: "GO"})
+			},
+		},
+		{
+			name: "追加した別名が自身のNameとして照合される場合、更新すること",
+			mutate: func(ctx context.Context, u *ProgrammingLangUseCase) (*model.ProgrammingLang, error) {
+				mock.EXPECT().Read(ctx, 1).Return(existing(), nil)
+				mock.EXPECT().ReadByName(ctx, "go-lang").Return(existing(), nil)
+				updated(ctx)
+				return u.Update(ctx, 1, &input.ProgrammingLangUpdate{Name: "Go", Aliases: []string{"golang", "go-lang"}})
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			u := &ProgrammingLangUseCase{
+				Repo: mock,
+			}
+
+			_, err := tt.mutate(context.Background(), u)
+			if reflect.TypeOf(errors.Cause(err)) != reflect.TypeOf(tt.wantErrType) {
+				t.Errorf("error = %v, want %T", err, tt.wantErrType)
+			}
+		})
+	}
+}
+
+func TestProgrammingLangUseCase_Outbox(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mock := mock_repository.NewMockProgrammingLangRepository(ctrl)
+	transactor := mock_repository.NewMockTransactor(ctrl)
+	outbox := mock_repository.NewMockOutboxRepository(ctrl)
+
+	lang := model.CreateProgrammingLangs(1)[0]
+
+	tests := []struct {
+		name      string
+		mutate    func(ctx context.Context, u *ProgrammingLangUseCase) error
+		outboxErr error
+		want      string
+		wantErr   bool
+	}{
+		{
+			name: "ProgrammingLangを生成した場合、LangCreatedをOutboxに記録すること",
+			mutate: func(ctx context.Context, u *ProgrammingLangUseCase) error {
+				mock.EXPECT().ReadByName(ctx, lang.Name).Return(nil, &model.NoSuchDataError{Name: lang.Name})
+				mock.EXPECT().ReadBySlug(ctx, lang.Slug).Return(nil, &model.NoSuchDataError{Name: lang.Slug})
+				mock.EXPECT().ReadByPreviousSlug(ctx, lang.Slug).Return(nil, &model.NoSuchDataError{Name: lang.Slug})
+				mock.EXPECT().Create(ctx, gomock.Any()).Return(lang, nil)
+				_, err := u.Create(ctx, &input.ProgrammingLangCreate{Name: lang.Name})
+				return err
+			},
+			want: model.DomainEventLangCreated,
+		},
+		{
+			name: "ProgrammingLangを更新した場合、LangUpdatedをOutboxに記録すること",
+			mutate: func(ctx context.Context, u *ProgrammingLangUseCase) error {
+				mock.EXPECT().Read(ctx, lang.ID).Return(model.CreateProgrammingLangs(1)[0], nil)
+				mock.EXPECT().Update(ctx, gomock.Any()).Return(lang, nil)
+				_, err := u.Update(ctx, lang.ID, &input.ProgrammingLangUpdate{Name: lang.Name})
+				return err
+			},
+			want: model.DomainEventLangUpdated,
+		},
+		{
+			name: "ProgrammingLangを削除した場合、LangDeletedをOutboxに記録すること",
+			mutate: func(ctx context.Context, u *ProgrammingLangUseCase) error {
+				mock.EXPECT().Read(ctx, lang.ID).Return(lang, nil)
+				mock.EXPECT().Delete(ctx, lang.ID).Return(nil)
+				return u.Delete(ctx, lang.ID)
+			},
+			want: model.DomainEventLangDeleted,
+		},
+		{
+			name: "Outboxへの記録に失敗した場合、エラーを返すこと",
+			mutate: func(ctx context.Context, u *ProgrammingLangUseCase) error {
+				mock.EXPECT().Read(ctx, lang.ID).Return(lang, nil)
+				mock.EXPECT().Delete(ctx, lang.ID).Return(nil)
+				return u.Delete(ctx, lang.ID)
+			},
+			outboxErr: errors.New(model.TestDBSomeErr),
+			want:      model.DomainEventLangDeleted,
+			wantErr:   true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			u := NewProgrammingLangUseCase(mock, nil, transactor, outbox, NewEventBroker()).(*ProgrammingLangUseCase)
+
+			// 変更とOutboxへの記録は、同じトランザクションの中で行うこと
+			inTx := false
+			transactor.EXPECT().Transaction(gomock.Any(), gomock.Any()).DoAndReturn(func(ctx context.Context, fn func(ctx context.Context) error) error {
+				inTx = true
+				defer func() { inTx = false }()
+				return fn(ctx)
+			})
+			outbox.EXPECT().Create(gomock.Any(), gomock.Any()).DoAndReturn(func(ctx context.Context, event *model.OutboxEvent) (*model.OutboxEvent, error) {
+				if !inTx {
+					t.Error("OutboxRepository.Create() was called outside the transaction")
+				}
+				if event.Name != tt.want || event.AggregateID != lang.ID {
+					t.Errorf("OutboxRepository.Create() = %+v, want name %v", event, tt.want)
+				}
+				return event, tt.outboxErr
+			})
+
+			err := tt.mutate(context.Background(), u)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("error = %v, wantErr %v", err, tt.wantErr)
+			}
+		})
+	}
+}
+
+func TestProgrammingLangUseCase_Watch(t *testing.T) {
+	lang := model.CreateProgrammingLangs(1)[0]
+
+	tests := []struct {
+		name    string
+		broker  *EventBroker
+		wantErr bool
+	}{
+		{
+			name:   "Brokerに配信されたイベントを受信できること",
+			broker: NewEventBroker(),
+		},
+		{
+			name:    "Brokerが設定されていない場合、エラーを返すこと",
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			u := &ProgrammingLangUseCase{Broker: tt.broker}
+
+			ctx, cancel := context.WithCancel(context.Background())
+			defer cancel()
+
+			events, err := u.Watch(ctx)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("ProgrammingLangUseCase.Watch() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if tt.wantErr {
+				return
+			}
+
+			tt.broker.Publish(&model.ProgrammingLangEvent{ID: 1, Type: model.EventTypeUpdated, Lang: lang})
+
+			event := <-events
+			if event.Type != model.EventTypeUpdated || event.Lang.ID != lang.ID {
+				t.Errorf("ProgrammingLangUseCase.Watch() = %+v, want updated event of lang %v", event, lang.ID)
+			}
+		})
+	}
+}
+
+func TestProgrammingLangUseCase_WatchFrom(t *testing.T) {
+	broker := NewEventBroker()
+	for id := 1; id <= 3; id++ {
+		broker.Publish(&model.ProgrammingLangEvent{ID: id, Type: model.EventTypeUpdated})
+	}
+
+	tests := []struct {
+		name         string
+		lastEventID  int
+		wantFirst    int
+		wantComplete bool
+	}{
+		{
+			name:         "保持しているイベントを指定した場合、その後の変更から受信すること",
+			lastEventID:  2,
+			wantFirst:    3,
+			wantComplete: true,
+		},
+		{
+			name:         "保持していないイベントを指定した場合、falseを返すこと",
+			lastEventID:  10,
+			wantFirst:    11,
+			wantComplete: false,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			u := &ProgrammingLangUseCase{Broker: broker}
+
+			ctx, cancel := context.WithCancel(context.Background())
+			defer cancel()
+
+			events, complete, err := u.WatchFrom(ctx, tt.lastEventID)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if complete != tt.wantComplete {
+				t.Errorf("ProgrammingLangUseCase.WatchFrom() complete = %v, want %v", complete, tt.wantComplete)
+			}
+
+			broker.Publish(&model.ProgrammingLangEvent{ID: 11, Type: model.EventTypeUpdated})
+			if event := <-events; event.ID != tt.wantFirst {
+				t.Errorf("ProgrammingLangUseCase.WatchFrom() first id = %v, want %v", event.ID, tt.wantFirst)
+			}
+		})
+	}
+}
@@ -0,0 +1,47 @@
+package usecase
+
+import (
+	"context"
+	"strings"
+	"unicode/utf8"
+
+	"github.com/SekiguchiKai/clean-architecture-with-go/server/domain/model"
+	"github.com/SekiguchiKai/clean-architecture-with-go/server/domain/repository"
+	"github.com/SekiguchiKai/clean-architecture-with-go/server/domain/service"
+	"github.com/SekiguchiKai/clean-architecture-with-go/server/usecase/input"
+)
+
+// SearchUseCase は、ProgrammingLangの全文検索のUseCase。
+type SearchUseCase struct {
+	Repo repository.LangSearchRepository
+}
+
+// NewSearchUseCase は、SearchUseCaseを生成し、返す。
+func NewSearchUseCase(repo repository.LangSearchRepository) input.SearchInputPort {
+	return &SearchUseCase{
+		Repo: repo,
+	}
+}
+
+// Search は、queryの語をNameもしくはFeatureに含むProgrammingLangを、スコアの高い順に最大limit件返す。
+func (u *SearchUseCase) Search(ctx context.Context, query string, limit int) ([]*model.SearchResult, error) {
+	if strings.TrimSpace(query) == "" {
+		return nil, &model.RequiredError{
+			Property: model.PropertyQuery,
+		}
+	}
+
+	if utf8.RuneCountInString(query) > service.MaxSearchQueryLength {
+		return nil, &model.InvalidPropertyError{
+			Property: model.PropertyQuery,
+			Message:  model.QueryIsTooLong,
+		}
+	}
+
+	return u.Repo.Search(ctx, query, limit)
+}
+
+// Reindex は、全文検索の索引を構築し直す。
+func (u *SearchUseCase) Reindex(ctx context.Context) (*model.ReindexReport, error) {
+	return u.Repo.Reindex(ctx)
+}
@@ -0,0 +1,69 @@
+package usecase
+
+import (
+	"context"
+	"reflect"
+	"strings"
+	"testing"
+
+	"github.com/SekiguchiKai/clean-architecture-with-go/server/domain/model"
+	"github.com/SekiguchiKai/clean-architecture-with-go/server/infra/dao/mock"
+	"github.com/golang/mock/gomock"
+	"github.com/pkg/errors"
+)
+
+func TestSearchUseCase_Search(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	repo := mock_repository.NewMockLangSearchRepository(ctrl)
+
+	results := []*model.SearchResult{
+		{ID: 1, Name: "Go", Slug: "go", Score: 1.5, Snippet: "<mark>garbage</mark> collection"},
+	}
+
+	tests := []struct {
+		name    string
+		query   string
+		mock    func(ctx context.Context)
+		want    []*model.SearchResult
+		wantErr error
+	}{
+		{
+			name:  "検索語を指定した場合、検索結果を返すこと",
+			query: "garbage",
+			mock: func(ctx context.Context) {
+				repo.EXPECT().Search(ctx, "garbage", 10).Return(results, nil)
+			},
+			want: results,
+		},
+		{
+			name:    "検索語が空白のみの場合、RequiredErrorを返すこと",
+			query:   " ",
+			mock:    func(ctx context.Context) {},
+			wantErr: &model.RequiredError{Property: model.PropertyQuery},
+		},
+		{
+			name:    "検索語が長すぎる場合、InvalidPropertyErrorを返すこと",
+			query:   strings.Repeat("a", 257),
+			mock:    func(ctx context.Context) {},
+			wantErr: &model.InvalidPropertyError{Property: model.PropertyQuery, Message: model.QueryIsTooLong},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			u := NewSearchUseCase(repo)
+
+			ctx := context.Background()
+			tt.mock(ctx)
+
+			got, err := u.Search(ctx, tt.query, 10)
+			if !reflect.DeepEqual(errors.Cause(err), tt.wantErr) {
+				t.Errorf("SearchUseCase.Search() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("SearchUseCase.Search() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
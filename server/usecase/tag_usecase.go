@@ -0,0 +1,166 @@
+package usecase
+
+import (
+	"context"
+	"time"
+
+	"github.com/SekiguchiKai/clean-architecture-with-go/server/domain/model"
+	"github.com/SekiguchiKai/clean-architecture-with-go/server/domain/repository"
+	"github.com/SekiguchiKai/clean-architecture-with-go/server/domain/service"
+	"github.com/SekiguchiKai/clean-architecture-with-go/server/usecase/input"
+	"github.com/pkg/errors"
+)
+
+// TagUseCase は、TagのUseCase。
+type TagUseCase struct {
+	LangRepo repository.ProgrammingLangRepository
+	Repo     repository.TagRepository
+}
+
+// NewTagUseCase は、TagUseCaseを生成し、返す。
+func NewTagUseCase(langRepo repository.ProgrammingLangRepository, repo repository.TagRepository) input.TagInputPort {
+	return &TagUseCase{
+		LangRepo: langRepo,
+		Repo:     repo,
+	}
+}
+
+// List は、Tagの一覧を名前の昇順で返す。
+func (u *TagUseCase) List(ctx context.Context) ([]*model.Tag, error) {
+	return u.Repo.List(ctx)
+}
+
+// Get は、IDで指定したTagを1件返す。
+func (u *TagUseCase) Get(ctx context.Context, id int) (*model.Tag, error) {
+	return u.Repo.Read(ctx, id)
+}
+
+// Create は、Tagを生成する。
+func (u *TagUseCase) Create(ctx context.Context, name string) (*model.Tag, error) {
+	tag, err := service.NewTag(name)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := u.checkNameUnused(ctx, 0, tag.Name); err != nil {
+		return nil, err
+	}
+
+	tag.CreatedAt = time.Now().UTC()
+	tag.UpdatedAt = time.Now().UTC()
+
+	return u.Repo.Create(ctx, tag)
+}
+
+// Rename は、Tagの名前を変更する。
+func (u *TagUseCase) Rename(ctx context.Context, id int, name string) (*model.Tag, error) {
+	renamed, err := service.NewTag(name)
+	if err != nil {
+		return nil, err
+	}
+
+	tag, err := u.Repo.Read(ctx, id)
+	if err != nil {
+		return nil, errors.WithStack(err)
+	}
+
+	if err := u.checkNameUnused(ctx, id, renamed.Name); err != nil {
+		return nil, err
+	}
+
+	tag.Name = renamed.Name
+	tag.UpdatedAt = time.Now().UTC()
+
+	return u.Repo.Update(ctx, tag)
+}
+
+// Delete は、Tagを削除する。
+func (u *TagUseCase) Delete(ctx context.Context, id int) error {
+	if _, err := u.Repo.Read(ctx, id); err != nil {
+		return errors.WithStack(err)
+	}
+
+	return u.Repo.Delete(ctx, id)
+}
+
+// Merge は、fromIDのTagをintoIDのTagに統合し、統合先のTagを返す。
+// fromIDのTagが付いていたProgrammingLangにはintoIDのTagが付き、fromIDのTagは削除される。
+func (u *TagUseCase) Merge(ctx context.Context, fromID, intoID int) (*model.Tag, error) {
+	if err := service.ValidateTagMerge(fromID, intoID); err != nil {
+		return nil, err
+	}
+
+	if _, err := u.Repo.Read(ctx, fromID); err != nil {
+		return nil, errors.WithStack(err)
+	}
+
+	into, err := u.Repo.Read(ctx, intoID)
+	if err != nil {
+		return nil, errors.WithStack(err)
+	}
+
+	if err := u.Repo.Merge(ctx, fromID, intoID); err != nil {
+		return nil, errors.WithStack(err)
+	}
+
+	return into, nil
+}
+
+// ListByLang は、ProgrammingLangに付いているTagの一覧を名前の昇順で返す。
+func (u *TagUseCase) ListByLang(ctx context.Context, langID int) ([]*model.Tag, error) {
+	if _, err := u.LangRepo.Read(ctx, langID); err != nil {
+		return nil, errors.WithStack(err)
+	}
+
+	return u.Repo.ListByLang(ctx, langID)
+}
+
+// Attach は、ProgrammingLangにTagを付ける。
+func (u *TagUseCase) Attach(ctx context.Context, langID, tagID int) error {
+	if err := u.checkLangAndTag(ctx, langID, tagID); err != nil {
+		return err
+	}
+
+	return u.Repo.Attach(ctx, langID, tagID)
+}
+
+// Detach は、ProgrammingLangからTagを外す。
+func (u *TagUseCase) Detach(ctx context.Context, langID, tagID int) error {
+	if err := u.checkLangAndTag(ctx, langID, tagID); err != nil {
+		return err
+	}
+
+	return u.Repo.Detach(ctx, langID, tagID)
+}
+
+// checkNameUnused は、id以外のTagが名前を使用していないことを確認する。
+func (u *TagUseCase) checkNameUnused(ctx context.Context, id int, name string) error {
+	tag, err := u.Repo.ReadByName(ctx, name)
+	if tag != nil {
+		if tag.ID == id {
+			return nil
+		}
+		return &model.AlreadyExistError{
+			ID:        tag.ID,
+			Name:      tag.Name,
+			ModelName: model.ModelNameTag,
+		}
+	}
+
+	if _, ok := errors.Cause(err).(*model.NoSuchDataError); !ok {
+		return errors.WithStack(err)
+	}
+	return nil
+}
+
+// checkLangAndTag は、ProgrammingLangとTagが存在することを確認する。
+func (u *TagUseCase) checkLangAndTag(ctx context.Context, langID, tagID int) error {
+	if _, err := u.LangRepo.Read(ctx, langID); err != nil {
+		return errors.WithStack(err)
+	}
+
+	if _, err := u.Repo.Read(ctx, tagID); err != nil {
+		return errors.WithStack(err)
+	}
+	return nil
+}
@@ -0,0 +1,218 @@
+package usecase
+
+import (
+	"context"
+	"reflect"
+	"testing"
+	"time"
+
+	"github.com/SekiguchiKai/clean-architecture-with-go/server/domain/model"
+	"github.com/SekiguchiKai/clean-architecture-with-go/server/infra/dao/mock"
+	"github.com/golang/mock/gomock"
+	"github.com/pkg/errors"
+)
+
+func TestTagUseCase_Rename(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	repo := mock_repository.NewMockTagRepository(ctrl)
+
+	newTag := func(id int, name string) *model.Tag {
+		return &model.Tag{
+			ID:        id,
+			Name:      name,
+			CreatedAt: model.GetTestTime(time.September, 1),
+			UpdatedAt: model.GetTestTime(time.September, 2),
+		}
+	}
+	noDataErr := &model.NoSuchDataError{Name: "fp", ModelName: model.ModelNameTag}
+
+	tests := []struct {
+		name        string
+		arg         string
+		mock        func(ctx context.Context)
+		want        string
+		wantErrType error
+	}{
+		{
+			name: "名前が使用されていない場合、正規化した名前に変更すること",
+			arg:  " FP ",
+			mock: func(ctx context.Context) {
+				repo.EXPECT().Read(ctx, 1).Return(newTag(1, model.TestTagName), nil)
+				repo.EXPECT().ReadByName(ctx, "fp").Return(nil, noDataErr)
+				repo.EXPECT().Update(ctx, gomock.Any()).DoAndReturn(func(ctx context.Context, tag *model.Tag) (*model.Tag, error) {
+					return tag, nil
+				})
+			},
+			want: "fp",
+		},
+		{
+			name: "大文字小文字のみを変更する場合、自身の名前と重複しても変更すること",
+			arg:  "Functional",
+			mock: func(ctx context.Context) {
+				repo.EXPECT().Read(ctx, 1).Return(newTag(1, model.TestTagName), nil)
+				repo.EXPECT().ReadByName(ctx, model.TestTagName).Return(newTag(1, model.TestTagName), nil)
+				repo.EXPECT().Update(ctx, gomock.Any()).DoAndReturn(func(ctx context.Context, tag *model.Tag) (*model.Tag, error) {
+					return tag, nil
+				})
+			},
+			want: model.TestTagName,
+		},
+		{
+			name: "他のTagが名前を使用している場合、AlreadyExistErrorを返すこと",
+			arg:  "fp",
+			mock: func(ctx context.Context) {
+				repo.EXPECT().Read(ctx, 1).Return(newTag(1, model.TestTagName), nil)
+				repo.EXPECT().ReadByName(ctx, "fp").Return(newTag(2, "fp"), nil)
+			},
+			wantErrType: &model.AlreadyExistError{},
+		},
+		{
+			name:        "名前の形式が不正な場合、InvalidPropertyErrorを返すこと",
+			arg:         "pure functional",
+			mock:        func(ctx context.Context) {},
+			wantErrType: &model.InvalidPropertyError{},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			u := &TagUseCase{
+				Repo: repo,
+			}
+			ctx := context.Background()
+			tt.mock(ctx)
+
+			got, err := u.Rename(ctx, 1, tt.arg)
+			if reflect.TypeOf(err) != reflect.TypeOf(tt.wantErrType) {
+				t.Errorf("TagUseCase.Rename() error = %v, want %T", err, tt.wantErrType)
+				return
+			}
+			if err == nil && got.Name != tt.want {
+				t.Errorf("TagUseCase.Rename() = %v, want %v", got.Name, tt.want)
+			}
+		})
+	}
+}
+
+func TestTagUseCase_Merge(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	repo := mock_repository.NewMockTagRepository(ctrl)
+
+	from := &model.Tag{ID: 1, Name: "fp"}
+	into := &model.Tag{ID: 2, Name: model.TestTagName}
+
+	tests := []struct {
+		name        string
+		fromID      int
+		intoID      int
+		mock        func(ctx context.Context)
+		want        *model.Tag
+		wantErrType error
+	}{
+		{
+			name:   "両方のTagが存在する場合、統合して統合先のTagを返すこと",
+			fromID: 1,
+			intoID: 2,
+			mock: func(ctx context.Context) {
+				repo.EXPECT().Read(ctx, 1).Return(from, nil)
+				repo.EXPECT().Read(ctx, 2).Return(into, nil)
+				repo.EXPECT().Merge(ctx, 1, 2).Return(nil)
+			},
+			want: into,
+		},
+		{
+			name:   "統合先のTagが存在しない場合、統合せずにNoSuchDataErrorを返すこと",
+			fromID: 1,
+			intoID: 2,
+			mock: func(ctx context.Context) {
+				repo.EXPECT().Read(ctx, 1).Return(from, nil)
+				repo.EXPECT().Read(ctx, 2).Return(nil, &model.NoSuchDataError{ID: 2, ModelName: model.ModelNameTag})
+			},
+			wantErrType: &model.NoSuchDataError{},
+		},
+		{
+			name:        "自身に統合する場合、InvalidPropertyErrorを返すこと",
+			fromID:      1,
+			intoID:      1,
+			mock:        func(ctx context.Context) {},
+			wantErrType: &model.InvalidPropertyError{},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			u := &TagUseCase{
+				Repo: repo,
+			}
+			ctx := context.Background()
+			tt.mock(ctx)
+
+			got, err := u.Merge(ctx, tt.fromID, tt.intoID)
+			if reflect.TypeOf(errors.Cause(err)) != reflect.TypeOf(tt.wantErrType) {
+				t.Errorf("TagUseCase.Merge() error = %v, want %T", err, tt.wantErrType)
+				return
+			}
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("TagUseCase.Merge() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestTagUseCase_Attach(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	langRepo := mock_repository.NewMockProgrammingLangRepository(ctrl)
+	repo := mock_repository.NewMockTagRepository(ctrl)
+
+	lang := model.CreateProgrammingLangs(1)[0]
+	tag := &model.Tag{ID: 2, Name: model.TestTagName}
+
+	tests := []struct {
+		name        string
+		mock        func(ctx context.Context)
+		wantErrType error
+	}{
+		{
+			name: "ProgrammingLangとTagが存在する場合、Tagを付けること",
+			mock: func(ctx context.Context) {
+				langRepo.EXPECT().Read(ctx, 1).Return(lang, nil)
+				repo.EXPECT().Read(ctx, 2).Return(tag, nil)
+				repo.EXPECT().Attach(ctx, 1, 2).Return(nil)
+			},
+		},
+		{
+			name: "ProgrammingLangが存在しない場合、NoSuchDataErrorを返すこと",
+			mock: func(ctx context.Context) {
+				langRepo.EXPECT().Read(ctx, 1).Return(nil, &model.NoSuchDataError{ID: 1, ModelName: model.ModelNameProgrammingLang})
+			},
+			wantErrType: &model.NoSuchDataError{},
+		},
+		{
+			name: "Tagが存在しない場合、NoSuchDataErrorを返すこと",
+			mock: func(ctx context.Context) {
+				langRepo.EXPECT().Read(ctx, 1).Return(lang, nil)
+				repo.EXPECT().Read(ctx, 2).Return(nil, &model.NoSuchDataError{ID: 2, ModelName: model.ModelNameTag})
+			},
+			wantErrType: &model.NoSuchDataError{},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			u := &TagUseCase{
+				LangRepo: langRepo,
+				Repo:     repo,
+			}
+			ctx := context.Background()
+			tt.mock(ctx)
+
+			err := u.Attach(ctx, 1, 2)
+			if reflect.TypeOf(errors.Cause(err)) != reflect.TypeOf(tt.wantErrType) {
+				t.Errorf("TagUseCase.Attach() error = %v, want %T", err, tt.wantErrType)
+			}
+		})
+	}
+}
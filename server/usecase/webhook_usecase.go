@@ -0,0 +1,307 @@
+package usecase
+
+import (
+	"context"
+	"encoding/json"
+	"time"
+
+	"github.com/SekiguchiKai/clean-architecture-with-go/server/domain/model"
+	"github.com/SekiguchiKai/clean-architecture-with-go/server/domain/repository"
+	"github.com/SekiguchiKai/clean-architecture-with-go/server/domain/service"
+	"github.com/SekiguchiKai/clean-architecture-with-go/server/usecase/input"
+	"github.com/SekiguchiKai/clean-architecture-with-go/server/usecase/output"
+	"github.com/pkg/errors"
+)
+
+// WebhookUseCase は、WebhookのUseCase。
+type WebhookUseCase struct {
+	Repo         repository.WebhookRepository
+	DeliveryRepo repository.WebhookDeliveryRepository
+	Sender       repository.WebhookSender
+}
+
+// NewWebhookUseCase は、WebhookUseCaseを生成し、返す。
+func NewWebhookUseCase(repo repository.WebhookRepository, deliveryRepo repository.WebhookDeliveryRepository, sender repository.WebhookSender) input.WebhookInputPort {
+	return &WebhookUseCase{
+		Repo:         repo,
+		DeliveryRepo: deliveryRepo,
+		Sender:       sender,
+	}
+}
+
+// List は、Webhookの一覧を返す。Secretは返さない。
+func (u *WebhookUseCase) List(ctx context.Context) ([]*model.Webhook, error) {
+	webhooks, err := u.Repo.List(ctx)
+	if err != nil {
+		return nil, errors.WithStack(err)
+	}
+
+	for i, w := range webhooks {
+		webhooks[i] = withoutSecret(w)
+	}
+
+	return webhooks, nil
+}
+
+// Get は、IDで指定したWebhookを1件返す。Secretは返さない。
+func (u *WebhookUseCase) Get(ctx context.Context, id int) (*model.Webhook, error) {
+	w, err := u.Repo.Read(ctx, id)
+	if err != nil {
+		return nil, errors.WithStack(err)
+	}
+
+	return withoutSecret(w), nil
+}
+
+// Create は、Webhookを生成する。Secretを指定していない場合は生成する。
+// Secretを返すのは、生成した時のみ。
+func (u *WebhookUseCase) Create(ctx context.Context, param *model.Webhook) (*model.Webhook, error) {
+	w := &model.Webhook{
+		URL:        param.URL,
+		Secret:     param.Secret,
+		EventTypes: param.EventTypes,
+		Disabled:   param.Disabled,
+	}
+
+	if w.Secret == "" {
+		secret, err := service.NewWebhookSecret()
+		if err != nil {
+			return nil, err
+		}
+		w.Secret = secret
+	}
+
+	if err := service.ValidateWebhook(w); err != nil {
+		return nil, err
+	}
+
+	w.CreatedAt = time.Now().UTC()
+	w.UpdatedAt = time.Now().UTC()
+
+	return u.Repo.Create(ctx, w)
+}
+
+// Update は、Webhookを更新する。Secretを指定していない場合は、変更しない。Secretは返さない。
+func (u *WebhookUseCase) Update(ctx context.Context, id int, param *model.Webhook) (*model.Webhook, error) {
+	w, err := u.Repo.Read(ctx, id)
+	if err != nil {
+		return nil, errors.WithStack(err)
+	}
+
+	w.URL = param.URL
+	w.EventTypes = param.EventTypes
+	w.Disabled = param.Disabled
+	if param.Secret != "" {
+		w.Secret = param.Secret
+	}
+
+	if err := service.ValidateWebhook(w); err != nil {
+		return nil, err
+	}
+
+	w.UpdatedAt = time.Now().UTC()
+
+	updated, err := u.Repo.Update(ctx, w)
+	if err != nil {
+		return nil, errors.WithStack(err)
+	}
+
+	return withoutSecret(updated), nil
+}
+
+// Delete は、Webhookを、その通知と送信の記録とともに削除する。
+func (u *WebhookUseCase) Delete(ctx context.Context, id int) error {
+	if _, err := u.Repo.Read(ctx, id); err != nil {
+		return errors.WithStack(err)
+	}
+
+	return u.Repo.Delete(ctx, id)
+}
+
+// ListDeliveries は、指定したWebhookの通知の一覧を新しい順に最大limit件返す。statusが空の場合は、全ての状態のものを返す。
+func (u *WebhookUseCase) ListDeliveries(ctx context.Context, webhookID int, status model.DeliveryStatus, limit int) ([]*model.WebhookDelivery, error) {
+	if err := service.ValidateDeliveryStatus(status); err != nil {
+		return nil, err
+	}
+
+	if _, err := u.Repo.Read(ctx, webhookID); err != nil {
+		return nil, errors.WithStack(err)
+	}
+
+	return u.DeliveryRepo.ListByWebhook(ctx, webhookID, status, limit)
+}
+
+// ListDeadLetters は、全てのWebhookのうち、再送の上限に達した通知の一覧を新しい順に最大limit件返す。
+func (u *WebhookUseCase) ListDeadLetters(ctx context.Context, limit int) ([]*model.WebhookDelivery, error) {
+	return u.DeliveryRepo.ListByStatus(ctx, model.DeliveryStatusDead, limit)
+}
+
+// GetDelivery は、指定したWebhookの通知を、送信の記録とともに1件返す。
+func (u *WebhookUseCase) GetDelivery(ctx context.Context, webhookID, deliveryID int) (*model.WebhookDelivery, error) {
+	d, err := u.readDelivery(ctx, webhookID, deliveryID)
+	if err != nil {
+		return nil, err
+	}
+
+	d.Log, err = u.DeliveryRepo.ListAttempts(ctx, d.ID)
+	if err != nil {
+		return nil, errors.WithStack(err)
+	}
+
+	return d, nil
+}
+
+// Redeliver は、指定したWebhookの通知を、状態に関わらず直ちに送信し直すように設定する。
+// 再送の回数は、最初から数え直す。
+func (u *WebhookUseCase) Redeliver(ctx context.Context, webhookID, deliveryID int) (*model.WebhookDelivery, error) {
+	d, err := u.readDelivery(ctx, webhookID, deliveryID)
+	if err != nil {
+		return nil, err
+	}
+
+	now := time.Now().UTC()
+	d.Status = model.DeliveryStatusPending
+	d.Attempts = 0
+	d.NextAttemptAt = now
+	d.UpdatedAt = now
+
+	return u.DeliveryRepo.Update(ctx, d)
+}
+
+// Enqueue は、変更を通知する対象としている全てのWebhookに対して、未送信の通知を生成する。
+func (u *WebhookUseCase) Enqueue(ctx context.Context, event *model.ProgrammingLangEvent) error {
+	webhooks, err := u.Repo.List(ctx)
+	if err != nil {
+		return errors.WithStack(err)
+	}
+
+	payload, err := json.Marshal(output.NewProgrammingLangEventOutput(event))
+	if err != nil {
+		return errors.WithStack(err)
+	}
+
+	now := time.Now().UTC()
+	for _, w := range webhooks {
+		if !service.IsWebhookSubscribed(w, event.Type) {
+			continue
+		}
+
+		d := &model.WebhookDelivery{
+			WebhookID:     w.ID,
+			EventType:     event.Type,
+			Payload:       payload,
+			Status:        model.DeliveryStatusPending,
+			NextAttemptAt: now,
+			CreatedAt:     now,
+			UpdatedAt:     now,
+		}
+		if _, err := u.DeliveryRepo.Create(ctx, d); err != nil {
+			return errors.WithStack(err)
+		}
+	}
+
+	return nil
+}
+
+// DeliverDue は、送信予定の日時を過ぎた通知を最大limit件送信し、送信した件数を返す。
+// 他のプロセスが送信する権利を先に得た通知は、送信しない。
+func (u *WebhookUseCase) DeliverDue(ctx context.Context, limit int) (int, error) {
+	if u.Sender == nil {
+		return 0, errors.New("webhook sender is not configured")
+	}
+
+	deliveries, err := u.DeliveryRepo.ListDue(ctx, time.Now().UTC(), limit)
+	if err != nil {
+		return 0, errors.WithStack(err)
+	}
+
+	sent := 0
+	for _, d := range deliveries {
+		claimed, err := u.DeliveryRepo.Claim(ctx, d, time.Now().UTC().Add(service.WebhookLease))
+		if err != nil {
+			return sent, errors.WithStack(err)
+		}
+		if !claimed {
+			continue
+		}
+
+		if err := u.deliver(ctx, d); err != nil {
+			return sent, err
+		}
+		sent++
+	}
+
+	return sent, nil
+}
+
+// PurgeDeliveries は、送信に成功したか、送信を諦めた通知のうち、retentionより前に終えたものを送信の記録とともに削除し、削除した件数を返す。
+func (u *WebhookUseCase) PurgeDeliveries(ctx context.Context, retention time.Duration) (int, error) {
+	if retention <= 0 {
+		return 0, &model.InvalidPropertyError{
+			Property: model.PropertyRetention,
+			Message:  model.RetentionShouldBePositive,
+		}
+	}
+
+	purged, err := u.DeliveryRepo.DeleteFinishedBefore(ctx, time.Now().UTC().Add(-retention))
+	if err != nil {
+		return 0, errors.WithStack(err)
+	}
+
+	return purged, nil
+}
+
+// deliver は、通知を1回送信し、その結果を記録する。
+func (u *WebhookUseCase) deliver(ctx context.Context, d *model.WebhookDelivery) error {
+	w, err := u.Repo.Read(ctx, d.WebhookID)
+	if err != nil {
+		return errors.WithStack(err)
+	}
+
+	start := time.Now().UTC()
+	statusCode, sendErr := u.Sender.Send(ctx, service.NewWebhookRequest(w, d, start))
+	end := time.Now().UTC()
+
+	attempt := &model.DeliveryAttempt{
+		DeliveryID:  d.ID,
+		Attempt:     d.Attempts + 1,
+		StatusCode:  statusCode,
+		Error:       service.DeliveryErrorMessage(statusCode, sendErr),
+		DurationMS:  int(end.Sub(start) / time.Millisecond),
+		AttemptedAt: start,
+	}
+	if _, err := u.DeliveryRepo.CreateAttempt(ctx, attempt); err != nil {
+		return errors.WithStack(err)
+	}
+
+	service.RecordDeliveryAttempt(d, statusCode, sendErr, end)
+	if _, err := u.DeliveryRepo.Update(ctx, d); err != nil {
+		return errors.WithStack(err)
+	}
+
+	return nil
+}
+
+// readDelivery は、指定したWebhookの通知を1件返す。他のWebhookの通知の場合は、存在しないものとして扱う。
+func (u *WebhookUseCase) readDelivery(ctx context.Context, webhookID, deliveryID int) (*model.WebhookDelivery, error) {
+	d, err := u.DeliveryRepo.Read(ctx, deliveryID)
+	if err != nil {
+		return nil, errors.WithStack(err)
+	}
+
+	if d.WebhookID != webhookID {
+		return nil, &model.NoSuchDataError{
+			ID:        deliveryID,
+			ModelName: model.ModelNameWebhookDelivery,
+		}
+	}
+
+	return d, nil
+}
+
+// withoutSecret は、Secretを除いたWebhookの複製を返す。
+func withoutSecret(w *model.Webhook) *model.Webhook {
+	cp := *w
+	cp.Secret = ""
+	return &cp
+}
@@ -0,0 +1,372 @@
+package usecase
+
+import (
+	"context"
+	"errors"
+	"reflect"
+	"testing"
+	"time"
+
+	"github.com/SekiguchiKai/clean-architecture-with-go/server/domain/model"
+	"github.com/SekiguchiKai/clean-architecture-with-go/server/domain/service"
+	"github.com/SekiguchiKai/clean-architecture-with-go/server/infra/dao/mock"
+	"github.com/golang/mock/gomock"
+)
+
+// testWebhookSecret は、テスト用のWebhookのSecret。
+const testWebhookSecret = "0123456789abcdef"
+
+func TestWebhookUseCase_Create(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	repo := mock_repository.NewMockWebhookRepository(ctrl)
+
+	tests := []struct {
+		name        string
+		arg         *model.Webhook
+		mock        func(ctx context.Context)
+		wantSecret  func(secret string) bool
+		wantErrType error
+	}{
+		{
+			name: "Secretを指定した場合、指定したSecretで生成すること",
+			arg:  &model.Webhook{URL: "https://example.com/hooks", Secret: testWebhookSecret},
+			mock: func(ctx context.Context) {
+				repo.EXPECT().Create(ctx, gomock.Any()).DoAndReturn(func(ctx context.Context, w *model.Webhook) (*model.Webhook, error) {
+					return w, nil
+				})
+			},
+			wantSecret: func(secret string) bool { return secret == testWebhookSecret },
+		},
+		{
+			name: "Secretを指定していない場合、Secretを生成して返すこと",
+			arg:  &model.Webhook{URL: "https://example.com/hooks"},
+			mock: func(ctx context.Context) {
+				repo.EXPECT().Create(ctx, gomock.Any()).DoAndReturn(func(ctx context.Context, w *model.Webhook) (*model.Webhook, error) {
+					return w, nil
+				})
+			},
+			wantSecret: func(secret string) bool { return len(secret) == 2*service.WebhookSecretBytes },
+		},
+		{
+			name:        "URLが不正な場合、InvalidPropertyErrorを返すこと",
+			arg:         &model.Webhook{URL: "ftp://example.com/hooks"},
+			mock:        func(ctx context.Context) {},
+			wantErrType: &model.InvalidPropertyError{},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			u := &WebhookUseCase{
+				Repo: repo,
+			}
+			ctx := context.Background()
+			tt.mock(ctx)
+
+			got, err := u.Create(ctx, tt.arg)
+			if reflect.TypeOf(err) != reflect.TypeOf(tt.wantErrType) {
+				t.Errorf("WebhookUseCase.Create() error = %v, want %T", err, tt.wantErrType)
+				return
+			}
+			if err == nil && !tt.wantSecret(got.Secret) {
+				t.Errorf("WebhookUseCase.Create() Secret = %v", got.Secret)
+			}
+		})
+	}
+}
+
+func TestWebhookUseCase_Update(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	repo := mock_repository.NewMockWebhookRepository(ctrl)
+	ctx := context.Background()
+
+	stored := &model.Webhook{ID: 1, URL: "https://example.com/hooks", Secret: testWebhookSecret}
+	repo.EXPECT().Read(ctx, 1).Return(stored, nil)
+	repo.EXPECT().Update(ctx, gomock.Any()).DoAndReturn(func(ctx context.Context, w *model.Webhook) (*model.Webhook, error) {
+		if w.Secret != testWebhookSecret {
+			t.Errorf("WebhookRepository.Update() Secret = %v, want %v", w.Secret, testWebhookSecret)
+		}
+		return w, nil
+	})
+
+	u := &WebhookUseCase{
+		Repo: repo,
+	}
+
+	param := &model.Webhook{URL: "https://example.com/v2/hooks", EventTypes: []model.EventType{model.EventTypeDeleted}, Disabled: true}
+	got, err := u.Update(ctx, 1, param)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	want := &model.Webhook{ID: 1, URL: param.URL, EventTypes: param.EventTypes, Disabled: true, UpdatedAt: got.UpdatedAt}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("WebhookUseCase.Update() = %v, want %v", got, want)
+	}
+}
+
+func TestWebhookUseCase_GetDelivery(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	deliveryRepo := mock_repository.NewMockWebhookDeliveryRepository(ctrl)
+
+	log := []*model.DeliveryAttempt{{ID: 1, DeliveryID: 2, Attempt: 1, StatusCode: 500}}
+
+	tests := []struct {
+		name        string
+		webhookID   int
+		mock        func(ctx context.Context)
+		want        *model.WebhookDelivery
+		wantErrType error
+	}{
+		{
+			name:      "指定したWebhookの通知の場合、送信の記録とともに返すこと",
+			webhookID: 1,
+			mock: func(ctx context.Context) {
+				deliveryRepo.EXPECT().Read(ctx, 2).Return(&model.WebhookDelivery{ID: 2, WebhookID: 1}, nil)
+				deliveryRepo.EXPECT().ListAttempts(ctx, 2).Return(log, nil)
+			},
+			want: &model.WebhookDelivery{ID: 2, WebhookID: 1, Log: log},
+		},
+		{
+			name:      "他のWebhookの通知の場合、NoSuchDataErrorを返すこと",
+			webhookID: 3,
+			mock: func(ctx context.Context) {
+				deliveryRepo.EXPECT().Read(ctx, 2).Return(&model.WebhookDelivery{ID: 2, WebhookID: 1}, nil)
+			},
+			wantErrType: &model.NoSuchDataError{},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			u := &WebhookUseCase{
+				DeliveryRepo: deliveryRepo,
+			}
+			ctx := context.Background()
+			tt.mock(ctx)
+
+			got, err := u.GetDelivery(ctx, tt.webhookID, 2)
+			if reflect.TypeOf(err) != reflect.TypeOf(tt.wantErrType) {
+				t.Errorf("WebhookUseCase.GetDelivery() error = %v, want %T", err, tt.wantErrType)
+				return
+			}
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("WebhookUseCase.GetDelivery() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestWebhookUseCase_Redeliver(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	deliveryRepo := mock_repository.NewMockWebhookDeliveryRepository(ctrl)
+	ctx := context.Background()
+
+	dead := &model.WebhookDelivery{ID: 2, WebhookID: 1, Status: model.DeliveryStatusDead, Attempts: service.MaxWebhookAttempts}
+	deliveryRepo.EXPECT().Read(ctx, 2).Return(dead, nil)
+	deliveryRepo.EXPECT().Update(ctx, gomock.Any()).DoAndReturn(func(ctx context.Context, d *model.WebhookDelivery) (*model.WebhookDelivery, error) {
+		return d, nil
+	})
+
+	u := &WebhookUseCase{
+		DeliveryRepo: deliveryRepo,
+	}
+
+	got, err := u.Redeliver(ctx, 1, 2)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got.Status != model.DeliveryStatusPending || got.Attempts != 0 || got.NextAttemptAt.IsZero() {
+		t.Errorf("WebhookUseCase.Redeliver() = %v, want pending with no attempts", got)
+	}
+}
+
+func TestWebhookUseCase_Enqueue(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	repo := mock_repository.NewMockWebhookRepository(ctrl)
+	deliveryRepo := mock_repository.NewMockWebhookDeliveryRepository(ctrl)
+	ctx := context.Background()
+
+	webhooks := []*model.Webhook{
+		{ID: 1},
+		{ID: 2, EventTypes: []model.EventType{model.EventTypeDeleted}},
+		{ID: 3, EventTypes: []model.EventType{model.EventTypeCreated}},
+		{ID: 4, Disabled: true},
+	}
+	repo.EXPECT().List(ctx).Return(webhooks, nil)
+
+	var got []int
+	deliveryRepo.EXPECT().Create(ctx, gomock.Any()).Times(2).DoAndReturn(func(ctx context.Context, d *model.WebhookDelivery) (*model.WebhookDelivery, error) {
+		if d.Status != model.DeliveryStatusPending || d.EventType != model.EventTypeCreated {
+			t.Errorf("WebhookDeliveryRepository.Create() = %v, want pending created delivery", d)
+		}
+		got = append(got, d.WebhookID)
+		return d, nil
+	})
+
+	u := &WebhookUseCase{
+		Repo:         repo,
+		DeliveryRepo: deliveryRepo,
+	}
+
+	event := &model.ProgrammingLangEvent{Type: model.EventTypeCreated, Lang: &model.ProgrammingLang{ID: 1, Name: "Go"}}
+	if err := u.Enqueue(ctx, event); err != nil {
+		t.Fatal(err)
+	}
+	if want := []int{1, 3}; !reflect.DeepEqual(got, want) {
+		t.Errorf("WebhookUseCase.Enqueue() webhooks = %v, want %v", got, want)
+	}
+}
+
+func TestWebhookUseCase_DeliverDue(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	webhook := &model.Webhook{ID: 1, URL: "https://example.com/hooks", Secret: testWebhookSecret}
+
+	tests := []struct {
+		name       string
+		claimed    bool
+		statusCode int
+		sendErr    error
+		want       int
+		wantStatus model.DeliveryStatus
+		wantError  string
+	}{
+		{
+			name:       "2xxの応答の場合、succeededにすること",
+			claimed:    true,
+			statusCode: 200,
+			want:       1,
+			wantStatus: model.DeliveryStatusSucceeded,
+		},
+		{
+			name:       "送信に失敗した場合、理由を記録して再送を待つこと",
+			claimed:    true,
+			sendErr:    errors.New("connection refused"),
+			want:       1,
+			wantStatus: model.DeliveryStatusPending,
+			wantError:  "connection refused",
+		},
+		{
+			name:    "他のプロセスが送信する権利を先に得た場合、送信しないこと",
+			claimed: false,
+			want:    0,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			repo := mock_repository.NewMockWebhookRepository(ctrl)
+			deliveryRepo := mock_repository.NewMockWebhookDeliveryRepository(ctrl)
+			sender := mock_repository.NewMockWebhookSender(ctrl)
+			ctx := context.Background()
+
+			d := &model.WebhookDelivery{ID: 2, WebhookID: 1, EventType: model.EventTypeCreated, Payload: []byte(`{}`), Status: model.DeliveryStatusPending}
+			deliveryRepo.EXPECT().ListDue(ctx, gomock.Any(), 10).Return([]*model.WebhookDelivery{d}, nil)
+			deliveryRepo.EXPECT().Claim(ctx, d, gomock.Any()).Return(tt.claimed, nil)
+			if tt.claimed {
+				repo.EXPECT().Read(ctx, 1).Return(webhook, nil)
+				sender.EXPECT().Send(ctx, gomock.Any()).DoAndReturn(func(ctx context.Context, req *model.WebhookRequest) (int, error) {
+					if req.URL != webhook.URL || req.Headers[service.WebhookSignatureHeader] == "" {
+						t.Errorf("WebhookSender.Send() request = %v, want signed request to %v", req, webhook.URL)
+					}
+					return tt.statusCode, tt.sendErr
+				})
+				deliveryRepo.EXPECT().CreateAttempt(ctx, gomock.Any()).DoAndReturn(func(ctx context.Context, a *model.DeliveryAttempt) (*model.DeliveryAttempt, error) {
+					if a.Attempt != 1 || a.Error != tt.wantError {
+						t.Errorf("WebhookDeliveryRepository.CreateAttempt() = %v", a)
+					}
+					return a, nil
+				})
+				deliveryRepo.EXPECT().Update(ctx, d).Return(d, nil)
+			}
+
+			u := &WebhookUseCase{
+				Repo:         repo,
+				DeliveryRepo: deliveryRepo,
+				Sender:       sender,
+			}
+
+			got, err := u.DeliverDue(ctx, 10)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if got != tt.want {
+				t.Errorf("WebhookUseCase.DeliverDue() = %v, want %v", got, tt.want)
+			}
+			if tt.claimed && (d.Status != tt.wantStatus || d.Attempts != 1 || d.LastError != tt.wantError) {
+				t.Errorf("WebhookUseCase.DeliverDue() delivery = %v, want status %v", d, tt.wantStatus)
+			}
+		})
+	}
+}
+
+func TestWebhookUseCase_PurgeDeliveries(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	deliveryRepo := mock_repository.NewMockWebhookDeliveryRepository(ctrl)
+	ctx := context.Background()
+
+	tests := []struct {
+		name      string
+		retention time.Duration
+		setup     func()
+		want      int
+		wantErr   bool
+	}{
+		{
+			name:      "保持期間を指定した場合、保持期間より前に終えた通知を削除し、削除した件数を返すこと",
+			retention: 24 * time.Hour,
+			setup: func() {
+				deliveryRepo.EXPECT().DeleteFinishedBefore(ctx, gomock.Any()).DoAndReturn(func(ctx context.Context, before time.Time) (int, error) {
+					if d := time.Since(before); d < 24*time.Hour || d > 25*time.Hour {
+						t.Errorf("before = %v, want 24 hours ago", before)
+					}
+					return 2, nil
+				})
+			},
+			want: 2,
+		},
+		{
+			name:      "保持期間が0以下の場合、削除せずにエラーを返すこと",
+			retention: 0,
+			setup:     func() {},
+			wantErr:   true,
+		},
+		{
+			name:      "DBのエラーが発生した場合、エラーを返すこと",
+			retention: time.Hour,
+			setup: func() {
+				deliveryRepo.EXPECT().DeleteFinishedBefore(ctx, gomock.Any()).Return(0, errors.New(model.TestDBSomeErr))
+			},
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tt.setup()
+
+			u := &WebhookUseCase{
+				DeliveryRepo: deliveryRepo,
+			}
+
+			got, err := u.PurgeDeliveries(ctx, tt.retention)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("WebhookUseCase.PurgeDeliveries() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+			if got != tt.want {
+				t.Errorf("WebhookUseCase.PurgeDeliveries() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}